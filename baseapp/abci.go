@@ -218,13 +218,15 @@ func (app *BaseApp) CheckTx(ctx context.Context, req *abci.RequestCheckTx) (*abc
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	sdkCtx := app.getContextForTx(mode, req.Tx)
+	// the tx checksum keys the signature verifications the ante handler may reuse in DeliverTx
+	checksum := sha256.Sum256(req.Tx)
+	sdkCtx := app.getContextForTx(mode, req.Tx).WithTxSum(checksum)
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
 		res := sdkerrors.ResponseCheckTx(err, 0, 0, app.trace)
 		return &abci.ResponseCheckTxV2{ResponseCheckTx: &res}, err
 	}
-	gInfo, result, _, priority, pendingTxChecker, expireTxHandler, txCtx, err := app.runTx(sdkCtx, mode, tx, sha256.Sum256(req.Tx))
+	gInfo, result, _, priority, pendingTxChecker, expireTxHandler, txCtx, err := app.runTx(sdkCtx, mode, tx, checksum)
 	if err != nil {
 		res := sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 		return &abci.ResponseCheckTxV2{ResponseCheckTx: &res}, err
@@ -257,13 +259,25 @@ func (app *BaseApp) DeliverTxBatch(ctx sdk.Context, req sdk.DeliverTxBatchReques
 		return sdk.DeliverTxBatchResponse{Results: responses}
 	}
 
+	app.verifyTxBatchSigs(ctx, req.TxEntries)
+
+	app.estimateTxBatchDependencies(ctx, req.TxEntries)
+
+	if app.occReplayEnabled {
+		return app.deliverTxBatchWithReplay(ctx, req)
+	}
+
 	// avoid overhead for empty batches
-	scheduler := tasks.NewScheduler(app.concurrencyWorkers, app.TracingInfo, app.DeliverTx)
+	scheduler := app.newScheduler()
+	app.beginAccessObserverBatch()
 	txRes, err := scheduler.ProcessAll(ctx, req.TxEntries)
 	if err != nil {
 		ctx.Logger().Error("error while processing scheduler", "err", err)
 		panic(err)
 	}
+	app.endAccessObserverBatch(true)
+	app.occReports.Add(scheduler.Report())
+	app.listenDeliverTxBatch(req.TxEntries, txRes, scheduler.Writesets())
 	for _, tx := range txRes {
 		responses = append(responses, &sdk.DeliverTxResult{Response: tx})
 	}
@@ -271,20 +285,109 @@ func (app *BaseApp) DeliverTxBatch(ctx sdk.Context, req sdk.DeliverTxBatchReques
 	return sdk.DeliverTxBatchResponse{Results: responses}
 }
 
+// verifyTxBatchSigs verifies the signatures of all the txs of the batch with the tx sig verifier, so that
+// the ante handlers executed by the scheduler don't verify them one tx at a time.
+func (app *BaseApp) verifyTxBatchSigs(ctx sdk.Context, entries []*sdk.DeliverTxEntry) {
+	if app.txSigVerifier == nil {
+		return
+	}
+	txs := make([]sdk.Tx, 0, len(entries))
+	checksums := make([][32]byte, 0, len(entries))
+	for _, entry := range entries {
+		if entry.SdkTx == nil {
+			continue
+		}
+		txs = append(txs, entry.SdkTx)
+		checksums = append(checksums, entry.Checksum)
+	}
+	app.txSigVerifier.VerifyTxs(ctx, txs, checksums)
+}
+
+// TxDependencyEstimator returns, for the position of every tx in txs, the positions of the txs it is expected to
+// depend on, e.g. the accesscontrol keeper's GenerateEstimatedTxDependencies.
+type TxDependencyEstimator func(ctx sdk.Context, txs []sdk.Tx) (map[int][]int, error)
+
+// estimateTxBatchDependencies sets the estimated dependencies of the txs of the batch with the tx dependency
+// estimator. The estimates only delay txs in the scheduler, so the batch is executed without them if they fail.
+func (app *BaseApp) estimateTxBatchDependencies(ctx sdk.Context, entries []*sdk.DeliverTxEntry) {
+	if app.txDependencyEstimator == nil {
+		return
+	}
+	txEntries := make([]*sdk.DeliverTxEntry, 0, len(entries))
+	txs := make([]sdk.Tx, 0, len(entries))
+	for _, entry := range entries {
+		if entry.SdkTx == nil {
+			continue
+		}
+		txEntries = append(txEntries, entry)
+		txs = append(txs, entry.SdkTx)
+	}
+	txDependencies, err := app.txDependencyEstimator(ctx, txs)
+	if err != nil {
+		ctx.Logger().Error("failed to estimate tx dependencies", "err", err)
+		return
+	}
+	tasks.SetEstimatedDependencies(txEntries, txDependencies)
+}
+
+// deliverTxBatchWithReplay executes the batch with the OCC scheduler and sequentially on separate branches
+// of the block state, and logs the txs and keys for which the two executions diverge. The results of the
+// OCC execution are kept either way, so that enabling the replay doesn't change the resulting app hash.
+// Since every tx is executed twice, DeliverTx hooks are called twice as well, while the streaming listeners
+// only get the results of the OCC execution.
+func (app *BaseApp) deliverTxBatchWithReplay(ctx sdk.Context, req sdk.DeliverTxBatchRequest) sdk.DeliverTxBatchResponse {
+	occStore, occWrites := tasks.BranchWithWriteRecorder(ctx.MultiStore())
+	scheduler := app.newScheduler()
+	app.beginAccessObserverBatch()
+	occRes, err := scheduler.ProcessAll(ctx.WithMultiStore(occStore), req.TxEntries)
+	if err != nil {
+		ctx.Logger().Error("error while processing scheduler", "err", err)
+		panic(err)
+	}
+	app.endAccessObserverBatch(true)
+	app.occReports.Add(scheduler.Report())
+
+	// the sequential execution is discarded, so are its access observations
+	seqStore, seqWrites := tasks.BranchWithWriteRecorder(ctx.MultiStore())
+	seqCtx := ctx.WithMultiStore(seqStore)
+	seqRes := make([]abci.ResponseDeliverTx, 0, len(req.TxEntries))
+	app.beginAccessObserverBatch()
+	for _, entry := range req.TxEntries {
+		seqRes = append(seqRes, app.deliverTx(seqCtx.WithTxIndex(entry.AbsoluteIndex), entry.Request, entry.SdkTx, entry.Checksum))
+	}
+	app.endAccessObserverBatch(false)
+
+	if divergence := tasks.CompareExecutions(req.TxEntries, occRes, seqRes, occWrites, seqWrites); !divergence.Empty() {
+		telemetry.IncrCounter(1, "occ", "replay", "divergence")
+		ctx.Logger().Error("occ replay diverged from sequential execution", "height", ctx.BlockHeight(), "txs", divergence.TxIndexes(), "keys", len(divergence.Keys))
+		ctx.Logger().Error(divergence.String())
+	}
+	occStore.Write()
+	app.listenDeliverTxBatch(req.TxEntries, occRes, scheduler.Writesets())
+
+	responses := make([]*sdk.DeliverTxResult, 0, len(occRes))
+	for _, tx := range occRes {
+		responses = append(responses, &sdk.DeliverTxResult{Response: tx})
+	}
+	return sdk.DeliverTxBatchResponse{Results: responses}
+}
+
 // DeliverTx implements the ABCI interface and executes a tx in DeliverTx mode.
 // State only gets persisted if all messages are valid and get executed successfully.
 // Otherwise, the ResponseDeliverTx will contain relevant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
 func (app *BaseApp) DeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res abci.ResponseDeliverTx) {
-	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
 	defer func() {
-		for _, streamingListener := range app.abciListeners {
-			if err := streamingListener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
-				app.logger.Error("DeliverTx listening hook failed", "err", err)
-			}
-		}
+		app.listenDeliverTx(req, res)
 	}()
+	return app.deliverTx(ctx, req, tx, checksum)
+}
+
+// deliverTx executes a tx in DeliverTx mode like DeliverTx, without calling the streaming service hooks. The txs
+// executed by the OCC scheduler are streamed once their batch is validated, since their executions can be discarded.
+func (app *BaseApp) deliverTx(ctx sdk.Context, req abci.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res abci.ResponseDeliverTx) {
+	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
 
 	gInfo := sdk.GasInfo{}
 	resultStr := "successful"
@@ -331,6 +434,46 @@ func (app *BaseApp) DeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, tx sdk
 	return
 }
 
+// newScheduler creates the OCC scheduler of a batch, which records the final writesets of the txs if a streaming
+// service streams them.
+func (app *BaseApp) newScheduler() tasks.Scheduler {
+	var opts []tasks.SchedulerOption
+	for _, streamingListener := range app.abciListeners {
+		if _, ok := streamingListener.(OCCListener); ok {
+			opts = append(opts, tasks.WithWritesets())
+			break
+		}
+	}
+	return tasks.NewScheduler(app.concurrencyWorkers, app.TracingInfo, app.deliverTx, opts...)
+}
+
+// listenDeliverTx calls the streaming service hooks with the DeliverTx messages
+func (app *BaseApp) listenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("DeliverTx listening hook failed", "err", err)
+		}
+	}
+}
+
+// listenDeliverTxBatch calls the streaming service hooks with the DeliverTx messages of a batch executed by the OCC
+// scheduler in tx order. The OCC listeners get the final writesets of the txs as well.
+func (app *BaseApp) listenDeliverTxBatch(entries []*sdk.DeliverTxEntry, responses []abci.ResponseDeliverTx, writesets []tasks.TxWriteset) {
+	for i, entry := range entries {
+		for _, streamingListener := range app.abciListeners {
+			var err error
+			if occListener, ok := streamingListener.(OCCListener); ok && writesets != nil {
+				err = occListener.ListenDeliverTxWriteset(app.deliverState.ctx, entry.Request, responses[i], writesets[i])
+			} else {
+				err = streamingListener.ListenDeliverTx(app.deliverState.ctx, entry.Request, responses[i])
+			}
+			if err != nil {
+				app.logger.Error("DeliverTx listening hook failed", "err", err)
+			}
+		}
+	}
+}
+
 func (app *BaseApp) WriteState() sdk.CommitMultiStore {
 	app.stateToCommit.ms.Write()
 	return app.cms
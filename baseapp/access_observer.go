@@ -0,0 +1,182 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+)
+
+// AccessDependencyGetter returns the access operations declared for a message, e.g. the accesscontrol keeper's
+// GetMessageDependencies
+type AccessDependencyGetter func(ctx sdk.Context, msg sdk.Msg) []acltypes.AccessOperation
+
+// accessObserver compares the store accesses of the messages executed in DeliverTx with their declared access
+// operations and aggregates the mismatches in a report
+type accessObserver struct {
+	validator       *acltypes.MsgValidator
+	getDependencies AccessDependencyGetter
+	report          *acltypes.ValidationReport
+
+	batchMtx sync.Mutex
+	batch    map[int][]observation // the observations of the txs of the batch being executed by tx index, if any
+
+	traceMtx sync.Mutex
+	trace    io.Writer // writes an acltypes.AccessTrace JSON line per observed message, if set
+}
+
+// observation is the outcome of observing a message, recorded in the report and the trace
+type observation struct {
+	ctx        sdk.Context
+	msg        sdk.Msg
+	messageKey string
+	accesses   []acltypes.Comparator
+	mismatches []acltypes.AccessMismatch
+}
+
+// beginBatch buffers the observations of the txs executed until endBatch, so that only the observations of the
+// last execution of every tx are recorded. The txs of a batch are executed again by OCC when they conflict.
+func (o *accessObserver) beginBatch() {
+	o.batchMtx.Lock()
+	defer o.batchMtx.Unlock()
+	o.batch = make(map[int][]observation)
+}
+
+// endBatch records the buffered observations in the order of the txs if record is set, and discards them otherwise
+func (o *accessObserver) endBatch(record bool) {
+	o.batchMtx.Lock()
+	batch := o.batch
+	o.batch = nil
+	o.batchMtx.Unlock()
+	if !record {
+		return
+	}
+	txIndexes := make([]int, 0, len(batch))
+	for txIndex := range batch {
+		txIndexes = append(txIndexes, txIndex)
+	}
+	sort.Ints(txIndexes)
+	for _, txIndex := range txIndexes {
+		for _, obs := range batch[txIndex] {
+			o.record(obs)
+		}
+	}
+}
+
+// startTx discards the observations buffered for a previous execution of the tx of the context
+func (o *accessObserver) startTx(ctx sdk.Context) {
+	o.batchMtx.Lock()
+	defer o.batchMtx.Unlock()
+	if o.batch != nil {
+		delete(o.batch, ctx.TxIndex())
+	}
+}
+
+// observe compares the accesses recorded for a message with its declared access operations, and returns an event
+// per mismatch. The dependencies are looked up with depsCtx, which must not track the reads of the tx. The mismatches
+// are added to the report, once the execution of the tx is final if it's executed in a batch.
+func (o *accessObserver) observe(ctx sdk.Context, depsCtx sdk.Context, msg sdk.Msg, accesses []acltypes.Comparator) sdk.Events {
+	// looking up the dependencies must not consume the gas of the tx, which would change its result on observing nodes
+	accessOps := o.getDependencies(depsCtx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(depsCtx)), msg)
+	mismatches := o.validator.CompareAccessOperations(accessOps, accesses)
+	messageKey := proto.MessageName(msg)
+	obs := observation{ctx: ctx, msg: msg, messageKey: messageKey, accesses: accesses, mismatches: mismatches}
+	o.batchMtx.Lock()
+	if o.batch != nil {
+		o.batch[ctx.TxIndex()] = append(o.batch[ctx.TxIndex()], obs)
+		o.batchMtx.Unlock()
+	} else {
+		o.batchMtx.Unlock()
+		o.record(obs)
+	}
+
+	events := make(sdk.Events, 0, len(mismatches))
+	for _, mismatch := range mismatches {
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(acltypes.AttributeKeyMessageKey, messageKey),
+			sdk.NewAttribute(acltypes.AttributeKeyMismatchType, mismatch.Type.String()),
+		}
+		if mismatch.Type == acltypes.MismatchType_OVERBROAD_UNKNOWN {
+			attributes = append(attributes,
+				sdk.NewAttribute(acltypes.AttributeKeyResourceType, mismatch.AccessOp.ResourceType.String()),
+				sdk.NewAttribute(acltypes.AttributeKeyIdentifier, mismatch.AccessOp.IdentifierTemplate),
+				sdk.NewAttribute(acltypes.AttributeKeyAccessType, mismatch.AccessOp.AccessType.String()),
+			)
+		} else {
+			attributes = append(attributes,
+				sdk.NewAttribute(acltypes.AttributeKeyStoreKey, mismatch.Access.StoreKey),
+				sdk.NewAttribute(acltypes.AttributeKeyIdentifier, mismatch.Access.Identifier),
+				sdk.NewAttribute(acltypes.AttributeKeyAccessType, mismatch.Access.AccessType.String()),
+			)
+		}
+		events = append(events, sdk.NewEvent(acltypes.EventTypeAccessMismatch, attributes...))
+	}
+	return events
+}
+
+// record adds the mismatches of an observation to the report and writes its trace
+func (o *accessObserver) record(obs observation) {
+	o.report.Add(obs.messageKey, obs.ctx.BlockHeight(), obs.mismatches)
+	o.writeTrace(obs.ctx, obs.msg, obs.messageKey, obs.accesses)
+}
+
+func (o *accessObserver) writeTrace(ctx sdk.Context, msg sdk.Msg, messageKey string, accesses []acltypes.Comparator) {
+	o.traceMtx.Lock()
+	defer o.traceMtx.Unlock()
+	if o.trace == nil {
+		return
+	}
+	var contractAddress string
+	if contractMsg, ok := msg.(interface{ GetContract() string }); ok {
+		contractAddress = contractMsg.GetContract()
+	}
+	line, err := json.Marshal(o.validator.NewAccessTrace(ctx.BlockHeight(), messageKey, contractAddress, accesses))
+	if err == nil {
+		_, err = o.trace.Write(append(line, '\n'))
+	}
+	if err != nil {
+		ctx.Logger().Error("failed to write access trace", "message", messageKey, "err", err)
+	}
+}
+
+// closeTrace closes the trace writer if it is an io.Closer, and stops writing the trace
+func (o *accessObserver) closeTrace() error {
+	o.traceMtx.Lock()
+	defer o.traceMtx.Unlock()
+	closer, ok := o.trace.(io.Closer)
+	o.trace = nil
+	if !ok {
+		return nil
+	}
+	return closer.Close()
+}
+
+// beginAccessObserverBatch starts buffering the access observations of a batch of txs, if the access observer is
+// enabled
+func (app *BaseApp) beginAccessObserverBatch() {
+	if app.accessObserver != nil {
+		app.accessObserver.beginBatch()
+	}
+}
+
+// endAccessObserverBatch records or discards the access observations of a batch of txs, if the access observer is
+// enabled
+func (app *BaseApp) endAccessObserverBatch(record bool) {
+	if app.accessObserver != nil {
+		app.accessObserver.endBatch(record)
+	}
+}
+
+// AccessValidationReport returns the report of the access mismatches observed for the executed messages, or nil if
+// the access observer isn't enabled.
+func (app *BaseApp) AccessValidationReport() *acltypes.ValidationReport {
+	if app.accessObserver == nil {
+		return nil
+	}
+	return app.accessObserver.report
+}
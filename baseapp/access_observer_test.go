@@ -0,0 +1,69 @@
+package baseapp
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+)
+
+func TestAccessObserverBatch(t *testing.T) {
+	var depsHeights []int64
+	trace := new(bytes.Buffer)
+	observer := &accessObserver{
+		validator: acltypes.NewMsgValidator(acltypes.StoreKeyToResourceTypePrefixMap{}),
+		getDependencies: func(ctx sdk.Context, msg sdk.Msg) []acltypes.AccessOperation {
+			depsHeights = append(depsHeights, ctx.BlockHeight())
+			return nil
+		},
+		report: acltypes.NewValidationReport(),
+		trace:  trace,
+	}
+	msg := &testdata.TestMsg{}
+	messageKey := proto.MessageName(msg)
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 10}, false, log.NewNopLogger())
+	depsCtx := ctx.WithBlockHeight(9)
+	executions := func() uint64 {
+		report, _ := observer.report.Get(messageKey)
+		return report.Executions
+	}
+
+	// the observations of a tx outside of a batch are recorded right away
+	observer.observe(ctx, depsCtx, msg, nil)
+	require.Equal(t, uint64(1), executions())
+	require.Equal(t, []int64{9}, depsHeights)
+
+	// only the last execution of every tx of a batch is recorded, once the batch ends
+	observer.beginBatch()
+	for _, txIndex := range []int{0, 1, 0} {
+		txCtx := ctx.WithTxIndex(txIndex)
+		observer.startTx(txCtx)
+		observer.observe(txCtx, depsCtx, msg, nil)
+		observer.observe(txCtx, depsCtx, msg, nil)
+	}
+	require.Equal(t, uint64(1), executions())
+	observer.endBatch(true)
+	require.Equal(t, uint64(5), executions())
+
+	// a tx whose last execution doesn't reach its messages isn't recorded
+	observer.beginBatch()
+	observer.startTx(ctx)
+	observer.observe(ctx, depsCtx, msg, nil)
+	observer.startTx(ctx)
+	observer.endBatch(true)
+	require.Equal(t, uint64(5), executions())
+
+	// the observations of a discarded batch are not recorded
+	observer.beginBatch()
+	observer.observe(ctx, depsCtx, msg, nil)
+	observer.endBatch(false)
+	require.Equal(t, uint64(5), executions())
+	require.Equal(t, 5, bytes.Count(trace.Bytes(), []byte("\n")))
+}
@@ -29,6 +29,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/tasks"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
@@ -57,9 +58,15 @@ const (
 	FlagArchivalArweaveIndexDBFullPath = "archival-arweave-index-db-full-path"
 	FlagArchivalArweaveNodeURL         = "archival-arweave-node-url"
 
-	FlagChainID            = "chain-id"
-	FlagConcurrencyWorkers = "concurrency-workers"
-	FlagOccEnabled         = "occ-enabled"
+	FlagChainID                 = "chain-id"
+	FlagConcurrencyWorkers      = "concurrency-workers"
+	FlagOccEnabled              = "occ-enabled"
+	FlagOccReportBufferSize     = "occ-report-buffer-size"
+	FlagOccReplayEnabled        = "occ-replay-enabled"
+	FlagOccEstimateDependencies = "occ-estimate-dependencies"
+
+	FlagAccessObserverEnabled   = "access-observer-enabled"
+	FlagAccessObserverTraceFile = "access-observer-trace-file"
 )
 
 var (
@@ -91,7 +98,9 @@ type BaseApp struct { //nolint: maligned
 	prepareProposalHandler sdk.PrepareProposalHandler
 	processProposalHandler sdk.ProcessProposalHandler
 	finalizeBlocker        sdk.FinalizeBlocker
-	anteHandler            sdk.AnteHandler // ante handler for fee and auth
+	anteHandler            sdk.AnteHandler       // ante handler for fee and auth
+	txSigVerifier          sdk.TxSigVerifier     // verifies tx signatures ahead of the ante handler
+	txDependencyEstimator  TxDependencyEstimator // estimates the dependencies of the txs of a batch
 	loadVersionHandler     sdk.LoadVersionHandler
 	preCommitHandler       sdk.PreCommitHandler
 	closeHandler           sdk.CloseHandler
@@ -174,6 +183,10 @@ type BaseApp struct { //nolint: maligned
 
 	concurrencyWorkers int
 	occEnabled         bool
+	occReports         *tasks.ReportBuffer
+	occReplayEnabled   bool
+
+	accessObserver *accessObserver
 
 	deliverTxHooks []DeliverTxHook
 }
@@ -225,6 +238,7 @@ type snapshotData struct {
 	snapshotInterval   uint64 // block interval between state sync snapshots
 	snapshotKeepRecent uint32 // recent state sync snapshots to keep
 	snapshotDirectory  string //  state sync snapshots directory
+	snapshotFormat     uint32 // format of the state sync snapshots taken, 0 for the current format
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -314,6 +328,13 @@ func NewBaseApp(
 	if app.concurrencyWorkers == 0 {
 		app.concurrencyWorkers = config.DefaultConcurrencyWorkers
 	}
+	if app.occReports == nil {
+		reportBufferSize := config.DefaultOccReportBufferSize
+		if appOpts.Get(FlagOccReportBufferSize) != nil {
+			reportBufferSize = cast.ToInt(appOpts.Get(FlagOccReportBufferSize))
+		}
+		app.occReports = tasks.NewReportBuffer(reportBufferSize)
+	}
 
 	return app
 }
@@ -338,6 +359,16 @@ func (app *BaseApp) OccEnabled() bool {
 	return app.occEnabled
 }
 
+// OccReplayEnabled returns whether OCC batches are cross-checked against a sequential execution.
+func (app *BaseApp) OccReplayEnabled() bool {
+	return app.occReplayEnabled
+}
+
+// OccReports returns the execution reports of the most recent batches processed by the OCC scheduler.
+func (app *BaseApp) OccReports() *tasks.ReportBuffer {
+	return app.occReports
+}
+
 // Version returns the application's version string.
 func (app *BaseApp) Version() string {
 	return app.version
@@ -915,6 +946,10 @@ func (app *BaseApp) runTx(ctx sdk.Context, mode runTxMode, tx sdk.Tx, checksum [
 		return sdk.GasInfo{}, nil, nil, 0, nil, nil, ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx decode error")
 	}
 
+	if app.accessObserver != nil && mode == runTxModeDeliver {
+		app.accessObserver.startTx(ctx)
+	}
+
 	msgs := tx.GetMsgs()
 
 	if err := validateBasicTxMsgs(msgs); err != nil {
@@ -1091,6 +1126,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 
 		msgCtx, msgMsCache := app.cacheTxContext(ctx, [32]byte{})
 		msgCtx = msgCtx.WithMessageIndex(i)
+		var accessRecorder *acltypes.AccessRecorder
+		if app.accessObserver != nil && mode == runTxModeDeliver {
+			accessRecorder = acltypes.NewAccessRecorder()
+			msgCtx = msgCtx.WithAccessRecorder(accessRecorder)
+		}
 
 		startTime := time.Now()
 		if handler := app.msgServiceRouter.Handler(msg); handler != nil {
@@ -1132,6 +1172,12 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, eventMsgName)),
 		}
 		msgEvents = msgEvents.AppendEvents(msgResult.GetEvents())
+		if accessRecorder != nil {
+			// the dependencies are read from a branch of the block state, so that the reads are not tracked as the
+			// reads of the tx by OCC
+			depsCtx := ctx.WithMultiStore(app.deliverState.ctx.MultiStore().CacheMultiStore())
+			msgEvents = msgEvents.AppendEvents(app.accessObserver.observe(ctx, depsCtx, msg, accessRecorder.Accesses()))
+		}
 
 		// append message events, data and logs
 		//
@@ -1220,6 +1266,11 @@ func (app *BaseApp) Close() error {
 	if err := app.snapshotManager.Close(); err != nil {
 		return err
 	}
+	if app.accessObserver != nil {
+		if err := app.accessObserver.closeTrace(); err != nil {
+			return err
+		}
+	}
 	if app.closeHandler == nil {
 		return nil
 	}
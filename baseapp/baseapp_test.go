@@ -145,6 +145,11 @@ func TestSetOccEnabled(t *testing.T) {
 	require.True(t, app.OccEnabled())
 }
 
+func TestSetOccReplayEnabled(t *testing.T) {
+	app := newBaseApp(t.Name(), SetOccReplayEnabled(true))
+	require.True(t, app.OccReplayEnabled())
+}
+
 // func TestGetMaximumBlockGas(t *testing.T) {
 // 	app := setupBaseApp(t)
 // 	app.InitChain(context.Background(), &abci.RequestInitChain{})
@@ -17,6 +17,14 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	circuitBreaker    CircuitBreaker
+}
+
+// CircuitBreaker decides whether the messages of a type URL can be executed. It is consulted by
+// the MsgServiceRouter before routing any message, including the messages executed by other
+// messages, e.g. authz.MsgExec, through the router.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, typeURL string) (bool, error)
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -107,6 +115,16 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 		}
 
 		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			if msr.circuitBreaker != nil {
+				allowed, err := msr.circuitBreaker.IsAllowed(ctx, requestTypeName)
+				if err != nil {
+					return nil, err
+				}
+				if !allowed {
+					return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is disabled by the circuit breaker", requestTypeName)
+				}
+			}
+
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
@@ -138,6 +156,11 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 	}
 }
 
+// SetCircuit sets the circuit breaker consulted before routing any message.
+func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
+	msr.circuitBreaker = cb
+}
+
 // SetInterfaceRegistry sets the interface registry for the router.
 func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
 	msr.interfaceRegistry = interfaceRegistry
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -16,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -69,6 +71,46 @@ func TestRegisterMsgServiceTwice(t *testing.T) {
 	})
 }
 
+type mockCircuitBreaker map[string]bool
+
+func (cb mockCircuitBreaker) IsAllowed(_ sdk.Context, typeURL string) (bool, error) {
+	return !cb[typeURL], nil
+}
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	db := dbm.NewMemDB()
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := baseapp.NewBaseApp("test", log.NewTestingLogger(t), db, encCfg.TxConfig.TxDecoder(), nil, &testutil.TestAppOpts{})
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+
+	// without a circuit breaker every message is routed
+	_, err := handler(ctx, msg)
+	require.NoError(t, err)
+
+	circuitBreaker := mockCircuitBreaker{}
+	app.MsgServiceRouter().SetCircuit(circuitBreaker)
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+
+	circuitBreaker[sdk.MsgTypeURL(msg)] = true
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	delete(circuitBreaker, sdk.MsgTypeURL(msg))
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+}
+
 func TestMsgService(t *testing.T) {
 	priv, _, _ := testdata.KeyTestPubAddr()
 	encCfg := simapp.MakeTestEncodingConfig()
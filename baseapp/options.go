@@ -9,7 +9,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/tasks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
 )
 
 // File for storing in-package BaseApp optional functions,
@@ -90,11 +92,26 @@ func SetOccEnabled(occEnabled bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetOccEnabled(occEnabled) }
 }
 
+// SetOccReplayEnabled cross-checks every OCC batch against a sequential execution, for debugging only
+func SetOccReplayEnabled(occReplayEnabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetOccReplayEnabled(occReplayEnabled) }
+}
+
+// SetOccReportBufferSize sets the number of OCC execution reports to keep in memory.
+func SetOccReportBufferSize(size int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetOccReportBufferSize(size) }
+}
+
 // SetSnapshotKeepRecent sets the recent snapshots to keep.
 func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotKeepRecent(keepRecent) }
 }
 
+// SetSnapshotFormat sets the format of the snapshots taken.
+func SetSnapshotFormat(format uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotFormat(format) }
+}
+
 // SetSnapshotDirectory sets the snapshot directory.
 func SetSnapshotDirectory(dir string) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotDirectory(dir) }
@@ -247,6 +264,26 @@ func (app *BaseApp) SetAnteDepGenerator(adg sdk.AnteDepGenerator) {
 	app.anteDepGenerator = adg
 }
 
+// SetTxSigVerifier sets the verifier that checks the signatures of the txs of DeliverTxBatch, all at once,
+// before their ante handlers run.
+func (app *BaseApp) SetTxSigVerifier(v sdk.TxSigVerifier) {
+	if app.sealed {
+		panic("SetTxSigVerifier() on sealed BaseApp")
+	}
+
+	app.txSigVerifier = v
+}
+
+// SetTxDependencyEstimator sets the estimator of the dependencies between the txs of DeliverTxBatch, which the
+// scheduler uses to delay txs until the txs they are expected to conflict with have validated.
+func (app *BaseApp) SetTxDependencyEstimator(estimator TxDependencyEstimator) {
+	if app.sealed {
+		panic("SetTxDependencyEstimator() on sealed BaseApp")
+	}
+
+	app.txDependencyEstimator = estimator
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -304,6 +341,7 @@ func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
 		return
 	}
 	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms, app.logger)
+	app.setSnapshotManagerFormat()
 }
 
 // SetSnapshotInterval sets the snapshot interval.
@@ -328,6 +366,45 @@ func (app *BaseApp) SetOccEnabled(occEnabled bool) {
 	app.occEnabled = occEnabled
 }
 
+func (app *BaseApp) SetOccReplayEnabled(occReplayEnabled bool) {
+	if app.sealed {
+		panic("SetOccReplayEnabled() on sealed BaseApp")
+	}
+	app.occReplayEnabled = occReplayEnabled
+}
+
+func (app *BaseApp) SetOccReportBufferSize(size int) {
+	if app.sealed {
+		panic("SetOccReportBufferSize() on sealed BaseApp")
+	}
+	app.occReports = tasks.NewReportBuffer(size)
+}
+
+// SetAccessObserver records the store accesses of the messages executed in DeliverTx and compares them with the
+// access operations returned by getDependencies. The mismatches are emitted as events and aggregated in the report.
+// It doesn't change the execution of the messages.
+func (app *BaseApp) SetAccessObserver(validator *acltypes.MsgValidator, getDependencies AccessDependencyGetter, report *acltypes.ValidationReport) {
+	if app.sealed {
+		panic("SetAccessObserver() on sealed BaseApp")
+	}
+	app.accessObserver = &accessObserver{
+		validator:       validator,
+		getDependencies: getDependencies,
+		report:          report,
+	}
+}
+
+// SetAccessTrace writes the store accesses of every message observed by the access observer to w, as JSON lines
+// that can be turned into dependency mapping proposals. w is closed with the app if it is an io.Closer.
+func (app *BaseApp) SetAccessTrace(w io.Writer) {
+	if app.accessObserver == nil {
+		panic("SetAccessTrace() without an access observer")
+	}
+	app.accessObserver.traceMtx.Lock()
+	defer app.accessObserver.traceMtx.Unlock()
+	app.accessObserver.trace = w
+}
+
 // SetSnapshotKeepRecent sets the number of recent snapshots to keep.
 func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	if app.sealed {
@@ -336,6 +413,26 @@ func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	app.snapshotKeepRecent = snapshotKeepRecent
 }
 
+// SetSnapshotFormat sets the format of the snapshots taken, which is snapshottypes.CurrentFormat by default or
+// for 0. The multistore must implement snapshottypes.ChunkRestorer to take snapshots in the StoreChunksFormat.
+func (app *BaseApp) SetSnapshotFormat(format uint32) {
+	if app.sealed {
+		panic("SetSnapshotFormat() on sealed BaseApp")
+	}
+	app.snapshotFormat = format
+	app.setSnapshotManagerFormat()
+}
+
+// setSnapshotManagerFormat applies the snapshot format to the snapshot manager, if both are set.
+func (app *BaseApp) setSnapshotManagerFormat() {
+	if app.snapshotManager == nil || app.snapshotFormat == 0 {
+		return
+	}
+	if err := app.snapshotManager.SetSnapshotFormat(app.snapshotFormat); err != nil {
+		panic(err)
+	}
+}
+
 // SetSnapshotDirectory sets the snapshot directory.
 func (app *BaseApp) SetSnapshotDirectory(dir string) {
 	if app.sealed {
@@ -7,6 +7,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	store "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/tasks"
 	"github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -20,6 +21,13 @@ type ABCIListener interface {
 	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
 }
 
+// OCCListener is implemented by the ABCI listeners that stream the final writesets of the txs executed by the OCC
+// scheduler. ListenDeliverTxWriteset is called instead of ListenDeliverTx for those txs once their batch is
+// validated, in tx order, so that the writes of discarded incarnations are never streamed.
+type OCCListener interface {
+	ListenDeliverTxWriteset(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx, writeset tasks.TxWriteset) error
+}
+
 // StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
 type StreamingService interface {
 	// Stream is the streaming service loop, awaits kv pairs and writes them to some destination stream or file
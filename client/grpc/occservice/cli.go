@@ -0,0 +1,69 @@
+package occservice
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the parent command for the OCC queries
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "occ",
+		Short:                      "Querying commands for the OCC scheduler",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetReportCmd(),
+	)
+
+	return cmd
+}
+
+// GetReportCmd returns the OCC execution reports of a block, or of the latest block if no height is given
+func GetReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report [height]",
+		Short: "Query the OCC execution report of a block",
+		Long: `Query the OCC execution report of a block at the given height, or of the latest
+executed block if no height is given. Only the reports of the most recent blocks are kept
+by the node, see the occ-report-buffer-size option of app.toml.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := NewServiceClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.GetLatestBlockReport(cmd.Context(), &GetLatestBlockReportRequest{})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			res, err := queryClient.GetBlockReportByHeight(cmd.Context(), &GetBlockReportByHeightRequest{Height: height})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,2412 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/occ/v1beta1/query.proto
+
+package occservice
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GetLatestBlockReportRequest is the request type for the Query/GetLatestBlockReport RPC method.
+type GetLatestBlockReportRequest struct {
+}
+
+func (m *GetLatestBlockReportRequest) Reset()         { *m = GetLatestBlockReportRequest{} }
+func (m *GetLatestBlockReportRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLatestBlockReportRequest) ProtoMessage()    {}
+func (*GetLatestBlockReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c4cb0cafff27fa, []int{0}
+}
+func (m *GetLatestBlockReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLatestBlockReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLatestBlockReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLatestBlockReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLatestBlockReportRequest.Merge(m, src)
+}
+func (m *GetLatestBlockReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLatestBlockReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLatestBlockReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLatestBlockReportRequest proto.InternalMessageInfo
+
+// GetLatestBlockReportResponse is the response type for the Query/GetLatestBlockReport RPC method.
+type GetLatestBlockReportResponse struct {
+	// reports contains one report per batch of txs executed in the block.
+	Reports []*BlockReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (m *GetLatestBlockReportResponse) Reset()         { *m = GetLatestBlockReportResponse{} }
+func (m *GetLatestBlockReportResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLatestBlockReportResponse) ProtoMessage()    {}
+func (*GetLatestBlockReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c4cb0cafff27fa, []int{1}
+}
+func (m *GetLatestBlockReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLatestBlockReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLatestBlockReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLatestBlockReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLatestBlockReportResponse.Merge(m, src)
+}
+func (m *GetLatestBlockReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLatestBlockReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLatestBlockReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLatestBlockReportResponse proto.InternalMessageInfo
+
+func (m *GetLatestBlockReportResponse) GetReports() []*BlockReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+// GetBlockReportByHeightRequest is the request type for the Query/GetBlockReportByHeight RPC method.
+type GetBlockReportByHeightRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetBlockReportByHeightRequest) Reset()         { *m = GetBlockReportByHeightRequest{} }
+func (m *GetBlockReportByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockReportByHeightRequest) ProtoMessage()    {}
+func (*GetBlockReportByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c4cb0cafff27fa, []int{2}
+}
+func (m *GetBlockReportByHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockReportByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockReportByHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockReportByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockReportByHeightRequest.Merge(m, src)
+}
+func (m *GetBlockReportByHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockReportByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockReportByHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockReportByHeightRequest proto.InternalMessageInfo
+
+func (m *GetBlockReportByHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetBlockReportByHeightResponse is the response type for the Query/GetBlockReportByHeight RPC method.
+type GetBlockReportByHeightResponse struct {
+	// reports contains one report per batch of txs executed in the block.
+	Reports []*BlockReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (m *GetBlockReportByHeightResponse) Reset()         { *m = GetBlockReportByHeightResponse{} }
+func (m *GetBlockReportByHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockReportByHeightResponse) ProtoMessage()    {}
+func (*GetBlockReportByHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c4cb0cafff27fa, []int{3}
+}
+func (m *GetBlockReportByHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockReportByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockReportByHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockReportByHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockReportByHeightResponse.Merge(m, src)
+}
+func (m *GetBlockReportByHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockReportByHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockReportByHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockReportByHeightResponse proto.InternalMessageInfo
+
+func (m *GetBlockReportByHeightResponse) GetReports() []*BlockReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+// GetBlockReportsRequest is the request type for the Query/GetBlockReports RPC method.
+type GetBlockReportsRequest struct {
+}
+
+func (m *GetBlockReportsRequest) Reset()         { *m = GetBlockReportsRequest{} }
+func (m *GetBlockReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockReportsRequest) ProtoMessage()    {}
+func (*GetBlockReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c4cb0cafff27fa, []int{4}
+}
+func (m *GetBlockReportsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockReportsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockReportsRequest.Merge(m, src)
+}
+func (m *GetBlockReportsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockReportsRequest proto.InternalMessageInfo
+
+// GetBlockReportsResponse is the response type for the Query/GetBlockReports RPC method.
+type GetBlockReportsResponse struct {
+	// reports are ordered from the oldest to the latest.
+	Reports []*BlockReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (m *GetBlockReportsResponse) Reset()         { *m = GetBlockReportsResponse{} }
+func (m *GetBlockReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockReportsResponse) ProtoMessage()    {}
+func (*GetBlockReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c4cb0cafff27fa, []int{5}
+}
+func (m *GetBlockReportsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockReportsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockReportsResponse.Merge(m, src)
+}
+func (m *GetBlockReportsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockReportsResponse proto.InternalMessageInfo
+
+func (m *GetBlockReportsResponse) GetReports() []*BlockReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+// BlockReport describes how a batch of txs was executed by the OCC scheduler.
+type BlockReport struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// txs is the number of txs in the batch.
+	Txs int64 `protobuf:"varint,2,opt,name=txs,proto3" json:"txs,omitempty"`
+	// latency_ms is the time it took to execute the batch.
+	LatencyMs int64 `protobuf:"varint,3,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	// iterations is the number of execute and validate rounds.
+	Iterations int64 `protobuf:"varint,4,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// retries is the number of tx executions beyond the first one.
+	Retries int64 `protobuf:"varint,5,opt,name=retries,proto3" json:"retries,omitempty"`
+	// max_incarnation is the highest incarnation of any tx in the batch.
+	MaxIncarnation int64 `protobuf:"varint,6,opt,name=max_incarnation,json=maxIncarnation,proto3" json:"max_incarnation,omitempty"`
+	// synchronous is true if the batch fell back to fully sequential execution.
+	Synchronous bool `protobuf:"varint,7,opt,name=synchronous,proto3" json:"synchronous,omitempty"`
+	// parallel_executions is the number of tx executions that ran concurrently with other txs.
+	ParallelExecutions int64 `protobuf:"varint,8,opt,name=parallel_executions,json=parallelExecutions,proto3" json:"parallel_executions,omitempty"`
+	// sequential_executions is the number of tx executions that ran sequentially due to conflicts.
+	SequentialExecutions int64 `protobuf:"varint,9,opt,name=sequential_executions,json=sequentialExecutions,proto3" json:"sequential_executions,omitempty"`
+	// tx_reports contains the txs that had to be executed more than once.
+	TxReports []*TxReport `protobuf:"bytes,10,rep,name=tx_reports,json=txReports,proto3" json:"tx_reports,omitempty"`
+}
+
+func (m *BlockReport) Reset()         { *m = BlockReport{} }
+func (m *BlockReport) String() string { return proto.CompactTextString(m) }
+func (*BlockReport) ProtoMessage()    {}
+func (*BlockReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c4cb0cafff27fa, []int{6}
+}
+func (m *BlockReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockReport.Merge(m, src)
+}
+func (m *BlockReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockReport proto.InternalMessageInfo
+
+func (m *BlockReport) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockReport) GetTxs() int64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func (m *BlockReport) GetLatencyMs() int64 {
+	if m != nil {
+		return m.LatencyMs
+	}
+	return 0
+}
+
+func (m *BlockReport) GetIterations() int64 {
+	if m != nil {
+		return m.Iterations
+	}
+	return 0
+}
+
+func (m *BlockReport) GetRetries() int64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *BlockReport) GetMaxIncarnation() int64 {
+	if m != nil {
+		return m.MaxIncarnation
+	}
+	return 0
+}
+
+func (m *BlockReport) GetSynchronous() bool {
+	if m != nil {
+		return m.Synchronous
+	}
+	return false
+}
+
+func (m *BlockReport) GetParallelExecutions() int64 {
+	if m != nil {
+		return m.ParallelExecutions
+	}
+	return 0
+}
+
+func (m *BlockReport) GetSequentialExecutions() int64 {
+	if m != nil {
+		return m.SequentialExecutions
+	}
+	return 0
+}
+
+func (m *BlockReport) GetTxReports() []*TxReport {
+	if m != nil {
+		return m.TxReports
+	}
+	return nil
+}
+
+// TxReport describes the executions of a tx that had to be executed more than once.
+type TxReport struct {
+	// index is the absolute index of the tx in the block.
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// hash is the hex encoded sha256 hash of the tx bytes.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// incarnation is the final incarnation of the tx.
+	Incarnation int64 `protobuf:"varint,3,opt,name=incarnation,proto3" json:"incarnation,omitempty"`
+	// conflicts are the reasons the previous executions of the tx were discarded.
+	Conflicts []*Conflict `protobuf:"bytes,4,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (m *TxReport) Reset()         { *m = TxReport{} }
+func (m *TxReport) String() string { return proto.CompactTextString(m) }
+func (*TxReport) ProtoMessage()    {}
+func (*TxReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c4cb0cafff27fa, []int{7}
+}
+func (m *TxReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxReport.Merge(m, src)
+}
+func (m *TxReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxReport proto.InternalMessageInfo
+
+func (m *TxReport) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TxReport) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TxReport) GetIncarnation() int64 {
+	if m != nil {
+		return m.Incarnation
+	}
+	return 0
+}
+
+func (m *TxReport) GetConflicts() []*Conflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+// Conflict describes why an execution of a tx was discarded.
+type Conflict struct {
+	// store is the name of the store the conflict occurred in, empty if unknown.
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	// key is the conflicting key, empty if the conflict could not be attributed to a key,
+	// e.g. when an iterated range changed.
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// dependent_tx_index is the index of the tx that caused the conflict, -1 if unknown.
+	DependentTxIndex int64 `protobuf:"varint,3,opt,name=dependent_tx_index,json=dependentTxIndex,proto3" json:"dependent_tx_index,omitempty"`
+	// abort is true if the execution was aborted by reading an estimate, false if it failed validation.
+	Abort bool `protobuf:"varint,4,opt,name=abort,proto3" json:"abort,omitempty"`
+}
+
+func (m *Conflict) Reset()         { *m = Conflict{} }
+func (m *Conflict) String() string { return proto.CompactTextString(m) }
+func (*Conflict) ProtoMessage()    {}
+func (*Conflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35c4cb0cafff27fa, []int{8}
+}
+func (m *Conflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Conflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Conflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Conflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Conflict.Merge(m, src)
+}
+func (m *Conflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *Conflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_Conflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Conflict proto.InternalMessageInfo
+
+func (m *Conflict) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *Conflict) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *Conflict) GetDependentTxIndex() int64 {
+	if m != nil {
+		return m.DependentTxIndex
+	}
+	return 0
+}
+
+func (m *Conflict) GetAbort() bool {
+	if m != nil {
+		return m.Abort
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*GetLatestBlockReportRequest)(nil), "cosmos.base.occ.v1beta1.GetLatestBlockReportRequest")
+	proto.RegisterType((*GetLatestBlockReportResponse)(nil), "cosmos.base.occ.v1beta1.GetLatestBlockReportResponse")
+	proto.RegisterType((*GetBlockReportByHeightRequest)(nil), "cosmos.base.occ.v1beta1.GetBlockReportByHeightRequest")
+	proto.RegisterType((*GetBlockReportByHeightResponse)(nil), "cosmos.base.occ.v1beta1.GetBlockReportByHeightResponse")
+	proto.RegisterType((*GetBlockReportsRequest)(nil), "cosmos.base.occ.v1beta1.GetBlockReportsRequest")
+	proto.RegisterType((*GetBlockReportsResponse)(nil), "cosmos.base.occ.v1beta1.GetBlockReportsResponse")
+	proto.RegisterType((*BlockReport)(nil), "cosmos.base.occ.v1beta1.BlockReport")
+	proto.RegisterType((*TxReport)(nil), "cosmos.base.occ.v1beta1.TxReport")
+	proto.RegisterType((*Conflict)(nil), "cosmos.base.occ.v1beta1.Conflict")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/occ/v1beta1/query.proto", fileDescriptor_35c4cb0cafff27fa)
+}
+
+var fileDescriptor_35c4cb0cafff27fa = []byte{
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x4f, 0xd4, 0x40,
+	0x14, 0xa7, 0x2c, 0x7f, 0x76, 0x1f, 0x46, 0xc8, 0xb8, 0x42, 0xb3, 0x42, 0xb3, 0x56, 0x13, 0x96,
+	0xa8, 0xad, 0x80, 0xca, 0x4d, 0x0d, 0xc6, 0x20, 0x89, 0x5c, 0x2a, 0x17, 0x3d, 0xb8, 0xce, 0xce,
+	0x8e, 0xbb, 0x0d, 0xdd, 0x99, 0xd2, 0x99, 0x25, 0xdd, 0x18, 0x2f, 0xfa, 0x05, 0x4c, 0x3c, 0x78,
+	0x33, 0x7e, 0x08, 0x8f, 0x7e, 0x00, 0x8f, 0x24, 0x5e, 0x3c, 0x1a, 0xf0, 0x83, 0x98, 0xce, 0xb4,
+	0x50, 0x22, 0xe5, 0x4f, 0xc2, 0x69, 0xe7, 0xfd, 0x7e, 0xef, 0xf7, 0xe6, 0x37, 0xf3, 0xa6, 0x6f,
+	0xe1, 0x06, 0xe1, 0xa2, 0xc7, 0x85, 0xdb, 0xc2, 0x82, 0xba, 0x9c, 0x10, 0x77, 0x67, 0xb1, 0x45,
+	0x25, 0x5e, 0x74, 0xb7, 0xfb, 0x34, 0x1a, 0x38, 0x61, 0xc4, 0x25, 0x47, 0x33, 0x3a, 0xc9, 0x49,
+	0x92, 0x1c, 0x4e, 0x88, 0x93, 0x26, 0xd5, 0x66, 0x3b, 0x9c, 0x77, 0x02, 0xea, 0xe2, 0xd0, 0x77,
+	0x31, 0x63, 0x5c, 0x62, 0xe9, 0x73, 0x26, 0xb4, 0xcc, 0x9e, 0x83, 0x6b, 0x6b, 0x54, 0x3e, 0xc7,
+	0x92, 0x0a, 0xb9, 0x1a, 0x70, 0xb2, 0xe5, 0xd1, 0x90, 0x47, 0xd2, 0xa3, 0xdb, 0x7d, 0x2a, 0xa4,
+	0xfd, 0x1a, 0x66, 0x8f, 0xa7, 0x45, 0xc8, 0x99, 0xa0, 0xe8, 0x21, 0x8c, 0x47, 0x0a, 0x11, 0xa6,
+	0x51, 0x2f, 0x35, 0x26, 0x96, 0x6e, 0x3a, 0x05, 0x3e, 0x9c, 0xbc, 0x3c, 0x13, 0xd9, 0x2b, 0x30,
+	0xb7, 0x46, 0xf3, 0x95, 0x57, 0x07, 0xcf, 0xa8, 0xdf, 0xe9, 0x66, 0x06, 0xd0, 0x34, 0x8c, 0x75,
+	0x15, 0x60, 0x1a, 0x75, 0xa3, 0x51, 0xf2, 0xd2, 0xc8, 0x7e, 0x03, 0x56, 0x91, 0xf0, 0x82, 0xac,
+	0x99, 0x30, 0x7d, 0x74, 0x07, 0x91, 0x5d, 0xca, 0x4b, 0x98, 0xf9, 0x8f, 0xb9, 0xa0, 0x4d, 0x3f,
+	0x96, 0x60, 0x22, 0x47, 0x14, 0x1d, 0x1f, 0x4d, 0x41, 0x49, 0xc6, 0xc2, 0x1c, 0x56, 0x60, 0xb2,
+	0x44, 0x73, 0x00, 0x01, 0x96, 0x94, 0x91, 0x41, 0xb3, 0x27, 0xcc, 0x92, 0x22, 0x2a, 0x29, 0xb2,
+	0x21, 0x90, 0x05, 0xe0, 0x4b, 0x1a, 0xe9, 0xde, 0x9b, 0x23, 0x8a, 0xce, 0x21, 0xc8, 0x4c, 0x8c,
+	0xcb, 0xc8, 0xa7, 0xc2, 0x1c, 0x55, 0x64, 0x16, 0xa2, 0x79, 0x98, 0xec, 0xe1, 0xb8, 0xe9, 0x33,
+	0x82, 0x23, 0xa6, 0xb2, 0xcd, 0x31, 0x95, 0x71, 0xb9, 0x87, 0xe3, 0xf5, 0x43, 0x14, 0xd5, 0x61,
+	0x42, 0x0c, 0x18, 0xe9, 0x46, 0x9c, 0xf1, 0xbe, 0x30, 0xc7, 0xeb, 0x46, 0xa3, 0xec, 0xe5, 0x21,
+	0xe4, 0xc2, 0x95, 0x10, 0x47, 0x38, 0x08, 0x68, 0xd0, 0xa4, 0x31, 0x25, 0x7d, 0xed, 0xa6, 0xac,
+	0xca, 0xa1, 0x8c, 0x7a, 0x7a, 0xc0, 0xa0, 0x65, 0xb8, 0x2a, 0x92, 0x4b, 0x67, 0xd2, 0xc7, 0x47,
+	0x24, 0x15, 0x25, 0xa9, 0x1e, 0x92, 0x39, 0xd1, 0x63, 0x00, 0x19, 0x37, 0xb3, 0x36, 0x80, 0x6a,
+	0xc3, 0xf5, 0xc2, 0x36, 0x6c, 0xc6, 0x69, 0x0f, 0x2a, 0x32, 0x5d, 0x09, 0xfb, 0x8b, 0x01, 0xe5,
+	0x0c, 0x47, 0x55, 0x18, 0xf5, 0x59, 0x9b, 0xc6, 0x69, 0x07, 0x74, 0x80, 0x10, 0x8c, 0x74, 0xb1,
+	0xe8, 0xaa, 0x0e, 0x54, 0x3c, 0xb5, 0x4e, 0x2e, 0x20, 0x7f, 0x4b, 0xba, 0x07, 0x79, 0x08, 0x3d,
+	0x82, 0x0a, 0xe1, 0xec, 0x6d, 0xe0, 0x13, 0x99, 0x34, 0xe1, 0x64, 0x67, 0x4f, 0xd2, 0x4c, 0xef,
+	0x50, 0x63, 0xef, 0x40, 0x39, 0x83, 0x13, 0x63, 0x42, 0xf2, 0x88, 0x2a, 0x63, 0x15, 0x4f, 0x07,
+	0xc9, 0xcb, 0xd8, 0xa2, 0x03, 0xe5, 0xeb, 0x92, 0x97, 0x2c, 0xd1, 0x6d, 0x40, 0x6d, 0x1a, 0x52,
+	0xd6, 0xa6, 0x4c, 0x36, 0x65, 0xdc, 0xd4, 0xa7, 0xd1, 0xee, 0xa6, 0x0e, 0x98, 0xcd, 0x78, 0x5d,
+	0x1d, 0xac, 0x0a, 0xa3, 0xb8, 0xc5, 0x23, 0xa9, 0xde, 0x48, 0xd9, 0xd3, 0xc1, 0xd2, 0xb7, 0x11,
+	0x18, 0x7f, 0x41, 0xa3, 0x1d, 0x9f, 0x50, 0xf4, 0xdd, 0x80, 0xea, 0x71, 0x43, 0x01, 0xdd, 0x2b,
+	0x3c, 0xca, 0x09, 0x23, 0xa6, 0x76, 0xff, 0x9c, 0x2a, 0xfd, 0xa5, 0xd9, 0xee, 0x87, 0x5f, 0x7f,
+	0x3f, 0x0f, 0x2f, 0xa0, 0x79, 0xb7, 0x68, 0x3a, 0xa6, 0x2f, 0xc0, 0x0d, 0x54, 0x0d, 0xf4, 0xc3,
+	0x80, 0xe9, 0xe3, 0x47, 0x06, 0x7a, 0x70, 0x92, 0x85, 0xe2, 0xe1, 0x54, 0x5b, 0x39, 0xb7, 0x2e,
+	0x35, 0xbf, 0xa8, 0xcc, 0xdf, 0x42, 0x0b, 0xa7, 0x9a, 0x7f, 0xa7, 0x3f, 0xf8, 0xf7, 0xe8, 0xab,
+	0x01, 0x93, 0x47, 0xab, 0x0a, 0xe4, 0x9e, 0x71, 0xff, 0x6c, 0x72, 0xd5, 0xee, 0x9e, 0x5d, 0x90,
+	0x3a, 0x6d, 0x28, 0xa7, 0x36, 0xaa, 0x9f, 0xe6, 0x74, 0x75, 0xe3, 0xe7, 0x9e, 0x65, 0xec, 0xee,
+	0x59, 0xc6, 0x9f, 0x3d, 0xcb, 0xf8, 0xb4, 0x6f, 0x0d, 0xed, 0xee, 0x5b, 0x43, 0xbf, 0xf7, 0xad,
+	0xa1, 0x57, 0xcb, 0x1d, 0x5f, 0x76, 0xfb, 0x2d, 0x87, 0xf0, 0x5e, 0x56, 0x45, 0xff, 0xdc, 0x11,
+	0xed, 0x2d, 0x97, 0x04, 0x3e, 0x65, 0xd2, 0xed, 0x44, 0x21, 0x49, 0xea, 0x0a, 0xfd, 0xca, 0x5a,
+	0x63, 0xea, 0xff, 0x69, 0xf9, 0xdf, 0x00, 0x31, 0xa5, 0x26, 0x86, 0xfd, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// GetLatestBlockReport returns the execution reports of the latest block executed by the OCC scheduler.
+	GetLatestBlockReport(ctx context.Context, in *GetLatestBlockReportRequest, opts ...grpc.CallOption) (*GetLatestBlockReportResponse, error)
+	// GetBlockReportByHeight returns the execution reports of a block at a given height.
+	GetBlockReportByHeight(ctx context.Context, in *GetBlockReportByHeightRequest, opts ...grpc.CallOption) (*GetBlockReportByHeightResponse, error)
+	// GetBlockReports returns all execution reports kept by the node.
+	GetBlockReports(ctx context.Context, in *GetBlockReportsRequest, opts ...grpc.CallOption) (*GetBlockReportsResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) GetLatestBlockReport(ctx context.Context, in *GetLatestBlockReportRequest, opts ...grpc.CallOption) (*GetLatestBlockReportResponse, error) {
+	out := new(GetLatestBlockReportResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.occ.v1beta1.Service/GetLatestBlockReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetBlockReportByHeight(ctx context.Context, in *GetBlockReportByHeightRequest, opts ...grpc.CallOption) (*GetBlockReportByHeightResponse, error) {
+	out := new(GetBlockReportByHeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.occ.v1beta1.Service/GetBlockReportByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetBlockReports(ctx context.Context, in *GetBlockReportsRequest, opts ...grpc.CallOption) (*GetBlockReportsResponse, error) {
+	out := new(GetBlockReportsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.occ.v1beta1.Service/GetBlockReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// GetLatestBlockReport returns the execution reports of the latest block executed by the OCC scheduler.
+	GetLatestBlockReport(context.Context, *GetLatestBlockReportRequest) (*GetLatestBlockReportResponse, error)
+	// GetBlockReportByHeight returns the execution reports of a block at a given height.
+	GetBlockReportByHeight(context.Context, *GetBlockReportByHeightRequest) (*GetBlockReportByHeightResponse, error)
+	// GetBlockReports returns all execution reports kept by the node.
+	GetBlockReports(context.Context, *GetBlockReportsRequest) (*GetBlockReportsResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) GetLatestBlockReport(ctx context.Context, req *GetLatestBlockReportRequest) (*GetLatestBlockReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestBlockReport not implemented")
+}
+func (*UnimplementedServiceServer) GetBlockReportByHeight(ctx context.Context, req *GetBlockReportByHeightRequest) (*GetBlockReportByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockReportByHeight not implemented")
+}
+func (*UnimplementedServiceServer) GetBlockReports(ctx context.Context, req *GetBlockReportsRequest) (*GetBlockReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockReports not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_GetLatestBlockReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestBlockReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetLatestBlockReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.occ.v1beta1.Service/GetLatestBlockReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetLatestBlockReport(ctx, req.(*GetLatestBlockReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetBlockReportByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockReportByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetBlockReportByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.occ.v1beta1.Service/GetBlockReportByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetBlockReportByHeight(ctx, req.(*GetBlockReportByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetBlockReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetBlockReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.occ.v1beta1.Service/GetBlockReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetBlockReports(ctx, req.(*GetBlockReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.occ.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLatestBlockReport",
+			Handler:    _Service_GetLatestBlockReport_Handler,
+		},
+		{
+			MethodName: "GetBlockReportByHeight",
+			Handler:    _Service_GetBlockReportByHeight_Handler,
+		},
+		{
+			MethodName: "GetBlockReports",
+			Handler:    _Service_GetBlockReports_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/occ/v1beta1/query.proto",
+}
+
+func (m *GetLatestBlockReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatestBlockReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatestBlockReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatestBlockReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatestBlockReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatestBlockReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockReportByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockReportByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockReportByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockReportByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockReportByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockReportByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockReportsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockReportsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockReportsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockReportsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockReportsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockReportsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxReports) > 0 {
+		for iNdEx := len(m.TxReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.SequentialExecutions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SequentialExecutions))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ParallelExecutions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParallelExecutions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Synchronous {
+		i--
+		if m.Synchronous {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxIncarnation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxIncarnation))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Retries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Iterations != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Iterations))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LatencyMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatencyMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Txs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Incarnation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Incarnation))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Conflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Conflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Conflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Abort {
+		i--
+		if m.Abort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DependentTxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DependentTxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetLatestBlockReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetLatestBlockReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetBlockReportByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetBlockReportByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetBlockReportsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetBlockReportsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Txs != 0 {
+		n += 1 + sovQuery(uint64(m.Txs))
+	}
+	if m.LatencyMs != 0 {
+		n += 1 + sovQuery(uint64(m.LatencyMs))
+	}
+	if m.Iterations != 0 {
+		n += 1 + sovQuery(uint64(m.Iterations))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovQuery(uint64(m.Retries))
+	}
+	if m.MaxIncarnation != 0 {
+		n += 1 + sovQuery(uint64(m.MaxIncarnation))
+	}
+	if m.Synchronous {
+		n += 2
+	}
+	if m.ParallelExecutions != 0 {
+		n += 1 + sovQuery(uint64(m.ParallelExecutions))
+	}
+	if m.SequentialExecutions != 0 {
+		n += 1 + sovQuery(uint64(m.SequentialExecutions))
+	}
+	if len(m.TxReports) > 0 {
+		for _, e := range m.TxReports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Incarnation != 0 {
+		n += 1 + sovQuery(uint64(m.Incarnation))
+	}
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Conflict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DependentTxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.DependentTxIndex))
+	}
+	if m.Abort {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetLatestBlockReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLatestBlockReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLatestBlockReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLatestBlockReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLatestBlockReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLatestBlockReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, &BlockReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockReportByHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockReportByHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockReportByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockReportByHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockReportByHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockReportByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, &BlockReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockReportsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockReportsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockReportsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockReportsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockReportsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockReportsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, &BlockReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyMs", wireType)
+			}
+			m.LatencyMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatencyMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iterations", wireType)
+			}
+			m.Iterations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iterations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIncarnation", wireType)
+			}
+			m.MaxIncarnation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIncarnation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronous", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Synchronous = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelExecutions", wireType)
+			}
+			m.ParallelExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParallelExecutions |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequentialExecutions", wireType)
+			}
+			m.SequentialExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequentialExecutions |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxReports = append(m.TxReports, &TxReport{})
+			if err := m.TxReports[len(m.TxReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incarnation", wireType)
+			}
+			m.Incarnation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Incarnation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conflicts = append(m.Conflicts, &Conflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Conflict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Conflict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Conflict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependentTxIndex", wireType)
+			}
+			m.DependentTxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DependentTxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Abort = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
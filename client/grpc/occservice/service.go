@@ -0,0 +1,117 @@
+package occservice
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/tasks"
+)
+
+// This is the struct that we will implement all the handlers on.
+type queryServer struct {
+	reports *tasks.ReportBuffer
+}
+
+var _ ServiceServer = queryServer{}
+
+// NewQueryServer creates a new OCC report query server.
+func NewQueryServer(reports *tasks.ReportBuffer) ServiceServer {
+	return queryServer{
+		reports: reports,
+	}
+}
+
+// GetLatestBlockReport implements ServiceServer.GetLatestBlockReport
+func (s queryServer) GetLatestBlockReport(_ context.Context, _ *GetLatestBlockReportRequest) (*GetLatestBlockReportResponse, error) {
+	reports := s.reports.Latest()
+	if len(reports) == 0 {
+		return nil, status.Error(codes.NotFound, "no OCC execution report available")
+	}
+	return &GetLatestBlockReportResponse{
+		Reports: convertBlockReports(reports),
+	}, nil
+}
+
+// GetBlockReportByHeight implements ServiceServer.GetBlockReportByHeight
+func (s queryServer) GetBlockReportByHeight(_ context.Context, req *GetBlockReportByHeightRequest) (*GetBlockReportByHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+
+	reports := s.reports.GetByHeight(req.Height)
+	if len(reports) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no OCC execution report available for height %d", req.Height)
+	}
+	return &GetBlockReportByHeightResponse{
+		Reports: convertBlockReports(reports),
+	}, nil
+}
+
+// GetBlockReports implements ServiceServer.GetBlockReports
+func (s queryServer) GetBlockReports(_ context.Context, _ *GetBlockReportsRequest) (*GetBlockReportsResponse, error) {
+	return &GetBlockReportsResponse{
+		Reports: convertBlockReports(s.reports.All()),
+	}, nil
+}
+
+func convertBlockReports(reports []*tasks.BlockReport) []*BlockReport {
+	res := make([]*BlockReport, 0, len(reports))
+	for _, report := range reports {
+		res = append(res, convertBlockReport(report))
+	}
+	return res
+}
+
+func convertBlockReport(report *tasks.BlockReport) *BlockReport {
+	txReports := make([]*TxReport, 0, len(report.TxReports))
+	for _, txReport := range report.TxReports {
+		conflicts := make([]*Conflict, 0, len(txReport.Conflicts))
+		for _, conflict := range txReport.Conflicts {
+			conflicts = append(conflicts, &Conflict{
+				Store:            conflict.Store,
+				Key:              conflict.Key,
+				DependentTxIndex: int64(conflict.DependentTxIdx),
+				Abort:            conflict.Abort,
+			})
+		}
+		txReports = append(txReports, &TxReport{
+			Index:       int64(txReport.AbsoluteIndex),
+			Hash:        txReport.TxHash,
+			Incarnation: int64(txReport.Incarnation),
+			Conflicts:   conflicts,
+		})
+	}
+	return &BlockReport{
+		Height:               report.Height,
+		Txs:                  int64(report.Txs),
+		LatencyMs:            report.Latency.Milliseconds(),
+		Iterations:           int64(report.Iterations),
+		Retries:              int64(report.Retries),
+		MaxIncarnation:       int64(report.MaxIncarnation),
+		Synchronous:          report.Synchronous,
+		ParallelExecutions:   int64(report.ParallelExecutions),
+		SequentialExecutions: int64(report.SequentialExecutions),
+		TxReports:            txReports,
+	}
+}
+
+// RegisterOCCService registers the OCC report queries on the gRPC router.
+func RegisterOCCService(qrt gogogrpc.Server, reports *tasks.ReportBuffer) {
+	RegisterServiceServer(
+		qrt,
+		NewQueryServer(reports),
+	)
+}
+
+// RegisterGRPCGatewayRoutes mounts the OCC service's GRPC-gateway routes on the
+// given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
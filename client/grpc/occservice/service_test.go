@@ -0,0 +1,74 @@
+package occservice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/occservice"
+	"github.com/cosmos/cosmos-sdk/tasks"
+)
+
+func TestQueryServer(t *testing.T) {
+	reports := tasks.NewReportBuffer(10)
+	server := occservice.NewQueryServer(reports)
+	ctx := context.Background()
+
+	_, err := server.GetLatestBlockReport(ctx, &occservice.GetLatestBlockReportRequest{})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	reports.Add(&tasks.BlockReport{Height: 1, Txs: 2})
+	reports.Add(&tasks.BlockReport{
+		Height:         2,
+		Txs:            3,
+		Latency:        5 * time.Millisecond,
+		Iterations:     2,
+		Retries:        1,
+		MaxIncarnation: 1,
+		TxReports: []tasks.TxReport{{
+			AbsoluteIndex: 2,
+			TxHash:        "AB",
+			Incarnation:   1,
+			Conflicts: []tasks.Conflict{
+				{Store: "bank", Key: []byte("key"), DependentTxIdx: 1, Abort: true},
+			},
+		}},
+	})
+
+	latest, err := server.GetLatestBlockReport(ctx, &occservice.GetLatestBlockReportRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*occservice.BlockReport{{
+		Height:         2,
+		Txs:            3,
+		LatencyMs:      5,
+		Iterations:     2,
+		Retries:        1,
+		MaxIncarnation: 1,
+		TxReports: []*occservice.TxReport{{
+			Index:       2,
+			Hash:        "AB",
+			Incarnation: 1,
+			Conflicts: []*occservice.Conflict{
+				{Store: "bank", Key: []byte("key"), DependentTxIndex: 1, Abort: true},
+			},
+		}},
+	}}, latest.Reports)
+
+	byHeight, err := server.GetBlockReportByHeight(ctx, &occservice.GetBlockReportByHeightRequest{Height: 1})
+	require.NoError(t, err)
+	require.Len(t, byHeight.Reports, 1)
+	require.Equal(t, int64(2), byHeight.Reports[0].Txs)
+
+	_, err = server.GetBlockReportByHeight(ctx, &occservice.GetBlockReportByHeightRequest{Height: 3})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.GetBlockReportByHeight(ctx, &occservice.GetBlockReportByHeightRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	all, err := server.GetBlockReports(ctx, &occservice.GetBlockReportsRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Reports, 2)
+}
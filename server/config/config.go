@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,11 +23,18 @@ const (
 	// DefaultGRPCWebAddress defines the default address to bind the gRPC-web server to.
 	DefaultGRPCWebAddress = "0.0.0.0:9091"
 
+	// DefaultGRPCStreamerAddress defines the default address to bind the gRPC streaming service to. The service
+	// has no authentication, so it only listens on localhost by default.
+	DefaultGRPCStreamerAddress = "tcp://127.0.0.1:9095"
+
 	// DefaultConcurrencyWorkers defines the default workers to use for concurrent transactions
 	DefaultConcurrencyWorkers = 20
 
 	// DefaultOccEanbled defines whether to use OCC for tx processing
 	DefaultOccEnabled = false
+
+	// DefaultOccReportBufferSize defines how many OCC execution reports are kept for queries
+	DefaultOccReportBufferSize = 100
 )
 
 // BaseConfig defines the server's basic configuration
@@ -100,6 +108,21 @@ type BaseConfig struct {
 	ConcurrencyWorkers int `mapstructure:"concurrency-workers"`
 	// Whether to enable optimistic concurrency control for tx execution, default is true
 	OccEnabled bool `mapstructure:"occ-enabled"`
+	// OccReportBufferSize defines how many OCC execution reports of the most recent
+	// blocks are kept in memory for queries. A value of 0 disables the reports.
+	OccReportBufferSize int `mapstructure:"occ-report-buffer-size"`
+	// OccReplayEnabled cross-checks every OCC batch against a sequential execution and
+	// logs the diverging txs and keys. It executes every tx twice, so only use it for debugging.
+	OccReplayEnabled bool `mapstructure:"occ-replay-enabled"`
+	// OccEstimateDependencies delays the txs of a batch until the txs they are expected to
+	// conflict with, according to the access-control dependency dag, have validated.
+	OccEstimateDependencies bool `mapstructure:"occ-estimate-dependencies"`
+	// AccessObserverEnabled compares the store accesses of the executed messages with their
+	// access-control dependency mappings, and emits and reports the mismatches.
+	AccessObserverEnabled bool `mapstructure:"access-observer-enabled"`
+	// AccessObserverTraceFile is the file the access observer appends the store accesses of
+	// every executed message to, for generating dependency mapping proposals. Empty disables it.
+	AccessObserverTraceFile string `mapstructure:"access-observer-trace-file"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -190,6 +213,59 @@ type StateSyncConfig struct {
 	// SnapshotDirectory sets the parent directory for where state sync snapshots are persisted.
 	// Default is emtpy which will then store under the app home directory.
 	SnapshotDirectory string `mapstructure:"snapshot-directory"`
+
+	// SnapshotFormat sets the format of the state sync snapshots taken. The default format 1 is
+	// restored sequentially, while format 2 holds self-contained store chunks that a state commit
+	// store can restore in parallel and resume after an interruption.
+	SnapshotFormat uint32 `mapstructure:"snapshot-format"`
+}
+
+// StoreConfig defines the configuration of the store streaming.
+type StoreConfig struct {
+	// Streamers lists the streaming services the node streams the state changes to.
+	Streamers []string `mapstructure:"streamers"`
+}
+
+// StreamersConfig defines the configuration of the streaming services.
+type StreamersConfig struct {
+	GRPC GRPCStreamerConfig `mapstructure:"grpc"`
+}
+
+// GRPCStreamerConfig defines the configuration of the gRPC streaming service.
+type GRPCStreamerConfig struct {
+	// Keys lists the names of the stores whose changes are streamed, "*" for all the stores.
+	Keys []string `mapstructure:"keys"`
+
+	// Address defines the address the Streaming service listens on, either tcp://host:port or
+	// unix:///path/to/socket. The service has no authentication.
+	Address string `mapstructure:"address"`
+
+	// Delivery is either non-blocking or blocking, where the node waits for the subscribers to
+	// acknowledge the blocks.
+	Delivery string `mapstructure:"delivery"`
+
+	// MaxUnackedBlocks sets the number of blocks a subscriber may leave unacknowledged in blocking delivery.
+	MaxUnackedBlocks int64 `mapstructure:"max_unacked_blocks"`
+
+	// RetainBlocks sets the number of recent blocks kept in memory for the subscribers to resume from.
+	RetainBlocks int64 `mapstructure:"retain_blocks"`
+
+	// Plugin defines the command of an optional local plugin process subscribing to the service.
+	Plugin string `mapstructure:"plugin"`
+
+	// OCCWritesets defines if the final writesets of the txs executed with OCC are streamed.
+	OCCWritesets bool `mapstructure:"occ_writesets"`
+}
+
+// HistoricalProofsConfig defines the configuration of the proofs served at historical heights by the
+// state commit store.
+type HistoricalProofsConfig struct {
+	// Enable defines if proofs are served for every height kept by the state store. It requires the
+	// state store, and keeps enough state commit snapshots to rebuild the trees of those heights.
+	Enable bool `mapstructure:"enable"`
+
+	// CacheSize sets the number of heights whose rebuilt trees are cached for further proof queries.
+	CacheSize int `mapstructure:"cache-size"`
 }
 
 // GenesisConfig defines the genesis export, validation, and import configuration
@@ -215,6 +291,10 @@ type Config struct {
 	StateCommit config.StateCommitConfig `mapstructure:"state-commit"`
 	StateStore  config.StateStoreConfig  `mapstructure:"state-store"`
 	Genesis     GenesisConfig            `mapstructure:genesis`
+
+	HistoricalProofs HistoricalProofsConfig `mapstructure:"historical-proofs"`
+	Store            StoreConfig            `mapstructure:"store"`
+	Streamers        StreamersConfig        `mapstructure:"streamers"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -262,6 +342,7 @@ func DefaultConfig() *Config {
 			NoVersioning:        false,
 			ConcurrencyWorkers:  DefaultConcurrencyWorkers,
 			OccEnabled:          DefaultOccEnabled,
+			OccReportBufferSize: DefaultOccReportBufferSize,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -295,6 +376,7 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 			SnapshotDirectory:  "",
+			SnapshotFormat:     snapshottypes.CurrentFormat,
 		},
 		StateCommit: config.DefaultStateCommitConfig(),
 		StateStore:  config.DefaultStateStoreConfig(),
@@ -302,6 +384,24 @@ func DefaultConfig() *Config {
 			StreamImport:      false,
 			GenesisStreamFile: "",
 		},
+		HistoricalProofs: HistoricalProofsConfig{
+			Enable:    false,
+			CacheSize: 10,
+		},
+		Store: StoreConfig{
+			Streamers: []string{},
+		},
+		Streamers: StreamersConfig{
+			GRPC: GRPCStreamerConfig{
+				Keys:             []string{"*"},
+				Address:          DefaultGRPCStreamerAddress,
+				Delivery:         "non-blocking",
+				MaxUnackedBlocks: 0,
+				RetainBlocks:     100,
+				Plugin:           "",
+				OCCWritesets:     false,
+			},
+		},
 	}
 }
 
@@ -344,6 +444,11 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			OrphanDirectory:              v.GetString("orphan-dir"),
 			ConcurrencyWorkers:           v.GetInt("concurrency-workers"),
 			OccEnabled:                   v.GetBool("occ-enabled"),
+			OccReportBufferSize:          v.GetInt("occ-report-buffer-size"),
+			OccReplayEnabled:             v.GetBool("occ-replay-enabled"),
+			OccEstimateDependencies:      v.GetBool("occ-estimate-dependencies"),
+			AccessObserverEnabled:        v.GetBool("access-observer-enabled"),
+			AccessObserverTraceFile:      v.GetString("access-observer-trace-file"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -385,6 +490,7 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 			SnapshotDirectory:  v.GetString("state-sync.snapshot-directory"),
+			SnapshotFormat:     v.GetUint32("state-sync.snapshot-format"),
 		},
 		StateCommit: config.StateCommitConfig{
 			Enable:              v.GetBool("state-commit.enable"),
@@ -409,6 +515,24 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			StreamImport:      v.GetBool("genesis.stream-import"),
 			GenesisStreamFile: v.GetString("genesis.genesis-stream-file"),
 		},
+		HistoricalProofs: HistoricalProofsConfig{
+			Enable:    v.GetBool("historical-proofs.enable"),
+			CacheSize: v.GetInt("historical-proofs.cache-size"),
+		},
+		Store: StoreConfig{
+			Streamers: v.GetStringSlice("store.streamers"),
+		},
+		Streamers: StreamersConfig{
+			GRPC: GRPCStreamerConfig{
+				Keys:             v.GetStringSlice("streamers.grpc.keys"),
+				Address:          v.GetString("streamers.grpc.address"),
+				Delivery:         v.GetString("streamers.grpc.delivery"),
+				MaxUnackedBlocks: v.GetInt64("streamers.grpc.max_unacked_blocks"),
+				RetainBlocks:     v.GetInt64("streamers.grpc.retain_blocks"),
+				Plugin:           v.GetString("streamers.grpc.plugin"),
+				OCCWritesets:     v.GetBool("streamers.grpc.occ_writesets"),
+			},
+		},
 	}, nil
 }
 
@@ -37,6 +37,11 @@ func TestOCCEnabled(t *testing.T) {
 	require.True(t, cfg.OccEnabled)
 }
 
+func TestOCCReportBufferSize(t *testing.T) {
+	cfg := DefaultConfig()
+	require.Equal(t, DefaultOccReportBufferSize, cfg.OccReportBufferSize)
+}
+
 func TestDefaultSwaggerConfig(t *testing.T) {
 	cfg := DefaultConfig()
 	require.True(t, cfg.API.Swagger)
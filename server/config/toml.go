@@ -110,6 +110,27 @@ orphan-dir = "{{ .BaseConfig.OrphanDirectory }}"
 # occ-enabled defines whether OCC is enabled or not for transaction execution
 occ-enabled = {{ .BaseConfig.OccEnabled }}
 
+# occ-report-buffer-size defines how many OCC execution reports of the most recent blocks
+# are kept in memory and served by the OCC report query. 0 disables the reports.
+occ-report-buffer-size = {{ .BaseConfig.OccReportBufferSize }}
+
+# occ-replay-enabled cross-checks every OCC batch against a sequential execution and logs the
+# txs and keys for which they diverge. Every tx is executed twice, so only enable it for debugging.
+occ-replay-enabled = {{ .BaseConfig.OccReplayEnabled }}
+
+# occ-estimate-dependencies builds the access-control dependency dag of every OCC batch, and delays
+# the txs until the txs they are expected to conflict with have validated.
+occ-estimate-dependencies = {{ .BaseConfig.OccEstimateDependencies }}
+
+# access-observer-enabled records the store keys accessed by every message in DeliverTx and compares
+# them with the access operations of its dependency mapping. The mismatches are emitted as access_mismatch
+# events and served by the accesscontrol validation-report query. It doesn't change tx execution.
+access-observer-enabled = {{ .BaseConfig.AccessObserverEnabled }}
+
+# access-observer-trace-file is the file the access observer appends the store accesses of every
+# message to, which "tx accesscontrol generate-dependency-mapping-proposal" turns into proposals.
+access-observer-trace-file = "{{ .BaseConfig.AccessObserverTraceFile }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -246,6 +267,11 @@ snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 # default is emtpy which will then store under the app home directory same as before.
 snapshot-directory = "{{ .StateSync.SnapshotDirectory }}"
 
+# snapshot-format specifies the format of the snapshots taken. Format 1 is restored sequentially,
+# format 2 holds self-contained store chunks that are restored in parallel, and an interrupted
+# restore resumes from the last restored chunk. Format 2 requires the state commit store (sc-enable).
+snapshot-format = {{ .StateSync.SnapshotFormat }}
+
 ###############################################################################
 ###                         Genesis Configuration                           ###
 ###############################################################################
@@ -260,6 +286,61 @@ stream-import = {{ .Genesis.StreamImport }}
 
 # genesis-stream-file specifies the path of the genesis json file to stream from.
 genesis-stream-file = "{{ .Genesis.GenesisStreamFile }}"
+
+###############################################################################
+###                     Historical Proofs Configuration                     ###
+###############################################################################
+
+# Historical proofs allow proof queries at every height kept by the state store, instead of only
+# at the heights still held by the state commit store.
+[historical-proofs]
+
+# enable defines if proofs are served at historical heights. It requires the state store (ss-enable)
+# with a non-zero ss-keep-recent, and raises the number of state commit snapshots kept to cover it.
+enable = {{ .HistoricalProofs.Enable }}
+
+# cache-size sets the number of heights whose rebuilt trees are cached for further proof queries.
+cache-size = {{ .HistoricalProofs.CacheSize }}
+
+###############################################################################
+###                         Streaming Configuration                         ###
+###############################################################################
+
+[store]
+
+# streamers lists the streaming services the state changes are streamed to, e.g. ["grpc"].
+# Nothing is streamed if the list is empty.
+streamers = [{{ range .Store.Streamers }}"{{ . }}", {{ end }}]
+
+[streamers.grpc]
+
+# keys lists the names of the stores whose changes are streamed, "*" for all the stores.
+keys = [{{ range .Streamers.GRPC.Keys }}"{{ . }}", {{ end }}]
+
+# address defines the address the Streaming service listens on, either tcp://host:port or
+# unix:///path/to/socket. The service has no authentication nor TLS, so anyone who can reach the
+# address can read the whole state stream and, in blocking delivery, stall the node. Keep it on
+# localhost or a unix socket, and put an authenticating proxy in front of it for remote subscribers.
+address = "{{ .Streamers.GRPC.Address }}"
+
+# delivery is either "non-blocking", where the subscribers consume the stream at their own pace, or
+# "blocking", where the node waits at the end of every block for the subscribers to acknowledge it.
+delivery = "{{ .Streamers.GRPC.Delivery }}"
+
+# max_unacked_blocks sets the number of blocks a subscriber may leave unacknowledged in blocking delivery.
+max_unacked_blocks = {{ .Streamers.GRPC.MaxUnackedBlocks }}
+
+# retain_blocks sets the number of recent blocks kept in memory for the subscribers to resume from.
+# It must be greater than max_unacked_blocks. Older blocks, and the blocks streamed before a restart of
+# the node, can't be resumed from.
+retain_blocks = {{ .Streamers.GRPC.RetainBlocks }}
+
+# plugin defines the command of an optional local plugin process, started with the service and passed
+# its address in the STREAMING_GRPC_ADDRESS environment variable.
+plugin = "{{ .Streamers.GRPC.Plugin }}"
+
+# occ_writesets defines if the final writesets of the txs executed with OCC are streamed.
+occ_writesets = {{ .Streamers.GRPC.OCCWritesets }}
 ` + config.DefaultConfigTemplate
 
 var configTemplate *template.Template
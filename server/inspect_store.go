@@ -0,0 +1,279 @@
+package server
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/storev2/rootmulti"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagDecode = "decode"
+	flagLimit  = "limit"
+	flagPrefix = "prefix"
+)
+
+// StoreValueTypes maps the name of a store to the function resolving the proto message the value of a key
+// decodes into, or nil if the value isn't a proto message.
+type StoreValueTypes map[string]func(key []byte) codec.ProtoMarshaler
+
+// InspectStoreCmd creates the command group inspecting the commitment and state stores of a node. The values
+// are decoded as the proto messages resolved by valueTypes for their store.
+func InspectStoreCmd(defaultNodeHome string, valueTypes StoreValueTypes) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect-store",
+		Short: "Inspect the state commitment and state stores of a stopped node",
+		Long: `Inspect the state commitment and state stores of the node home read-only, with the
+state-commit and state-store configs of app.toml. The values are read from the state store if
+it's enabled, and from the state commitment store otherwise. Keys and prefixes are hex encoded.
+The values are decoded as the proto messages registered by the app for their store, and printed
+hex encoded otherwise.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(InspectStoreGetCmd(valueTypes))
+	cmd.AddCommand(InspectStoreIterateCmd(valueTypes))
+	cmd.AddCommand(InspectStoreDiffCmd(valueTypes))
+	cmd.AddCommand(InspectStoreHashesCmd())
+
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+func InspectStoreGetCmd(valueTypes StoreValueTypes) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [store] [key]",
+		Short: "Get the value of a key at a height",
+		Long: fmt.Sprintf(`Get the value of a hex encoded key in a store at a height, the latest height by default.
+
+Example:
+$ %s inspect-store get bank 0201 --height 100
+			`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid key: %w", err)
+			}
+			return withInspector(cmd, func(inspector *rootmulti.Inspector, height int64) error {
+				value, err := inspector.Get(args[0], height, key)
+				if err != nil {
+					return err
+				}
+				if value == nil {
+					return fmt.Errorf("key %X not found in store %s at height %d", key, args[0], height)
+				}
+				decoded, err := decodeValue(cmd, valueTypes[args[0]], key, value)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), decoded)
+				return nil
+			})
+		},
+	}
+	cmd.Flags().Int64(FlagHeight, 0, "The height to read at, the latest height if 0")
+	cmd.Flags().String(flagDecode, "", "The proto message name to decode the value as, instead of the one registered for the store")
+	return cmd
+}
+
+func InspectStoreIterateCmd(valueTypes StoreValueTypes) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iterate [store] [prefix]",
+		Short: "Iterate the keys with a prefix at a height",
+		Long: fmt.Sprintf(`Iterate the keys of a store with an optional hex encoded prefix at a height, the latest
+height by default.
+
+Example:
+$ %s inspect-store iterate bank 02 --limit 10
+			`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var prefix []byte
+			if len(args) > 1 {
+				var err error
+				if prefix, err = hex.DecodeString(args[1]); err != nil {
+					return fmt.Errorf("invalid prefix: %w", err)
+				}
+			}
+			limit, _ := cmd.Flags().GetInt(flagLimit)
+			return withInspector(cmd, func(inspector *rootmulti.Inspector, height int64) error {
+				var (
+					count     int
+					decodeErr error
+				)
+				err := inspector.Iterate(args[0], height, prefix, func(key, value []byte) bool {
+					decoded, err := decodeValue(cmd, valueTypes[args[0]], key, value)
+					if err != nil {
+						decodeErr = fmt.Errorf("failed to decode the value of key %X: %w", key, err)
+						return false
+					}
+					fmt.Fprintf(cmd.OutOrStdout(), "%X: %s\n", key, decoded)
+					count++
+					return limit <= 0 || count < limit
+				})
+				if err != nil {
+					return err
+				}
+				return decodeErr
+			})
+		},
+	}
+	cmd.Flags().Int64(FlagHeight, 0, "The height to read at, the latest height if 0")
+	cmd.Flags().String(flagDecode, "", "The proto message name to decode the values as, instead of the ones registered for the store")
+	cmd.Flags().Int(flagLimit, 0, "The maximum number of keys to print, all the keys if 0")
+	return cmd
+}
+
+func InspectStoreDiffCmd(valueTypes StoreValueTypes) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [store] [from-height] [to-height]",
+		Short: "Diff a store between two heights",
+		Long: fmt.Sprintf(`Print the keys of a store that were added (+), deleted (-) or changed (~) between two heights.
+
+Example:
+$ %s inspect-store diff bank 100 101 --prefix 02
+			`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height: %w", err)
+			}
+			to, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height: %w", err)
+			}
+			prefixStr, _ := cmd.Flags().GetString(flagPrefix)
+			prefix, err := hex.DecodeString(prefixStr)
+			if err != nil {
+				return fmt.Errorf("invalid prefix: %w", err)
+			}
+			return withInspector(cmd, func(inspector *rootmulti.Inspector, _ int64) error {
+				var decodeErr error
+				valueType := valueTypes[args[0]]
+				err := inspector.Diff(args[0], from, to, prefix, func(diff rootmulti.KVDiff) bool {
+					var line string
+					switch {
+					case diff.Old == nil:
+						line, decodeErr = decodeValue(cmd, valueType, diff.Key, diff.New)
+						line = fmt.Sprintf("+ %X: %s", diff.Key, line)
+					case diff.New == nil:
+						line, decodeErr = decodeValue(cmd, valueType, diff.Key, diff.Old)
+						line = fmt.Sprintf("- %X: %s", diff.Key, line)
+					default:
+						var oldValue, newValue string
+						if oldValue, decodeErr = decodeValue(cmd, valueType, diff.Key, diff.Old); decodeErr == nil {
+							newValue, decodeErr = decodeValue(cmd, valueType, diff.Key, diff.New)
+						}
+						line = fmt.Sprintf("~ %X: %s -> %s", diff.Key, oldValue, newValue)
+					}
+					if decodeErr != nil {
+						decodeErr = fmt.Errorf("failed to decode the value of key %X: %w", diff.Key, decodeErr)
+						return false
+					}
+					fmt.Fprintln(cmd.OutOrStdout(), line)
+					return true
+				})
+				if err != nil {
+					return err
+				}
+				return decodeErr
+			})
+		},
+	}
+	cmd.Flags().String(flagPrefix, "", "The hex encoded prefix of the keys to diff")
+	cmd.Flags().String(flagDecode, "", "The proto message name to decode the values as, instead of the ones registered for the store")
+	return cmd
+}
+
+func InspectStoreHashesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hashes",
+		Short: "Print the commit hashes of the stores at a height",
+		Long: fmt.Sprintf(`Print the app hash and the commit hash of every store at a height, the latest height by default.
+
+Example:
+$ %s inspect-store hashes --height 100
+			`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withInspector(cmd, func(inspector *rootmulti.Inspector, height int64) error {
+				commitInfo, err := inspector.CommitInfo(height)
+				if err != nil {
+					return err
+				}
+				commitID := commitInfo.CommitID()
+				fmt.Fprintf(cmd.OutOrStdout(), "height: %d\napp hash: %X\n", commitID.Version, commitID.Hash)
+				for _, storeInfo := range commitInfo.StoreInfos {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s: %X\n", storeInfo.Name, storeInfo.CommitId.Hash)
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().Int64(FlagHeight, 0, "The height of the commit info, the latest height if 0")
+	return cmd
+}
+
+// withInspector runs fn with an inspector of the node home, and the height of the height flag or the latest height
+func withInspector(cmd *cobra.Command, fn func(inspector *rootmulti.Inspector, height int64) error) error {
+	serverCtx := GetServerContextFromCmd(cmd)
+	cfg, err := config.GetConfig(serverCtx.Viper)
+	if err != nil {
+		return err
+	}
+	inspector, err := rootmulti.NewInspector(serverCtx.Config.RootDir, serverCtx.Logger, cfg.StateCommit, cfg.StateStore)
+	if err != nil {
+		return err
+	}
+	defer inspector.Close()
+
+	height, _ := cmd.Flags().GetInt64(FlagHeight)
+	if height == 0 {
+		if height, err = inspector.LatestHeight(); err != nil {
+			return err
+		}
+	}
+	return fn(inspector, height)
+}
+
+// decodeValue decodes a value with the app codec as the proto message of the decode flag, or the one resolved by
+// valueType for its key. The value is hex encoded if no proto message is found.
+func decodeValue(cmd *cobra.Command, valueType func(key []byte) codec.ProtoMarshaler, key, value []byte) (string, error) {
+	var msg codec.ProtoMarshaler
+	if name, _ := cmd.Flags().GetString(flagDecode); name != "" {
+		typ := proto.MessageType(name)
+		if typ == nil {
+			return "", fmt.Errorf("unknown proto message %s", name)
+		}
+		var ok bool
+		if msg, ok = reflect.New(typ.Elem()).Interface().(codec.ProtoMarshaler); !ok {
+			return "", fmt.Errorf("proto message %s can't be decoded by the codec", name)
+		}
+	} else if valueType != nil {
+		msg = valueType(key)
+	}
+	if msg == nil {
+		return fmt.Sprintf("%X", value), nil
+	}
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	if err := clientCtx.Codec.Unmarshal(value, msg); err != nil {
+		return "", err
+	}
+	bz, err := clientCtx.Codec.MarshalJSON(msg)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	storev2 "github.com/cosmos/cosmos-sdk/storev2/rootmulti"
+	"github.com/spf13/cobra"
+)
+
+// MigrateStoreCmd creates a command to migrate the IAVL application state to the commitment and state stores.
+func MigrateStoreCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-store",
+		Short: "Migrate the IAVL application state to the state commitment and state stores",
+		Long: `
+Migrate the IAVL stores of the application DB at its latest height to the state commitment
+store and, if enabled in app.toml, the state store. The root hash of every migrated store is
+verified against the commit info of the application DB. The progress is saved after every
+store, so an interrupted migration resumes with the next store when the command is run again.
+The node must be stopped during the migration.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+			cfg, err := config.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(home)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			height := rootmulti.GetLatestVersion(db)
+			if height == 0 {
+				return fmt.Errorf("application DB has no committed height")
+			}
+			commitInfo, err := rootmulti.GetCommitInfo(db, height)
+			if err != nil {
+				return err
+			}
+
+			legacy := rootmulti.NewStore(db, serverCtx.Logger)
+			store := storev2.NewStore(home, serverCtx.Logger, cfg.StateCommit, cfg.StateStore, true, StoreV2Options(cfg)...)
+			defer store.Close()
+			for _, info := range commitInfo.StoreInfos {
+				key := storetypes.NewKVStoreKey(info.Name)
+				legacy.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+				store.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+			}
+			if err := legacy.LoadLatestVersion(); err != nil {
+				return err
+			}
+			fmt.Printf("Migrating %d stores at height %d\n", len(commitInfo.StoreInfos), height)
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			progressFile := filepath.Join(home, "data", "migrate-store.json")
+			return store.MigrateFromIAVL(ctx, legacy, progressFile, func(event storev2.MigrationEvent) {
+				switch {
+				case event.Verified:
+					fmt.Printf("Verified store %s\n", event.Store)
+				case event.Skipped:
+					fmt.Printf("Skipped store %s, migrated by an interrupted migration\n", event.Store)
+				case event.Done:
+					fmt.Printf("Migrated store %s, %d nodes\n", event.Store, event.Nodes)
+				default:
+					fmt.Printf("Migrating store %s, %d nodes\n", event.Store, event.Nodes)
+				}
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// StoreV2Options returns the options of the commitment and state stores set by the app config.
+func StoreV2Options(cfg config.Config) []storev2.Option {
+	var opts []storev2.Option
+	if cfg.HistoricalProofs.Enable {
+		opts = append(opts, storev2.HistoricalProofs(cfg.HistoricalProofs.CacheSize))
+	}
+	return opts
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
 	crgserver "github.com/cosmos/cosmos-sdk/server/rosetta/lib/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/utils/tracing"
@@ -77,6 +78,7 @@ const (
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
 	FlagStateSyncSnapshotDir        = "state-sync.snapshot-directory"
+	FlagStateSyncSnapshotFormat     = "state-sync.snapshot-format"
 
 	// gRPC-related flags
 	flagGRPCOnly       = "grpc-only"
@@ -266,6 +268,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotFormat, snapshottypes.CurrentFormat, "State sync snapshot format (2 for parallel restorable store chunks)")
 
 	cmd.Flags().Int64(FlagArchivalVersion, 0, "Application data before this version is stored in archival DB")
 	cmd.Flags().String(FlagArchivalDBType, "", "Archival DB type. Valid options: arweave")
@@ -351,6 +351,7 @@ func AddCommands(
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
 		LatestVersionCmd(defaultNodeHome),
+		MigrateStoreCmd(defaultNodeHome),
 	)
 }
 
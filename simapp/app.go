@@ -21,6 +21,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/occservice"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -32,6 +33,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkacltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
 	genesistypes "github.com/cosmos/cosmos-sdk/types/genesis"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/utils"
@@ -58,6 +60,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	circuitkeeper "github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
@@ -71,6 +76,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -88,6 +96,7 @@ import (
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingaclmapping "github.com/cosmos/cosmos-sdk/x/staking/aclmapping"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
@@ -115,6 +124,7 @@ var (
 		capability.AppModuleBasic{},
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
+		feemarket.AppModuleBasic{},
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
@@ -129,6 +139,7 @@ var (
 		vesting.AppModuleBasic{},
 		aclmodule.AppModuleBasic{},
 		params.AppModuleBasic{},
+		circuit.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -138,7 +149,9 @@ var (
 		minttypes.ModuleName:           {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		stakingtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		govtypes.ModuleName:            {authtypes.Burner},
+		feemarkettypes.ModuleName:      {authtypes.Burner},
 	}
 )
 
@@ -171,6 +184,7 @@ type SimApp struct {
 	StakingKeeper       stakingkeeper.Keeper
 	SlashingKeeper      slashingkeeper.Keeper
 	MintKeeper          mintkeeper.Keeper
+	FeeMarketKeeper     feemarketkeeper.Keeper
 	DistrKeeper         distrkeeper.Keeper
 	GovKeeper           govkeeper.Keeper
 	CrisisKeeper        crisiskeeper.Keeper
@@ -179,6 +193,7 @@ type SimApp struct {
 	AuthzKeeper         authzkeeper.Keeper
 	EvidenceKeeper      evidencekeeper.Keeper
 	FeeGrantKeeper      feegrantkeeper.Keeper
+	CircuitKeeper       circuitkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -190,6 +205,8 @@ type SimApp struct {
 	configurator module.Configurator
 
 	txDecoder sdk.TxDecoder
+	// verifies the signatures of proposed txs, so that DeliverTx finds them in the sig verification cache
+	sigVerifier *ante.ParallelSigVerifier
 }
 
 func init() {
@@ -222,9 +239,9 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey, acltypes.StoreKey,
+		authzkeeper.StoreKey, acltypes.StoreKey, feemarkettypes.StoreKey, circuittypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, feemarkettypes.TStoreKey)
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
 	// not include this key.
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, "testingkey", banktypes.DeferredCacheStoreKey)
@@ -263,7 +280,7 @@ func NewSimApp(
 	)
 	app.BankKeeper = bankkeeper.NewBaseKeeperWithDeferredCache(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(), memKeys[banktypes.DeferredCacheStoreKey],
-	)
+	).WithDeferredSettlement(banktypes.DeferredSettlementEndBlock)
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
@@ -271,6 +288,10 @@ func NewSimApp(
 		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), &stakingKeeper,
 		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
 	)
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+		appCodec, keys[feemarkettypes.StoreKey], tkeys[feemarkettypes.TStoreKey], app.GetSubspace(feemarkettypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper,
+	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
@@ -290,16 +311,53 @@ func NewSimApp(
 	app.StakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
+	// the rewards of the share tokens of the tokenized delegations are settled before their balances change
+	app.BankKeeper.RegisterBalanceChangeHook(app.StakingKeeper.BeforeShareTokenBalanceChange)
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
+
+	// the circuit breakers can be tripped and reset by governance, and by the accounts it authorizes
+	app.CircuitKeeper = circuitkeeper.NewKeeper(
+		appCodec, keys[circuittypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.BaseApp.MsgServiceRouter().SetCircuit(app.CircuitKeeper)
+	aclOpts := []aclkeeper.Option{
+		aclkeeper.WithDependencyMappingGenerator(acltestutil.MessageDependencyGeneratorTestHelper()),
+		aclkeeper.WithDependencyGeneratorMappings(stakingaclmapping.GetStakingDependencyGenerators()),
+		aclkeeper.WithDependencyDagBuilder(encodingConfig.TxConfig.TxDecoder(), bApp.GetAnteDepGenerator),
+	}
+	var accessValidationReport *sdkacltypes.ValidationReport
+	if cast.ToBool(appOpts.Get(baseapp.FlagAccessObserverEnabled)) {
+		accessValidationReport = sdkacltypes.NewValidationReport()
+		aclOpts = append(aclOpts, aclkeeper.WithValidationReport(accessValidationReport))
+	}
 	app.AccessControlKeeper = aclkeeper.NewKeeper(
 		appCodec,
 		keys[acltypes.StoreKey],
 		app.GetSubspace(acltypes.ModuleName),
 		app.AccountKeeper,
 		app.StakingKeeper,
-		aclkeeper.WithDependencyMappingGenerator(acltestutil.MessageDependencyGeneratorTestHelper()),
+		aclOpts...,
 	)
+	if accessValidationReport != nil {
+		bApp.SetAccessObserver(
+			sdkacltypes.NewMsgValidator(acltestutil.TestingStoreKeyToResourceTypePrefixMap),
+			app.AccessControlKeeper.GetMessageDependencies,
+			accessValidationReport,
+		)
+		if traceFile := cast.ToString(appOpts.Get(baseapp.FlagAccessObserverTraceFile)); traceFile != "" {
+			traceWriter, err := os.OpenFile(traceFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+			if err != nil {
+				panic(err)
+			}
+			bApp.SetAccessTrace(traceWriter)
+		}
+	}
+	if cast.ToBool(appOpts.Get(baseapp.FlagOccEstimateDependencies)) {
+		bApp.SetTxDependencyEstimator(func(ctx sdk.Context, txs []sdk.Tx) (map[int][]int, error) {
+			return app.AccessControlKeeper.GenerateEstimatedTxDependencies(ctx, bApp.GetAnteDepGenerator(), txs)
+		})
+	}
 
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
@@ -310,7 +368,7 @@ func NewSimApp(
 	//TODO: we may need to add acl gov proposal types here
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, app.ParamsKeeper, govRouter,
+		&stakingKeeper, app.ParamsKeeper, govRouter, app.BaseApp.MsgServiceRouter(),
 	)
 
 	app.GovKeeper = *govKeeper.SetHooks(
@@ -348,6 +406,7 @@ func NewSimApp(
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
+		feemarket.NewAppModule(appCodec, app.FeeMarketKeeper, app.AccountKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
@@ -355,6 +414,7 @@ func NewSimApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		circuit.NewAppModule(appCodec, app.CircuitKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -367,8 +427,12 @@ func NewSimApp(
 		evidencetypes.ModuleName, stakingtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName,
 		authz.ModuleName, feegrant.ModuleName,
-		paramstypes.ModuleName, vestingtypes.ModuleName, acltypes.ModuleName,
+		paramstypes.ModuleName, vestingtypes.ModuleName, acltypes.ModuleName, feemarkettypes.ModuleName,
+		circuittypes.ModuleName,
 	)
+	// NOTE: the bank module settles the deferred balances in its MidBlocker or EndBlocker, depending on
+	// the deferred settlement of its keeper
+	app.mm.SetOrderMidBlockers(banktypes.ModuleName)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName,
@@ -376,6 +440,7 @@ func NewSimApp(
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName, acltypes.ModuleName,
+		feemarkettypes.ModuleName, circuittypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -389,6 +454,7 @@ func NewSimApp(
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName, acltypes.ModuleName,
+		feemarkettypes.ModuleName, circuittypes.ModuleName,
 	)
 
 	// Uncomment if you want to set a custom migration order here.
@@ -423,15 +489,20 @@ func NewSimApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 
 	signModeHandler := encodingConfig.TxConfig.SignModeHandler()
+	sigVerificationCache := ante.NewSigVerificationCache(ante.DefaultSigVerificationCacheSize)
+	app.sigVerifier = ante.NewParallelSigVerifier(app.AccountKeeper, signModeHandler, 0, sigVerificationCache)
 	anteHandler, anteDepGenerator, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: signModeHandler,
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			ParamsKeeper:    app.ParamsKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
-			TxFeeChecker:    ante.CheckTxFeeWithValidatorMinGasPrices,
+			AccountKeeper:        app.AccountKeeper,
+			BankKeeper:           app.BankKeeper,
+			SignModeHandler:      signModeHandler,
+			FeegrantKeeper:       app.FeeGrantKeeper,
+			FeeMarketKeeper:      app.FeeMarketKeeper,
+			ParamsKeeper:         app.ParamsKeeper,
+			CircuitKeeper:        app.CircuitKeeper,
+			SigGasConsumer:       ante.DefaultSigVerificationGasConsumer,
+			SigVerificationCache: sigVerificationCache,
+			TxFeeChecker:         ante.CheckTxFeeWithValidatorMinGasPrices,
 		},
 	)
 
@@ -441,6 +512,8 @@ func NewSimApp(
 
 	app.SetAnteHandler(anteHandler)
 	app.SetAnteDepGenerator(anteDepGenerator)
+	app.SetTxSigVerifier(app.sigVerifier)
+	app.SetMidBlocker(app.MidBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	app.SetPrepareProposalHandler(app.PrepareProposalHandler)
 	app.SetProcessProposalHandler(app.ProcessProposalHandler)
@@ -468,6 +541,18 @@ func (app *SimApp) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrep
 }
 
 func (app *SimApp) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+	txs := make([]sdk.Tx, 0, len(req.Txs))
+	checksums := make([][32]byte, 0, len(req.Txs))
+	for _, bz := range req.Txs {
+		tx, err := app.txDecoder(bz)
+		if err != nil {
+			continue
+		}
+		txs = append(txs, tx)
+		checksums = append(checksums, sha256.Sum256(bz))
+	}
+	app.sigVerifier.VerifyTxs(ctx, txs, checksums)
+
 	return &abci.ResponseProcessProposal{
 		Status: abci.ResponseProcessProposal_ACCEPT,
 	}, nil
@@ -535,6 +620,7 @@ func (app *SimApp) FinalizeBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlo
 			Codespace: deliverTxResp.Codespace,
 		})
 	}
+	events = append(events, app.MidBlock(ctx, req.Height)...)
 	endBlockResp := app.EndBlock(ctx, abci.RequestEndBlock{
 		Height: req.Height,
 	})
@@ -679,6 +765,8 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new tendermint queries routes from grpc-gateway.
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register OCC report queries routes from grpc-gateway.
+	occservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
 	// Register legacy and grpc-gateway routes for all modules.
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
@@ -698,6 +786,7 @@ func (app *SimApp) RegisterTxService(clientCtx client.Context) {
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry)
+	occservice.RegisterOCCService(app.BaseApp.GRPCQueryRouter(), app.BaseApp.OccReports())
 }
 
 // RegisterSwaggerAPI registers swagger route with API Server
@@ -728,6 +817,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(banktypes.ModuleName)
 	paramsKeeper.Subspace(stakingtypes.ModuleName)
 	paramsKeeper.Subspace(minttypes.ModuleName)
+	paramsKeeper.Subspace(feemarkettypes.ModuleName)
 	paramsKeeper.Subspace(distrtypes.ModuleName)
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
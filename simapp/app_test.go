@@ -25,10 +25,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
@@ -176,6 +178,7 @@ func TestRunMigrations(t *testing.T) {
 					"authz":         authzmodule.AppModule{}.ConsensusVersion(),
 					"staking":       staking.AppModule{}.ConsensusVersion(),
 					"mint":          mint.AppModule{}.ConsensusVersion(),
+					"feemarket":     feemarket.AppModule{}.ConsensusVersion(),
 					"distribution":  distribution.AppModule{}.ConsensusVersion(),
 					"slashing":      slashing.AppModule{}.ConsensusVersion(),
 					"gov":           gov.AppModule{}.ConsensusVersion(),
@@ -187,6 +190,7 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":        crisis.AppModule{}.ConsensusVersion(),
 					"genutil":       genutil.AppModule{}.ConsensusVersion(),
 					"capability":    capability.AppModule{}.ConsensusVersion(),
+					"circuit":       circuit.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"errors"
 	"io"
 	"os"
@@ -23,9 +24,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/occservice"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -33,11 +36,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	aclcli "github.com/cosmos/cosmos-sdk/x/accesscontrol/client/cli"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 const DefaultTracingURL = "http://localhost:14268/api/traces"
@@ -163,6 +168,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		debug.Cmd(),
 		config.Cmd(),
 		pruning.PruningCmd(a.newApp),
+		server.InspectStoreCmd(simapp.DefaultNodeHome, storeValueTypes()),
+		aclcli.ReplayDependencyMappingProposalCmd(a.newApp, simapp.DefaultNodeHome),
 	)
 
 	exp, err := jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(DefaultTracingURL)))
@@ -193,6 +200,38 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	rootCmd.AddCommand(server.RosettaCommand(encodingConfig.InterfaceRegistry, encodingConfig.Marshaler))
 }
 
+// storeValueTypes resolves the proto messages of the store values decoded by the inspect-store commands.
+func storeValueTypes() server.StoreValueTypes {
+	return server.StoreValueTypes{
+		banktypes.StoreKey: func(key []byte) codec.ProtoMarshaler {
+			switch {
+			case bytes.HasPrefix(key, banktypes.BalancesPrefix):
+				return &sdk.Coin{}
+			case bytes.HasPrefix(key, banktypes.DenomMetadataPrefix):
+				return &banktypes.Metadata{}
+			}
+			return nil
+		},
+		stakingtypes.StoreKey: func(key []byte) codec.ProtoMarshaler {
+			switch {
+			case bytes.HasPrefix(key, stakingtypes.ValidatorsKey):
+				return &stakingtypes.Validator{}
+			case bytes.HasPrefix(key, stakingtypes.DelegationKey):
+				return &stakingtypes.Delegation{}
+			case bytes.HasPrefix(key, stakingtypes.UnbondingDelegationKey):
+				return &stakingtypes.UnbondingDelegation{}
+			case bytes.HasPrefix(key, stakingtypes.RedelegationKey):
+				return &stakingtypes.Redelegation{}
+			case bytes.HasPrefix(key, stakingtypes.HistoricalInfoKey):
+				return &stakingtypes.HistoricalInfo{}
+			case bytes.HasPrefix(key, stakingtypes.TokenizeShareRecordPrefix):
+				return &stakingtypes.TokenizeShareRecord{}
+			}
+			return nil
+		},
+	}
+}
+
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 }
@@ -213,6 +252,7 @@ func queryCommand() *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
+		occservice.GetQueryCmd(),
 	)
 
 	simapp.ModuleBasics.AddQueryCommands(cmd)
@@ -302,10 +342,12 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, t
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
 		baseapp.SetSnapshotDirectory(snapshotDirectory),
+		baseapp.SetSnapshotFormat(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotFormat))),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(server.FlagIAVLFastNode))),
 		baseapp.SetCompactionInterval(cast.ToUint64(appOpts.Get(server.FlagCompactionInterval))),
 		baseapp.SetOccEnabled(cast.ToBool(appOpts.Get(baseapp.FlagOccEnabled))),
+		baseapp.SetOccReplayEnabled(cast.ToBool(appOpts.Get(baseapp.FlagOccReplayEnabled))),
 	)
 }
 
@@ -4,12 +4,14 @@ import (
 	"bufio"
 	"bytes"
 	"compress/zlib"
+	"context"
 	"crypto/sha256"
 	"errors"
 	"github.com/tendermint/tendermint/libs/log"
 	"io"
 	"io/ioutil"
 	"os"
+	"sort"
 	"testing"
 	"time"
 
@@ -193,3 +195,74 @@ func (m *hungSnapshotter) Restore(
 ) (snapshottypes.SnapshotItem, error) {
 	panic("not implemented")
 }
+
+// mockExtensionSnapshotter is a mockSnapshotter registered as an extension.
+type mockExtensionSnapshotter struct {
+	mockSnapshotter
+}
+
+func (m *mockExtensionSnapshotter) SnapshotName() string {
+	return "mock"
+}
+
+// mockChunkRestorer is a multistore snapshotter for the StoreChunksFormat, which snapshots the keys of its
+// stores as IAVL leaves.
+type mockChunkRestorer struct {
+	stores   map[string][][]byte
+	restored []snapshottypes.StoreChunk
+	failAt   uint32 // if non-zero, the restore fails at the chunk with this index
+	finished bool   // set once all the store chunks are restored
+}
+
+func (m *mockChunkRestorer) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	names := make([]string, 0, len(m.stores))
+	for name := range m.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_Store{Store: &snapshottypes.SnapshotStoreItem{Name: name}},
+		})
+		if err != nil {
+			return err
+		}
+		for _, key := range m.stores[name] {
+			err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+				Item: &snapshottypes.SnapshotItem_IAVL{IAVL: &snapshottypes.SnapshotIAVLItem{Key: key, Version: 1}},
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *mockChunkRestorer) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	return snapshottypes.SnapshotItem{}, types.ErrUnknownFormat
+}
+
+func (m *mockChunkRestorer) RestoreChunks(
+	ctx context.Context, height uint64, chunks <-chan snapshottypes.StoreChunk, checkpoint func(restored uint32) error,
+) error {
+	for chunk := range chunks {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if m.failAt != 0 && chunk.Index == m.failAt {
+			return errors.New("restore failed")
+		}
+		m.restored = append(m.restored, chunk)
+		if err := checkpoint(chunk.Index + 1); err != nil {
+			return err
+		}
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	m.finished = true
+	return nil
+}
@@ -2,6 +2,7 @@ package snapshots
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -54,6 +56,7 @@ type Manager struct {
 	logger     log.Logger
 	multistore types.Snapshotter
 	extensions map[string]types.ExtensionSnapshotter
+	format     uint32 // format of the snapshots taken by Create
 
 	mtx                sync.Mutex
 	operation          operation
@@ -61,6 +64,7 @@ type Manager struct {
 	chRestoreDone      <-chan restoreDone
 	restoreChunkHashes [][]byte
 	restoreChunkIndex  uint32
+	restoreResume      uint32 // chunks before it were restored by an interrupted restore
 }
 
 // NewManager creates a new manager.
@@ -70,6 +74,7 @@ func NewManager(store *Store, multistore types.Snapshotter, logger log.Logger) *
 		store:      store,
 		multistore: multistore,
 		extensions: make(map[string]types.ExtensionSnapshotter),
+		format:     types.CurrentFormat,
 	}
 }
 
@@ -79,9 +84,20 @@ func NewManagerWithExtensions(store *Store, multistore types.Snapshotter, extens
 		store:      store,
 		multistore: multistore,
 		extensions: extensions,
+		format:     types.CurrentFormat,
 	}
 }
 
+// SetSnapshotFormat sets the format of the snapshots taken by Create, which is CurrentFormat by default.
+// Snapshots in the StoreChunksFormat can only be taken of multistores that implement ChunkRestorer.
+func (m *Manager) SetSnapshotFormat(format uint32) error {
+	if format != types.CurrentFormat && format != types.StoreChunksFormat {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", format)
+	}
+	m.format = format
+	return nil
+}
+
 func (m *Manager) SetMultiStore(s types.Snapshotter) {
 	m.multistore = s
 }
@@ -141,6 +157,7 @@ func (m *Manager) endLocked() {
 	m.chRestoreDone = nil
 	m.restoreChunkHashes = nil
 	m.restoreChunkIndex = 0
+	m.restoreResume = 0
 }
 
 // sortedExtensionNames sort extension names for deterministic iteration.
@@ -174,19 +191,36 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	format := m.format
+	if _, ok := m.multistore.(types.ChunkRestorer); format == types.StoreChunksFormat && !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "multistore doesn't support snapshot format %v", format)
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	go m.createSnapshot(height, format, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	return m.store.Save(height, format, ch)
+}
+
+// snapshotWriter is a stream writer for the items of a snapshot format.
+type snapshotWriter interface {
+	protoio.WriteCloser
+	CloseWithError(err error)
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
-func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
-	if streamWriter == nil {
-		return
+func (m *Manager) createSnapshot(height uint64, format uint32, ch chan<- io.ReadCloser) {
+	var streamWriter snapshotWriter
+	if format == types.StoreChunksFormat {
+		streamWriter = NewChunkedStreamWriter(ch)
+	} else {
+		sw := NewStreamWriter(ch)
+		if sw == nil {
+			return
+		}
+		streamWriter = sw
 	}
 	defer streamWriter.Close()
 	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
@@ -261,7 +295,8 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	_, chunked := m.multistore.(types.ChunkRestorer)
+	if snapshot.Format != types.CurrentFormat && (snapshot.Format != types.StoreChunksFormat || !chunked) {
 		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -272,6 +307,22 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 			"snapshot height %v cannot exceed %v", snapshot.Height, int64(math.MaxInt64))
 	}
 
+	// resume an interrupted restore of the snapshot
+	var resume uint32
+	if snapshot.Format == types.StoreChunksFormat {
+		var err error
+		resume, err = m.store.GetRestoreProgress(snapshot)
+		if err != nil {
+			return err
+		}
+		if resume > snapshot.Chunks {
+			resume = 0
+		}
+		if resume > 0 {
+			m.logger.Info(fmt.Sprintf("Resuming restore of snapshot for version %d from chunk %d", snapshot.Height, resume))
+		}
+	}
+
 	err := m.beginLocked(opRestore)
 	if err != nil {
 		return err
@@ -283,7 +334,12 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 
 	go func() {
 		startTime := time.Now()
-		err := m.restoreSnapshot(snapshot, chChunks)
+		var err error
+		if snapshot.Format == types.StoreChunksFormat {
+			err = m.restoreChunkedSnapshot(snapshot, resume, chChunks)
+		} else {
+			err = m.restoreSnapshot(snapshot, chChunks)
+		}
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -296,6 +352,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	m.chRestoreDone = chDone
 	m.restoreChunkHashes = snapshot.Metadata.ChunkHashes
 	m.restoreChunkIndex = 0
+	m.restoreResume = resume
 	return nil
 }
 
@@ -311,6 +368,92 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 	if err != nil {
 		return sdkerrors.Wrap(err, "multistore restore")
 	}
+	return m.restoreExtensions(snapshot.Height, next, streamReader)
+}
+
+// restoreChunkedSnapshot restores a snapshot in the StoreChunksFormat, starting at the chunk given by resume. The
+// chunks are decoded in parallel, the store chunks are restored by the multistore, and the extensions after them.
+func (m *Manager) restoreChunkedSnapshot(snapshot types.Snapshot, resume uint32, chChunks <-chan io.ReadCloser) error {
+	restorer := m.multistore.(types.ChunkRestorer)
+	done := make(chan struct{})
+	defer close(done)
+	chunks := decodeChunks(chChunks, resume, done)
+
+	// the store restore is canceled unless all the store chunks are received
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var (
+		chStore     chan types.StoreChunk
+		chStoreDone chan error
+	)
+	// finishStores ends the restore of the store chunks and returns its result
+	finishStores := func() error {
+		if chStore == nil {
+			return nil
+		}
+		close(chStore)
+		chStore = nil
+		return <-chStoreDone
+	}
+	defer func() {
+		cancel()
+		_ = finishStores()
+	}()
+
+	reader := &chunkItemReader{chunks: chunks}
+	next := resume
+	for chunk := range chunks {
+		if chunk.err != nil {
+			return chunk.err
+		}
+		if len(chunk.items) == 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "chunk %v is empty", chunk.index)
+		}
+		next = chunk.index + 1
+		if chunk.items[0].GetStore() == nil {
+			// the extensions follow the stores
+			reader.items = chunk.items
+			break
+		}
+		if chStore == nil {
+			chStore = make(chan types.StoreChunk, chunkBufferSize)
+			chStoreDone = make(chan error, 1)
+			go func(chStore <-chan types.StoreChunk, chStoreDone chan<- error) {
+				chStoreDone <- restorer.RestoreChunks(ctx, snapshot.Height, chStore, func(restored uint32) error {
+					return m.store.SetRestoreProgress(snapshot, restored)
+				})
+				close(chStoreDone)
+			}(chStore, chStoreDone)
+		}
+		select {
+		case chStore <- types.StoreChunk{Index: chunk.index, Items: chunk.items}:
+		case err := <-chStoreDone:
+			if err == nil {
+				err = sdkerrors.Wrap(sdkerrors.ErrLogic, "restore ended prematurely")
+			}
+			return sdkerrors.Wrap(err, "multistore restore")
+		}
+	}
+	if reader.items == nil && next < snapshot.Chunks {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "restore ended prematurely")
+	}
+	if err := finishStores(); err != nil {
+		return sdkerrors.Wrap(err, "multistore restore")
+	}
+
+	item := types.SnapshotItem{}
+	if err := reader.ReadMsg(&item); err != nil && err != io.EOF {
+		return err
+	}
+	if err := m.restoreExtensions(snapshot.Height, item, reader); err != nil {
+		return err
+	}
+	return m.store.DeleteRestoreProgress(snapshot)
+}
+
+// restoreExtensions restores the extensions of a snapshot, starting with the extension metadata item next.
+func (m *Manager) restoreExtensions(height uint64, next types.SnapshotItem, protoReader protoio.Reader) error {
+	var err error
 	for {
 		if next.Item == nil {
 			// end of stream
@@ -327,7 +470,7 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 		if !IsFormatSupported(extension, metadata.Format) {
 			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
-		next, err = extension.Restore(snapshot.Height, metadata.Format, streamReader)
+		next, err = extension.Restore(height, metadata.Format, protoReader)
 		if err != nil {
 			return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
 		}
@@ -367,8 +510,11 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 			"expected %x, got %x", hash, expected)
 	}
 
-	// Pass the chunk to the restore, and wait for completion if it was the final one.
-	m.chRestore <- ioutil.NopCloser(bytes.NewReader(chunk))
+	// Pass the chunk to the restore, and wait for completion if it was the final one. Chunks that were
+	// restored by an interrupted restore are only verified.
+	if m.restoreChunkIndex >= m.restoreResume {
+		m.chRestore <- ioutil.NopCloser(bytes.NewReader(chunk))
+	}
 	m.restoreChunkIndex++
 
 	if int(m.restoreChunkIndex) >= len(m.restoreChunkHashes) {
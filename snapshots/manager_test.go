@@ -90,6 +90,12 @@ func TestManager_Take(t *testing.T) {
 	assert.Equal(t, snapshot, storeSnapshot)
 	assert.Equal(t, expectChunks, readChunks(chunks))
 
+	// the StoreChunksFormat requires a multistore that restores store chunks
+	require.Error(t, manager.SetSnapshotFormat(99))
+	require.NoError(t, manager.SetSnapshotFormat(types.StoreChunksFormat))
+	_, err = manager.Create(6)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
 	// creating a snapshot while a different snapshot is being created should error
 	manager = setupBusyManager(t)
 	_, err = manager.Create(9)
@@ -210,3 +216,108 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_StoreChunks(t *testing.T) {
+	store := setupStore(t)
+	source := &mockChunkRestorer{stores: map[string][][]byte{
+		"a": {{1}, {2}},
+		"b": {{3}},
+	}}
+	manager := snapshots.NewManager(store, source, log.NewNopLogger())
+	extension := &mockExtensionSnapshotter{mockSnapshotter{items: [][]byte{{4, 5, 6}}}}
+	require.NoError(t, manager.RegisterExtensions(extension))
+
+	// snapshots are taken in the CurrentFormat unless the StoreChunksFormat is set
+	snapshot, err := manager.Create(4)
+	require.NoError(t, err)
+	require.Equal(t, types.CurrentFormat, snapshot.Format)
+
+	// snapshots in the StoreChunksFormat have a chunk per store followed by a chunk for the extensions
+	require.NoError(t, manager.SetSnapshotFormat(types.StoreChunksFormat))
+	snapshot, err = manager.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, types.StoreChunksFormat, snapshot.Format)
+	require.EqualValues(t, 3, snapshot.Chunks)
+	var chunks [][]byte
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		chunks = append(chunks, chunk)
+	}
+
+	// the restore fails at the second store, after the first store is checkpointed
+	targetStore := setupStore(t)
+	target := &mockChunkRestorer{failAt: 1}
+	targetManager := snapshots.NewManager(targetStore, target, log.NewNopLogger())
+	targetExtension := &mockExtensionSnapshotter{}
+	require.NoError(t, targetManager.RegisterExtensions(targetExtension))
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for _, chunk := range chunks {
+		_, err = targetManager.RestoreChunk(chunk)
+		if err != nil {
+			break
+		}
+	}
+	require.Error(t, err)
+	progress, err := targetStore.GetRestoreProgress(*snapshot)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, progress)
+
+	// the restore of the snapshot resumes from the second store
+	target.failAt = 0
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i, chunk := range chunks {
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == len(chunks)-1, done)
+	}
+	require.Len(t, target.restored, 2)
+	assert.EqualValues(t, 0, target.restored[0].Index)
+	assert.EqualValues(t, 1, target.restored[1].Index)
+	assert.Equal(t, "b", target.restored[1].Items[0].GetStore().Name)
+	assert.Equal(t, []byte{3}, target.restored[1].Items[1].GetIAVL().Key)
+	assert.Equal(t, extension.items, targetExtension.items)
+
+	// the progress is deleted once the restore is complete
+	progress, err = targetStore.GetRestoreProgress(*snapshot)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, progress)
+}
+
+func TestManager_StoreChunksInterrupted(t *testing.T) {
+	store := setupStore(t)
+	source := &mockChunkRestorer{stores: map[string][][]byte{
+		"a": {{1}, {2}},
+		"b": {{3}},
+	}}
+	manager := snapshots.NewManager(store, source, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(&mockExtensionSnapshotter{}))
+	require.NoError(t, manager.SetSnapshotFormat(types.StoreChunksFormat))
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	var chunks [][]byte
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		chunks = append(chunks, chunk)
+	}
+
+	// the extension chunk can't be decoded, so the restore fails after all the store chunks are received
+	chunks[len(chunks)-1] = []byte{1, 2, 3}
+	snapshot.Metadata.ChunkHashes = checksums(chunks)
+
+	targetStore := setupStore(t)
+	target := &mockChunkRestorer{}
+	targetManager := snapshots.NewManager(targetStore, target, log.NewNopLogger())
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for _, chunk := range chunks {
+		_, err = targetManager.RestoreChunk(chunk)
+		if err != nil {
+			break
+		}
+	}
+	require.Error(t, err)
+
+	// the store restore is interrupted rather than finished
+	assert.False(t, target.finished)
+}
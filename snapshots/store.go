@@ -1,6 +1,7 @@
 package snapshots
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"io"
@@ -19,6 +20,8 @@ import (
 const (
 	// keyPrefixSnapshot is the prefix for snapshot database keys
 	keyPrefixSnapshot byte = 0x01
+	// keyPrefixRestoreProgress is the prefix for the progress of interrupted snapshot restores
+	keyPrefixRestoreProgress byte = 0x02
 )
 
 // Store is a snapshot store, containing snapshot metadata and binary chunks.
@@ -305,6 +308,38 @@ func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
 	return sdkerrors.Wrap(err, "failed to store snapshot")
 }
 
+// GetRestoreProgress fetches the number of chunks of a snapshot that were durably restored by an
+// interrupted restore. It returns 0 if there is no progress for the snapshot.
+func (s *Store) GetRestoreProgress(snapshot types.Snapshot) (uint32, error) {
+	value, err := s.db.Get(encodeRestoreProgressKey(snapshot.Height, snapshot.Format))
+	if err != nil {
+		return 0, sdkerrors.Wrapf(err, "failed to fetch restore progress for height %v format %v",
+			snapshot.Height, snapshot.Format)
+	}
+	// the progress belongs to a different snapshot at the same height and format
+	if len(value) != len(snapshot.Hash)+4 || !bytes.Equal(value[:len(snapshot.Hash)], snapshot.Hash) {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint32(value[len(snapshot.Hash):]), nil
+}
+
+// SetRestoreProgress saves the number of chunks of a snapshot that are durably restored.
+func (s *Store) SetRestoreProgress(snapshot types.Snapshot, chunks uint32) error {
+	value := make([]byte, len(snapshot.Hash)+4)
+	copy(value, snapshot.Hash)
+	binary.BigEndian.PutUint32(value[len(snapshot.Hash):], chunks)
+	err := s.db.SetSync(encodeRestoreProgressKey(snapshot.Height, snapshot.Format), value)
+	return sdkerrors.Wrapf(err, "failed to store restore progress for height %v format %v",
+		snapshot.Height, snapshot.Format)
+}
+
+// DeleteRestoreProgress deletes the restore progress of a snapshot.
+func (s *Store) DeleteRestoreProgress(snapshot types.Snapshot) error {
+	err := s.db.DeleteSync(encodeRestoreProgressKey(snapshot.Height, snapshot.Format))
+	return sdkerrors.Wrapf(err, "failed to delete restore progress for height %v format %v",
+		snapshot.Height, snapshot.Format)
+}
+
 // pathHeight generates the path to a height, containing multiple snapshot formats.
 func (s *Store) pathHeight(height uint64) string {
 	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
@@ -341,3 +376,10 @@ func encodeKey(height uint64, format uint32) []byte {
 	binary.BigEndian.PutUint32(k[9:], format)
 	return k
 }
+
+// encodeRestoreProgressKey encodes a restore progress key.
+func encodeRestoreProgressKey(height uint64, format uint32) []byte {
+	k := encodeKey(height, format)
+	k[0] = keyPrefixRestoreProgress
+	return k
+}
@@ -333,3 +333,37 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_RestoreProgress(t *testing.T) {
+	store := setupStore(t)
+	snapshot := types.Snapshot{Height: 3, Format: 2, Hash: []byte{1, 2, 3}}
+
+	progress, err := store.GetRestoreProgress(snapshot)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, progress)
+
+	err = store.SetRestoreProgress(snapshot, 2)
+	require.NoError(t, err)
+	progress, err = store.GetRestoreProgress(snapshot)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, progress)
+
+	// the progress doesn't apply to a different snapshot at the same height and format
+	progress, err = store.GetRestoreProgress(types.Snapshot{Height: 3, Format: 2, Hash: []byte{4, 5, 6}})
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, progress)
+
+	// the progress is kept apart from the snapshots
+	latest, err := store.GetLatest()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, latest.Height)
+	list, err := store.List()
+	require.NoError(t, err)
+	assert.Len(t, list, 4)
+
+	err = store.DeleteRestoreProgress(snapshot)
+	require.NoError(t, err)
+	progress, err = store.GetRestoreProgress(snapshot)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, progress)
+}
@@ -2,12 +2,15 @@ package snapshots
 
 import (
 	"bufio"
+	"bytes"
 	"compress/zlib"
 	"io"
+	"io/ioutil"
 
 	protoio "github.com/gogo/protobuf/io"
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -107,3 +110,212 @@ func (sr *StreamReader) Close() error {
 	sr.zReader.Close()
 	return sr.chunkReader.Close()
 }
+
+// ChunkedStreamWriter serializes snapshot items in the StoreChunksFormat, where every chunk is a separate
+// zlib stream of delimited Protobuf items:
+// Exported Items -> delimited Protobuf -> buffer -> zlib -> chan io.ReadCloser
+//
+// A new chunk is started for every store and for the first extension. When the items of a store chunk
+// exceed the chunk size, the store continues in a new chunk that starts with the store item again.
+type ChunkedStreamWriter struct {
+	ch         chan<- io.ReadCloser
+	chunkSize  int
+	buf        *bytes.Buffer
+	store      *types.SnapshotItem // store item of the current store chunk
+	extensions bool                // true once the extensions are written
+	closed     bool
+}
+
+// NewChunkedStreamWriter set up a stream pipeline to serialize snapshot items into self-contained chunks.
+func NewChunkedStreamWriter(ch chan<- io.ReadCloser) *ChunkedStreamWriter {
+	return &ChunkedStreamWriter{
+		ch:        ch,
+		chunkSize: int(snapshotChunkSize),
+		buf:       &bytes.Buffer{},
+	}
+}
+
+// WriteMsg implements protoio.Write interface
+func (sw *ChunkedStreamWriter) WriteMsg(msg proto.Message) error {
+	if sw.closed {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot write to closed ChunkedStreamWriter")
+	}
+	if item, ok := msg.(*types.SnapshotItem); ok {
+		switch {
+		case item.GetStore() != nil:
+			if err := sw.flush(); err != nil {
+				return err
+			}
+			sw.store = item
+		case item.GetExtension() != nil && !sw.extensions:
+			if err := sw.flush(); err != nil {
+				return err
+			}
+			sw.store = nil
+			sw.extensions = true
+		}
+	}
+	if sw.buf.Len() >= sw.chunkSize {
+		if err := sw.flush(); err != nil {
+			return err
+		}
+		// a store continues in a new chunk, which needs the store item to be self-contained
+		if sw.store != nil && sw.store != msg {
+			if err := protoio.NewDelimitedWriter(sw.buf).WriteMsg(sw.store); err != nil {
+				return err
+			}
+		}
+	}
+	return protoio.NewDelimitedWriter(sw.buf).WriteMsg(msg)
+}
+
+// flush compresses the buffered items into a chunk
+func (sw *ChunkedStreamWriter) flush() error {
+	if sw.buf.Len() == 0 {
+		return nil
+	}
+	chunk := &bytes.Buffer{}
+	zWriter, err := zlib.NewWriterLevel(chunk, snapshotCompressionLevel)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	if _, err = zWriter.Write(sw.buf.Bytes()); err != nil {
+		return err
+	}
+	if err = zWriter.Close(); err != nil {
+		return err
+	}
+	sw.ch <- ioutil.NopCloser(chunk)
+	sw.buf = &bytes.Buffer{}
+	return nil
+}
+
+// Close implements io.Closer interface
+func (sw *ChunkedStreamWriter) Close() error {
+	if sw.closed {
+		return nil
+	}
+	err := sw.flush()
+	if err != nil {
+		sw.CloseWithError(err)
+		return err
+	}
+	sw.closed = true
+	close(sw.ch)
+	return nil
+}
+
+// CloseWithError closes the writer and sends an error to the reader
+func (sw *ChunkedStreamWriter) CloseWithError(err error) {
+	if sw.closed {
+		return
+	}
+	pr, pw := io.Pipe()
+	_ = pw.CloseWithError(err)
+	sw.ch <- pr
+	sw.closed = true
+	close(sw.ch)
+}
+
+// decodeChunk decodes the items of a chunk in the StoreChunksFormat.
+func decodeChunk(chunk io.Reader) ([]types.SnapshotItem, error) {
+	zReader, err := zlib.NewReader(chunk)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	var items []types.SnapshotItem
+	for {
+		var item types.SnapshotItem
+		err := protoReader.ReadMsg(&item)
+		if err == io.EOF {
+			return items, nil
+		}
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+		items = append(items, item)
+	}
+}
+
+// decodedChunk is a chunk in the StoreChunksFormat decoded by decodeChunks
+type decodedChunk struct {
+	index uint32
+	items []types.SnapshotItem
+	err   error
+}
+
+// decodeChunks decodes chunks in the StoreChunksFormat in parallel, and returns them in order. The index of the
+// first chunk is given by first. Once done is closed, the remaining chunks are closed without decoding them.
+func decodeChunks(chunks <-chan io.ReadCloser, first uint32, done <-chan struct{}) <-chan decodedChunk {
+	futures := make(chan chan decodedChunk, chunkBufferSize)
+	go func() {
+		defer close(futures)
+		index := first
+		for chunk := range chunks {
+			select {
+			case <-done:
+				chunk.Close()
+				continue
+			default:
+			}
+			future := make(chan decodedChunk, 1)
+			go func(index uint32, chunk io.ReadCloser) {
+				defer chunk.Close()
+				items, err := decodeChunk(chunk)
+				future <- decodedChunk{index: index, items: items, err: err}
+			}(index, chunk)
+			select {
+			case futures <- future:
+			case <-done:
+			}
+			index++
+		}
+	}()
+
+	decoded := make(chan decodedChunk)
+	go func() {
+		defer close(decoded)
+		for future := range futures {
+			chunk := <-future
+			select {
+			case decoded <- chunk:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return decoded
+}
+
+// chunkItemReader reads the items of decoded chunks, implementing the protoio.Reader interface.
+type chunkItemReader struct {
+	chunks <-chan decodedChunk
+	items  []types.SnapshotItem
+}
+
+// ReadMsg implements protoio.Reader interface
+func (r *chunkItemReader) ReadMsg(msg proto.Message) error {
+	for len(r.items) == 0 {
+		chunk, ok := <-r.chunks
+		if !ok {
+			return io.EOF
+		}
+		if chunk.err != nil {
+			return chunk.err
+		}
+		r.items = chunk.items
+	}
+	item := r.items[0]
+	r.items = r.items[1:]
+	if target, ok := msg.(*types.SnapshotItem); ok {
+		*target = item
+		return nil
+	}
+	bz, err := item.Marshal()
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(bz, msg)
+}
@@ -4,3 +4,9 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 1
+
+// StoreChunksFormat is the format for snapshots in which every chunk is self-contained, and holds either
+// the items of a single store or the items of the extensions. Large stores are split into several chunks
+// by key range. The store chunks can be restored concurrently by a ChunkRestorer, and an interrupted
+// restore can resume from the last restored chunk.
+const StoreChunksFormat uint32 = 2
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"context"
+
 	protoio "github.com/gogo/protobuf/io"
 )
 
@@ -33,3 +35,22 @@ type ExtensionSnapshotter interface {
 	// SupportedFormats returns a list of formats it can restore from.
 	SupportedFormats() []uint32
 }
+
+// StoreChunk is a decoded chunk of a snapshot in the StoreChunksFormat. The items of a store chunk start with
+// the SnapshotStoreItem of the store, followed by the IAVL items of a key range of the store.
+type StoreChunk struct {
+	Index uint32
+	Items []SnapshotItem
+}
+
+// ChunkRestorer is a Snapshotter that can restore snapshots in the StoreChunksFormat chunk by chunk.
+type ChunkRestorer interface {
+	Snapshotter
+
+	// RestoreChunks restores the store chunks of a snapshot, which are received in order of their index until
+	// chunks is closed. The chunks before the first chunk received were restored by an interrupted restore. The
+	// restorer calls checkpoint with the number of chunks that are durably restored, so that an interrupted
+	// restore can resume from there, or with 0 once the restored chunks can't be resumed from anymore. If ctx is
+	// canceled, the restore is interrupted and returns the error of ctx.
+	RestoreChunks(ctx context.Context, height uint64, chunks <-chan StoreChunk, checkpoint func(restored uint32) error) error
+}
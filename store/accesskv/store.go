@@ -0,0 +1,108 @@
+package accesskv
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+)
+
+var _ types.KVStore = &Store{}
+var _ types.DeltaKVStore = &Store{}
+
+// Store records the keys accessed on an underlying KVStore with an
+// AccessRecorder. It implements the KVStore interface.
+type Store struct {
+	parent   types.KVStore
+	storeKey string
+	recorder *acltypes.AccessRecorder
+}
+
+// NewStore returns a reference to a new access recording KVStore.
+func NewStore(parent types.KVStore, storeKey types.StoreKey, recorder *acltypes.AccessRecorder) *Store {
+	return &Store{
+		parent:   parent,
+		storeKey: storeKey.Name(),
+		recorder: recorder,
+	}
+}
+
+// Implements Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+func (s *Store) GetWorkingHash() ([]byte, error) {
+	return s.parent.GetWorkingHash()
+}
+
+// Implements KVStore.
+func (s *Store) Get(key []byte) []byte {
+	s.recorder.RecordAccess(s.storeKey, acltypes.AccessType_READ, key)
+	return s.parent.Get(key)
+}
+
+// Implements KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	s.recorder.RecordAccess(s.storeKey, acltypes.AccessType_WRITE, key)
+	s.parent.Set(key, value)
+}
+
+// AddDelta implements types.DeltaKVStore. A delta is recorded as a write of its key.
+func (s *Store) AddDelta(key, delta []byte, merge types.MergeFunc) {
+	s.recorder.RecordAccess(s.storeKey, acltypes.AccessType_WRITE, key)
+	types.AddDelta(s.parent, key, delta, merge)
+}
+
+// Implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.recorder.RecordAccess(s.storeKey, acltypes.AccessType_READ, key)
+	return s.parent.Has(key)
+}
+
+// Implements KVStore.
+func (s *Store) Delete(key []byte) {
+	s.recorder.RecordAccess(s.storeKey, acltypes.AccessType_WRITE, key)
+	s.parent.Delete(key)
+}
+
+// Iterator implements the KVStore interface. The iteration is recorded as a
+// read of its start key, which is the prefix of the iterated keys.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	s.recorder.RecordAccess(s.storeKey, acltypes.AccessType_READ, start)
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. The iteration is recorded
+// as a read of its start key, which is the prefix of the iterated keys.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	s.recorder.RecordAccess(s.storeKey, acltypes.AccessType_READ, start)
+	return s.parent.ReverseIterator(start, end)
+}
+
+// Implements KVStore.
+func (s *Store) CacheWrap(_ types.StoreKey) types.CacheWrap {
+	panic("cannot CacheWrap an AccessKVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(_ types.StoreKey, _ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace an AccessKVStore")
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface.
+func (s *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	panic("cannot CacheWrapWithListeners an AccessKVStore")
+}
+
+func (s *Store) VersionExists(version int64) bool {
+	return s.parent.VersionExists(version)
+}
+
+func (s *Store) DeleteAll(start, end []byte) error {
+	return s.parent.DeleteAll(start, end)
+}
+
+func (s *Store) GetAllKeyStrsInRange(start, end []byte) (res []string) {
+	return s.parent.GetAllKeyStrsInRange(start, end)
+}
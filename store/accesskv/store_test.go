@@ -0,0 +1,34 @@
+package accesskv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/accesskv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+)
+
+func TestAccessKVStore(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	recorder := acltypes.NewAccessRecorder()
+	st := accesskv.NewStore(mem, types.NewKVStoreKey("bank"), recorder)
+
+	require.Empty(t, st.Get([]byte{1}))
+	st.Set([]byte{1}, []byte{2})
+	require.True(t, st.Has([]byte{1}))
+	st.Delete([]byte{3})
+	iter := st.Iterator([]byte{4}, nil)
+	require.NoError(t, iter.Close())
+	require.Equal(t, []byte{2}, mem.Get([]byte{1}))
+
+	require.Equal(t, []acltypes.Comparator{
+		{AccessType: acltypes.AccessType_READ, Identifier: "01", StoreKey: "bank"},
+		{AccessType: acltypes.AccessType_WRITE, Identifier: "01", StoreKey: "bank"},
+		{AccessType: acltypes.AccessType_WRITE, Identifier: "03", StoreKey: "bank"},
+		{AccessType: acltypes.AccessType_READ, Identifier: "04", StoreKey: "bank"},
+	}, recorder.Accesses())
+}
@@ -22,6 +22,7 @@ type Store struct {
 	cache         *sync.Map
 	deleted       *sync.Map
 	unsortedCache *sync.Map
+	deltas        *sync.Map  // keys that weren't cached -> deltas to write to a parent that supports deltas
 	sortedCache   *dbm.MemDB // always ascending sorted
 	parent        types.KVStore
 	eventManager  *sdktypes.EventManager
@@ -30,6 +31,7 @@ type Store struct {
 }
 
 var _ types.CacheKVStore = (*Store)(nil)
+var _ types.DeltaKVStore = (*Store)(nil)
 
 // NewStore creates a new Store object
 func NewStore(parent types.KVStore, storeKey types.StoreKey, cacheSize int) *Store {
@@ -37,6 +39,7 @@ func NewStore(parent types.KVStore, storeKey types.StoreKey, cacheSize int) *Sto
 		cache:         &sync.Map{},
 		deleted:       &sync.Map{},
 		unsortedCache: &sync.Map{},
+		deltas:        &sync.Map{},
 		sortedCache:   dbm.NewMemDB(),
 		parent:        parent,
 		eventManager:  sdktypes.NewEventManager(),
@@ -75,6 +78,7 @@ func (store *Store) getFromCache(key []byte) []byte {
 // Get implements types.KVStore.
 func (store *Store) Get(key []byte) (value []byte) {
 	types.AssertValidKey(key)
+	store.resolveDeltas(key)
 	return store.getFromCache(key)
 }
 
@@ -97,6 +101,52 @@ func (store *Store) Delete(key []byte) {
 	store.setCacheValue(key, nil, true, true)
 }
 
+// AddDelta implements types.DeltaKVStore. If the key isn't cached and the parent supports deltas, the delta is kept
+// until the key is read or the store is written, otherwise it's merged into the value of the key.
+func (store *Store) AddDelta(key, delta []byte, merge types.MergeFunc) {
+	types.AssertValidKey(key)
+	types.AssertValidDelta(delta, merge)
+	keyStr := string(key)
+	_, cached := store.cache.Load(keyStr)
+	_, parentSupportsDeltas := store.parent.(types.DeltaKVStore)
+	if cached || !parentSupportsDeltas {
+		store.setMergedValue(key, merge(store.Get(key), delta))
+		return
+	}
+	var deltas []types.Delta
+	if pending, ok := store.deltas.Load(keyStr); ok {
+		deltas = pending.([]types.Delta)
+	}
+	store.deltas.Store(keyStr, append(deltas, types.Delta{Delta: delta, Merge: merge}))
+}
+
+// resolveDeltas merges the pending deltas of a key into the cached value of the key
+func (store *Store) resolveDeltas(key []byte) {
+	deltas, ok := store.deltas.LoadAndDelete(conv.UnsafeBytesToStr(key))
+	if !ok {
+		return
+	}
+	store.setMergedValue(key, types.ApplyDeltas(store.getFromCache(key), deltas.([]types.Delta)))
+}
+
+// resolveDeltasInRange merges the pending deltas of the keys in the range into the cached values of the keys
+func (store *Store) resolveDeltasInRange(start, end []byte) {
+	keys := [][]byte{}
+	store.deltas.Range(func(key, value any) bool {
+		if dbm.IsKeyInDomain(conv.UnsafeStrToBytes(key.(string)), start, end) {
+			keys = append(keys, []byte(key.(string)))
+		}
+		return true
+	})
+	for _, key := range keys {
+		store.resolveDeltas(key)
+	}
+}
+
+func (store *Store) setMergedValue(key, value []byte) {
+	store.setCacheValue(key, value, value == nil, true)
+}
+
 // Implements Cachetypes.KVStore.
 func (store *Store) Write() {
 	store.mtx.Lock()
@@ -132,9 +182,24 @@ func (store *Store) Write() {
 		}
 	}
 
+	// forward the pending deltas, deltas are only kept if the parent supports them
+	deltaKeys := []string{}
+	store.deltas.Range(func(key, value any) bool {
+		deltaKeys = append(deltaKeys, key.(string))
+		return true
+	})
+	sort.Strings(deltaKeys)
+	for _, key := range deltaKeys {
+		deltas, _ := store.deltas.Load(key)
+		for _, delta := range deltas.([]types.Delta) {
+			types.AddDelta(store.parent, []byte(key), delta.Delta, delta.Merge)
+		}
+	}
+
 	store.cache = &sync.Map{}
 	store.deleted = &sync.Map{}
 	store.unsortedCache = &sync.Map{}
+	store.deltas = &sync.Map{}
 	store.sortedCache = dbm.NewMemDB()
 }
 
@@ -173,6 +238,9 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 
 	var parent, cache types.Iterator
 
+	// iterated values must include the pending deltas
+	store.resolveDeltasInRange(start, end)
+
 	if ascending {
 		parent = store.parent.Iterator(start, end)
 	} else {
@@ -349,6 +417,8 @@ func (store *Store) setCacheValue(key, value []byte, deleted bool, dirty bool) {
 
 	keyStr := conv.UnsafeBytesToStr(key)
 	store.cache.Store(keyStr, types.NewCValue(value, dirty))
+	// a cached value overrides the pending deltas
+	store.deltas.Delete(keyStr)
 	if deleted {
 		store.deleted.Store(keyStr, struct{}{})
 	} else {
@@ -376,6 +446,7 @@ func (store *Store) DeleteAll(start, end []byte) error {
 }
 
 func (store *Store) GetAllKeyStrsInRange(start, end []byte) (res []string) {
+	store.resolveDeltasInRange(start, end)
 	keyStrs := map[string]struct{}{}
 	for _, pk := range store.parent.GetAllKeyStrsInRange(start, end) {
 		keyStrs[pk] = struct{}{}
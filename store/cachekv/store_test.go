@@ -2,6 +2,7 @@ package cachekv_test
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -558,3 +559,51 @@ func BenchmarkCacheKVStoreGetKeyFound(b *testing.B) {
 		st.Get([]byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)})
 	}
 }
+
+// addInt merges an integer delta into an integer value, a zero sum deletes the value
+func addInt(value, delta []byte) []byte {
+	var sum int
+	if value != nil {
+		sum, _ = strconv.Atoi(string(value))
+	}
+	d, _ := strconv.Atoi(string(delta))
+	if sum += d; sum == 0 {
+		return nil
+	}
+	return []byte(strconv.Itoa(sum))
+}
+
+func TestCacheKVStoreAddDelta(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	mem.Set(keyFmt(1), bz("10"))
+	parent := cachekv.NewStore(mem, types.NewKVStoreKey("CacheKvTest"), types.DefaultCacheSizeLimit)
+
+	// the parent of the store doesn't support deltas, so they are merged right away
+	parent.AddDelta(keyFmt(1), bz("1"), addInt)
+	require.Equal(t, bz("11"), parent.Get(keyFmt(1)))
+
+	st := cachekv.NewStore(parent, types.NewKVStoreKey("CacheKvTest"), types.DefaultCacheSizeLimit)
+	st.AddDelta(keyFmt(1), bz("2"), addInt)
+	st.AddDelta(keyFmt(1), bz("3"), addInt)
+	st.AddDelta(keyFmt(2), bz("4"), addInt)
+	st.AddDelta(keyFmt(3), bz("5"), addInt)
+	st.Set(keyFmt(3), bz("1"))
+
+	// pending deltas are merged when the key is read
+	require.Equal(t, bz("16"), st.Get(keyFmt(1)))
+	// deltas to cached keys are merged right away
+	st.AddDelta(keyFmt(1), bz("-16"), addInt)
+	require.Nil(t, st.Get(keyFmt(1)))
+	require.ElementsMatch(t, []string{string(keyFmt(2)), string(keyFmt(3))}, st.GetAllKeyStrsInRange(keyFmt(2), keyFmt(4)))
+
+	// pending deltas are written to the parent as deltas
+	st.AddDelta(keyFmt(4), bz("6"), addInt)
+	st.Write()
+	require.Nil(t, parent.Get(keyFmt(1)))
+	require.Equal(t, bz("4"), parent.Get(keyFmt(2)))
+	require.Equal(t, bz("1"), parent.Get(keyFmt(3)))
+	require.Equal(t, bz("6"), parent.Get(keyFmt(4)))
+	parent.Write()
+	require.Nil(t, mem.Get(keyFmt(1)))
+	require.Equal(t, bz("6"), mem.Get(keyFmt(4)))
+}
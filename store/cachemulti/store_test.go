@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func TestStoreGetKVStore(t *testing.T) {
@@ -21,3 +23,12 @@ func TestStoreGetKVStore(t *testing.T) {
 	require.PanicsWithValue(errMsg,
 		func() { s.GetKVStore(key) })
 }
+
+func TestCacheMultiStoreKeepsStoreKeys(t *testing.T) {
+	key := types.NewKVStoreKey("abc")
+	s := NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{key: dbadapter.Store{DB: dbm.NewMemDB()}}, map[string]types.StoreKey{key.Name(): key}, nil, nil, nil)
+
+	branch := s.CacheMultiStore()
+	require.Equal(t, []types.StoreKey{key}, branch.StoreKeys())
+	require.Equal(t, []types.StoreKey{key}, branch.CacheMultiStore().StoreKeys())
+}
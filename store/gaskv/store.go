@@ -9,6 +9,7 @@ import (
 )
 
 var _ types.KVStore = &Store{}
+var _ types.DeltaKVStore = &Store{}
 
 // Store applies gas tracking to an underlying KVStore. It implements the
 // KVStore interface.
@@ -60,6 +61,18 @@ func (gs *Store) Set(key []byte, value []byte) {
 	gs.parent.Set(key, value)
 }
 
+// AddDelta implements types.DeltaKVStore. A delta costs the same gas as a write of the delta, so the gas used
+// doesn't depend on whether the parent supports deltas.
+func (gs *Store) AddDelta(key, delta []byte, merge types.MergeFunc) {
+	types.AssertValidKey(key)
+	types.AssertValidDelta(delta, merge)
+	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
+	// TODO overflow-safe math?
+	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(key)), types.GasWritePerByteDesc)
+	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(delta)), types.GasWritePerByteDesc)
+	types.AddDelta(gs.parent, key, delta, merge)
+}
+
 // Implements KVStore.
 func (gs *Store) Has(key []byte) bool {
 	defer telemetry.MeasureSince(time.Now(), "store", "gaskv", "has")
@@ -122,3 +122,18 @@ func TestGasKVStoreOutOfGasIterator(t *testing.T) {
 	iterator.Next()
 	require.Panics(t, func() { iterator.Value() }, "Expected out-of-gas")
 }
+
+func TestGasKVStoreAddDelta(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	meter := types.NewMultiplierGasMeter(10000, 1, 1)
+	st := gaskv.NewStore(mem, meter, types.KVGasConfig())
+	merge := func(value, delta []byte) []byte { return append(value, delta...) }
+
+	require.Panics(t, func() { st.AddDelta(keyFmt(1), nil, merge) }, "adding a nil delta should panic")
+	require.Panics(t, func() { st.AddDelta(keyFmt(1), valFmt(1), nil) }, "adding a delta without merge function should panic")
+
+	// the delta costs the same as a write of the delta, even though the parent is read to merge it
+	st.AddDelta(keyFmt(1), valFmt(1), merge)
+	require.Equal(t, valFmt(1), mem.Get(keyFmt(1)))
+	require.Equal(t, types.Gas(2000+30*(len(keyFmt(1))+len(valFmt(1)))), meter.GasConsumed())
+}
@@ -15,7 +15,10 @@ type MultiVersionValue interface {
 	GetLatest() (value MultiVersionValueItem, found bool)
 	GetLatestNonEstimate() (value MultiVersionValueItem, found bool)
 	GetLatestBeforeIndex(index int) (value MultiVersionValueItem, found bool)
+	GetLatestResolvedNonEstimate(parentValue func() []byte) (value MultiVersionValueItem, found bool)
+	GetLatestResolvedBeforeIndex(index int, parentValue func() []byte) (value MultiVersionValueItem, found bool)
 	Set(index int, incarnation int, value []byte)
+	SetDelta(index int, incarnation int, deltas []types.Delta)
 	SetEstimate(index int, incarnation int)
 	Delete(index int, incarnation int)
 	Remove(index int)
@@ -24,7 +27,9 @@ type MultiVersionValue interface {
 type MultiVersionValueItem interface {
 	IsDeleted() bool
 	IsEstimate() bool
+	IsDelta() bool
 	Value() []byte
+	Deltas() []types.Delta
 	Incarnation() int
 	Index() int
 }
@@ -100,6 +105,78 @@ func (item *multiVersionItem) GetLatestBeforeIndex(index int) (MultiVersionValue
 	return vItem, found
 }
 
+// GetLatestResolvedNonEstimate returns the latest value that isn't an ESTIMATE like GetLatestNonEstimate, with the
+// deltas written to the key merged into the latest value they apply to. parentValue is used to get the value that
+// deltas apply to if no value was written before them.
+func (item *multiVersionItem) GetLatestResolvedNonEstimate(parentValue func() []byte) (MultiVersionValueItem, bool) {
+	item.mtx.RLock()
+	defer item.mtx.RUnlock()
+
+	return resolveDeltas(func(iterator btree.ItemIterator) {
+		item.valueTree.Descend(iterator)
+	}, true, parentValue)
+}
+
+// GetLatestResolvedBeforeIndex returns the latest value prior to the index like GetLatestBeforeIndex, with the
+// deltas written to the key merged into the latest value they apply to. parentValue is used to get the value that
+// deltas apply to if no value was written before them. If deltas apply to an ESTIMATE, the ESTIMATE is returned.
+func (item *multiVersionItem) GetLatestResolvedBeforeIndex(index int, parentValue func() []byte) (MultiVersionValueItem, bool) {
+	item.mtx.RLock()
+	defer item.mtx.RUnlock()
+
+	pivot := &valueItem{index: index - 1}
+	return resolveDeltas(func(iterator btree.ItemIterator) {
+		item.valueTree.DescendLessOrEqual(pivot, iterator)
+	}, false, parentValue)
+}
+
+// resolveDeltas merges the latest delta items yielded by descend into the latest item that isn't a delta. The
+// resolved item has the index and incarnation of the latest delta item.
+func resolveDeltas(descend func(btree.ItemIterator), skipEstimates bool, parentValue func() []byte) (MultiVersionValueItem, bool) {
+	var latest, base *valueItem
+	var deltaItems []*valueItem
+	descend(func(bTreeItem btree.Item) bool {
+		vItem := bTreeItem.(*valueItem)
+		if skipEstimates && vItem.IsEstimate() {
+			return true
+		}
+		if latest == nil {
+			latest = vItem
+		}
+		if !vItem.IsDelta() {
+			base = vItem
+			return false
+		}
+		deltaItems = append(deltaItems, vItem)
+		return true
+	})
+	if latest == nil {
+		return nil, false
+	}
+	if !latest.IsDelta() {
+		return latest, true
+	}
+	if base != nil && base.IsEstimate() {
+		// the deltas can't be resolved until the estimated value is written
+		return base, true
+	}
+
+	var value []byte
+	if base != nil {
+		value = base.Value()
+	} else {
+		value = parentValue()
+	}
+	// deltas are merged in the order of the transactions
+	for i := len(deltaItems) - 1; i >= 0; i-- {
+		value = types.ApplyDeltas(value, deltaItems[i].deltas)
+	}
+	if value == nil {
+		return NewDeletedItem(latest.index, latest.incarnation), true
+	}
+	return NewValueItem(latest.index, latest.incarnation, value), true
+}
+
 func (item *multiVersionItem) Set(index int, incarnation int, value []byte) {
 	types.AssertValidValue(value)
 	item.mtx.Lock()
@@ -109,6 +186,14 @@ func (item *multiVersionItem) Set(index int, incarnation int, value []byte) {
 	item.valueTree.ReplaceOrInsert(valueItem)
 }
 
+func (item *multiVersionItem) SetDelta(index int, incarnation int, deltas []types.Delta) {
+	item.mtx.Lock()
+	defer item.mtx.Unlock()
+
+	deltaItem := NewDeltaItem(index, incarnation, deltas)
+	item.valueTree.ReplaceOrInsert(deltaItem)
+}
+
 func (item *multiVersionItem) Delete(index int, incarnation int) {
 	item.mtx.Lock()
 	defer item.mtx.Unlock()
@@ -137,6 +222,7 @@ type valueItem struct {
 	incarnation int
 	value       []byte
 	estimate    bool
+	deltas      []types.Delta
 }
 
 var _ MultiVersionValueItem = (*valueItem)(nil)
@@ -153,7 +239,7 @@ func (v *valueItem) Incarnation() int {
 
 // IsDeleted implements MultiVersionValueItem.
 func (v *valueItem) IsDeleted() bool {
-	return v.value == nil && !v.estimate
+	return v.value == nil && !v.estimate && v.deltas == nil
 }
 
 // IsEstimate implements MultiVersionValueItem.
@@ -161,11 +247,21 @@ func (v *valueItem) IsEstimate() bool {
 	return v.estimate
 }
 
+// IsDelta implements MultiVersionValueItem.
+func (v *valueItem) IsDelta() bool {
+	return v.deltas != nil
+}
+
 // Value implements MultiVersionValueItem.
 func (v *valueItem) Value() []byte {
 	return v.value
 }
 
+// Deltas implements MultiVersionValueItem.
+func (v *valueItem) Deltas() []types.Delta {
+	return v.deltas
+}
+
 // implement Less for btree.Item for valueItem
 func (i *valueItem) Less(other btree.Item) bool {
 	return i.index < other.(*valueItem).index
@@ -197,3 +293,13 @@ func NewDeletedItem(index int, incarnation int) *valueItem {
 		estimate:    false,
 	}
 }
+
+func NewDeltaItem(index int, incarnation int, deltas []types.Delta) *valueItem {
+	return &valueItem{
+		index:       index,
+		incarnation: incarnation,
+		value:       nil,
+		estimate:    false,
+		deltas:      deltas,
+	}
+}
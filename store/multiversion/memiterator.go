@@ -13,6 +13,9 @@ import (
 type memIterator struct {
 	types.Iterator
 	mvkv *VersionIndexedStore
+
+	// this ensures that we serve consistent values throughout the lifecycle of the memIterator
+	readCache map[string][]byte
 }
 
 func (store *VersionIndexedStore) newMemIterator(
@@ -37,16 +40,25 @@ func (store *VersionIndexedStore) newMemIterator(
 	}
 
 	return &memIterator{
-		Iterator: iter,
-		mvkv:     store,
+		Iterator:  iter,
+		mvkv:      store,
+		readCache: make(map[string][]byte),
 	}
 }
 
 // try to get value from the writeset, otherwise try to get from multiversion store, otherwise try to get from parent
 func (mi *memIterator) Value() []byte {
 	key := mi.Iterator.Key()
-	// TODO: verify that this is correct
-	return mi.mvkv.Get(key)
+	// the transaction's own writes are always served as is
+	if val, ok := mi.mvkv.writeset[string(key)]; ok {
+		return val
+	}
+	if val, ok := mi.readCache[string(key)]; ok {
+		return val
+	}
+	val := mi.mvkv.getForIterator(key)
+	mi.readCache[string(key)] = val
+	return val
 }
 
 type validationIterator struct {
@@ -113,7 +125,11 @@ func (vi *validationIterator) Value() []byte {
 
 	// if we have an estimate, write to abort channel
 	if val.IsEstimate() {
-		vi.abortChannel <- occtypes.NewEstimateAbort(val.Index())
+		// only the first abort is needed, so don't block the validation on the following ones
+		select {
+		case vi.abortChannel <- occtypes.NewEstimateAbortWithKey(val.Index(), key):
+		default:
+		}
 	}
 
 	// if we have a deleted value, return nil
@@ -32,7 +32,9 @@ type iterationTracker struct {
 	endKey       []byte              // end of the iteration range
 	earlyStopKey []byte              // key that caused early stop
 	iteratedKeys map[string]struct{} // TODO: is a map okay because the ordering will be enforced when we replay the iterator?
-	ascending    bool
+	// iteratedValues are the values served by the iterator, a key iterated without reading its value has no entry
+	iteratedValues map[string][]byte
+	ascending      bool
 
 	writeset WriteSet
 
@@ -53,11 +55,12 @@ func NewIterationTracker(startKey, endKey []byte, ascending bool, writeset Write
 	}
 
 	return iterationTracker{
-		startKey:     startKey,
-		endKey:       endKey,
-		iteratedKeys: make(map[string]struct{}),
-		ascending:    ascending,
-		writeset:     copyWriteset,
+		startKey:       startKey,
+		endKey:         endKey,
+		iteratedKeys:   make(map[string]struct{}),
+		iteratedValues: make(map[string][]byte),
+		ascending:      ascending,
+		writeset:       copyWriteset,
 	}
 }
 
@@ -71,6 +74,12 @@ func (item *iterationTracker) SetEarlyStopKey(key []byte) {
 	item.earlyStopKey = key
 }
 
+// AddValue adds a key to the iterated keys along with the value the iterator served for it
+func (item *iterationTracker) AddValue(key []byte, value []byte) {
+	item.AddKey(key)
+	item.iteratedValues[string(key)] = value
+}
+
 // Version Indexed Store wraps the multiversion store in a way that implements the KVStore interface, but also stores the index of the transaction, and so store actions are applied to the multiversion store using that index
 type VersionIndexedStore struct {
 	// TODO: this shouldnt NEED a mutex because its used within single transaction execution, therefore no concurrency
@@ -79,6 +88,7 @@ type VersionIndexedStore struct {
 	// TODO: does this need sync.Map?
 	readset    map[string][][]byte // contains the key -> []value mapping for all keys read from the store (not mvkv, underlying store)
 	writeset   map[string][]byte   // contains the key -> value mapping for all keys written to the store
+	deltas     DeltaSet            // contains the key -> deltas mapping for keys updated without being read or written
 	iterateset Iterateset
 	// TODO: need to add iterateset here as well
 
@@ -96,6 +106,7 @@ type VersionIndexedStore struct {
 }
 
 var _ types.KVStore = (*VersionIndexedStore)(nil)
+var _ types.DeltaKVStore = (*VersionIndexedStore)(nil)
 var _ ReadsetHandler = (*VersionIndexedStore)(nil)
 var _ IterateSetHandler = (*VersionIndexedStore)(nil)
 
@@ -103,6 +114,7 @@ func NewVersionIndexedStore(parent types.KVStore, multiVersionStore MultiVersion
 	return &VersionIndexedStore{
 		readset:           make(map[string][][]byte),
 		writeset:          make(map[string][]byte),
+		deltas:            make(DeltaSet),
 		iterateset:        []*iterationTracker{},
 		sortedStore:       dbm.NewMemDB(),
 		parent:            parent,
@@ -123,6 +135,11 @@ func (store *VersionIndexedStore) GetWriteset() map[string][]byte {
 	return store.writeset
 }
 
+// GetDeltas returns the deltas that weren't merged into the writeset
+func (store *VersionIndexedStore) GetDeltas() DeltaSet {
+	return store.deltas
+}
+
 // WriteAbort writes an abort to the store but only allows one abort to be written PER instance of mvkv. This is because we pair abort channel writes with panics, and if we hit this more than once, it means that the panic was swallowed, so we won't write any aborts after a first abort is written to prevent any potential for deadlocking due to full channels
 func (store *VersionIndexedStore) WriteAbort(abort scheduler.Abort) {
 	select {
@@ -145,6 +162,8 @@ func (store *VersionIndexedStore) Get(key []byte) []byte {
 
 	types.AssertValidKey(key)
 	strKey := string(key)
+	// merge pending deltas into the value of the key, which reads the key
+	store.resolveDeltas(key)
 	// first check the MVKV writeset, and return that value if present
 	cacheValue, ok := store.writeset[strKey]
 	if ok {
@@ -161,7 +180,7 @@ func (store *VersionIndexedStore) Get(key []byte) []byte {
 	mvsValue := store.multiVersionStore.GetLatestBeforeIndex(store.transactionIndex, key)
 	if mvsValue != nil {
 		if mvsValue.IsEstimate() {
-			abort := scheduler.NewEstimateAbort(mvsValue.Index())
+			abort := scheduler.NewEstimateAbortWithKey(mvsValue.Index(), key)
 			store.WriteAbort(abort)
 			panic(abort)
 		} else {
@@ -175,6 +194,31 @@ func (store *VersionIndexedStore) Get(key []byte) []byte {
 	return parentValue
 }
 
+// getForIterator gets the value of a key for an iterator, it's the same as Get except that the value isn't added to the readset
+func (store *VersionIndexedStore) getForIterator(key []byte) []byte {
+	strKey := string(key)
+	if cacheValue, ok := store.writeset[strKey]; ok {
+		return cacheValue
+	}
+	// serve values that were already read to keep the reads of the transaction consistent
+	if readsetVal, ok := store.readset[strKey]; ok {
+		return readsetVal[0]
+	}
+	mvsValue := store.multiVersionStore.GetLatestBeforeIndex(store.transactionIndex, key)
+	if mvsValue != nil {
+		if mvsValue.IsEstimate() {
+			abort := scheduler.NewEstimateAbortWithKey(mvsValue.Index(), key)
+			store.WriteAbort(abort)
+			panic(abort)
+		}
+		if mvsValue.IsDeleted() {
+			return nil
+		}
+		return mvsValue.Value()
+	}
+	return store.parent.Get(key)
+}
+
 // This functions handles reads with deleted items and values and verifies that the data is consistent to what we currently have in the readset (IF we have a readset value for that key)
 func (store *VersionIndexedStore) parseValueAndUpdateReadset(strKey string, mvsValue MultiVersionValueItem) []byte {
 	value := mvsValue.Value()
@@ -212,7 +256,7 @@ func (store *VersionIndexedStore) ValidateReadset() bool {
 		if mvsValue != nil {
 			if mvsValue.IsEstimate() {
 				// if we see an estimate, that means that we need to abort and rerun
-				store.WriteAbort(scheduler.NewEstimateAbort(mvsValue.Index()))
+				store.WriteAbort(scheduler.NewEstimateAbortWithKey(mvsValue.Index(), key))
 				return false
 			} else {
 				if mvsValue.IsDeleted() {
@@ -251,6 +295,31 @@ func (store *VersionIndexedStore) Delete(key []byte) {
 	store.setValue(key, nil)
 }
 
+// AddDelta implements types.DeltaKVStore. If the transaction already read or wrote the key, the delta is merged
+// into the value of the key, otherwise it's kept as a delta so that the key isn't added to the readset.
+func (store *VersionIndexedStore) AddDelta(key, delta []byte, merge types.MergeFunc) {
+	types.AssertValidKey(key)
+	types.AssertValidDelta(delta, merge)
+	strKey := string(key)
+	_, written := store.writeset[strKey]
+	_, read := store.readset[strKey]
+	if written || read {
+		store.setValue(key, merge(store.Get(key), delta))
+		return
+	}
+	store.deltas[strKey] = append(store.deltas[strKey], types.Delta{Delta: delta, Merge: merge})
+}
+
+// resolveDeltas merges the pending deltas of a key into the writeset
+func (store *VersionIndexedStore) resolveDeltas(key []byte) {
+	deltas, ok := store.deltas[string(key)]
+	if !ok {
+		return
+	}
+	delete(store.deltas, string(key))
+	store.setValue(key, types.ApplyDeltas(store.Get(key), deltas))
+}
+
 // Has implements types.KVStore.
 func (store *VersionIndexedStore) Has(key []byte) bool {
 	// necessary locking happens within store.Get
@@ -284,6 +353,13 @@ func (store *VersionIndexedStore) iterator(start []byte, end []byte, ascending b
 	// store.mtx.Lock()
 	// defer store.mtx.Unlock()
 
+	// iterated values must include the pending deltas
+	for key := range store.deltas {
+		if dbm.IsKeyInDomain([]byte(key), start, end) {
+			store.resolveDeltas([]byte(key))
+		}
+	}
+
 	// get the sorted keys from MVS
 	// TODO: ideally we take advantage of mvs keys already being sorted
 	// TODO: ideally merge btree and mvs keys into a single sorted btree
@@ -310,7 +386,9 @@ func (store *VersionIndexedStore) iterator(start []byte, end []byte, ascending b
 		parent = store.parent.ReverseIterator(start, end)
 	}
 
-	mergeIterator := NewMVSMergeIterator(parent, memIterator, ascending, store)
+	// values served by the iterator are validated by the iteration tracker instead of the readset, so that
+	// keys the merge iterator only skipped over don't invalidate the transaction
+	mergeIterator := NewMVSMergeIterator(parent, memIterator, ascending, NoOpHandler{})
 
 	iterationTracker := NewIterationTracker(start, end, ascending, store.writeset)
 	store.UpdateIterateSet(&iterationTracker)
@@ -372,6 +450,8 @@ func (store *VersionIndexedStore) setValue(key, value []byte) {
 
 	keyStr := string(key)
 	store.writeset[keyStr] = value
+	// a write overrides the pending deltas
+	delete(store.deltas, keyStr)
 }
 
 func (store *VersionIndexedStore) WriteToMultiVersionStore() {
@@ -379,7 +459,7 @@ func (store *VersionIndexedStore) WriteToMultiVersionStore() {
 	// store.mtx.Lock()
 	// defer store.mtx.Unlock()
 	// defer telemetry.MeasureSince(time.Now(), "store", "mvkv", "write_mvs")
-	store.multiVersionStore.SetWriteset(store.transactionIndex, store.incarnation, store.writeset)
+	store.multiVersionStore.SetDeltaWriteset(store.transactionIndex, store.incarnation, store.writeset, store.deltas)
 	store.multiVersionStore.SetReadset(store.transactionIndex, store.readset)
 	store.multiVersionStore.SetIterateset(store.transactionIndex, store.iterateset)
 }
@@ -389,7 +469,18 @@ func (store *VersionIndexedStore) WriteEstimatesToMultiVersionStore() {
 	// store.mtx.Lock()
 	// defer store.mtx.Unlock()
 	// defer telemetry.MeasureSince(time.Now(), "store", "mvkv", "write_mvs")
-	store.multiVersionStore.SetEstimatedWriteset(store.transactionIndex, store.incarnation, store.writeset)
+	writeset := store.writeset
+	if len(store.deltas) > 0 {
+		// keys with pending deltas are estimated as well
+		writeset = make(WriteSet, len(store.writeset)+len(store.deltas))
+		for key, value := range store.writeset {
+			writeset[key] = value
+		}
+		for key := range store.deltas {
+			writeset[key] = nil
+		}
+	}
+	store.multiVersionStore.SetEstimatedWriteset(store.transactionIndex, store.incarnation, writeset)
 	// TODO: do we need to write readset and iterateset in this case? I don't think so since if this is called it means we aren't doing validation
 }
 
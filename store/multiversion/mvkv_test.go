@@ -433,9 +433,15 @@ func TestIteratorReadsetRace(t *testing.T) {
 	require.Equal(t, []string{"value1", "value2", "value4"}, vals)
 	iter.Close()
 
-	// verify that key4 has two elements in the readset
+	// the value served by the iterator is tracked by the iterateset rather than the readset
 	readset := vis.GetReadset()
-	require.Len(t, readset["key4"], 2)
+	require.Len(t, readset["key4"], 1)
+
+	// the stale value served by the iterator invalidates the tx
+	vis.WriteToMultiVersionStore()
+	valid, conflicts := mvs.ValidateTransactionState(2)
+	require.False(t, valid)
+	require.Equal(t, []int{1}, conflicts)
 }
 
 func TestRemoveLastEntry(t *testing.T) {
@@ -472,7 +478,7 @@ func TestRemoveLastEntry(t *testing.T) {
 	// should not be valid
 	valid, conflicts := mvs.ValidateTransactionState(2)
 	require.False(t, valid)
-	require.Empty(t, conflicts)
+	require.Equal(t, []int{1}, conflicts)
 }
 
 func TestVersionIndexedStoreGetAllKeyStrsInRange(t *testing.T) {
@@ -521,3 +527,52 @@ func TestVersionIndexedStoreGetAllKeyStrsInRangeError(t *testing.T) {
 	valid, _ := mvs.ValidateTransactionState(3)
 	require.False(t, valid)
 }
+
+func TestVersionIndexedStoreAddDelta(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	parentKVStore := cachekv.NewStore(mem, types.NewKVStoreKey("mock"), 1000)
+	mvs := multiversion.NewMultiVersionStore(parentKVStore)
+	parentKVStore.Set([]byte("key1"), uint64Bytes(10))
+	mvs.SetDeltaWriteset(0, 1, nil, multiversion.DeltaSet{"key1": uint64Delta(1)})
+
+	vis := multiversion.NewVersionIndexedStore(parentKVStore, mvs, 1, 1, make(chan scheduler.Abort, 1))
+	// deltas to keys that weren't read or written are kept as deltas
+	vis.AddDelta([]byte("key1"), uint64Bytes(2), addUint64)
+	vis.AddDelta([]byte("key1"), uint64Bytes(3), addUint64)
+	vis.AddDelta([]byte("key2"), uint64Bytes(4), addUint64)
+	require.Empty(t, vis.GetReadset())
+	require.Empty(t, vis.GetWriteset())
+	require.Len(t, vis.GetDeltas()["key1"], 2)
+
+	// deltas to written keys are merged into the writeset
+	vis.Set([]byte("key3"), uint64Bytes(7))
+	vis.AddDelta([]byte("key3"), uint64Bytes(1), addUint64)
+	require.Equal(t, uint64Bytes(8), vis.GetWriteset()["key3"])
+
+	// a write overrides the pending deltas
+	vis.AddDelta([]byte("key4"), uint64Bytes(1), addUint64)
+	vis.Delete([]byte("key4"))
+	require.NotContains(t, vis.GetDeltas(), "key4")
+	require.Nil(t, vis.Get([]byte("key4")))
+
+	vis.WriteToMultiVersionStore()
+	valid, conflicts := mvs.ValidateTransactionState(1)
+	require.True(t, valid)
+	require.Empty(t, conflicts)
+	require.Equal(t, uint64Bytes(16), mvs.GetLatestBeforeIndex(2, []byte("key1")).Value())
+	require.Equal(t, uint64Bytes(4), mvs.GetLatestBeforeIndex(2, []byte("key2")).Value())
+
+	// reading a key merges the pending deltas into the value read
+	vis2 := multiversion.NewVersionIndexedStore(parentKVStore, mvs, 2, 1, make(chan scheduler.Abort, 1))
+	vis2.AddDelta([]byte("key1"), uint64Bytes(4), addUint64)
+	require.Equal(t, uint64Bytes(20), vis2.Get([]byte("key1")))
+	require.Equal(t, [][]byte{uint64Bytes(16)}, vis2.GetReadset()["key1"])
+	require.Empty(t, vis2.GetDeltas())
+
+	// iterating merges the pending deltas of the iterated range
+	vis2.AddDelta([]byte("key2"), uint64Bytes(1), addUint64)
+	iter := vis2.Iterator([]byte("key2"), []byte("key3"))
+	require.True(t, iter.Valid())
+	require.Equal(t, uint64Bytes(5), iter.Value())
+	iter.Close()
+}
@@ -17,6 +17,7 @@ type MultiVersionStore interface {
 	Has(index int, key []byte) bool
 	WriteLatestToStore()
 	SetWriteset(index int, incarnation int, writeset WriteSet)
+	SetDeltaWriteset(index int, incarnation int, writeset WriteSet, deltas DeltaSet)
 	InvalidateWriteset(index int, incarnation int)
 	SetEstimatedWriteset(index int, incarnation int, writeset WriteSet)
 	GetAllWritesetKeys() map[int][]string
@@ -29,12 +30,25 @@ type MultiVersionStore interface {
 	GetIterateset(index int) Iterateset
 	ClearIterateset(index int)
 	ValidateTransactionState(index int) (bool, []int)
+	GetReadsetConflicts(index int) []ReadConflict
+	GetIteratesetConflicts(index int) []ReadConflict
 }
 
 type WriteSet map[string][]byte
+type DeltaSet map[string][]types.Delta
 type ReadSet map[string][][]byte
 type Iterateset []*iterationTracker
 
+// ReadConflict is a key in the readset of a transaction that doesn't match the latest value before the transaction
+type ReadConflict struct {
+	Key string
+	// Index is the index of the transaction that wrote the latest value, -1 if the readset itself is inconsistent
+	// or the latest value comes from the parent store
+	Index int
+	// Estimate is true if the latest value is an estimate, which doesn't invalidate the readset by itself
+	Estimate bool
+}
+
 var _ MultiVersionStore = (*Store)(nil)
 
 type Store struct {
@@ -87,12 +101,15 @@ func (s *Store) GetLatestBeforeIndex(index int, key []byte) (value MultiVersionV
 	if !found {
 		return nil
 	}
-	val, found := mvVal.(MultiVersionValue).GetLatestBeforeIndex(index)
+	val, found := mvVal.(MultiVersionValue).GetLatestResolvedBeforeIndex(index, func() []byte {
+		return s.parentStore.Get(key)
+	})
 	// otherwise, we may have found a value for that key, but its not written before the index passed in
 	if !found {
 		return nil
 	}
 	// found a value prior to the passed in index, return that value (could be estimate OR deleted, but it is a definitive value)
+	// deltas are already merged into the value they apply to
 	return val
 }
 
@@ -109,7 +126,7 @@ func (s *Store) Has(index int, key []byte) bool {
 	return foundVal
 }
 
-func (s *Store) removeOldWriteset(index int, newWriteSet WriteSet) {
+func (s *Store) removeOldWriteset(index int, newWriteSet WriteSet, newDeltas DeltaSet) {
 	writeset := make(map[string][]byte)
 	if newWriteSet != nil {
 		// if non-nil writeset passed in, we can use that to optimize removals
@@ -126,6 +143,10 @@ func (s *Store) removeOldWriteset(index int, newWriteSet WriteSet) {
 				// we don't need to remove this key because it will be overwritten anyways - saves the operation of removing + rebalancing underlying btree
 				continue
 			}
+			if _, ok := newDeltas[key]; ok {
+				// we don't need to remove this key because it will be overwritten anyways - saves the operation of removing + rebalancing underlying btree
+				continue
+			}
 			// remove from the appropriate item if present in multiVersionMap
 			mvVal, found := s.multiVersionMap.Load(key)
 			// if the key doesn't exist in the overall map, return nil
@@ -140,11 +161,17 @@ func (s *Store) removeOldWriteset(index int, newWriteSet WriteSet) {
 // SetWriteset sets a writeset for a transaction index, and also writes all of the multiversion items in the writeset to the multiversion store.
 // TODO: returns a list of NEW keys added
 func (s *Store) SetWriteset(index int, incarnation int, writeset WriteSet) {
+	s.SetDeltaWriteset(index, incarnation, writeset, nil)
+}
+
+// SetDeltaWriteset sets a writeset along with the deltas the transaction added to keys it didn't read or write.
+// The deltas are written as delta items, which are merged into the value they apply to when the key is read.
+func (s *Store) SetDeltaWriteset(index int, incarnation int, writeset WriteSet, deltas DeltaSet) {
 	// TODO: add telemetry spans
 	// remove old writeset if it exists
-	s.removeOldWriteset(index, writeset)
+	s.removeOldWriteset(index, writeset, deltas)
 
-	writeSetKeys := make([]string, 0, len(writeset))
+	writeSetKeys := make([]string, 0, len(writeset)+len(deltas))
 	for key, value := range writeset {
 		writeSetKeys = append(writeSetKeys, key)
 		loadVal, _ := s.multiVersionMap.LoadOrStore(key, NewMultiVersionItem()) // init if necessary
@@ -157,6 +184,15 @@ func (s *Store) SetWriteset(index int, incarnation int, writeset WriteSet) {
 			mvVal.Set(index, incarnation, value)
 		}
 	}
+	for key, keyDeltas := range deltas {
+		if _, ok := writeset[key]; ok {
+			// the deltas of written keys are merged into the writeset
+			continue
+		}
+		writeSetKeys = append(writeSetKeys, key)
+		loadVal, _ := s.multiVersionMap.LoadOrStore(key, NewMultiVersionItem()) // init if necessary
+		loadVal.(MultiVersionValue).SetDelta(index, incarnation, keyDeltas)
+	}
 	sort.Strings(writeSetKeys) // TODO: if we're sorting here anyways, maybe we just put it into a btree instead of a slice
 	s.txWritesetKeys.Store(index, writeSetKeys)
 }
@@ -179,7 +215,7 @@ func (s *Store) InvalidateWriteset(index int, incarnation int) {
 // SetEstimatedWriteset is used to directly write estimates instead of writing a writeset and later invalidating
 func (s *Store) SetEstimatedWriteset(index int, incarnation int, writeset WriteSet) {
 	// remove old writeset if it exists
-	s.removeOldWriteset(index, writeset)
+	s.removeOldWriteset(index, writeset, nil)
 
 	writeSetKeys := make([]string, 0, len(writeset))
 	// still need to save the writeset so we can remove the elements later:
@@ -259,21 +295,25 @@ func (s *Store) CollectIteratorItems(index int) *db.MemDB {
 	return sortedItems
 }
 
-func (s *Store) validateIterator(index int, tracker iterationTracker) bool {
+// validateIterator replays the iteration up to its early stop key, and checks that it yields the same keys and
+// the same values for the keys whose values were read. Writes that don't change the replayed iteration don't
+// invalidate it. If the iteration is invalid, the returned conflicts are the keys where the replay diverged.
+func (s *Store) validateIterator(index int, tracker iterationTracker) (bool, []ReadConflict) {
 	// collect items from multiversion store
 	sortedItems := s.CollectIteratorItems(index)
 	// add the iterationtracker writeset keys to the sorted items
 	for key := range tracker.writeset {
 		sortedItems.Set([]byte(key), []byte{})
 	}
-	validChannel := make(chan bool, 1)
+	validChannel := make(chan []ReadConflict, 1)
 	abortChannel := make(chan occtypes.Abort, 1)
 
 	// listen for abort while iterating
-	go func(iterationTracker iterationTracker, items *db.MemDB, returnChan chan bool, abortChan chan occtypes.Abort) {
+	go func(iterationTracker iterationTracker, items *db.MemDB, returnChan chan []ReadConflict, abortChan chan occtypes.Abort) {
 		var parentIter types.Iterator
+		var conflicts []ReadConflict
 		expectedKeys := iterationTracker.iteratedKeys
-		foundKeys := 0
+		foundKeys := make(map[string]struct{}, len(expectedKeys))
 		iter := s.newMVSValidationIterator(index, iterationTracker.startKey, iterationTracker.endKey, items, iterationTracker.ascending, iterationTracker.writeset, abortChan)
 		if iterationTracker.ascending {
 			parentIter = s.parentStore.Iterator(iterationTracker.startKey, iterationTracker.endKey)
@@ -284,67 +324,128 @@ func (s *Store) validateIterator(index int, tracker iterationTracker) bool {
 		mergeIterator := NewMVSMergeIterator(parentIter, iter, iterationTracker.ascending, NoOpHandler{})
 		defer mergeIterator.Close()
 		for ; mergeIterator.Valid(); mergeIterator.Next() {
-			if (len(expectedKeys) - foundKeys) == 0 {
-				// if we have no more expected keys, then the iterator is invalid
-				returnChan <- false
-				return
-			}
 			key := mergeIterator.Key()
-			// TODO: is this ok to not delete the key since we shouldnt have duplicate keys?
 			if _, ok := expectedKeys[string(key)]; !ok {
-				// if key isn't found
-				returnChan <- false
-				return
+				// if key isn't expected, it was added by an earlier transaction
+				conflicts = append(conflicts, s.iteratorConflict(index, key))
+				continue
+			}
+			foundKeys[string(key)] = struct{}{}
+
+			// if the iterator served the value, it must not have changed either
+			if expectedValue, ok := iterationTracker.iteratedValues[string(key)]; ok && !bytes.Equal(mergeIterator.Value(), expectedValue) {
+				conflicts = append(conflicts, s.iteratorConflict(index, key))
 			}
-			// remove from expected keys
-			foundKeys += 1
-			// delete(expectedKeys, string(key))
 
 			// if our iterator key was the early stop, then we can break
 			if bytes.Equal(key, iterationTracker.earlyStopKey) {
 				break
 			}
 		}
-		// return whether we found the exact number of expected keys
-		returnChan <- !((len(expectedKeys) - foundKeys) > 0)
+		// any expected key that wasn't found was removed by an earlier transaction
+		for key := range expectedKeys {
+			if _, ok := foundKeys[key]; !ok {
+				conflicts = append(conflicts, s.iteratorConflict(index, []byte(key)))
+			}
+		}
+		sort.Slice(conflicts, func(i, j int) bool {
+			return conflicts[i].Key < conflicts[j].Key
+		})
+		returnChan <- conflicts
 	}(tracker, sortedItems, validChannel, abortChannel)
 	select {
-	case <-abortChannel:
+	case abort := <-abortChannel:
 		// if we get an abort, then we know that the iterator is invalid
-		return false
-	case valid := <-validChannel:
-		return valid
+		return false, []ReadConflict{{Key: string(abort.Key), Index: abort.DependentTxIdx, Estimate: true}}
+	case conflicts := <-validChannel:
+		// the replay may have completed after reading an estimate
+		select {
+		case abort := <-abortChannel:
+			return false, []ReadConflict{{Key: string(abort.Key), Index: abort.DependentTxIdx, Estimate: true}}
+		default:
+		}
+		return len(conflicts) == 0, conflicts
 	}
 }
 
-func (s *Store) checkIteratorAtIndex(index int) bool {
-	valid := true
+// iteratorConflict returns the conflict for a key that diverged when replaying an iteration
+func (s *Store) iteratorConflict(index int, key []byte) ReadConflict {
+	conflict := ReadConflict{Key: string(key), Index: -1}
+	if latestValue := s.GetLatestBeforeIndex(index, key); latestValue != nil {
+		conflict.Index = latestValue.Index()
+	}
+	return conflict
+}
+
+// GetIteratesetConflicts returns the keys where the iterations of the index diverged
+func (s *Store) GetIteratesetConflicts(index int) []ReadConflict {
+	var conflicts []ReadConflict
 	iterateSetAny, found := s.txIterateSets.Load(index)
 	if !found {
-		return true
+		return conflicts
+	}
+	for _, iterationTracker := range iterateSetAny.(Iterateset) {
+		if valid, iteratorConflicts := s.validateIterator(index, *iterationTracker); !valid {
+			conflicts = append(conflicts, iteratorConflicts...)
+		}
+	}
+	return conflicts
+}
+
+func (s *Store) checkIteratorAtIndex(index int) (bool, []int) {
+	valid := true
+	conflictSet := make(map[int]struct{})
+	for _, conflict := range s.GetIteratesetConflicts(index) {
+		valid = false
+		if conflict.Index >= 0 {
+			conflictSet[conflict.Index] = struct{}{}
+		}
 	}
-	iterateset := iterateSetAny.(Iterateset)
-	for _, iterationTracker := range iterateset {
-		// TODO: if the value of the key is nil maybe we need to exclude it? - actually it should
-		iteratorValid := s.validateIterator(index, *iterationTracker)
-		valid = valid && iteratorValid
+	conflictIndices := make([]int, 0, len(conflictSet))
+	for index := range conflictSet {
+		conflictIndices = append(conflictIndices, index)
 	}
-	return valid
+	return valid, conflictIndices
 }
 
 func (s *Store) checkReadsetAtIndex(index int) (bool, []int) {
 	conflictSet := make(map[int]struct{})
 	valid := true
 
+	for _, conflict := range s.GetReadsetConflicts(index) {
+		if conflict.Index >= 0 {
+			conflictSet[conflict.Index] = struct{}{}
+		}
+		// estimates are conflicts to wait on, but they don't invalidate the readset
+		if !conflict.Estimate {
+			valid = false
+		}
+	}
+
+	conflictIndices := make([]int, 0, len(conflictSet))
+	for index := range conflictSet {
+		conflictIndices = append(conflictIndices, index)
+	}
+
+	sort.Ints(conflictIndices)
+
+	return valid, conflictIndices
+}
+
+// GetReadsetConflicts returns the keys in the readset of the index whose values no longer match
+// the latest values in the multiversion store or the parent store
+func (s *Store) GetReadsetConflicts(index int) []ReadConflict {
+	var conflicts []ReadConflict
+
 	readSetAny, found := s.txReadSets.Load(index)
 	if !found {
-		return true, []int{}
+		return conflicts
 	}
 	readset := readSetAny.(ReadSet)
 	// iterate over readset and check if the value is the same as the latest value relateive to txIndex in the multiversion store
 	for key, valueArr := range readset {
 		if len(valueArr) != 1 {
-			valid = false
+			conflicts = append(conflicts, ReadConflict{Key: key, Index: -1})
 			continue
 		}
 		value := valueArr[0]
@@ -354,34 +455,27 @@ func (s *Store) checkReadsetAtIndex(index int) (bool, []int) {
 			// this is possible if we previously read a value from a transaction write that was later reverted, so this time we read from parent store
 			parentVal := s.parentStore.Get([]byte(key))
 			if !bytes.Equal(parentVal, value) {
-				valid = false
+				conflicts = append(conflicts, ReadConflict{Key: key, Index: -1})
 			}
 		} else {
 			// if estimate, mark as conflict index - but don't invalidate
 			if latestValue.IsEstimate() {
-				conflictSet[latestValue.Index()] = struct{}{}
+				conflicts = append(conflicts, ReadConflict{Key: key, Index: latestValue.Index(), Estimate: true})
 			} else if latestValue.IsDeleted() {
 				if value != nil {
 					// conflict
 					// TODO: would we want to return early?
-					conflictSet[latestValue.Index()] = struct{}{}
-					valid = false
+					conflicts = append(conflicts, ReadConflict{Key: key, Index: latestValue.Index()})
 				}
 			} else if !bytes.Equal(latestValue.Value(), value) {
-				conflictSet[latestValue.Index()] = struct{}{}
-				valid = false
+				conflicts = append(conflicts, ReadConflict{Key: key, Index: latestValue.Index()})
 			}
 		}
 	}
-
-	conflictIndices := make([]int, 0, len(conflictSet))
-	for index := range conflictSet {
-		conflictIndices = append(conflictIndices, index)
-	}
-
-	sort.Ints(conflictIndices)
-
-	return valid, conflictIndices
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Key < conflicts[j].Key
+	})
+	return conflicts
 }
 
 // TODO: do we want to return bool + []int where bool indicates whether it was valid and then []int indicates only ones for which we need to wait due to estimates? - yes i think so?
@@ -389,10 +483,20 @@ func (s *Store) ValidateTransactionState(index int) (bool, []int) {
 	// defer telemetry.MeasureSince(time.Now(), "store", "mvs", "validate")
 
 	// TODO: can we parallelize for all iterators?
-	iteratorValid := s.checkIteratorAtIndex(index)
+	iteratorValid, iteratorConflicts := s.checkIteratorAtIndex(index)
 
 	readsetValid, conflictIndices := s.checkReadsetAtIndex(index)
 
+	// merge the conflicts of the iterateset into the sorted readset conflicts
+	for _, conflict := range iteratorConflicts {
+		i := sort.SearchInts(conflictIndices, conflict)
+		if i == len(conflictIndices) || conflictIndices[i] != conflict {
+			conflictIndices = append(conflictIndices, 0)
+			copy(conflictIndices[i+1:], conflictIndices[i:])
+			conflictIndices[i] = conflict
+		}
+	}
+
 	return iteratorValid && readsetValid, conflictIndices
 }
 
@@ -410,7 +514,9 @@ func (s *Store) WriteLatestToStore() {
 		if !ok {
 			continue
 		}
-		mvValue, found := val.(MultiVersionValue).GetLatestNonEstimate()
+		mvValue, found := val.(MultiVersionValue).GetLatestResolvedNonEstimate(func() []byte {
+			return s.parentStore.Get([]byte(key))
+		})
 		if !found {
 			// this means that at some point, there was an estimate, but we have since removed it so there isn't anything writeable at the key, so we can skip
 			continue
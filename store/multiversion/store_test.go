@@ -2,10 +2,12 @@ package multiversion_test
 
 import (
 	"bytes"
+	"encoding/binary"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/multiversion"
+	"github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/types/occ"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
@@ -307,6 +309,31 @@ func TestMultiVersionStoreMultipleReadsetValueValidationFailure(t *testing.T) {
 	require.Empty(t, conflicts)
 }
 
+func TestMultiVersionStoreGetReadsetConflicts(t *testing.T) {
+	parentKVStore := dbadapter.Store{DB: dbm.NewMemDB()}
+	mvs := multiversion.NewMultiVersionStore(parentKVStore)
+
+	parentKVStore.Set([]byte("key3"), []byte("value3"))
+	parentKVStore.Set([]byte("key4"), []byte("value4"))
+
+	mvs.SetWriteset(1, 1, multiversion.WriteSet{"key1": []byte("value1")})
+	mvs.SetEstimatedWriteset(2, 1, multiversion.WriteSet{"key2": nil})
+
+	readset := make(multiversion.ReadSet)
+	readset["key1"] = [][]byte{[]byte("value0")}
+	readset["key2"] = [][]byte{[]byte("value2")}
+	readset["key3"] = [][]byte{[]byte("value3")}
+	readset["key4"] = [][]byte{[]byte("value5")}
+	mvs.SetReadset(5, readset)
+
+	require.Equal(t, []multiversion.ReadConflict{
+		{Key: "key1", Index: 1},
+		{Key: "key2", Index: 2, Estimate: true},
+		{Key: "key4", Index: -1},
+	}, mvs.GetReadsetConflicts(5))
+	require.Empty(t, mvs.GetReadsetConflicts(6))
+}
+
 func TestMVSValidationWithOnlyEstimate(t *testing.T) {
 	parentKVStore := dbadapter.Store{DB: dbm.NewMemDB()}
 	mvs := multiversion.NewMultiVersionStore(parentKVStore)
@@ -474,7 +501,7 @@ func TestMVSIteratorValidationWithKeyAdded(t *testing.T) {
 	// should be invalid
 	valid, conflicts := mvs.ValidateTransactionState(5)
 	require.False(t, valid)
-	require.Empty(t, conflicts)
+	require.Equal(t, []int{2}, conflicts)
 }
 
 func TestMVSIteratorValidationWithWritesetValues(t *testing.T) {
@@ -673,7 +700,7 @@ func TestMVSIteratorValidationEarlyStopEarlierKeyRemoved(t *testing.T) {
 	// should be invalid
 	valid, conflicts := mvs.ValidateTransactionState(5)
 	require.False(t, valid)
-	require.Empty(t, conflicts)
+	require.Equal(t, []int{2}, conflicts)
 }
 
 func TestMVSIteratorValidationEarlyStopEarlierKeyRemovedAndOtherReplaced(t *testing.T) {
@@ -719,7 +746,7 @@ func TestMVSIteratorValidationEarlyStopEarlierKeyRemovedAndOtherReplaced(t *test
 	// should be invalid because key mismatch
 	valid, conflicts := mvs.ValidateTransactionState(5)
 	require.False(t, valid)
-	require.Empty(t, conflicts)
+	require.Equal(t, []int{2}, conflicts)
 }
 
 // TODO: what about early stop with a new key added in the range? - especially if its the last key that we stopped at?
@@ -793,7 +820,7 @@ func TestMVSIteratorValidationWithKeyAddedForgetToClose(t *testing.T) {
 	// should be invalid
 	valid, conflicts := mvs.ValidateTransactionState(5)
 	require.False(t, valid)
-	require.Empty(t, conflicts)
+	require.Equal(t, []int{2}, conflicts)
 }
 
 func TestMVSIteratorValidationEarlyStopIncludedInIterateset(t *testing.T) {
@@ -823,3 +850,155 @@ func TestMVSIteratorValidationEarlyStopIncludedInIterateset(t *testing.T) {
 	require.True(t, valid)
 	require.Empty(t, conflicts)
 }
+
+func TestMVSIteratorValidationKeysOnlyValueChanged(t *testing.T) {
+	parentKVStore := dbadapter.Store{DB: dbm.NewMemDB()}
+	mvs := multiversion.NewMultiVersionStore(parentKVStore)
+	vis := multiversion.NewVersionIndexedStore(parentKVStore, mvs, 5, 1, make(chan occ.Abort, 1))
+
+	parentKVStore.Set([]byte("key2"), []byte("value2"))
+	parentKVStore.Set([]byte("key3"), []byte("value3"))
+
+	mvs.SetWriteset(1, 1, multiversion.WriteSet{"key1": []byte("value1")})
+
+	// only iterate over the keys, e.g. to count them
+	iter := vis.Iterator([]byte("key1"), []byte("key5"))
+	keys := 0
+	for ; iter.Valid(); iter.Next() {
+		iter.Key()
+		keys++
+	}
+	iter.Close()
+	require.Equal(t, 3, keys)
+	vis.WriteToMultiVersionStore()
+
+	// earlier txs change the values of iterated keys, but not which keys exist
+	mvs.SetWriteset(1, 2, multiversion.WriteSet{"key1": []byte("value1_b")})
+	mvs.SetWriteset(2, 1, multiversion.WriteSet{"key3": []byte("value3_b")})
+
+	// should be valid
+	valid, conflicts := mvs.ValidateTransactionState(5)
+	require.True(t, valid)
+	require.Empty(t, conflicts)
+	require.Empty(t, mvs.GetIteratesetConflicts(5))
+}
+
+func TestMVSIteratorValidationIteratedValueChanged(t *testing.T) {
+	parentKVStore := dbadapter.Store{DB: dbm.NewMemDB()}
+	mvs := multiversion.NewMultiVersionStore(parentKVStore)
+	vis := multiversion.NewVersionIndexedStore(parentKVStore, mvs, 5, 1, make(chan occ.Abort, 1))
+
+	parentKVStore.Set([]byte("key2"), []byte("value2"))
+	parentKVStore.Set([]byte("key3"), []byte("value3"))
+	parentKVStore.Set([]byte("key4"), []byte("value4"))
+
+	iter := vis.Iterator([]byte("key1"), []byte("key5"))
+	for ; iter.Valid(); iter.Next() {
+		// read values until key3
+		iter.Value()
+		if bytes.Equal(iter.Key(), []byte("key3")) {
+			break
+		}
+	}
+	iter.Close()
+	vis.WriteToMultiVersionStore()
+
+	// the value of a key after the early stop changes, and a key before it is rewritten with the same value
+	mvs.SetWriteset(1, 1, multiversion.WriteSet{"key4": []byte("value4_b")})
+	mvs.SetWriteset(2, 1, multiversion.WriteSet{"key2": []byte("value2")})
+
+	// should be valid
+	valid, conflicts := mvs.ValidateTransactionState(5)
+	require.True(t, valid)
+	require.Empty(t, conflicts)
+
+	// the value of an iterated key changes
+	mvs.SetWriteset(3, 1, multiversion.WriteSet{"key3": []byte("value3_b")})
+
+	// should be invalid with conflict of 3
+	valid, conflicts = mvs.ValidateTransactionState(5)
+	require.False(t, valid)
+	require.Equal(t, []int{3}, conflicts)
+	require.Equal(t, []multiversion.ReadConflict{{Key: "key3", Index: 3}}, mvs.GetIteratesetConflicts(5))
+}
+
+// addUint64 merges a big endian uint64 delta into a big endian uint64 counter
+func addUint64(value, delta []byte) []byte {
+	var counter uint64
+	if value != nil {
+		counter = binary.BigEndian.Uint64(value)
+	}
+	return binary.BigEndian.AppendUint64(nil, counter+binary.BigEndian.Uint64(delta))
+}
+
+func uint64Bytes(i uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, i)
+}
+
+func uint64Delta(i uint64) []types.Delta {
+	return []types.Delta{{Delta: uint64Bytes(i), Merge: addUint64}}
+}
+
+func TestMultiVersionStoreDeltas(t *testing.T) {
+	parentKVStore := dbadapter.Store{DB: dbm.NewMemDB()}
+	mvs := multiversion.NewMultiVersionStore(parentKVStore)
+	parentKVStore.Set([]byte("key1"), uint64Bytes(10))
+
+	// deltas are merged into the parent value
+	mvs.SetDeltaWriteset(1, 1, nil, multiversion.DeltaSet{"key1": uint64Delta(1)})
+	mvs.SetDeltaWriteset(2, 1, nil, multiversion.DeltaSet{"key1": uint64Delta(2)})
+	require.True(t, mvs.GetLatest([]byte("key1")).IsDelta())
+	require.Equal(t, uint64Bytes(10), parentKVStore.Get([]byte("key1")))
+	require.Nil(t, mvs.GetLatestBeforeIndex(1, []byte("key1")))
+	val := mvs.GetLatestBeforeIndex(3, []byte("key1"))
+	require.Equal(t, uint64Bytes(13), val.Value())
+	require.Equal(t, 2, val.Index())
+
+	// deltas are merged into the latest value written before them
+	mvs.SetWriteset(3, 1, map[string][]byte{"key1": uint64Bytes(100)})
+	mvs.SetDeltaWriteset(4, 1, nil, multiversion.DeltaSet{"key1": uint64Delta(4)})
+	require.Equal(t, uint64Bytes(104), mvs.GetLatestBeforeIndex(5, []byte("key1")).Value())
+
+	// deltas to an estimate resolve to the estimate
+	mvs.InvalidateWriteset(3, 1)
+	val = mvs.GetLatestBeforeIndex(5, []byte("key1"))
+	require.True(t, val.IsEstimate())
+	require.Equal(t, 3, val.Index())
+
+	// a delete resets the value the deltas are merged into
+	mvs.SetWriteset(3, 2, map[string][]byte{"key1": nil})
+	require.Equal(t, uint64Bytes(4), mvs.GetLatestBeforeIndex(5, []byte("key1")).Value())
+
+	// the merged values are written to the parent
+	mvs.SetWriteset(3, 3, map[string][]byte{"key1": uint64Bytes(20)})
+	mvs.SetDeltaWriteset(5, 1, nil, multiversion.DeltaSet{"key2": uint64Delta(5)})
+	mvs.WriteLatestToStore()
+	require.Equal(t, uint64Bytes(24), parentKVStore.Get([]byte("key1")))
+	require.Equal(t, uint64Bytes(5), parentKVStore.Get([]byte("key2")))
+}
+
+func TestMultiVersionStoreDeltasDontInvalidateReadset(t *testing.T) {
+	parentKVStore := dbadapter.Store{DB: dbm.NewMemDB()}
+	mvs := multiversion.NewMultiVersionStore(parentKVStore)
+	parentKVStore.Set([]byte("key1"), uint64Bytes(10))
+
+	mvs.SetDeltaWriteset(1, 1, nil, multiversion.DeltaSet{"key1": uint64Delta(1)})
+	mvs.SetDeltaWriteset(2, 1, nil, multiversion.DeltaSet{"key1": uint64Delta(2)})
+	// the delta writers don't read the key
+	for _, index := range []int{1, 2} {
+		valid, conflicts := mvs.ValidateTransactionState(index)
+		require.True(t, valid)
+		require.Empty(t, conflicts)
+	}
+
+	// a reader of the merged value is invalidated by a changed delta
+	mvs.SetReadset(3, multiversion.ReadSet{"key1": [][]byte{uint64Bytes(13)}})
+	valid, conflicts := mvs.ValidateTransactionState(3)
+	require.True(t, valid)
+	require.Empty(t, conflicts)
+
+	mvs.SetDeltaWriteset(1, 2, nil, multiversion.DeltaSet{"key1": uint64Delta(5)})
+	valid, conflicts = mvs.ValidateTransactionState(3)
+	require.False(t, valid)
+	require.Equal(t, []int{2}, conflicts)
+}
@@ -42,8 +42,8 @@ func (ti *trackedIterator) Key() []byte {
 func (ti *trackedIterator) Value() []byte {
 	key := ti.Iterator.Key()
 	val := ti.Iterator.Value()
-	// add key to the tracker
-	ti.iterateset.AddKey(key)
+	// add key and value to the tracker
+	ti.iterateset.AddValue(key, val)
 	return val
 }
 
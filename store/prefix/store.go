@@ -12,6 +12,7 @@ import (
 )
 
 var _ types.KVStore = Store{}
+var _ types.DeltaKVStore = Store{}
 
 // Store is similar with tendermint/tendermint/libs/db/prefix_db
 // both gives access only to the limited subset of the store
@@ -85,6 +86,13 @@ func (s Store) Set(key, value []byte) {
 	s.parent.Set(s.key(key), value)
 }
 
+// AddDelta implements types.DeltaKVStore
+func (s Store) AddDelta(key, delta []byte, merge types.MergeFunc) {
+	types.AssertValidKey(key)
+	types.AssertValidDelta(delta, merge)
+	types.AddDelta(s.parent, s.key(key), delta, merge)
+}
+
 // Implements KVStore
 func (s Store) Delete(key []byte) {
 	s.parent.Delete(s.key(key))
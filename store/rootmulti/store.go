@@ -1109,6 +1109,11 @@ func GetLatestVersion(db dbm.DB) int64 {
 	return latestVersion
 }
 
+// GetCommitInfo returns the commit info of a committed version.
+func GetCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
+	return getCommitInfo(db, ver)
+}
+
 // Commits each store and returns a new commitInfo.
 func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore, bumpVersion bool) *types.CommitInfo {
 	storeInfos := make([]types.StoreInfo, 0, len(storeMap))
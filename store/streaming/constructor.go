@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/spf13/cast"
@@ -23,6 +24,7 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
 	// add more in the future
 )
 
@@ -31,6 +33,8 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "grpc", "g":
+		return GRPC
 	default:
 		return Unknown
 	}
@@ -41,6 +45,8 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case GRPC:
+		return "grpc"
 	default:
 		return "unknown"
 	}
@@ -49,6 +55,7 @@ func (sst ServiceType) String() string {
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
 	File: NewFileStreamingService,
+	GRPC: NewGRPCStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
@@ -67,9 +74,39 @@ func NewServiceConstructor(name string) (ServiceConstructor, error) {
 func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
 	filePrefix := cast.ToString(opts.Get("streamers.file.prefix"))
 	fileDir := cast.ToString(opts.Get("streamers.file.write_dir"))
+	if cast.ToBool(opts.Get("streamers.file.occ_writesets")) {
+		return file.NewOCCStreamingService(fileDir, filePrefix, keys, marshaller)
+	}
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
 }
 
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for creating a gRPC StreamingService
+func NewGRPCStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+	config := grpc.Config{
+		Address:          cast.ToString(opts.Get("streamers.grpc.address")),
+		MaxUnackedBlocks: cast.ToInt64(opts.Get("streamers.grpc.max_unacked_blocks")),
+		RetainBlocks:     cast.ToInt64(opts.Get("streamers.grpc.retain_blocks")),
+		Plugin:           cast.ToString(opts.Get("streamers.grpc.plugin")),
+	}
+	switch delivery := cast.ToString(opts.Get("streamers.grpc.delivery")); delivery {
+	case "", "non-blocking":
+	case "blocking":
+		config.Blocking = true
+	default:
+		return nil, fmt.Errorf("unrecognized grpc streaming delivery %s", delivery)
+	}
+	if config.Address == "" {
+		config.Address = grpc.DefaultAddress
+	}
+	if config.RetainBlocks == 0 {
+		config.RetainBlocks = grpc.DefaultRetainBlocks
+	}
+	if cast.ToBool(opts.Get("streamers.grpc.occ_writesets")) {
+		return grpc.NewOCCStreamingService(config, keys, marshaller)
+	}
+	return grpc.NewStreamingService(config, keys, marshaller)
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
 // It returns the WaitGroup and quit channel used to synchronize with the streaming services and any error that occurs during the setup
 func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryCodec, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
@@ -119,7 +156,14 @@ func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions
 		// register the streaming service with the BaseApp
 		bApp.SetStreamingService(streamingService)
 		// kick off the background streaming service loop
-		streamingService.Stream(wg)
+		if err := streamingService.Stream(wg); err != nil {
+			// close any services we may have already spun up before hitting the error on this one
+			for _, activeStreamer := range activeStreamers {
+				activeStreamer.Close()
+			}
+			streamingService.Close()
+			return nil, nil, err
+		}
 		// add to the list of active streamers
 		activeStreamers = append(activeStreamers, streamingService)
 	}
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,6 +17,10 @@ type fakeOptions struct{}
 
 func (f *fakeOptions) Get(string) interface{} { return nil }
 
+type mapOptions map[string]interface{}
+
+func (m mapOptions) Get(key string) interface{} { return m[key] }
+
 var (
 	mockOptions       = new(fakeOptions)
 	mockKeys          = []types.StoreKey{sdk.NewKVStoreKey("mockKey1"), sdk.NewKVStoreKey("mockKey2")}
@@ -41,3 +46,31 @@ func TestStreamingServiceConstructor(t *testing.T) {
 		require.True(t, ok)
 	}
 }
+
+func TestGRPCStreamingServiceConstructor(t *testing.T) {
+	constructor, err := NewServiceConstructor("grpc")
+	require.Nil(t, err)
+
+	// the address is required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.NotNil(t, err)
+	_, err = constructor(mapOptions{
+		"streamers.grpc.address":  "tcp://127.0.0.1:0",
+		"streamers.grpc.delivery": "unexpectedDelivery",
+	}, mockKeys, testMarshaller)
+	require.NotNil(t, err)
+
+	serv, err := constructor(mapOptions{
+		"streamers.grpc.address":       "tcp://127.0.0.1:0",
+		"streamers.grpc.delivery":      "blocking",
+		"streamers.grpc.occ_writesets": true,
+	}, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &grpc.OCCStreamingService{}, serv)
+	listeners := serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
+	require.Nil(t, serv.Close())
+}
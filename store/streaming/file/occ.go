@@ -0,0 +1,74 @@
+package file
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/tasks"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.OCCListener = &OCCStreamingService{}
+
+// OCCStreamingService is a StreamingService that writes the final writesets of the txs executed by the OCC scheduler
+// to their DeliverTx files, instead of the state changes observed by its WriteListeners while they were executed,
+// which can include the writes of discarded incarnations
+type OCCStreamingService struct {
+	*StreamingService
+	storeKeys map[string]struct{} // the names of the exposed stores
+}
+
+// NewOCCStreamingService creates a new OCCStreamingService for the provided writeDir, (optional) filePrefix, and storeKeys
+func NewOCCStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec) (*OCCStreamingService, error) {
+	fss, err := NewStreamingService(writeDir, filePrefix, storeKeys, c)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]struct{}, len(storeKeys))
+	for _, key := range storeKeys {
+		names[key.Name()] = struct{}{}
+	}
+	return &OCCStreamingService{StreamingService: fss, storeKeys: names}, nil
+}
+
+// ListenDeliverTxWriteset satisfies the baseapp.OCCListener interface
+// It writes the metadata of the writeset, the received DeliverTx request and response and the final writeset of the
+// tx out to a file as described in the above the naming schema
+func (fss *OCCStreamingService) ListenDeliverTxWriteset(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx, writeset tasks.TxWriteset) error {
+	// the state changes observed while the batch was executed are superseded by the writeset
+	fss.stateCacheLock.Lock()
+	fss.stateCache = nil
+	fss.stateCacheLock.Unlock()
+
+	// generate the new file
+	dstFile, err := fss.openDeliverTxFile()
+	if err != nil {
+		return err
+	}
+	defer dstFile.Close()
+	metadata := types.TxWritesetMetadata{
+		TxHash:      writeset.TxHash,
+		TxIndex:     int64(writeset.AbsoluteIndex),
+		Incarnation: int64(writeset.Incarnation),
+	}
+	messages := []codec.ProtoMarshaler{&metadata, &req}
+	for _, pair := range writeset.Changes {
+		if _, ok := fss.storeKeys[pair.StoreKey]; ok {
+			messages = append(messages, pair)
+		}
+	}
+	messages = append(messages, &res)
+	for _, msg := range messages {
+		lengthPrefixedBytes, err := fss.codec.MarshalLengthPrefixed(msg)
+		if err != nil {
+			return err
+		}
+		if _, err = dstFile.Write(lengthPrefixedBytes); err != nil {
+			return err
+		}
+	}
+	// close file
+	return dstFile.Close()
+}
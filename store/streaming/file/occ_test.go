@@ -0,0 +1,74 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/tasks"
+)
+
+func TestOCCStreamingService(t *testing.T) {
+	if os.Getenv("CI") != "" {
+		t.Skip("Skipping TestOCCStreamingService in CI environment")
+	}
+	err := os.Mkdir(testDir, 0o700)
+	require.Nil(t, err)
+	defer os.RemoveAll(testDir)
+
+	// only the first store is exposed
+	occStreamingService, err := NewOCCStreamingService(testDir, testPrefix, []types.StoreKey{mockStoreKey1}, testMarshaller)
+	require.Nil(t, err)
+	wg := new(sync.WaitGroup)
+	occStreamingService.Stream(wg)
+	defer func() {
+		occStreamingService.Close()
+		wg.Wait()
+	}()
+
+	err = occStreamingService.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes)
+	require.Nil(t, err)
+
+	// the writes observed during the execution are discarded
+	occStreamingService.listeners[mockStoreKey1][0].OnWrite(mockStoreKey1, mockKey2, mockValue2, false)
+
+	writeset := tasks.TxWriteset{
+		AbsoluteIndex: 0,
+		TxHash:        mockHash,
+		Incarnation:   2,
+		Changes: []*types.StoreKVPair{
+			{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue1},
+			{StoreKey: mockStoreKey1.Name(), Key: mockKey3, Delete: true},
+			{StoreKey: mockStoreKey2.Name(), Key: mockKey2, Value: mockValue2},
+		},
+	}
+	err = occStreamingService.ListenDeliverTxWriteset(emptyContext, testDeliverTxReq1, testDeliverTxRes1, writeset)
+	require.Nil(t, err)
+
+	expectedMetadata, err := testMarshaller.Marshal(&types.TxWritesetMetadata{TxHash: mockHash, TxIndex: 0, Incarnation: 2})
+	require.Nil(t, err)
+	expectedReq, err := testMarshaller.Marshal(&testDeliverTxReq1)
+	require.Nil(t, err)
+	expectedKVPair1, err := testMarshaller.Marshal(writeset.Changes[0])
+	require.Nil(t, err)
+	expectedKVPair2, err := testMarshaller.Marshal(writeset.Changes[1])
+	require.Nil(t, err)
+	expectedRes, err := testMarshaller.Marshal(&testDeliverTxRes1)
+	require.Nil(t, err)
+
+	fileName := fmt.Sprintf("%s-block-%d-tx-%d", testPrefix, testBeginBlockReq.GetHeader().Height, 0)
+	fileBytes, err := readInFile(fileName)
+	require.Nil(t, err)
+	segments, err := segmentBytes(fileBytes)
+	require.Nil(t, err)
+	require.Equal(t, 5, len(segments))
+	require.Equal(t, expectedMetadata, segments[0])
+	require.Equal(t, expectedReq, segments[1])
+	require.Equal(t, expectedKVPair1, segments[2])
+	require.Equal(t, expectedKVPair2, segments[3])
+	require.Equal(t, expectedRes, segments[4])
+}
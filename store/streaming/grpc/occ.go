@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/tasks"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OCCStreamingService is a StreamingService that streams the final writesets of the txs executed by the OCC scheduler
+// in their DeliverTx records, instead of the state changes observed by its WriteListeners while they were executed,
+// which can include the writes of discarded incarnations
+type OCCStreamingService struct {
+	*StreamingService
+	storeKeys map[string]struct{} // the names of the exposed stores
+}
+
+// NewOCCStreamingService creates a new OCCStreamingService listening on the address of the config for the provided storeKeys
+func NewOCCStreamingService(config Config, storeKeys []types.StoreKey, c codec.BinaryCodec) (*OCCStreamingService, error) {
+	s, err := NewStreamingService(config, storeKeys, c)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]struct{}, len(storeKeys))
+	for _, key := range storeKeys {
+		names[key.Name()] = struct{}{}
+	}
+	return &OCCStreamingService{StreamingService: s, storeKeys: names}, nil
+}
+
+// ListenDeliverTxWriteset satisfies the baseapp.OCCListener interface
+// It streams the received DeliverTx request and response with the final writeset of the tx and its metadata
+func (s *OCCStreamingService) ListenDeliverTxWriteset(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx, writeset tasks.TxWriteset) error {
+	// the state changes observed while the batch was executed are superseded by the writeset
+	s.takeStateCache()
+
+	var changes []*types.StoreKVPair
+	for _, pair := range writeset.Changes {
+		if _, ok := s.storeKeys[pair.StoreKey]; ok {
+			changes = append(changes, pair)
+		}
+	}
+	record, err := s.newRecord(RecordType_RECORD_TYPE_DELIVER_TX, &req, &res, changes)
+	if err != nil {
+		return err
+	}
+	record.WritesetMetadata = &types.TxWritesetMetadata{
+		TxHash:      writeset.TxHash,
+		TxIndex:     int64(writeset.AbsoluteIndex),
+		Incarnation: int64(writeset.Incarnation),
+	}
+	return s.streamTx(record)
+}
@@ -0,0 +1,458 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultAddress is the default address of the service. The service has no authentication, so it only listens
+	// on localhost by default.
+	DefaultAddress = "tcp://127.0.0.1:9095"
+	// DefaultRetainBlocks is the default number of recent blocks kept for the subscribers to resume from
+	DefaultRetainBlocks = 100
+	// PluginAddressEnv is the environment variable the address of the service is passed to the plugin process in
+	PluginAddressEnv = "STREAMING_GRPC_ADDRESS"
+)
+
+var _ StreamingServer = &StreamingService{}
+
+// Config is the configuration of a StreamingService
+type Config struct {
+	// Address is the address the Streaming service listens on, either tcp://host:port or unix:///path/to/socket.
+	// The service has no authentication nor TLS, so the address should only be reachable by trusted subscribers.
+	Address string
+	// Blocking makes the node wait at the end of every block until every subscriber has acknowledged all but
+	// MaxUnackedBlocks of the blocks streamed to it, instead of letting the subscribers fall behind
+	Blocking         bool
+	MaxUnackedBlocks int64
+	// RetainBlocks is the number of recent blocks kept in memory for the subscribers to resume from
+	RetainBlocks int64
+	// Plugin is the command of a local plugin process, which is started with the service and is expected to
+	// subscribe to the address passed in the PluginAddressEnv environment variable
+	Plugin string
+}
+
+// StreamingService is a concrete implementation of StreamingService that pushes the state changes and the ABCI
+// messages of the blocks to the subscribers of its gRPC Streaming service. The records of the recent blocks are
+// retained so that subscribers can resume from the height following the last block they acknowledged.
+type StreamingService struct {
+	config    Config
+	listeners map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	codec     codec.BinaryCodec                        // marshaller used for marshalling the ABCI messages of the records
+	lis       net.Listener                             // the listener of the Streaming service
+	server    *grpc.Server                             // the server of the Streaming service, set once Stream is called
+	plugin    *exec.Cmd                                // the plugin process, if any
+
+	stateCache     []*types.StoreKVPair // cache the StoreKVPairs in the order they are received
+	stateCacheLock *sync.Mutex          // mutex for the state cache
+
+	mtx         sync.Mutex
+	cond        *sync.Cond               // signaled whenever a record is streamed, a block is acknowledged or a subscriber leaves
+	blocks      []*block                 // the retained blocks in height order, the last one being the current block
+	subscribers map[*subscriber]struct{} // the connected subscribers
+	txIndex     int64                    // the index of the next tx of the current block
+	firstHeight int64                    // the height of the first block streamed since the service started
+	closed      bool
+}
+
+// block is the records of a block streamed so far
+type block struct {
+	height  int64
+	records []*StreamRecord
+	ended   bool // true once the EndBlock record is streamed
+}
+
+// subscriber is the cursor of a subscription
+type subscriber struct {
+	height int64 // the height of the next record, 0 for the first retained block
+	index  int   // the index of the next record in its block
+	acked  int64 // the latest height acknowledged by the subscriber
+	done   bool  // true once the subscriber is gone
+}
+
+// NewStreamingService creates a new StreamingService listening on the address of the config for the provided storeKeys
+func NewStreamingService(config Config, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
+	if config.Address == "" {
+		return nil, errors.New("the address of the grpc streaming service is not set")
+	}
+	if config.MaxUnackedBlocks < 0 {
+		return nil, fmt.Errorf("invalid max unacked blocks %d", config.MaxUnackedBlocks)
+	}
+	// the blocks a blocking subscriber hasn't acknowledged yet must be retained
+	if config.RetainBlocks <= config.MaxUnackedBlocks {
+		return nil, fmt.Errorf("retain blocks %d must be greater than max unacked blocks %d", config.RetainBlocks, config.MaxUnackedBlocks)
+	}
+	lis, err := listen(config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", config.Address, err)
+	}
+	s := &StreamingService{
+		config:         config,
+		listeners:      make(map[types.StoreKey][]types.WriteListener, len(storeKeys)),
+		codec:          c,
+		lis:            lis,
+		stateCacheLock: new(sync.Mutex),
+		subscribers:    make(map[*subscriber]struct{}),
+	}
+	s.cond = sync.NewCond(&s.mtx)
+	// in this case, we are using the service itself as the listener of each Store
+	for _, key := range storeKeys {
+		s.listeners[key] = append(s.listeners[key], s)
+	}
+	return s, nil
+}
+
+// listen listens on a tcp://host:port, host:port or unix:///path/to/socket address
+func listen(address string) (net.Listener, error) {
+	if path := strings.TrimPrefix(address, "unix://"); path != address {
+		// remove the socket left by a previous run
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		return net.Listen("unix", path)
+	}
+	return net.Listen("tcp", strings.TrimPrefix(address, "tcp://"))
+}
+
+// Addr returns the address the Streaming service listens on
+func (s *StreamingService) Addr() string {
+	addr := s.lis.Addr()
+	if addr.Network() == "unix" {
+		return "unix://" + addr.String()
+	}
+	return addr.String()
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (s *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return s.listeners
+}
+
+// OnWrite satisfies the types.WriteListener interface by caching the StoreKVPairs
+func (s *StreamingService) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	s.stateCacheLock.Lock()
+	defer s.stateCacheLock.Unlock()
+	s.stateCache = append(s.stateCache, &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	return nil
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It starts a new block with the received BeginBlock request and response and the resulting state changes
+func (s *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	record, err := s.newRecord(RecordType_RECORD_TYPE_BEGIN_BLOCK, &req, &res, s.takeStateCache())
+	if err != nil {
+		return err
+	}
+	record.Height = req.Header.Height
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.firstHeight == 0 {
+		s.firstHeight = record.Height
+	}
+	s.blocks = append(s.blocks, &block{height: record.Height, records: []*StreamRecord{record}})
+	if int64(len(s.blocks)) > s.config.RetainBlocks {
+		s.blocks[0] = nil
+		s.blocks = s.blocks[1:]
+	}
+	s.txIndex = 0
+	s.cond.Broadcast()
+	return nil
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It streams the received DeliverTx request and response and the resulting state changes
+func (s *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	record, err := s.newRecord(RecordType_RECORD_TYPE_DELIVER_TX, &req, &res, s.takeStateCache())
+	if err != nil {
+		return err
+	}
+	return s.streamTx(record)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It ends the current block with the received EndBlock request and response and the resulting state changes. In
+// blocking mode, it then waits for the subscribers to acknowledge the block.
+func (s *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	record, err := s.newRecord(RecordType_RECORD_TYPE_END_BLOCK, &req, &res, s.takeStateCache())
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	current, err := s.currentBlock()
+	if err != nil {
+		return err
+	}
+	record.Height = current.height
+	current.records = append(current.records, record)
+	current.ended = true
+	s.cond.Broadcast()
+	for s.config.Blocking && !s.closed && s.lagging(current.height) {
+		s.cond.Wait()
+	}
+	return nil
+}
+
+// streamTx adds the record of a tx to the current block
+func (s *StreamingService) streamTx(record *StreamRecord) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	current, err := s.currentBlock()
+	if err != nil {
+		return err
+	}
+	record.Height = current.height
+	record.TxIndex = s.txIndex
+	s.txIndex++
+	current.records = append(current.records, record)
+	s.cond.Broadcast()
+	return nil
+}
+
+func (s *StreamingService) newRecord(
+	typ RecordType, req, res codec.ProtoMarshaler, changes []*types.StoreKVPair,
+) (*StreamRecord, error) {
+	reqBytes, err := s.codec.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	resBytes, err := s.codec.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	return &StreamRecord{Type: typ, Request: reqBytes, Response: resBytes, StateChanges: changes}, nil
+}
+
+// takeStateCache returns the cached state changes and resets the cache
+func (s *StreamingService) takeStateCache() []*types.StoreKVPair {
+	s.stateCacheLock.Lock()
+	defer s.stateCacheLock.Unlock()
+	changes := s.stateCache
+	s.stateCache = nil
+	return changes
+}
+
+// currentBlock returns the block being streamed. It must be called with the lock held.
+func (s *StreamingService) currentBlock() (*block, error) {
+	if len(s.blocks) == 0 || s.blocks[len(s.blocks)-1].ended {
+		return nil, errors.New("no block is being streamed")
+	}
+	return s.blocks[len(s.blocks)-1], nil
+}
+
+// lagging returns true if a subscriber has more than MaxUnackedBlocks unacknowledged blocks at the height. It must be
+// called with the lock held.
+func (s *StreamingService) lagging(height int64) bool {
+	for sub := range s.subscribers {
+		if height-sub.acked > s.config.MaxUnackedBlocks {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscribe implements the Streaming service. The first request of the stream sets the height to stream from, and
+// the following ones acknowledge the blocks processed by the subscriber.
+func (s *StreamingService) Subscribe(stream Streaming_SubscribeServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	sub, err := s.subscribe(req.StartHeight)
+	if err != nil {
+		return err
+	}
+	defer s.unsubscribe(sub)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				s.unsubscribe(sub)
+				return
+			}
+			s.ack(sub, req.AckHeight)
+		}
+	}()
+	for {
+		record, err := s.next(sub)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(record); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *StreamingService) subscribe(startHeight int64) (*subscriber, error) {
+	if startHeight < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start height %d", startHeight)
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.closed {
+		return nil, status.Error(codes.Unavailable, "the streaming service is closed")
+	}
+	if len(s.blocks) > 0 {
+		if startHeight == 0 {
+			startHeight = s.blocks[len(s.blocks)-1].height + 1
+		} else if err := s.checkRetained(startHeight); err != nil {
+			return nil, err
+		}
+	}
+	sub := &subscriber{height: startHeight, acked: startHeight - 1}
+	s.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+// checkRetained returns an OutOfRange error if the block of the height is not retained. The blocks are only retained
+// in memory, so the blocks streamed before the node started can't be resumed from. It must be called with the lock
+// held and at least one block retained.
+func (s *StreamingService) checkRetained(height int64) error {
+	if height >= s.blocks[0].height {
+		return nil
+	}
+	if height < s.firstHeight {
+		return status.Errorf(codes.OutOfRange, "block %d was streamed before the node started, the first block streamed since is %d", height, s.firstHeight)
+	}
+	return status.Errorf(codes.OutOfRange, "block %d is not retained anymore, the oldest retained block is %d", height, s.blocks[0].height)
+}
+
+func (s *StreamingService) unsubscribe(sub *subscriber) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	sub.done = true
+	delete(s.subscribers, sub)
+	s.cond.Broadcast()
+}
+
+func (s *StreamingService) ack(sub *subscriber, height int64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if height > sub.acked {
+		sub.acked = height
+		s.cond.Broadcast()
+	}
+}
+
+// next returns the next record of a subscriber, waiting for it to be streamed if needed
+func (s *StreamingService) next(sub *subscriber) (*StreamRecord, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	for {
+		if s.closed {
+			return nil, status.Error(codes.Unavailable, "the streaming service is closed")
+		}
+		if sub.done {
+			return nil, status.Error(codes.Canceled, "the subscriber is gone")
+		}
+		if len(s.blocks) == 0 {
+			s.cond.Wait()
+			continue
+		}
+		if sub.height == 0 {
+			sub.height = s.blocks[0].height
+		}
+		// a subscriber that fell behind the retained blocks must resume from the last block it acknowledged
+		if err := s.checkRetained(sub.height); err != nil {
+			return nil, err
+		}
+		i := sort.Search(len(s.blocks), func(i int) bool {
+			return s.blocks[i].height >= sub.height
+		})
+		if i == len(s.blocks) {
+			s.cond.Wait()
+			continue
+		}
+		b := s.blocks[i]
+		if b.height != sub.height {
+			sub.height, sub.index = b.height, 0
+		}
+		if sub.index < len(b.records) {
+			sub.index++
+			return b.records[sub.index-1], nil
+		}
+		if b.ended {
+			sub.height, sub.index = b.height+1, 0
+			continue
+		}
+		s.cond.Wait()
+	}
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It starts serving the Streaming service and the plugin process, if any
+// returns an error if it is called twice
+func (s *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if s.server != nil {
+		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
+	}
+	s.server = grpc.NewServer()
+	RegisterStreamingServer(s.server, s)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = s.server.Serve(s.lis)
+	}()
+
+	if s.config.Plugin == "" {
+		return nil
+	}
+	args := strings.Fields(s.config.Plugin)
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", PluginAddressEnv, s.Addr()))
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start the streaming plugin: %w", err)
+	}
+	s.plugin = cmd
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = cmd.Wait()
+	}()
+	return nil
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It ends the subscriptions, stops the Streaming service and kills the plugin process
+func (s *StreamingService) Close() error {
+	s.mtx.Lock()
+	s.closed = true
+	s.cond.Broadcast()
+	s.mtx.Unlock()
+
+	if s.plugin != nil {
+		_ = s.plugin.Process.Kill()
+	}
+	if s.server != nil {
+		s.server.Stop()
+		return nil
+	}
+	return s.lis.Close()
+}
@@ -0,0 +1,173 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/tasks"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	testMarshaller = codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	emptyContext   = sdk.Context{}
+	mockStoreKey1  = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2  = sdk.NewKVStoreKey("mockStore2")
+	mockKey        = []byte{1, 2, 3}
+	mockValue      = []byte{3, 2, 1}
+	mockTx         = []byte{9, 8, 7}
+)
+
+func newTestService(t *testing.T, config Config) *StreamingService {
+	config.Address = "tcp://127.0.0.1:0"
+	s, err := NewStreamingService(config, []types.StoreKey{mockStoreKey1}, testMarshaller)
+	require.NoError(t, err)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, s.Stream(wg))
+	t.Cleanup(func() {
+		require.NoError(t, s.Close())
+		wg.Wait()
+	})
+	return s
+}
+
+func subscribe(t *testing.T, s *StreamingService, startHeight int64) Streaming_SubscribeClient {
+	conn, err := grpc.Dial(s.Addr(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream, err := NewStreamingClient(conn).Subscribe(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&SubscribeRequest{StartHeight: startHeight}))
+	return stream
+}
+
+// streamBlock streams a block with a tx, returning once ListenEndBlock returns
+func streamBlock(t *testing.T, s *StreamingService, height int64) {
+	require.NoError(t, s.ListenBeginBlock(emptyContext, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, s.OnWrite(mockStoreKey1, mockKey, mockValue, false))
+	require.NoError(t, s.ListenDeliverTx(emptyContext, abci.RequestDeliverTx{Tx: mockTx}, abci.ResponseDeliverTx{Code: 1}))
+	require.NoError(t, s.ListenEndBlock(emptyContext, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+}
+
+func requireBlock(t *testing.T, stream Streaming_SubscribeClient, height int64) {
+	recordTypes := []RecordType{RecordType_RECORD_TYPE_BEGIN_BLOCK, RecordType_RECORD_TYPE_DELIVER_TX, RecordType_RECORD_TYPE_END_BLOCK}
+	for _, typ := range recordTypes {
+		record, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, record.Height)
+		require.Equal(t, typ, record.Type)
+		if typ == RecordType_RECORD_TYPE_DELIVER_TX {
+			var req abci.RequestDeliverTx
+			require.NoError(t, testMarshaller.Unmarshal(record.Request, &req))
+			require.Equal(t, mockTx, req.Tx)
+			require.Equal(t, []*types.StoreKVPair{{StoreKey: mockStoreKey1.Name(), Key: mockKey, Value: mockValue}}, record.StateChanges)
+		}
+	}
+}
+
+func TestStreamingServiceResume(t *testing.T) {
+	s := newTestService(t, Config{RetainBlocks: 2})
+	for height := int64(1); height <= 3; height++ {
+		streamBlock(t, s, height)
+	}
+
+	// the blocks are streamed from the start height, and then as they are streamed
+	stream := subscribe(t, s, 2)
+	requireBlock(t, stream, 2)
+	requireBlock(t, stream, 3)
+	streamBlock(t, s, 4)
+	requireBlock(t, stream, 4)
+
+	// the first block is not retained anymore
+	stream = subscribe(t, s, 1)
+	_, err := stream.Recv()
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+
+	// the blocks streamed before a restart can't be resumed from
+	s = newTestService(t, Config{RetainBlocks: 2})
+	stream = subscribe(t, s, 3)
+	streamBlock(t, s, 5)
+	_, err = stream.Recv()
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "before the node started")
+}
+
+func TestStreamingServiceBlocking(t *testing.T) {
+	s := newTestService(t, Config{Blocking: true, RetainBlocks: DefaultRetainBlocks})
+
+	// the node doesn't wait without subscribers
+	streamBlock(t, s, 1)
+
+	stream := subscribe(t, s, 0)
+	require.Eventually(t, func() bool {
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+		return len(s.subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		streamBlock(t, s, 2)
+		close(done)
+	}()
+	requireBlock(t, stream, 2)
+	select {
+	case <-done:
+		t.Fatal("block ended before it was acknowledged")
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.NoError(t, stream.Send(&SubscribeRequest{AckHeight: 2}))
+	<-done
+
+	// the node stops waiting for the subscribers that are gone
+	require.NoError(t, stream.CloseSend())
+	streamBlock(t, s, 3)
+}
+
+func TestOCCStreamingService(t *testing.T) {
+	s, err := NewOCCStreamingService(Config{Address: "tcp://127.0.0.1:0", RetainBlocks: 1}, []types.StoreKey{mockStoreKey1}, testMarshaller)
+	require.NoError(t, err)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, s.Stream(wg))
+	defer func() {
+		require.NoError(t, s.Close())
+		wg.Wait()
+	}()
+	stream := subscribe(t, s.StreamingService, 1)
+
+	require.NoError(t, s.ListenBeginBlock(emptyContext, abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}, abci.ResponseBeginBlock{}))
+	// the writes observed during the execution are discarded
+	require.NoError(t, s.OnWrite(mockStoreKey1, mockValue, mockKey, false))
+	writeset := tasks.TxWriteset{
+		AbsoluteIndex: 0,
+		TxHash:        mockTx,
+		Incarnation:   1,
+		Changes: []*types.StoreKVPair{
+			{StoreKey: mockStoreKey1.Name(), Key: mockKey, Value: mockValue},
+			{StoreKey: mockStoreKey2.Name(), Key: mockKey, Delete: true},
+		},
+	}
+	require.NoError(t, s.ListenDeliverTxWriteset(emptyContext, abci.RequestDeliverTx{Tx: mockTx}, abci.ResponseDeliverTx{}, writeset))
+
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	record, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, RecordType_RECORD_TYPE_DELIVER_TX, record.Type)
+	require.Equal(t, writeset.Changes[:1], record.StateChanges)
+	require.Equal(t, &types.TxWritesetMetadata{TxHash: mockTx, TxIndex: 0, Incarnation: 1}, record.WritesetMetadata)
+}
@@ -0,0 +1,973 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/streaming/v1beta1/streaming.proto
+
+package grpc
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/store/types"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RecordType is the type of the ABCI message of a StreamRecord.
+type RecordType int32
+
+const (
+	RecordType_RECORD_TYPE_UNSPECIFIED RecordType = 0
+	RecordType_RECORD_TYPE_BEGIN_BLOCK RecordType = 1
+	RecordType_RECORD_TYPE_DELIVER_TX  RecordType = 2
+	RecordType_RECORD_TYPE_END_BLOCK   RecordType = 3
+)
+
+var RecordType_name = map[int32]string{
+	0: "RECORD_TYPE_UNSPECIFIED",
+	1: "RECORD_TYPE_BEGIN_BLOCK",
+	2: "RECORD_TYPE_DELIVER_TX",
+	3: "RECORD_TYPE_END_BLOCK",
+}
+
+var RecordType_value = map[string]int32{
+	"RECORD_TYPE_UNSPECIFIED": 0,
+	"RECORD_TYPE_BEGIN_BLOCK": 1,
+	"RECORD_TYPE_DELIVER_TX":  2,
+	"RECORD_TYPE_END_BLOCK":   3,
+}
+
+func (x RecordType) String() string {
+	return proto.EnumName(RecordType_name, int32(x))
+}
+
+func (RecordType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d35c2a410efc27fe, []int{0}
+}
+
+// SubscribeRequest is the request type for the Streaming/Subscribe RPC method.
+type SubscribeRequest struct {
+	// start_height is the height to stream from, and is only read from the first request of a stream. The stream starts
+	// with the next block if it's 0.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// ack_height acknowledges that the subscriber has processed the blocks up to ack_height.
+	AckHeight int64 `protobuf:"varint,2,opt,name=ack_height,json=ackHeight,proto3" json:"ack_height,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d35c2a410efc27fe, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *SubscribeRequest) GetAckHeight() int64 {
+	if m != nil {
+		return m.AckHeight
+	}
+	return 0
+}
+
+// StreamRecord is an ABCI message of a block with the state changes written since the previous message, i.e. the
+// content of a file of the file streaming service.
+type StreamRecord struct {
+	Height  int64      `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Type    RecordType `protobuf:"varint,2,opt,name=type,proto3,enum=cosmos.base.streaming.v1beta1.RecordType" json:"type,omitempty"`
+	TxIndex int64      `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// request and response are the protobuf encoded tendermint.abci.Request{BeginBlock,DeliverTx,EndBlock} and
+	// tendermint.abci.Response{BeginBlock,DeliverTx,EndBlock} of the record type
+	Request      []byte               `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	Response     []byte               `protobuf:"bytes,5,opt,name=response,proto3" json:"response,omitempty"`
+	StateChanges []*types.StoreKVPair `protobuf:"bytes,6,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+	// writeset_metadata is set for the DeliverTx records of the txs executed with OCC if OCC writesets are streamed
+	WritesetMetadata *types.TxWritesetMetadata `protobuf:"bytes,7,opt,name=writeset_metadata,json=writesetMetadata,proto3" json:"writeset_metadata,omitempty"`
+}
+
+func (m *StreamRecord) Reset()         { *m = StreamRecord{} }
+func (m *StreamRecord) String() string { return proto.CompactTextString(m) }
+func (*StreamRecord) ProtoMessage()    {}
+func (*StreamRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d35c2a410efc27fe, []int{1}
+}
+func (m *StreamRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamRecord.Merge(m, src)
+}
+func (m *StreamRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamRecord proto.InternalMessageInfo
+
+func (m *StreamRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StreamRecord) GetType() RecordType {
+	if m != nil {
+		return m.Type
+	}
+	return RecordType_RECORD_TYPE_UNSPECIFIED
+}
+
+func (m *StreamRecord) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *StreamRecord) GetRequest() []byte {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *StreamRecord) GetResponse() []byte {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *StreamRecord) GetStateChanges() []*types.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+func (m *StreamRecord) GetWritesetMetadata() *types.TxWritesetMetadata {
+	if m != nil {
+		return m.WritesetMetadata
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.base.streaming.v1beta1.RecordType", RecordType_name, RecordType_value)
+	proto.RegisterType((*SubscribeRequest)(nil), "cosmos.base.streaming.v1beta1.SubscribeRequest")
+	proto.RegisterType((*StreamRecord)(nil), "cosmos.base.streaming.v1beta1.StreamRecord")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/streaming/v1beta1/streaming.proto", fileDescriptor_d35c2a410efc27fe)
+}
+
+var fileDescriptor_d35c2a410efc27fe = []byte{
+	// 501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x6f, 0x12, 0x41,
+	0x14, 0x67, 0xa1, 0x42, 0x79, 0xa0, 0xc1, 0x49, 0xac, 0x5b, 0x4c, 0x37, 0xd8, 0x83, 0xa1, 0x1a,
+	0x16, 0xc1, 0xb3, 0x17, 0x60, 0x55, 0x42, 0xa5, 0x64, 0xc0, 0xaa, 0xbd, 0x6c, 0x66, 0x97, 0x17,
+	0xd8, 0x20, 0x2c, 0xce, 0x0c, 0x96, 0x9a, 0xf8, 0x1d, 0xfc, 0x58, 0x1e, 0x7b, 0xf4, 0xa6, 0x81,
+	0x2f, 0xd2, 0x30, 0xbb, 0x2c, 0x94, 0x43, 0x7b, 0xda, 0xfc, 0xde, 0xef, 0x4f, 0xde, 0xec, 0x7b,
+	0x0f, 0x4a, 0xae, 0x2f, 0xc6, 0xbe, 0x28, 0x3b, 0x4c, 0x60, 0x59, 0x48, 0x8e, 0x6c, 0xec, 0x4d,
+	0x06, 0xe5, 0x1f, 0x15, 0x07, 0x25, 0xab, 0x6c, 0x2a, 0xe6, 0x94, 0xfb, 0xd2, 0x27, 0x47, 0x81,
+	0xdc, 0x5c, 0xc9, 0xcd, 0x0d, 0x19, 0xca, 0xf3, 0x27, 0xb7, 0xd3, 0x7c, 0x8e, 0x51, 0xd2, 0x37,
+	0x4f, 0x48, 0x9c, 0x44, 0x49, 0xc7, 0x3d, 0xc8, 0x75, 0x67, 0x8e, 0x70, 0xb9, 0xe7, 0x20, 0xc5,
+	0xef, 0x33, 0x14, 0x92, 0x3c, 0x87, 0xac, 0x90, 0x8c, 0x4b, 0x7b, 0x88, 0xde, 0x60, 0x28, 0x75,
+	0xad, 0xa0, 0x15, 0x13, 0x34, 0xa3, 0x6a, 0x1f, 0x54, 0x89, 0x1c, 0x01, 0x30, 0x77, 0xb4, 0x16,
+	0xc4, 0x95, 0x20, 0xcd, 0xdc, 0x51, 0x40, 0x1f, 0xff, 0x8b, 0x43, 0xb6, 0xab, 0xda, 0xa2, 0xe8,
+	0xfa, 0xbc, 0x4f, 0x0e, 0x20, 0x79, 0x2b, 0x2c, 0x44, 0xe4, 0x2d, 0xec, 0xc9, 0xab, 0x29, 0xaa,
+	0x84, 0x47, 0xd5, 0x13, 0xf3, 0xce, 0x77, 0x99, 0x41, 0x58, 0xef, 0x6a, 0x8a, 0x54, 0xd9, 0xc8,
+	0x21, 0xec, 0xcb, 0xb9, 0xed, 0x4d, 0xfa, 0x38, 0xd7, 0x13, 0x2a, 0x38, 0x25, 0xe7, 0xcd, 0x15,
+	0x24, 0x3a, 0xa4, 0x78, 0xf0, 0x1e, 0x7d, 0xaf, 0xa0, 0x15, 0xb3, 0x74, 0x0d, 0x49, 0x1e, 0xf6,
+	0x39, 0x8a, 0xa9, 0x3f, 0x11, 0xa8, 0x3f, 0x50, 0x54, 0x84, 0x49, 0x0b, 0x1e, 0x0a, 0xc9, 0x24,
+	0xda, 0xee, 0x90, 0x4d, 0x06, 0x28, 0xf4, 0x64, 0x21, 0x51, 0xcc, 0x54, 0x5f, 0xec, 0x34, 0xe6,
+	0x73, 0x8c, 0x9a, 0xea, 0xae, 0x50, 0xeb, 0xbc, 0xc3, 0x3c, 0x4e, 0xb3, 0xca, 0x5c, 0x0f, 0xbc,
+	0xe4, 0x02, 0x1e, 0x5f, 0x72, 0x4f, 0xa2, 0x40, 0x69, 0x8f, 0x51, 0xb2, 0x3e, 0x93, 0x4c, 0x4f,
+	0x15, 0xb4, 0x62, 0xa6, 0x5a, 0xba, 0x23, 0xb0, 0x37, 0xff, 0x1c, 0xba, 0x3e, 0x86, 0x26, 0x9a,
+	0xbb, 0xdc, 0xa9, 0xbc, 0xfc, 0x05, 0xb0, 0xf9, 0x1b, 0xe4, 0x19, 0x3c, 0xa5, 0x56, 0xfd, 0x8c,
+	0x36, 0xec, 0xde, 0xd7, 0x8e, 0x65, 0x7f, 0x6a, 0x77, 0x3b, 0x56, 0xbd, 0xf9, 0xae, 0x69, 0x35,
+	0x72, 0xb1, 0x5d, 0xb2, 0x66, 0xbd, 0x6f, 0xb6, 0xed, 0xda, 0xe9, 0x59, 0xbd, 0x95, 0xd3, 0x48,
+	0x1e, 0x0e, 0xb6, 0xc9, 0x86, 0x75, 0xda, 0x3c, 0xb7, 0xa8, 0xdd, 0xfb, 0x92, 0x8b, 0x93, 0x43,
+	0x78, 0xb2, 0xcd, 0x59, 0xed, 0x46, 0x68, 0x4b, 0x54, 0x7f, 0x42, 0xba, 0xbb, 0x1e, 0x0f, 0x19,
+	0x43, 0x3a, 0xda, 0x21, 0x52, 0xbe, 0x67, 0x86, 0xbb, 0xdb, 0x96, 0x7f, 0x75, 0x9f, 0x61, 0x6b,
+	0x8f, 0x8a, 0xda, 0x6b, 0xad, 0xd6, 0xfa, 0xb3, 0x30, 0xb4, 0xeb, 0x85, 0xa1, 0xfd, 0x5f, 0x18,
+	0xda, 0xef, 0xa5, 0x11, 0xbb, 0x5e, 0x1a, 0xb1, 0xbf, 0x4b, 0x23, 0x76, 0x51, 0x19, 0x78, 0x72,
+	0x38, 0x73, 0x4c, 0xd7, 0x1f, 0x97, 0xc3, 0x13, 0x08, 0x3e, 0x25, 0xd1, 0x1f, 0x85, 0x87, 0xb0,
+	0x39, 0xae, 0x01, 0x9f, 0xba, 0x4e, 0x52, 0x9d, 0xc1, 0x9b, 0x9b, 0x01, 0x00, 0xac, 0xe6, 0x35,
+	0x12, 0x81, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamingClient is the client API for Streaming service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamingClient interface {
+	// Subscribe streams the records of the blocks from the start height of the first request of the stream. The
+	// subscriber acknowledges the blocks it has processed by sending their height.
+	Subscribe(ctx context.Context, opts ...grpc.CallOption) (Streaming_SubscribeClient, error)
+}
+
+type streamingClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamingClient(cc grpc1.ClientConn) StreamingClient {
+	return &streamingClient{cc}
+}
+
+func (c *streamingClient) Subscribe(ctx context.Context, opts ...grpc.CallOption) (Streaming_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Streaming_serviceDesc.Streams[0], "/cosmos.base.streaming.v1beta1.Streaming/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamingSubscribeClient{stream}
+	return x, nil
+}
+
+type Streaming_SubscribeClient interface {
+	Send(*SubscribeRequest) error
+	Recv() (*StreamRecord, error)
+	grpc.ClientStream
+}
+
+type streamingSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamingSubscribeClient) Send(m *SubscribeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *streamingSubscribeClient) Recv() (*StreamRecord, error) {
+	m := new(StreamRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamingServer is the server API for Streaming service.
+type StreamingServer interface {
+	// Subscribe streams the records of the blocks from the start height of the first request of the stream. The
+	// subscriber acknowledges the blocks it has processed by sending their height.
+	Subscribe(Streaming_SubscribeServer) error
+}
+
+// UnimplementedStreamingServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamingServer struct {
+}
+
+func (*UnimplementedStreamingServer) Subscribe(srv Streaming_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterStreamingServer(s grpc1.Server, srv StreamingServer) {
+	s.RegisterService(&_Streaming_serviceDesc, srv)
+}
+
+func _Streaming_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StreamingServer).Subscribe(&streamingSubscribeServer{stream})
+}
+
+type Streaming_SubscribeServer interface {
+	Send(*StreamRecord) error
+	Recv() (*SubscribeRequest, error)
+	grpc.ServerStream
+}
+
+type streamingSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamingSubscribeServer) Send(m *StreamRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *streamingSubscribeServer) Recv() (*SubscribeRequest, error) {
+	m := new(SubscribeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Streaming_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.streaming.v1beta1.Streaming",
+	HandlerType: (*StreamingServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Streaming_Subscribe_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "cosmos/base/streaming/v1beta1/streaming.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AckHeight != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.AckHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WritesetMetadata != nil {
+		{
+			size, err := m.WritesetMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintStreaming(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Request) > 0 {
+		i -= len(m.Request)
+		copy(dAtA[i:], m.Request)
+		i = encodeVarintStreaming(dAtA, i, uint64(len(m.Request)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStreaming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStreaming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovStreaming(uint64(m.StartHeight))
+	}
+	if m.AckHeight != 0 {
+		n += 1 + sovStreaming(uint64(m.AckHeight))
+	}
+	return n
+}
+
+func (m *StreamRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStreaming(uint64(m.Height))
+	}
+	if m.Type != 0 {
+		n += 1 + sovStreaming(uint64(m.Type))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovStreaming(uint64(m.TxIndex))
+	}
+	l = len(m.Request)
+	if l > 0 {
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	if m.WritesetMetadata != nil {
+		l = m.WritesetMetadata.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	return n
+}
+
+func sovStreaming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStreaming(x uint64) (n int) {
+	return sovStreaming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckHeight", wireType)
+			}
+			m.AckHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RecordType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
+			if m.Request == nil {
+				m.Request = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Response = append(m.Response[:0], dAtA[iNdEx:postIndex]...)
+			if m.Response == nil {
+				m.Response = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WritesetMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WritesetMetadata == nil {
+				m.WritesetMetadata = &types.TxWritesetMetadata{}
+			}
+			if err := m.WritesetMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStreaming(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStreaming
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStreaming
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStreaming
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStreaming        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStreaming          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStreaming = fmt.Errorf("proto: unexpected end of group")
+)
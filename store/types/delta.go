@@ -0,0 +1,43 @@
+package types
+
+// MergeFunc merges a delta into the value of a key. A nil value means that the key doesn't exist, and a nil
+// result deletes the key. Merges of deltas to the same key must commute, so that the deltas of different
+// transactions can be resolved in any order without reading the key when they are written.
+type MergeFunc func(value []byte, delta []byte) []byte
+
+// Delta is a commutative update of a key that hasn't been merged into the value of the key yet
+type Delta struct {
+	Delta []byte
+	Merge MergeFunc
+}
+
+// DeltaKVStore is a KVStore that can record deltas to keys without reading them
+type DeltaKVStore interface {
+	KVStore
+
+	// AddDelta records a delta to a key, the delta is merged into the value of the key when the key is read or
+	// the store is written. Panics on nil key, delta or merge function.
+	AddDelta(key, delta []byte, merge MergeFunc)
+}
+
+// AddDelta adds a delta to a key of the store. If the store doesn't support deltas, the delta is merged into
+// the current value of the key right away.
+func AddDelta(store KVStore, key, delta []byte, merge MergeFunc) {
+	if deltaStore, ok := store.(DeltaKVStore); ok {
+		deltaStore.AddDelta(key, delta, merge)
+		return
+	}
+	if value := merge(store.Get(key), delta); value != nil {
+		store.Set(key, value)
+	} else {
+		store.Delete(key)
+	}
+}
+
+// ApplyDeltas merges the deltas into the value in order
+func ApplyDeltas(value []byte, deltas []Delta) []byte {
+	for _, delta := range deltas {
+		value = delta.Merge(value, delta.Delta)
+	}
+	return value
+}
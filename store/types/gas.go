@@ -362,3 +362,37 @@ func TransientGasConfig() GasConfig {
 		IterNextCostFlat: 3,
 	}
 }
+
+// GasSchedule defines the gas config of the KVStore of each store key. The KVStores and TransientStores of
+// the store keys without a gas config of their own are charged KV and Transient respectively.
+type GasSchedule struct {
+	KV        GasConfig
+	Transient GasConfig
+	// gas configs by store key name
+	Stores map[string]GasConfig
+}
+
+// DefaultGasSchedule returns a gas schedule charging the default gas configs to every store.
+func DefaultGasSchedule() *GasSchedule {
+	return &GasSchedule{
+		KV:        KVGasConfig(),
+		Transient: TransientGasConfig(),
+		Stores:    map[string]GasConfig{},
+	}
+}
+
+// KVGasConfig returns the gas config of the KVStore of the store key with the given name.
+func (s *GasSchedule) KVGasConfig(storeKey string) GasConfig {
+	if config, ok := s.Stores[storeKey]; ok {
+		return config
+	}
+	return s.KV
+}
+
+// TransientGasConfig returns the gas config of the TransientStore of the store key with the given name.
+func (s *GasSchedule) TransientGasConfig(storeKey string) GasConfig {
+	if config, ok := s.Stores[storeKey]; ok {
+		return config
+	}
+	return s.Transient
+}
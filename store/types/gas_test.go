@@ -191,3 +191,15 @@ func TestTransientGasConfig(t *testing.T) {
 		IterNextCostFlat: 3,
 	})
 }
+
+func TestGasSchedule(t *testing.T) {
+	t.Parallel()
+	schedule := DefaultGasSchedule()
+	require.Equal(t, KVGasConfig(), schedule.KVGasConfig("bank"))
+	require.Equal(t, TransientGasConfig(), schedule.TransientGasConfig("transient_params"))
+
+	config := GasConfig{HasCost: 1, DeleteCost: 2, ReadCostFlat: 3, ReadCostPerByte: 4, WriteCostFlat: 5, WriteCostPerByte: 6, IterNextCostFlat: 7}
+	schedule.Stores["bank"] = config
+	require.Equal(t, config, schedule.KVGasConfig("bank"))
+	require.Equal(t, KVGasConfig(), schedule.KVGasConfig("staking"))
+}
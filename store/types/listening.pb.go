@@ -95,8 +95,70 @@ func (m *StoreKVPair) GetValue() []byte {
 	return nil
 }
 
+// TxWritesetMetadata identifies the tx and the incarnation a final tx writeset streamed under OCC was produced by
+type TxWritesetMetadata struct {
+	TxHash      []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxIndex     int64  `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	Incarnation int64  `protobuf:"varint,3,opt,name=incarnation,proto3" json:"incarnation,omitempty"`
+}
+
+func (m *TxWritesetMetadata) Reset()         { *m = TxWritesetMetadata{} }
+func (m *TxWritesetMetadata) String() string { return proto.CompactTextString(m) }
+func (*TxWritesetMetadata) ProtoMessage()    {}
+func (*TxWritesetMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5d350879fe4fecd, []int{1}
+}
+func (m *TxWritesetMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxWritesetMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxWritesetMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxWritesetMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxWritesetMetadata.Merge(m, src)
+}
+func (m *TxWritesetMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxWritesetMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxWritesetMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxWritesetMetadata proto.InternalMessageInfo
+
+func (m *TxWritesetMetadata) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *TxWritesetMetadata) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *TxWritesetMetadata) GetIncarnation() int64 {
+	if m != nil {
+		return m.Incarnation
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StoreKVPair)(nil), "cosmos.base.store.v1beta1.StoreKVPair")
+	proto.RegisterType((*TxWritesetMetadata)(nil), "cosmos.base.store.v1beta1.TxWritesetMetadata")
 }
 
 func init() {
@@ -104,21 +166,26 @@ func init() {
 }
 
 var fileDescriptor_a5d350879fe4fecd = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a, 0xd5, 0x2f, 0x33,
-	0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0xcf, 0xc9, 0x2c, 0x2e, 0x49, 0xcd, 0xcb, 0xcc, 0x4b, 0xd7,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x84, 0x28, 0xd5, 0x03, 0x29, 0xd5, 0x03, 0x2b, 0xd5,
-	0x83, 0x2a, 0x55, 0xca, 0xe2, 0xe2, 0x0e, 0x06, 0x09, 0x78, 0x87, 0x05, 0x24, 0x66, 0x16, 0x09,
-	0x49, 0x73, 0x71, 0x82, 0xe5, 0xe3, 0xb3, 0x53, 0x2b, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83,
-	0x38, 0xc0, 0x02, 0xde, 0xa9, 0x95, 0x42, 0x62, 0x5c, 0x6c, 0x29, 0xa9, 0x39, 0xa9, 0x25, 0xa9,
-	0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x1c, 0x41, 0x50, 0x9e, 0x90, 0x00, 0x17, 0x33, 0x48, 0x39, 0xb3,
-	0x02, 0xa3, 0x06, 0x4f, 0x10, 0x88, 0x29, 0x24, 0xc2, 0xc5, 0x5a, 0x96, 0x98, 0x53, 0x9a, 0x2a,
-	0xc1, 0x02, 0x16, 0x83, 0x70, 0x9c, 0x9c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0x4a, 0x23, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x2d,
-	0x08, 0xa5, 0x5b, 0x9c, 0x92, 0x0d, 0xf5, 0x5c, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8,
-	0x47, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xe0, 0xb3, 0x51, 0xfe, 0x00, 0x00, 0x00,
+	// 294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xcd, 0x4a, 0x03, 0x31,
+	0x14, 0x85, 0x1b, 0x47, 0xfb, 0x93, 0x76, 0x21, 0x41, 0xb4, 0x45, 0x08, 0x43, 0x57, 0xe3, 0xc2,
+	0x19, 0x8a, 0x6f, 0xd0, 0x95, 0x52, 0x04, 0x89, 0xa2, 0xe0, 0xa6, 0x64, 0x3a, 0x97, 0x4e, 0xfa,
+	0x93, 0x94, 0xc9, 0x6d, 0x49, 0xdf, 0xc2, 0xc7, 0x72, 0xd9, 0xa5, 0x4b, 0x69, 0x5f, 0x44, 0x26,
+	0x33, 0x0b, 0x57, 0xc9, 0xf9, 0xee, 0x07, 0x07, 0x0e, 0xbd, 0x9b, 0x19, 0xbb, 0x36, 0x36, 0x49,
+	0xa5, 0x85, 0xc4, 0xa2, 0x29, 0x20, 0xd9, 0x8d, 0x52, 0x40, 0x39, 0x4a, 0x56, 0xca, 0x22, 0x68,
+	0xa5, 0xe7, 0xf1, 0xa6, 0x30, 0x68, 0xd8, 0xa0, 0x52, 0xe3, 0x52, 0x8d, 0xbd, 0x1a, 0xd7, 0xea,
+	0x70, 0x41, 0xbb, 0xaf, 0x25, 0x98, 0xbc, 0xbf, 0x48, 0x55, 0xb0, 0x5b, 0xda, 0xf1, 0xf7, 0xe9,
+	0x12, 0xf6, 0x7d, 0x12, 0x92, 0xa8, 0x23, 0xda, 0x1e, 0x4c, 0x60, 0xcf, 0xae, 0x69, 0x33, 0x83,
+	0x15, 0x20, 0xf4, 0xcf, 0x42, 0x12, 0xb5, 0x45, 0x9d, 0xd8, 0x25, 0x0d, 0x4a, 0x3d, 0x08, 0x49,
+	0xd4, 0x13, 0xe5, 0x97, 0x5d, 0xd1, 0x8b, 0x9d, 0x5c, 0x6d, 0xa1, 0x7f, 0xee, 0x59, 0x15, 0x86,
+	0x0b, 0xca, 0xde, 0xdc, 0x47, 0xa1, 0x10, 0x2c, 0xe0, 0x33, 0xa0, 0xcc, 0x24, 0x4a, 0x76, 0x43,
+	0x5b, 0xe8, 0xa6, 0xb9, 0xb4, 0xb9, 0x2f, 0xec, 0x89, 0x26, 0xba, 0x47, 0x69, 0x73, 0x36, 0xa0,
+	0x6d, 0x74, 0x53, 0xa5, 0x33, 0x70, 0xbe, 0x30, 0x10, 0x2d, 0x74, 0x4f, 0x65, 0x64, 0x21, 0xed,
+	0x2a, 0x3d, 0x93, 0x85, 0x96, 0xa8, 0x8c, 0xf6, 0xcd, 0x81, 0xf8, 0x8f, 0xc6, 0xe3, 0xef, 0x23,
+	0x27, 0x87, 0x23, 0x27, 0xbf, 0x47, 0x4e, 0xbe, 0x4e, 0xbc, 0x71, 0x38, 0xf1, 0xc6, 0xcf, 0x89,
+	0x37, 0x3e, 0xa3, 0xb9, 0xc2, 0x7c, 0x9b, 0xc6, 0x33, 0xb3, 0x4e, 0xea, 0x09, 0xab, 0xe7, 0xde,
+	0x66, 0xcb, 0x7a, 0x48, 0xdc, 0x6f, 0xc0, 0xa6, 0x4d, 0xbf, 0xde, 0xc3, 0xdf, 0x00, 0x9a, 0x4b,
+	0xc7, 0x3e, 0x6a, 0x01, 0x00, 0x00,
 }
 
 func (m *StoreKVPair) Marshal() (dAtA []byte, err error) {
@@ -175,6 +242,46 @@ func (m *StoreKVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxWritesetMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxWritesetMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxWritesetMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Incarnation != 0 {
+		i = encodeVarintListening(dAtA, i, uint64(m.Incarnation))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintListening(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintListening(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintListening(dAtA []byte, offset int, v uint64) int {
 	offset -= sovListening(v)
 	base := offset
@@ -210,6 +317,25 @@ func (m *StoreKVPair) Size() (n int) {
 	return n
 }
 
+func (m *TxWritesetMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovListening(uint64(m.TxIndex))
+	}
+	if m.Incarnation != 0 {
+		n += 1 + sovListening(uint64(m.Incarnation))
+	}
+	return n
+}
+
 func sovListening(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -386,6 +512,128 @@ func (m *StoreKVPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TxWritesetMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowListening
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxWritesetMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxWritesetMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incarnation", wireType)
+			}
+			m.Incarnation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Incarnation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipListening(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthListening
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipListening(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
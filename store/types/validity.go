@@ -13,3 +13,13 @@ func AssertValidValue(value []byte) {
 		panic("value is nil")
 	}
 }
+
+// Check if the delta is valid(delta and merge function are not nil)
+func AssertValidDelta(delta []byte, merge MergeFunc) {
+	if delta == nil {
+		panic("delta is nil")
+	}
+	if merge == nil {
+		panic("merge function is nil")
+	}
+}
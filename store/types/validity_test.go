@@ -21,3 +21,11 @@ func TestAssertValidValue(t *testing.T) {
 	require.NotPanics(t, func() { types.AssertValidValue([]byte{0x01}) })
 	require.Panics(t, func() { types.AssertValidValue(nil) })
 }
+
+func TestAssertValidDelta(t *testing.T) {
+	t.Parallel()
+	merge := func(value, delta []byte) []byte { return delta }
+	require.NotPanics(t, func() { types.AssertValidDelta([]byte{}, merge) })
+	require.Panics(t, func() { types.AssertValidDelta(nil, merge) })
+	require.Panics(t, func() { types.AssertValidDelta([]byte{0x01}, nil) })
+}
@@ -0,0 +1,193 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/plume-protocol/plume-db/common/utils"
+	"github.com/plume-protocol/plume-db/config"
+	"github.com/plume-protocol/plume-db/sc"
+	sctypes "github.com/plume-protocol/plume-db/sc/types"
+	"github.com/plume-protocol/plume-db/ss"
+	"github.com/plume-protocol/plume-db/ss/pebbledb"
+	sstypes "github.com/plume-protocol/plume-db/ss/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Inspector reads the commitment and state stores of a node without modifying them. The values are read from the
+// state store if it's enabled, and from the commitment store otherwise, which only serves the heights it keeps
+// snapshots and changelog for.
+type Inspector struct {
+	scStore *sc.CommitStore
+	ssStore sstypes.StateStore
+}
+
+// NewInspector opens the commitment and state stores of homeDir read-only. The state store is only supported for the
+// pebbledb backend, and it can't be opened while the node is running.
+func NewInspector(
+	homeDir string, logger log.Logger, scConfig config.StateCommitConfig, ssConfig config.StateStoreConfig,
+) (*Inspector, error) {
+	inspector := &Inspector{scStore: sc.NewCommitStore(homeDir, logger, scConfig)}
+	if ssConfig.Enable {
+		if ssConfig.Backend != string(ss.PebbleDBBackend) {
+			return nil, fmt.Errorf("state store backend %s can't be opened read-only", ssConfig.Backend)
+		}
+		dir := utils.GetStateStorePath(homeDir, ssConfig.Backend)
+		if ssConfig.DBDirectory != "" {
+			dir = ssConfig.DBDirectory
+		}
+		db, err := pebble.Open(dir, &pebble.Options{Comparer: pebbledb.MVCCComparer, ReadOnly: true})
+		if err != nil {
+			return nil, fmt.Errorf("failed to open state store: %w", err)
+		}
+		inspector.ssStore = pebbledb.NewWithDB(db)
+	}
+	return inspector, nil
+}
+
+// Close closes the state store.
+func (i *Inspector) Close() error {
+	if i.ssStore != nil {
+		return i.ssStore.Close()
+	}
+	return nil
+}
+
+// LatestHeight returns the latest committed height.
+func (i *Inspector) LatestHeight() (int64, error) {
+	if i.ssStore != nil {
+		return i.ssStore.GetLatestVersion()
+	}
+	return i.scStore.GetLatestVersion()
+}
+
+// CommitInfo returns the commit info of the height, or of the latest height if height is 0.
+func (i *Inspector) CommitInfo(height int64) (*types.CommitInfo, error) {
+	var commitInfo *types.CommitInfo
+	err := i.withCommitStore(height, func(store sctypes.Committer) error {
+		commitInfo = convertCommitInfo(store.LastCommitInfo())
+		return nil
+	})
+	return commitInfo, err
+}
+
+// Get returns the value of the key in the store at the height, or nil if the key doesn't exist.
+func (i *Inspector) Get(storeKey string, height int64, key []byte) ([]byte, error) {
+	if i.ssStore != nil {
+		return i.ssStore.Get(storeKey, height, key)
+	}
+	var value []byte
+	err := i.withTree(storeKey, height, func(tree sctypes.Tree) error {
+		value = tree.Get(key)
+		return nil
+	})
+	return value, err
+}
+
+// Iterate calls fn with the keys of the store with the prefix at the height in ascending order, until fn returns
+// false.
+func (i *Inspector) Iterate(storeKey string, height int64, prefix []byte, fn func(key, value []byte) bool) error {
+	return i.withIterator(storeKey, height, prefix, func(it kvIterator) error {
+		for ; it.Valid(); it.Next() {
+			if !fn(it.Key(), it.Value()) {
+				break
+			}
+		}
+		return it.Error()
+	})
+}
+
+// KVDiff is a key that differs between two heights of a store. Old is nil if the key was added, and New is nil if the
+// key was deleted.
+type KVDiff struct {
+	Key []byte
+	Old []byte
+	New []byte
+}
+
+// Diff calls fn with the keys of the store with the prefix that differ between the heights from and to in ascending
+// order, until fn returns false.
+func (i *Inspector) Diff(storeKey string, from, to int64, prefix []byte, fn func(KVDiff) bool) error {
+	return i.withIterator(storeKey, from, prefix, func(oldIt kvIterator) error {
+		return i.withIterator(storeKey, to, prefix, func(newIt kvIterator) error {
+			for oldIt.Valid() || newIt.Valid() {
+				var diff KVDiff
+				switch {
+				case !newIt.Valid() || (oldIt.Valid() && bytes.Compare(oldIt.Key(), newIt.Key()) < 0):
+					diff = KVDiff{Key: oldIt.Key(), Old: oldIt.Value()}
+					oldIt.Next()
+				case !oldIt.Valid() || bytes.Compare(oldIt.Key(), newIt.Key()) > 0:
+					diff = KVDiff{Key: newIt.Key(), New: newIt.Value()}
+					newIt.Next()
+				default:
+					diff = KVDiff{Key: oldIt.Key(), Old: oldIt.Value(), New: newIt.Value()}
+					oldIt.Next()
+					newIt.Next()
+					if bytes.Equal(diff.Old, diff.New) {
+						continue
+					}
+				}
+				if !fn(diff) {
+					break
+				}
+			}
+			if err := oldIt.Error(); err != nil {
+				return err
+			}
+			return newIt.Error()
+		})
+	})
+}
+
+// kvIterator is the iterator of both the state store and the commitment trees
+type kvIterator interface {
+	Valid() bool
+	Next()
+	Key() []byte
+	Value() []byte
+	Error() error
+	Close() error
+}
+
+func (i *Inspector) withIterator(storeKey string, height int64, prefix []byte, fn func(kvIterator) error) error {
+	var start, end []byte
+	if len(prefix) > 0 {
+		start, end = prefix, types.PrefixEndBytes(prefix)
+	}
+	if i.ssStore != nil {
+		it, err := i.ssStore.Iterator(storeKey, height, start, end)
+		if err != nil {
+			return err
+		}
+		defer it.Close()
+		return fn(it)
+	}
+	return i.withTree(storeKey, height, func(tree sctypes.Tree) error {
+		it := tree.Iterator(start, end, true)
+		defer it.Close()
+		return fn(it)
+	})
+}
+
+func (i *Inspector) withTree(storeKey string, height int64, fn func(sctypes.Tree) error) error {
+	return i.withCommitStore(height, func(store sctypes.Committer) error {
+		tree := store.GetTreeByName(storeKey)
+		if tree == nil {
+			return fmt.Errorf("store %s doesn't exist at height %d", storeKey, height)
+		}
+		return fn(tree)
+	})
+}
+
+// withCommitStore runs fn with the commitment store loaded read-only at the height, or at the latest height if
+// height is 0.
+func (i *Inspector) withCommitStore(height int64, fn func(sctypes.Committer) error) error {
+	store, err := i.scStore.LoadVersion(height, true)
+	if err != nil {
+		return fmt.Errorf("failed to load commitment store at height %d: %w", height, err)
+	}
+	defer store.Close()
+	return fn(store)
+}
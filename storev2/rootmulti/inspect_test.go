@@ -0,0 +1,82 @@
+package rootmulti
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/plume-protocol/plume-db/config"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestInspector(t *testing.T) {
+	scConfig := config.DefaultStateCommitConfig()
+	scConfig.AsyncCommitBuffer = 0
+	scConfig.SnapshotInterval = 100
+	key := types.NewKVStoreKey("store")
+
+	for _, ssEnabled := range []bool{false, true} {
+		dir := t.TempDir()
+		ssConfig := config.DefaultStateStoreConfig()
+		ssConfig.Enable = ssEnabled
+		ssConfig.AsyncWriteBuffer = 0
+		store := NewStore(dir, log.NewNopLogger(), scConfig, ssConfig, false)
+		store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+		require.NoError(t, store.LoadLatestVersion())
+		kvStore := store.GetKVStore(key)
+		kvStore.Set([]byte("a1"), []byte("1"))
+		kvStore.Set([]byte("a2"), []byte("2"))
+		kvStore.Set([]byte("b1"), []byte("3"))
+		store.Commit(true)
+		kvStore = store.GetKVStore(key)
+		kvStore.Set([]byte("a2"), []byte("4"))
+		kvStore.Set([]byte("a3"), []byte("5"))
+		kvStore.Delete([]byte("a1"))
+		lastCommitID := store.Commit(true)
+		if ssEnabled {
+			// the state store applies the changes in the background
+			require.Eventually(t, func() bool {
+				version, err := store.GetStateStore().GetLatestVersion()
+				return err == nil && version == 2
+			}, time.Second, 10*time.Millisecond)
+		}
+		require.NoError(t, store.Close())
+
+		inspector, err := NewInspector(dir, log.NewNopLogger(), scConfig, ssConfig)
+		require.NoError(t, err)
+		latest, err := inspector.LatestHeight()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), latest)
+
+		value, err := inspector.Get("store", 1, []byte("a2"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("2"), value)
+		value, err = inspector.Get("store", 2, []byte("a1"))
+		require.NoError(t, err)
+		require.Nil(t, value)
+
+		var keys []string
+		require.NoError(t, inspector.Iterate("store", 2, []byte("a"), func(key, _ []byte) bool {
+			keys = append(keys, string(key))
+			return true
+		}))
+		require.Equal(t, []string{"a2", "a3"}, keys)
+
+		var diffs []KVDiff
+		require.NoError(t, inspector.Diff("store", 1, 2, nil, func(diff KVDiff) bool {
+			diffs = append(diffs, diff)
+			return true
+		}))
+		require.Equal(t, []KVDiff{
+			{Key: []byte("a1"), Old: []byte("1")},
+			{Key: []byte("a2"), Old: []byte("2"), New: []byte("4")},
+			{Key: []byte("a3"), New: []byte("5")},
+		}, diffs)
+
+		commitInfo, err := inspector.CommitInfo(0)
+		require.NoError(t, err)
+		require.Equal(t, lastCommitID, commitInfo.CommitID())
+		require.NoError(t, inspector.Close())
+	}
+}
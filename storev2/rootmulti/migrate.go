@@ -0,0 +1,267 @@
+package rootmulti
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	iavltree "github.com/cosmos/iavl"
+)
+
+// migrationChunkSize is the number of IAVL nodes per chunk passed to RestoreChunks during a migration
+const migrationChunkSize = 10000
+
+// MigrationProgress is the progress of a migration from an IAVL multistore, which is saved after every store so that
+// an interrupted migration can resume.
+type MigrationProgress struct {
+	Height int64    `json:"height"`
+	Stores []string `json:"stores"` // the stores that are fully migrated
+	Chunks uint32   `json:"chunks"` // the number of chunks of the migrated stores
+}
+
+// MigrationEvent reports the progress of a migration.
+type MigrationEvent struct {
+	Store    string
+	Nodes    int64 // the number of nodes of the store migrated so far
+	Done     bool  // true once all the nodes of the store are exported
+	Skipped  bool  // true if the store was migrated by an interrupted migration
+	Verified bool  // true once the root hash of the store is verified against the legacy commit info
+}
+
+// MigrateFromIAVL copies the IAVL stores of a legacy multistore at its latest version into the commitment and state
+// stores, and verifies the root hash of every store against the commit info of the legacy multistore. The stores
+// are imported like the chunks of a state sync snapshot, and the progress is saved to progressFile after every
+// store, so that an interrupted migration resumes with the next store. The stores of the legacy multistore must be
+// mounted in the store as well.
+func (rs *Store) MigrateFromIAVL(
+	ctx context.Context, legacy *rootmulti.Store, progressFile string, report func(MigrationEvent),
+) error {
+	commitInfo := legacy.LastCommitInfo()
+	if commitInfo == nil || commitInfo.Version == 0 {
+		return fmt.Errorf("legacy multistore has no committed version")
+	}
+	height := commitInfo.Version
+
+	progress, err := loadMigrationProgress(progressFile)
+	if err != nil {
+		return err
+	}
+	if progress.Height != height {
+		progress = MigrationProgress{Height: height}
+	}
+	migrated := make(map[string]bool, len(progress.Stores))
+	for _, name := range progress.Stores {
+		migrated[name] = true
+	}
+
+	type namedStore struct {
+		*iavl.Store
+		name string
+	}
+	var stores []namedStore
+	for _, key := range legacy.StoreKeys() {
+		if store, ok := legacy.GetCommitKVStore(key).(*iavl.Store); ok {
+			stores = append(stores, namedStore{Store: store, name: key.Name()})
+		}
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		return stores[i].name < stores[j].name
+	})
+
+	// the commitment store must be open to be restored
+	if err := rs.LoadLatestVersion(); err != nil {
+		return err
+	}
+
+	// firstChunks maps the index of the first chunk of a store to the stores migrated before it
+	firstChunks := make(map[uint32][]string)
+	checkpoint := func(restored uint32) error {
+		// the migration starts over if the commitment store was being finalized
+		progress.Stores, progress.Chunks = nil, 0
+		if restored > 0 {
+			progress.Stores, progress.Chunks = firstChunks[restored], restored
+		}
+		return saveMigrationProgress(progressFile, progress)
+	}
+	restoreCtx, cancel := context.WithCancel(ctx)
+	chunks := make(chan snapshottypes.StoreChunk)
+	restoreDone := make(chan error, 1)
+	go func() {
+		restoreDone <- rs.RestoreChunks(restoreCtx, uint64(height), chunks, checkpoint)
+		close(restoreDone)
+	}()
+	// an interrupted migration waits for the restore to save its progress
+	defer func() {
+		cancel()
+		<-restoreDone
+	}()
+	// send passes a chunk to the restore, and returns the error of the restore if it ended
+	send := func(chunk snapshottypes.StoreChunk) error {
+		select {
+		case chunks <- chunk:
+			return nil
+		case err := <-restoreDone:
+			if err == nil {
+				err = fmt.Errorf("migration ended prematurely")
+			}
+			return err
+		}
+	}
+
+	// the chunk indexes continue the ones of the interrupted migration, so that its stores are kept by the restore
+	var (
+		index = progress.Chunks
+		done  []string
+	)
+	for _, store := range stores {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if migrated[store.name] {
+			done = append(done, store.name)
+			report(MigrationEvent{Store: store.name, Done: true, Skipped: true})
+			continue
+		}
+		firstChunks[index] = append([]string{}, done...)
+		nodes, err := exportIAVLChunks(store.Store, store.name, height, &index, send, func(nodes int64) {
+			report(MigrationEvent{Store: store.name, Nodes: nodes})
+		})
+		if err != nil {
+			return err
+		}
+		done = append(done, store.name)
+		report(MigrationEvent{Store: store.name, Nodes: nodes, Done: true})
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	close(chunks)
+	if err := <-restoreDone; err != nil {
+		return err
+	}
+
+	if err := rs.verifyMigration(commitInfo, done, report); err != nil {
+		return err
+	}
+	if err := os.Remove(progressFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// exportIAVLChunks exports the nodes of an IAVL store as store chunks, starting at the chunk index, and returns the
+// number of nodes exported.
+func exportIAVLChunks(
+	store *iavl.Store, name string, height int64, index *uint32,
+	send func(snapshottypes.StoreChunk) error, report func(nodes int64),
+) (int64, error) {
+	exporter, err := store.Export(height)
+	if err != nil {
+		return 0, err
+	}
+	defer exporter.Close()
+
+	storeItem := snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{Store: &snapshottypes.SnapshotStoreItem{Name: name}},
+	}
+	items := []snapshottypes.SnapshotItem{storeItem}
+	var nodes int64
+	flush := func() error {
+		if err := send(snapshottypes.StoreChunk{Index: *index, Items: items}); err != nil {
+			return err
+		}
+		*index++
+		items = []snapshottypes.SnapshotItem{storeItem}
+		report(nodes)
+		return nil
+	}
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		} else if err != nil {
+			return nodes, err
+		}
+		items = append(items, snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
+				},
+			},
+		})
+		nodes++
+		if len(items) > migrationChunkSize {
+			if err := flush(); err != nil {
+				return nodes, err
+			}
+		}
+	}
+	if len(items) > 1 || nodes == 0 {
+		if err := flush(); err != nil {
+			return nodes, err
+		}
+	}
+	return nodes, nil
+}
+
+// verifyMigration verifies the root hashes of the migrated stores against the commit info of the legacy multistore.
+func (rs *Store) verifyMigration(legacy *types.CommitInfo, stores []string, report func(MigrationEvent)) error {
+	legacyIDs := make(map[string]types.CommitID, len(legacy.StoreInfos))
+	for _, info := range legacy.StoreInfos {
+		legacyIDs[info.Name] = info.CommitId
+	}
+	migratedIDs := make(map[string]types.CommitID, len(rs.lastCommitInfo.StoreInfos))
+	for _, info := range rs.lastCommitInfo.StoreInfos {
+		migratedIDs[info.Name] = info.CommitId
+	}
+	for _, name := range stores {
+		migrated, ok := migratedIDs[name]
+		if !ok {
+			return fmt.Errorf("store %s is missing from the migrated commit info", name)
+		}
+		if migrated.Version != legacy.Version || !bytes.Equal(migrated.Hash, legacyIDs[name].Hash) {
+			return fmt.Errorf("store %s has migrated root hash %X at version %d, but legacy root hash %X at version %d",
+				name, migrated.Hash, migrated.Version, legacyIDs[name].Hash, legacy.Version)
+		}
+		report(MigrationEvent{Store: name, Done: true, Verified: true})
+	}
+	return nil
+}
+
+// loadMigrationProgress loads the progress of an interrupted migration, if any.
+func loadMigrationProgress(file string) (MigrationProgress, error) {
+	var progress MigrationProgress
+	bz, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return progress, nil
+	} else if err != nil {
+		return progress, err
+	}
+	if err := json.Unmarshal(bz, &progress); err != nil {
+		return progress, fmt.Errorf("invalid migration progress file %s: %w", file, err)
+	}
+	return progress, nil
+}
+
+// saveMigrationProgress saves the progress of a migration atomically.
+func saveMigrationProgress(file string, progress MigrationProgress) error {
+	bz, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	tmpFile := file + ".tmp"
+	if err := os.WriteFile(tmpFile, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, file)
+}
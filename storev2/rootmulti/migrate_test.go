@@ -0,0 +1,107 @@
+package rootmulti
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/plume-protocol/plume-db/config"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// setupLegacyStore creates an IAVL multistore with a few versions of the named stores
+func setupLegacyStore(t *testing.T, names ...string) (*rootmulti.Store, []types.StoreKey) {
+	legacy := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	keys := make([]types.StoreKey, len(names))
+	for i, name := range names {
+		keys[i] = types.NewKVStoreKey(name)
+		legacy.MountStoreWithDB(keys[i], types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, legacy.LoadLatestVersion())
+	for version := 1; version <= 3; version++ {
+		for _, key := range keys {
+			store := legacy.GetKVStore(key)
+			for i := 0; i < 50; i++ {
+				store.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("%s-%d-%d", key.Name(), version, i)))
+			}
+			store.Delete([]byte(fmt.Sprintf("key%d", version)))
+		}
+		legacy.Commit(true)
+	}
+	return legacy, keys
+}
+
+func newMigrationTarget(t *testing.T, dir string, ssConfig config.StateStoreConfig, keys []types.StoreKey) *Store {
+	scConfig := config.DefaultStateCommitConfig()
+	scConfig.AsyncCommitBuffer = 0
+	store := NewStore(dir, log.NewNopLogger(), scConfig, ssConfig, true)
+	for _, key := range keys {
+		store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	return store
+}
+
+func TestMigrateFromIAVL(t *testing.T) {
+	legacy, keys := setupLegacyStore(t, "a", "b")
+	dir := t.TempDir()
+	ssConfig := config.DefaultStateStoreConfig()
+	ssConfig.Enable = true
+	ssConfig.AsyncWriteBuffer = 0
+	store := newMigrationTarget(t, dir, ssConfig, keys)
+
+	var events []MigrationEvent
+	err := store.MigrateFromIAVL(context.Background(), legacy, filepath.Join(dir, "migrate.json"), func(event MigrationEvent) {
+		events = append(events, event)
+	})
+	require.NoError(t, err)
+	require.Equal(t, legacy.LastCommitID(), store.LastCommitID())
+	require.Contains(t, events, MigrationEvent{Store: "a", Done: true, Verified: true})
+	require.Contains(t, events, MigrationEvent{Store: "b", Done: true, Verified: true})
+
+	// the state store serves the migrated state
+	res := store.Query(abci.RequestQuery{Path: "/b/key", Data: []byte("key7"), Height: 3})
+	require.Zero(t, res.Code, res.Log)
+	require.Equal(t, []byte("b-3-7"), res.Value)
+	res = store.Query(abci.RequestQuery{Path: "/b/key", Data: []byte("key3"), Height: 3})
+	require.Zero(t, res.Code, res.Log)
+	require.Nil(t, res.Value)
+	require.NoError(t, store.Close())
+}
+
+func TestMigrateFromIAVLResume(t *testing.T) {
+	legacy, keys := setupLegacyStore(t, "a", "b", "c")
+	dir := t.TempDir()
+	progressFile := filepath.Join(dir, "migrate.json")
+
+	// the migration is interrupted after the second store
+	store := newMigrationTarget(t, dir, config.StateStoreConfig{}, keys)
+	ctx, cancel := context.WithCancel(context.Background())
+	err := store.MigrateFromIAVL(ctx, legacy, progressFile, func(event MigrationEvent) {
+		if event.Store == "b" && event.Done {
+			cancel()
+		}
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	progress, err := loadMigrationProgress(progressFile)
+	require.NoError(t, err)
+	require.Equal(t, MigrationProgress{Height: 3, Stores: []string{"a"}, Chunks: 1}, progress)
+	require.NoError(t, store.Close())
+
+	// the resumed migration skips the first store
+	store = newMigrationTarget(t, dir, config.StateStoreConfig{}, keys)
+	var events []MigrationEvent
+	err = store.MigrateFromIAVL(context.Background(), legacy, progressFile, func(event MigrationEvent) {
+		events = append(events, event)
+	})
+	require.NoError(t, err)
+	require.Equal(t, MigrationEvent{Store: "a", Done: true, Skipped: true}, events[0])
+	require.Equal(t, legacy.LastCommitID(), store.LastCommitID())
+	require.NoFileExists(t, progressFile)
+	require.NoError(t, store.Close())
+}
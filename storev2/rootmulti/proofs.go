@@ -0,0 +1,83 @@
+package rootmulti
+
+import (
+	"math"
+	"sync"
+
+	"cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/plume-protocol/plume-db/config"
+	sctypes "github.com/plume-protocol/plume-db/sc/types"
+)
+
+// Option configures a Store at construction.
+type Option func(scConfig *config.StateCommitConfig, ssConfig config.StateStoreConfig, rs *Store)
+
+// HistoricalProofs enables proofs for historical heights. The commitment store keeps enough snapshots and
+// changelog to rebuild the trees of every height served by the state store, and a proof query at a historical
+// height rebuilds the trees from the closest snapshot before it. Rebuilt commitment stores are cached for up
+// to cacheSize heights, since relayers usually query many keys at the same height. Historical proofs stay
+// disabled unless the state store is enabled with a bounded KeepRecent and the commitment store takes
+// snapshots, as the commitment store would otherwise have to keep every snapshot.
+func HistoricalProofs(cacheSize int) Option {
+	return func(scConfig *config.StateCommitConfig, ssConfig config.StateStoreConfig, rs *Store) {
+		if !ssConfig.Enable || ssConfig.KeepRecent <= 0 || scConfig.SnapshotInterval == 0 {
+			return
+		}
+		// the snapshot before the earliest version of the state store is needed to rebuild the trees
+		snapshots := (uint64(ssConfig.KeepRecent)+uint64(scConfig.SnapshotInterval)-1)/uint64(scConfig.SnapshotInterval) + 1
+		if snapshots > math.MaxUint32 {
+			return
+		}
+		if keepRecent := uint32(snapshots); keepRecent > scConfig.SnapshotKeepRecent {
+			scConfig.SnapshotKeepRecent = keepRecent
+		}
+		rs.proofCache = newProofCache(cacheSize)
+	}
+}
+
+// proofCache caches the commitment stores rebuilt for historical proof queries. The queries are served one at a
+// time, so that a cached commitment store is never closed while it is being used.
+type proofCache struct {
+	mtx    sync.Mutex
+	stores *lru.Cache[int64, sctypes.Committer]
+}
+
+func newProofCache(size int) *proofCache {
+	if size <= 0 {
+		size = 1
+	}
+	stores, err := lru.NewWithEvict[int64, sctypes.Committer](size, func(_ int64, store sctypes.Committer) {
+		_ = store.Close()
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &proofCache{stores: stores}
+}
+
+// withVersion runs fn with the commitment store of the version, loading it from the commitment store if it's not
+// cached yet.
+func (c *proofCache) withVersion(scStore sctypes.Committer, version int64, fn func(sctypes.Committer)) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	store, ok := c.stores.Get(version)
+	if !ok {
+		var err error
+		store, err = scStore.LoadVersion(version, true)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidHeight, "proofs at height %d are not available: %s", version, err)
+		}
+		c.stores.Add(version, store)
+	}
+	fn(store)
+	return nil
+}
+
+// close closes the cached commitment stores.
+func (c *proofCache) close() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.stores.Purge()
+}
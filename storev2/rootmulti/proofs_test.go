@@ -0,0 +1,99 @@
+package rootmulti
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/plume-protocol/plume-db/config"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// rewriteLogger signals every background snapshot rewrite of the commitment store that is ready to be switched to.
+type rewriteLogger struct {
+	log.Logger
+	rewritten chan struct{}
+}
+
+func (l rewriteLogger) Info(msg string, keyvals ...interface{}) {
+	if msg == "finished best-effort catchup" {
+		l.rewritten <- struct{}{}
+	}
+}
+
+func TestHistoricalProofs(t *testing.T) {
+	scConfig := config.DefaultStateCommitConfig()
+	scConfig.AsyncCommitBuffer = 0
+	scConfig.SnapshotInterval = 2
+	scConfig.SnapshotKeepRecent = 0
+	ssConfig := config.DefaultStateStoreConfig()
+	ssConfig.Enable = true
+	ssConfig.KeepRecent = 4
+	key := types.NewKVStoreKey("store")
+
+	for _, historical := range []bool{false, true} {
+		var opts []Option
+		if historical {
+			opts = append(opts, HistoricalProofs(2))
+		}
+		logger := rewriteLogger{Logger: log.NewNopLogger(), rewritten: make(chan struct{}, 1)}
+		store := NewStore(t.TempDir(), logger, scConfig, ssConfig, false, opts...)
+		store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+		require.NoError(t, store.LoadLatestVersion())
+		for i := 1; i <= 10; i++ {
+			store.GetKVStore(key).Set([]byte("key"), []byte(fmt.Sprintf("value%d", i)))
+			store.Commit(true)
+			if i%2 == 0 && i < 10 {
+				// the next commit switches to the rewritten snapshot and prunes the older ones
+				<-logger.rewritten
+			}
+		}
+		// the pruning after the last switch ends by truncating the changelog before the earliest snapshot kept
+		earliest := 8
+		if historical {
+			earliest = 2
+		}
+		segment := filepath.Join(store.scDir, "changelog", fmt.Sprintf("%020d", earliest+1))
+		require.Eventually(t, func() bool {
+			_, err := os.Stat(segment)
+			return err == nil
+		}, 5*time.Second, time.Millisecond)
+
+		res := store.Query(abci.RequestQuery{Path: "/store/key", Data: []byte("key"), Height: 3, Prove: true})
+		if !historical {
+			require.NotZero(t, res.Code, "the commitment store of height 3 should be pruned")
+			require.NoError(t, store.Close())
+			continue
+		}
+		require.Zero(t, res.Code, res.Log)
+		require.Equal(t, []byte("value3"), res.Value)
+		require.NotNil(t, res.ProofOps)
+		require.Len(t, res.ProofOps.Ops, 2)
+
+		// the rebuilt commitment store is cached for further queries at the same height
+		res = store.Query(abci.RequestQuery{Path: "/store/key", Data: []byte("key"), Height: 3, Prove: true})
+		require.Zero(t, res.Code, res.Log)
+		require.Equal(t, []byte("value3"), res.Value)
+		require.NoError(t, store.Close())
+	}
+}
+
+func TestHistoricalProofsRequireBoundedStateStore(t *testing.T) {
+	scConfig := config.DefaultStateCommitConfig()
+	scConfig.SnapshotInterval = 2
+	for _, ssConfig := range []config.StateStoreConfig{
+		{Enable: false, KeepRecent: 4},
+		{Enable: true, KeepRecent: 0},
+	} {
+		keepRecent := scConfig.SnapshotKeepRecent
+		store := &Store{}
+		HistoricalProofs(2)(&scConfig, ssConfig, store)
+		require.Nil(t, store.proofCache)
+		require.Equal(t, keepRecent, scConfig.SnapshotKeepRecent)
+	}
+}
@@ -1,9 +1,13 @@
 package rootmulti
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"math"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
@@ -23,9 +27,11 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	protoio "github.com/gogo/protobuf/io"
 	commonerrors "github.com/plume-protocol/plume-db/common/errors"
+	"github.com/plume-protocol/plume-db/common/utils"
 	"github.com/plume-protocol/plume-db/config"
 	"github.com/plume-protocol/plume-db/proto"
 	"github.com/plume-protocol/plume-db/sc"
+	"github.com/plume-protocol/plume-db/sc/memiavl"
 	sctypes "github.com/plume-protocol/plume-db/sc/types"
 	"github.com/plume-protocol/plume-db/ss"
 	"github.com/plume-protocol/plume-db/ss/pruning"
@@ -38,6 +44,8 @@ import (
 var (
 	_ types.CommitMultiStore = (*Store)(nil)
 	_ types.Queryable        = (*Store)(nil)
+
+	_ snapshottypes.ChunkRestorer = (*Store)(nil)
 )
 
 type Store struct {
@@ -51,6 +59,8 @@ type Store struct {
 	ckvStores      map[types.StoreKey]types.CommitKVStore
 	pendingChanges chan VersionedChangesets
 	pruningManager *pruning.Manager
+	proofCache     *proofCache // set if proofs for historical heights are enabled
+	scDir          string      // the directory of the commitment store
 }
 
 type VersionedChangesets struct {
@@ -64,16 +74,25 @@ func NewStore(
 	scConfig config.StateCommitConfig,
 	ssConfig config.StateStoreConfig,
 	migrateIavl bool,
+	opts ...Option,
 ) *Store {
-	scStore := sc.NewCommitStore(homeDir, logger, scConfig)
 	store := &Store{
 		logger:         logger,
-		scStore:        scStore,
 		storesParams:   make(map[types.StoreKey]storeParams),
 		storeKeys:      make(map[string]types.StoreKey),
 		ckvStores:      make(map[types.StoreKey]types.CommitKVStore),
 		pendingChanges: make(chan VersionedChangesets, 1000),
 	}
+	for _, opt := range opts {
+		opt(&scConfig, ssConfig, store)
+	}
+	scStore := sc.NewCommitStore(homeDir, logger, scConfig)
+	store.scStore = scStore
+	scDir := homeDir
+	if scConfig.Directory != "" {
+		scDir = scConfig.Directory
+	}
+	store.scDir = utils.GetCommitStorePath(scDir)
 	if ssConfig.Enable {
 		ssStore, err := ss.NewStateStore(logger, homeDir, ssConfig)
 		if err != nil {
@@ -180,6 +199,9 @@ func (rs *Store) flush() error {
 }
 
 func (rs *Store) Close() error {
+	if rs.proofCache != nil {
+		rs.proofCache.close()
+	}
 	err := rs.scStore.Close()
 	close(rs.pendingChanges)
 	if rs.ssStore != nil {
@@ -408,7 +430,7 @@ func (rs *Store) LoadVersionAndUpgrade(version int64, upgrades *types.StoreUpgra
 	}
 	rs.scStore.Initialize(initialStores)
 	if _, err := rs.scStore.LoadVersion(version, false); err != nil {
-		return nil
+		return err
 	}
 
 	storesKeysForDeletion := make(map[types.StoreKey]struct{})
@@ -556,12 +578,25 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
-	var store types.Queryable
-	var commitInfo *types.CommitInfo
-
 	if !req.Prove && rs.ssStore != nil {
 		// Serve abci query from ss store if no proofs needed
-		store = types.Queryable(state.NewStore(rs.ssStore, types.NewKVStoreKey(storeName), version))
+		store := state.NewStore(rs.ssStore, types.NewKVStoreKey(storeName), version)
+		req.Path = subPath
+		return store.Query(req)
+	}
+
+	var (
+		res        abci.ResponseQuery
+		commitInfo *types.CommitInfo
+	)
+	if rs.proofCache != nil {
+		// Serve abci query from the historical sc store rebuilt for the height
+		err = rs.proofCache.withVersion(rs.scStore, version, func(scStore sctypes.Committer) {
+			res, commitInfo = rs.queryCommitStore(scStore, storeName, subPath, req)
+		})
+		if err != nil {
+			return sdkerrors.QueryResult(err)
+		}
 	} else {
 		// Serve abci query from historical sc store if proofs needed
 		scStore, err := rs.scStore.LoadVersion(version, true)
@@ -569,18 +604,12 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 			return sdkerrors.QueryResult(err)
 		}
 		defer scStore.Close()
-		store = types.Queryable(commitment.NewStore(scStore.GetTreeByName(storeName), rs.logger))
-		commitInfo = convertCommitInfo(scStore.LastCommitInfo())
-		commitInfo = amendCommitInfo(commitInfo, rs.storesParams)
+		res, commitInfo = rs.queryCommitStore(scStore, storeName, subPath, req)
 	}
 
-	// trim the path and execute the query
-	req.Path = subPath
-	res := store.Query(req)
-
 	if !req.Prove || !rootmulti.RequireProof(subPath) {
 		return res
-	} else if commitInfo != nil {
+	} else if res.ProofOps != nil {
 		// Restore origin path and append proof op.
 		res.ProofOps.Ops = append(res.ProofOps.Ops, commitInfo.ProofOp(storeName))
 	}
@@ -590,6 +619,17 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	return res
 }
 
+// queryCommitStore queries a store of a commitment store, and returns the commit info for the proof.
+func (rs *Store) queryCommitStore(
+	scStore sctypes.Committer, storeName string, subPath string, req abci.RequestQuery,
+) (abci.ResponseQuery, *types.CommitInfo) {
+	store := commitment.NewStore(scStore.GetTreeByName(storeName), rs.logger)
+	commitInfo := convertCommitInfo(scStore.LastCommitInfo())
+	commitInfo = amendCommitInfo(commitInfo, rs.storesParams)
+	req.Path = subPath
+	return store.Query(req), commitInfo
+}
+
 // parsePath expects a format like /<storeName>[/<subpath>]
 // Must start with /, subpath may be empty
 // Returns error if it doesn't start with /
@@ -751,35 +791,10 @@ loop:
 			}
 			rs.logger.Info(fmt.Sprintf("Start restoring store: %s", storeKey))
 		case *snapshottypes.SnapshotItem_IAVL:
-			if item.IAVL.Height > math.MaxInt8 {
-				restoreErr = errors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
+			if err = restoreNode(scImporter.AddNode, ssImporter, storeKey, item.IAVL); err != nil {
+				restoreErr = err
 				break loop
 			}
-			node := &sctypes.SnapshotNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
-			}
-			scImporter.AddNode(node)
-
-			// Check if we should also import to SS store
-			if rs.ssStore != nil && node.Height == 0 && ssImporter != nil {
-				ssImporter <- sstypes.SnapshotNode{
-					StoreKey: storeKey,
-					Key:      node.Key,
-					Value:    node.Value,
-				}
-			}
 		default:
 			// unknown element, could be an extension
 			break loop
@@ -802,6 +817,254 @@ loop:
 	return snapshotItem, restoreErr
 }
 
+// restoreNode imports an IAVL node of a snapshot into the commitment tree of the store, and leaf nodes into the
+// state store as well if ssImporter is set.
+func restoreNode(
+	addNode func(*sctypes.SnapshotNode), ssImporter chan<- sstypes.SnapshotNode, storeKey string, item *snapshottypes.SnapshotIAVLItem,
+) error {
+	if item.Height > math.MaxInt8 {
+		return errors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v", item.Height, math.MaxInt8)
+	}
+	node := &sctypes.SnapshotNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	addNode(node)
+
+	// Check if we should also import to SS store
+	if node.Height == 0 && ssImporter != nil {
+		ssImporter <- sstypes.SnapshotNode{
+			StoreKey: storeKey,
+			Key:      node.Key,
+			Value:    node.Value,
+		}
+	}
+	return nil
+}
+
+// RestoreChunks implements snapshottypes.ChunkRestorer. The commitment trees are imported one store after another
+// into a restore directory next to the commitment store, while the state store imports of the stores run
+// concurrently. A store is checkpointed once both its tree and its state store import are done, and a resumed
+// restore imports the stores after the checkpoint into the same restore directory. The commitment store is only
+// replaced by the imported trees once all the chunks are restored.
+func (rs *Store) RestoreChunks(
+	ctx context.Context, height uint64, chunks <-chan snapshottypes.StoreChunk, checkpoint func(restored uint32) error,
+) error {
+	if err := rs.restoreChunks(ctx, int64(height), chunks, checkpoint); err != nil {
+		return err
+	}
+	return rs.LoadLatestVersion()
+}
+
+// storeImport is the import of a store whose commitment tree is done, but not checkpointed yet
+type storeImport struct {
+	done <-chan error // the result of the state store import
+	next uint32       // the index of the first chunk after the store
+}
+
+// restoreDir returns the directory the commitment trees of a restore at the height are imported into. It must not
+// look like a memiavl snapshot directory, since memiavl removes unfinished snapshots when the store is opened.
+func (rs *Store) restoreDir(height int64) string {
+	return filepath.Join(rs.scDir, fmt.Sprintf("restore-%020d", height))
+}
+
+func (rs *Store) restoreChunks(
+	ctx context.Context, height int64, chunks <-chan snapshottypes.StoreChunk, checkpoint func(restored uint32) error,
+) error {
+	var (
+		scImporter *memiavl.TreeImporter
+		ssImporter chan sstypes.SnapshotNode
+		ssDone     <-chan error
+		pending    []storeImport
+		storeKey   string
+		started    bool
+	)
+	restoreDir := rs.restoreDir(height)
+	// closeStore ends the imports of the current store, and checkpoints the stores whose imports are done.
+	// If wait is set, it waits for all the state store imports.
+	closeStore := func(next uint32, wait bool) error {
+		if scImporter != nil {
+			err := scImporter.Close()
+			scImporter = nil
+			if err != nil {
+				return err
+			}
+		}
+		if ssImporter != nil {
+			close(ssImporter)
+			ssImporter = nil
+		}
+		if storeKey != "" {
+			pending = append(pending, storeImport{done: ssDone, next: next})
+			storeKey = ""
+		}
+		for len(pending) > 0 {
+			if pending[0].done != nil {
+				var err error
+				if wait {
+					err = <-pending[0].done
+				} else {
+					select {
+					case err = <-pending[0].done:
+					default:
+						return nil
+					}
+				}
+				if err != nil {
+					return err
+				}
+			}
+			if err := checkpoint(pending[0].next); err != nil {
+				return err
+			}
+			pending = pending[1:]
+		}
+		return nil
+	}
+	// On failure the trees imported so far are left in the restore directory for a resume
+	defer func() {
+		if scImporter != nil {
+			_ = scImporter.Close()
+		}
+		if ssImporter != nil {
+			close(ssImporter)
+		}
+	}()
+
+loop:
+	for {
+		var chunk snapshottypes.StoreChunk
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case next, ok := <-chunks:
+			if !ok {
+				break loop
+			}
+			chunk = next
+		}
+		if !started {
+			// the trees of a restore that isn't resumed are stale
+			if chunk.Index == 0 {
+				if err := os.RemoveAll(restoreDir); err != nil {
+					return err
+				}
+			}
+			started = true
+		}
+		if len(chunk.Items) == 0 || chunk.Items[0].GetStore() == nil {
+			return errors.Wrapf(sdkerrors.ErrLogic, "chunk %v doesn't start with a store item", chunk.Index)
+		}
+		if name := chunk.Items[0].GetStore().Name; name != storeKey {
+			if err := closeStore(chunk.Index, false); err != nil {
+				return err
+			}
+			storeKey = name
+			scImporter = memiavl.NewTreeImporter(filepath.Join(restoreDir, name), height)
+			if rs.ssStore != nil {
+				ssImporter = make(chan sstypes.SnapshotNode, 10000)
+				done := make(chan error, 1)
+				go func(ch <-chan sstypes.SnapshotNode) {
+					done <- rs.ssStore.Import(height, ch)
+				}(ssImporter)
+				ssDone = done
+			}
+			rs.logger.Info(fmt.Sprintf("Start restoring store: %s", storeKey))
+		}
+		for _, item := range chunk.Items[1:] {
+			node := item.GetIAVL()
+			if node == nil {
+				return errors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot item %T in chunk %v", item.Item, chunk.Index)
+			}
+			if err := restoreNode(scImporter.Add, ssImporter, storeKey, node); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The last store is checkpointed as no chunks restored: the restore directory is moved into the commitment
+	// store below, so a resumed restore has to start over.
+	if err := closeStore(0, true); err != nil {
+		return err
+	}
+	if err := rs.finalizeRestore(height, restoreDir); err != nil {
+		return err
+	}
+	// initialize the earliest version for SS store
+	if rs.ssStore != nil {
+		rs.ssStore.SetEarliestVersion(height, false)
+	}
+	return nil
+}
+
+// finalizeRestore installs the trees of the restore directory as the snapshot of the commitment store at the height.
+// The trees are imported through the memiavl importer, which writes and activates the snapshot, and the restore
+// directory is removed afterwards.
+func (rs *Store) finalizeRestore(height int64, restoreDir string) error {
+	if rs.scStore != nil {
+		if err := rs.scStore.Close(); err != nil {
+			return fmt.Errorf("failed to close db: %w", err)
+		}
+	}
+	entries, err := os.ReadDir(restoreDir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	importer, err := memiavl.NewMultiTreeImporter(rs.scDir, uint64(height))
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if err := importer.AddTree(entry.Name()); err != nil {
+			return err
+		}
+		if err := importRestoredTree(importer, filepath.Join(restoreDir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	if err := importer.Close(); err != nil {
+		return err
+	}
+	return os.RemoveAll(restoreDir)
+}
+
+// importRestoredTree adds the nodes of the restored tree in the directory to the current tree of the importer.
+func importRestoredTree(importer *memiavl.MultiTreeImporter, dir string) error {
+	snapshot, err := memiavl.OpenSnapshot(dir)
+	if err != nil {
+		return err
+	}
+	defer snapshot.Close()
+	exporter := snapshot.Export()
+	defer exporter.Close()
+	for {
+		node, err := exporter.Next()
+		if err == commonerrors.ErrorExportDone {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		// the exported node points into the snapshot, which is closed while the importer may still hold the node
+		node.Key = bytes.Clone(node.Key)
+		node.Value = bytes.Clone(node.Value)
+		importer.AddNode(node)
+	}
+}
+
 // Snapshot Implements the interface from Snapshotter
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 	if height > math.MaxUint32 {
@@ -1,15 +1,102 @@
 package rootmulti
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/plume-protocol/plume-db/config"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func TestLastCommitID(t *testing.T) {
 	store := NewStore(t.TempDir(), log.NewNopLogger(), config.StateCommitConfig{}, config.StateStoreConfig{}, false)
 	require.Equal(t, types.CommitID{}, store.LastCommitID())
 }
+
+func TestRestoreChunksResume(t *testing.T) {
+	keys := []*types.KVStoreKey{types.NewKVStoreKey("a"), types.NewKVStoreKey("b"), types.NewKVStoreKey("c")}
+	scConfig := config.DefaultStateCommitConfig()
+	scConfig.AsyncCommitBuffer = 0
+	newStore := func(dir string) *Store {
+		store := NewStore(dir, log.NewNopLogger(), scConfig, config.StateStoreConfig{}, false)
+		for _, key := range keys {
+			store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+		}
+		require.NoError(t, store.LoadLatestVersion())
+		return store
+	}
+
+	source := newStore(t.TempDir())
+	for _, key := range keys {
+		for i := 0; i < 10; i++ {
+			source.GetKVStore(key).Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("%s%d", key.Name(), i)))
+		}
+	}
+	commitID := source.Commit(true)
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(snapshotStore, source, log.NewNopLogger())
+	require.NoError(t, manager.SetSnapshotFormat(snapshottypes.StoreChunksFormat))
+	snapshot, err := manager.Create(uint64(commitID.Version))
+	require.NoError(t, err)
+	require.NoError(t, source.Close())
+	// a chunk per store
+	require.EqualValues(t, len(keys), snapshot.Chunks)
+	var chunks [][]byte
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		chunks = append(chunks, chunk)
+	}
+
+	// the restore is interrupted by a corrupt chunk of the last store
+	dir := t.TempDir()
+	targetSnapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	target := newStore(dir)
+	targetManager := snapshots.NewManager(targetSnapshotStore, target, log.NewNopLogger())
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for _, chunk := range chunks[:len(chunks)-1] {
+		_, err = targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+	// the first store is checkpointed once the restore moves on to the second one
+	require.Eventually(t, func() bool {
+		progress, err := targetSnapshotStore.GetRestoreProgress(*snapshot)
+		return err == nil && progress == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	_, err = targetManager.RestoreChunk([]byte("corrupt"))
+	require.Error(t, err)
+	progress, err := targetSnapshotStore.GetRestoreProgress(*snapshot)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, progress)
+	require.NoError(t, target.Close())
+
+	// the node restarts and resumes the restore with the second store
+	target = newStore(dir)
+	targetManager = snapshots.NewManager(targetSnapshotStore, target, log.NewNopLogger())
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i, chunk := range chunks {
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == len(chunks)-1, done)
+	}
+	progress, err = targetSnapshotStore.GetRestoreProgress(*snapshot)
+	require.NoError(t, err)
+	require.Zero(t, progress)
+
+	require.NoDirExists(t, target.restoreDir(commitID.Version))
+	require.Equal(t, commitID, target.LastCommitID())
+	for _, key := range keys {
+		for i := 0; i < 10; i++ {
+			require.Equal(t, []byte(fmt.Sprintf("%s%d", key.Name(), i)), target.GetKVStore(key).Get([]byte(fmt.Sprintf("key%d", i))))
+		}
+	}
+	require.NoError(t, target.Close())
+}
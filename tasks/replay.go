@@ -0,0 +1,245 @@
+package tasks
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Writesets holds the writes made to a multistore by store name and key, a nil value is a delete
+type Writesets map[string]map[string][]byte
+
+// recordingStore records the writes made to a store of a branched multistore
+type recordingStore struct {
+	store.CacheKVStore
+	mtx    *sync.Mutex
+	writes map[string][]byte
+}
+
+func (r *recordingStore) Set(key []byte, value []byte) {
+	r.CacheKVStore.Set(key, value)
+	r.record(key, value)
+}
+
+func (r *recordingStore) Delete(key []byte) {
+	r.CacheKVStore.Delete(key)
+	r.record(key, nil)
+}
+
+func (r *recordingStore) record(key []byte, value []byte) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.writes[string(key)] = value
+}
+
+// CacheWrap wraps the recording store itself so that writes of nested branches are recorded
+func (r *recordingStore) CacheWrap(storeKey store.StoreKey) store.CacheWrap {
+	return cachekv.NewStore(r, storeKey, store.DefaultCacheSizeLimit)
+}
+
+func (r *recordingStore) CacheWrapWithTrace(storeKey store.StoreKey, _ io.Writer, _ store.TraceContext) store.CacheWrap {
+	return r.CacheWrap(storeKey)
+}
+
+func (r *recordingStore) CacheWrapWithListeners(storeKey store.StoreKey, _ []store.WriteListener) store.CacheWrap {
+	return r.CacheWrap(storeKey)
+}
+
+// BranchWithWriteRecorder branches the multistore and records every write made to the branch
+func BranchWithWriteRecorder(ms sdk.MultiStore) (sdk.CacheMultiStore, Writesets) {
+	branch := ms.CacheMultiStore()
+	writesets := make(Writesets)
+	mtx := &sync.Mutex{}
+	branch.SetKVStores(func(sk store.StoreKey, kvs sdk.KVStore) store.CacheWrap {
+		writes := make(map[string][]byte)
+		writesets[sk.Name()] = writes
+		return &recordingStore{
+			CacheKVStore: kvs.(store.CacheKVStore),
+			mtx:          mtx,
+			writes:       writes,
+		}
+	})
+	return branch, writesets
+}
+
+// TxDivergence describes a tx whose response differs between the OCC and the sequential execution
+type TxDivergence struct {
+	AbsoluteIndex int
+	// Fields are the names of the response fields that differ
+	Fields []string
+}
+
+// KeyDivergence describes a key whose final write differs between the OCC and the sequential execution
+type KeyDivergence struct {
+	Store string
+	Key   []byte
+	// OccValue and SequentialValue are nil if the key was deleted or not written
+	OccValue          []byte
+	OccWritten        bool
+	SequentialValue   []byte
+	SequentialWritten bool
+}
+
+// Divergence lists the differences between the OCC and the sequential execution of a batch
+type Divergence struct {
+	Txs  []TxDivergence
+	Keys []KeyDivergence
+}
+
+// Empty returns true if both executions produced the same results
+func (d Divergence) Empty() bool {
+	return len(d.Txs) == 0 && len(d.Keys) == 0
+}
+
+// TxIndexes returns the absolute indexes of the txs whose responses differ
+func (d Divergence) TxIndexes() []int {
+	res := make([]int, 0, len(d.Txs))
+	for _, tx := range d.Txs {
+		res = append(res, tx.AbsoluteIndex)
+	}
+	return res
+}
+
+func (d Divergence) String() string {
+	var sb strings.Builder
+	for _, tx := range d.Txs {
+		sb.WriteString(fmt.Sprintf("tx %d: %s differ\n", tx.AbsoluteIndex, strings.Join(tx.Fields, ", ")))
+	}
+	for _, key := range d.Keys {
+		sb.WriteString(fmt.Sprintf("store %s key %X: occ %s, sequential %s\n",
+			key.Store, key.Key, formatWrite(key.OccValue, key.OccWritten), formatWrite(key.SequentialValue, key.SequentialWritten)))
+	}
+	return sb.String()
+}
+
+func formatWrite(value []byte, written bool) string {
+	switch {
+	case !written:
+		return "not written"
+	case value == nil:
+		return "deleted"
+	default:
+		return fmt.Sprintf("%X", value)
+	}
+}
+
+// CompareExecutions compares the responses and writesets of the OCC and the sequential execution of a batch
+func CompareExecutions(reqs []*sdk.DeliverTxEntry, occRes, seqRes []types.ResponseDeliverTx, occWrites, seqWrites Writesets) Divergence {
+	var d Divergence
+	for i, req := range reqs {
+		var fields []string
+		switch {
+		case i >= len(occRes):
+			fields = []string{"occ response missing"}
+		case i >= len(seqRes):
+			fields = []string{"sequential response missing"}
+		default:
+			fields = diffResponses(occRes[i], seqRes[i])
+		}
+		if len(fields) > 0 {
+			d.Txs = append(d.Txs, TxDivergence{AbsoluteIndex: req.AbsoluteIndex, Fields: fields})
+		}
+	}
+
+	storeNames := make(map[string]struct{})
+	for name := range occWrites {
+		storeNames[name] = struct{}{}
+	}
+	for name := range seqWrites {
+		storeNames[name] = struct{}{}
+	}
+	for name := range storeNames {
+		d.Keys = append(d.Keys, diffWrites(name, occWrites[name], seqWrites[name])...)
+	}
+	sort.Slice(d.Keys, func(i, j int) bool {
+		if d.Keys[i].Store != d.Keys[j].Store {
+			return d.Keys[i].Store < d.Keys[j].Store
+		}
+		return bytes.Compare(d.Keys[i].Key, d.Keys[j].Key) < 0
+	})
+	return d
+}
+
+func diffResponses(occ, seq types.ResponseDeliverTx) []string {
+	var fields []string
+	if occ.Code != seq.Code {
+		fields = append(fields, "code")
+	}
+	if occ.Codespace != seq.Codespace {
+		fields = append(fields, "codespace")
+	}
+	if !bytes.Equal(occ.Data, seq.Data) {
+		fields = append(fields, "data")
+	}
+	if occ.Log != seq.Log {
+		fields = append(fields, "log")
+	}
+	if occ.Info != seq.Info {
+		fields = append(fields, "info")
+	}
+	if occ.GasWanted != seq.GasWanted {
+		fields = append(fields, "gas_wanted")
+	}
+	if occ.GasUsed != seq.GasUsed {
+		fields = append(fields, "gas_used")
+	}
+	if !eventsEqual(occ.Events, seq.Events) {
+		fields = append(fields, "events")
+	}
+	return fields
+}
+
+func eventsEqual(a, b []types.Event) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Type != b[i].Type || len(a[i].Attributes) != len(b[i].Attributes) {
+			return false
+		}
+		for j, attr := range a[i].Attributes {
+			other := b[i].Attributes[j]
+			if !bytes.Equal(attr.Key, other.Key) || !bytes.Equal(attr.Value, other.Value) || attr.Index != other.Index {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func diffWrites(storeName string, occ, seq map[string][]byte) []KeyDivergence {
+	var res []KeyDivergence
+	for key, occValue := range occ {
+		seqValue, ok := seq[key]
+		if !ok || !bytes.Equal(occValue, seqValue) || (occValue == nil) != (seqValue == nil) {
+			res = append(res, KeyDivergence{
+				Store:             storeName,
+				Key:               []byte(key),
+				OccValue:          occValue,
+				OccWritten:        true,
+				SequentialValue:   seqValue,
+				SequentialWritten: ok,
+			})
+		}
+	}
+	for key, seqValue := range seq {
+		if _, ok := occ[key]; !ok {
+			res = append(res, KeyDivergence{
+				Store:             storeName,
+				Key:               []byte(key),
+				SequentialValue:   seqValue,
+				SequentialWritten: true,
+			})
+		}
+	}
+	return res
+}
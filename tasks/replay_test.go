@@ -0,0 +1,81 @@
+package tasks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/abci/types"
+	"go.opentelemetry.io/otel/trace"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/utils/tracing"
+)
+
+// replay executes the requests with the scheduler and sequentially, and compares both executions
+func replay(t *testing.T, ctx sdk.Context, reqs []*sdk.DeliverTxEntry, occDeliverTx, seqDeliverTx mockDeliverTxFunc) Divergence {
+	occStore, occWrites := BranchWithWriteRecorder(ctx.MultiStore())
+	tr := trace.NewNoopTracerProvider().Tracer("replay-test")
+	s := NewScheduler(10, &tracing.Info{Tracer: &tr}, occDeliverTx)
+	occRes, err := s.ProcessAll(ctx.WithMultiStore(occStore), reqs)
+	require.NoError(t, err)
+
+	seqStore, seqWrites := BranchWithWriteRecorder(ctx.MultiStore())
+	seqCtx := ctx.WithMultiStore(seqStore)
+	var seqRes []types.ResponseDeliverTx
+	for _, req := range reqs {
+		seqRes = append(seqRes, seqDeliverTx(seqCtx.WithTxIndex(req.AbsoluteIndex), req.Request, req.SdkTx, req.Checksum))
+	}
+	return CompareExecutions(reqs, occRes, seqRes, occWrites, seqWrites)
+}
+
+func TestReplayDeterministic(t *testing.T) {
+	deliverTx := func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+		defer abortRecoveryFunc(&res)
+		kv := ctx.MultiStore().GetKVStore(testStoreKey)
+		val := string(kv.Get(itemKey)) + fmt.Sprintf("%d,", ctx.TxIndex())
+		kv.Set(itemKey, []byte(val))
+		kv.Set(req.Tx, req.Tx)
+		if ctx.TxIndex()%5 == 0 {
+			kv.Delete(req.Tx)
+		}
+		return types.ResponseDeliverTx{
+			Info:   val,
+			Events: []types.Event{{Type: "test", Attributes: []types.EventAttribute{{Key: []byte("val"), Value: []byte(val)}}}},
+		}
+	}
+
+	ctx := initTestCtx(true)
+	divergence := replay(t, ctx, requestList(50), deliverTx, deliverTx)
+	require.True(t, divergence.Empty(), divergence.String())
+
+	// the branches are not written to the parent
+	require.Nil(t, ctx.MultiStore().GetKVStore(testStoreKey).Get(itemKey))
+}
+
+func TestReplayDivergence(t *testing.T) {
+	deliverTx := func(divergent bool) mockDeliverTxFunc {
+		return func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+			defer abortRecoveryFunc(&res)
+			kv := ctx.MultiStore().GetKVStore(testStoreKey)
+			kv.Set(req.Tx, req.Tx)
+			if divergent && ctx.TxIndex() == 3 {
+				kv.Set(itemKey, []byte("occ"))
+				return types.ResponseDeliverTx{Code: 1}
+			}
+			return types.ResponseDeliverTx{}
+		}
+	}
+
+	divergence := replay(t, initTestCtx(true), requestList(10), deliverTx(true), deliverTx(false))
+	require.False(t, divergence.Empty())
+	require.Equal(t, []int{3}, divergence.TxIndexes())
+	require.Equal(t, []string{"code"}, divergence.Txs[0].Fields)
+	require.Equal(t, []KeyDivergence{{
+		Store:      testStoreKey.Name(),
+		Key:        itemKey,
+		OccValue:   []byte("occ"),
+		OccWritten: true,
+	}}, divergence.Keys)
+	require.Contains(t, divergence.String(), "sequential not written")
+}
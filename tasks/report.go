@@ -0,0 +1,107 @@
+package tasks
+
+import (
+	"sync"
+	"time"
+)
+
+// Conflict describes why an execution of a task was discarded
+type Conflict struct {
+	// Store is the name of the store the conflict occurred in, empty if unknown
+	Store string
+	// Key is the conflicting key, nil if the conflict can't be attributed to a key (e.g. an iterated range changed)
+	Key []byte
+	// DependentTxIdx is the index of the tx that caused the conflict, -1 if unknown
+	DependentTxIdx int
+	// Abort is true if the execution aborted reading an estimate, false if it failed validation
+	Abort bool
+}
+
+// TxReport describes the executions of a tx that had to be executed more than once
+type TxReport struct {
+	AbsoluteIndex int
+	TxHash        string
+	Incarnation   int
+	Conflicts     []Conflict
+}
+
+// BlockReport describes how a batch of txs was executed by the scheduler
+type BlockReport struct {
+	Height               int64
+	Txs                  int
+	Latency              time.Duration
+	Iterations           int
+	Retries              int
+	MaxIncarnation       int
+	Synchronous          bool
+	ParallelExecutions   int
+	SequentialExecutions int
+	// TxReports only contains the txs that were executed more than once
+	TxReports []TxReport
+}
+
+// ReportBuffer keeps the execution reports of the most recently executed batches in a ring buffer
+type ReportBuffer struct {
+	mtx     sync.RWMutex
+	reports []*BlockReport
+	next    int
+	full    bool
+}
+
+// NewReportBuffer creates a ReportBuffer which keeps up to size reports, a size < 1 disables it
+func NewReportBuffer(size int) *ReportBuffer {
+	if size < 0 {
+		size = 0
+	}
+	return &ReportBuffer{
+		reports: make([]*BlockReport, size),
+	}
+}
+
+// Add stores a report, evicting the oldest one if the buffer is full
+func (b *ReportBuffer) Add(report *BlockReport) {
+	if b == nil || len(b.reports) == 0 || report == nil {
+		return
+	}
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.reports[b.next] = report
+	b.next = (b.next + 1) % len(b.reports)
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+// All returns the reports in the buffer ordered from the oldest to the latest
+func (b *ReportBuffer) All() []*BlockReport {
+	if b == nil {
+		return nil
+	}
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+	var res []*BlockReport
+	if b.full {
+		res = append(res, b.reports[b.next:]...)
+	}
+	return append(res, b.reports[:b.next]...)
+}
+
+// GetByHeight returns the reports of all batches executed at the height
+func (b *ReportBuffer) GetByHeight(height int64) []*BlockReport {
+	var res []*BlockReport
+	for _, report := range b.All() {
+		if report.Height == height {
+			res = append(res, report)
+		}
+	}
+	return res
+}
+
+// Latest returns the reports of all batches executed at the latest height in the buffer
+func (b *ReportBuffer) Latest() []*BlockReport {
+	all := b.All()
+	if len(all) == 0 {
+		return nil
+	}
+	return b.GetByHeight(all[len(all)-1].Height)
+}
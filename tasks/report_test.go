@@ -0,0 +1,41 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportBuffer(t *testing.T) {
+	buffer := NewReportBuffer(3)
+	require.Empty(t, buffer.All())
+	require.Empty(t, buffer.Latest())
+
+	for height := int64(1); height <= 4; height++ {
+		buffer.Add(&BlockReport{Height: height})
+	}
+	// a second batch at the latest height
+	buffer.Add(&BlockReport{Height: 4, Txs: 1})
+
+	all := buffer.All()
+	require.Len(t, all, 3)
+	require.Equal(t, int64(3), all[0].Height)
+	require.Equal(t, int64(4), all[1].Height)
+	require.Equal(t, int64(4), all[2].Height)
+
+	require.Empty(t, buffer.GetByHeight(1))
+	require.Len(t, buffer.GetByHeight(3), 1)
+	latest := buffer.Latest()
+	require.Len(t, latest, 2)
+	require.Equal(t, 1, latest[1].Txs)
+}
+
+func TestReportBufferDisabled(t *testing.T) {
+	buffer := NewReportBuffer(0)
+	buffer.Add(&BlockReport{Height: 1})
+	require.Empty(t, buffer.All())
+
+	var nilBuffer *ReportBuffer
+	nilBuffer.Add(&BlockReport{Height: 1})
+	require.Empty(t, nilBuffer.Latest())
+}
@@ -36,17 +36,25 @@ const (
 	statusValidated status = "validated"
 	// statusWaiting tasks are waiting for another tx to complete
 	statusWaiting status = "waiting"
-	// maximumIterations before we revert to sequential (for high conflict rates)
+	// maximumIterations before we run conflicting clusters of tasks sequentially (for high conflict rates)
 	maximumIterations = 10
+	// maximumClusteredIterations is the number of clustered iterations before we revert to fully sequential
+	maximumClusteredIterations = 3
 )
 
 type deliverTxTask struct {
 	Ctx     sdk.Context
 	AbortCh chan occ.Abort
 
-	mx            sync.RWMutex
-	Status        status
-	Dependencies  map[int]struct{}
+	mx           sync.RWMutex
+	Status       status
+	Dependencies map[int]struct{}
+	// UnknownConflict is set when the last failed validation could not name the conflicting txs
+	UnknownConflict bool
+	// Prefilled is set while the task waits on its estimated dependencies without having been executed
+	Prefilled bool
+	// Conflicts records why previous incarnations were discarded
+	Conflicts     []Conflict
 	Abort         *occ.Abort
 	Incarnation   int
 	Request       types.RequestDeliverTx
@@ -67,6 +75,13 @@ func (dt *deliverTxTask) AppendDependencies(deps []int) {
 	}
 }
 
+// AppendConflicts records why the current incarnation was discarded
+func (dt *deliverTxTask) AppendConflicts(conflicts ...Conflict) {
+	dt.mx.Lock()
+	defer dt.mx.Unlock()
+	dt.Conflicts = append(dt.Conflicts, conflicts...)
+}
+
 func (dt *deliverTxTask) IsStatus(s status) bool {
 	dt.mx.RLock()
 	defer dt.mx.RUnlock()
@@ -91,13 +106,25 @@ func (dt *deliverTxTask) Reset() {
 	}
 }
 
-func (dt *deliverTxTask) Increment() {
+// Increment moves the task to its next incarnation and returns true, unless the task was prefilled and is
+// about to run its first incarnation.
+func (dt *deliverTxTask) Increment() bool {
+	if dt.Prefilled {
+		dt.Prefilled = false
+		return false
+	}
 	dt.Incarnation++
+	return true
 }
 
 // Scheduler processes tasks concurrently
 type Scheduler interface {
 	ProcessAll(ctx sdk.Context, reqs []*sdk.DeliverTxEntry) ([]types.ResponseDeliverTx, error)
+	// Report returns the execution report of the last ProcessAll, nil if it didn't complete
+	Report() *BlockReport
+	// Writesets returns the final writesets of the txs of the last ProcessAll in tx order, nil if it didn't
+	// complete or the scheduler wasn't created WithWritesets
+	Writesets() []TxWriteset
 }
 
 type scheduler struct {
@@ -112,16 +139,23 @@ type scheduler struct {
 	metrics            *schedulerMetrics
 	synchronous        bool // true if maxIncarnation exceeds threshold
 	maxIncarnation     int  // current highest incarnation
+	report             *BlockReport
+	recordWritesets    bool
+	writesets          []TxWriteset
 }
 
 // NewScheduler creates a new scheduler
-func NewScheduler(workers int, tracingInfo *tracing.Info, deliverTxFunc func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx)) Scheduler {
-	return &scheduler{
+func NewScheduler(workers int, tracingInfo *tracing.Info, deliverTxFunc func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx), opts ...SchedulerOption) Scheduler {
+	s := &scheduler{
 		workers:     workers,
 		deliverTx:   deliverTxFunc,
 		tracingInfo: tracingInfo,
 		metrics:     &schedulerMetrics{},
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *scheduler) invalidateTask(task *deliverTxTask) {
@@ -167,7 +201,7 @@ func (s *scheduler) findConflicts(task *deliverTxTask) (bool, []int) {
 	var conflicts []int
 	uniq := make(map[int]struct{})
 	valid := true
-	for _, mv := range s.multiVersionStores {
+	for storeKey, mv := range s.multiVersionStores {
 		ok, mvConflicts := mv.ValidateTransactionState(task.AbsoluteIndex)
 		for _, c := range mvConflicts {
 			if _, ok := uniq[c]; !ok {
@@ -175,6 +209,9 @@ func (s *scheduler) findConflicts(task *deliverTxTask) (bool, []int) {
 				uniq[c] = struct{}{}
 			}
 		}
+		if !ok {
+			task.AppendConflicts(readsetConflicts(storeKey, mv, task.AbsoluteIndex)...)
+		}
 		// any non-ok value makes valid false
 		valid = valid && ok
 	}
@@ -182,6 +219,46 @@ func (s *scheduler) findConflicts(task *deliverTxTask) (bool, []int) {
 	return valid, conflicts
 }
 
+// readsetConflicts returns the conflicts of a task that failed validation of its readset or iterateset in the store
+func readsetConflicts(storeKey sdk.StoreKey, mv multiversion.MultiVersionStore, index int) []Conflict {
+	var conflicts []Conflict
+	for _, c := range mv.GetReadsetConflicts(index) {
+		if c.Estimate {
+			continue
+		}
+		conflicts = append(conflicts, Conflict{
+			Store:          storeKey.Name(),
+			Key:            []byte(c.Key),
+			DependentTxIdx: c.Index,
+		})
+	}
+	for _, c := range mv.GetIteratesetConflicts(index) {
+		conflicts = append(conflicts, Conflict{
+			Store:          storeKey.Name(),
+			Key:            []byte(c.Key),
+			DependentTxIdx: c.Index,
+		})
+	}
+	return conflicts
+}
+
+// abortConflict returns the conflict for an aborted execution of a task
+func (s *scheduler) abortConflict(task *deliverTxTask, abort occ.Abort) Conflict {
+	conflict := Conflict{
+		Key:            abort.Key,
+		DependentTxIdx: abort.DependentTxIdx,
+		Abort:          true,
+	}
+	// the abort doesn't carry the store, so look for the estimate that caused it
+	for storeKey, mv := range s.multiVersionStores {
+		if v := mv.GetLatestBeforeIndex(task.AbsoluteIndex, abort.Key); v != nil && v.IsEstimate() && v.Index() == abort.DependentTxIdx {
+			conflict.Store = storeKey.Name()
+			break
+		}
+	}
+	return conflict
+}
+
 func toTasks(reqs []*sdk.DeliverTxEntry) ([]*deliverTxTask, map[int]*deliverTxTask) {
 	tasksMap := make(map[int]*deliverTxTask)
 	allTasks := make([]*deliverTxTask, 0, len(reqs))
@@ -202,6 +279,47 @@ func toTasks(reqs []*sdk.DeliverTxEntry) ([]*deliverTxTask, map[int]*deliverTxTa
 	return allTasks, tasksMap
 }
 
+// SetEstimatedDependencies sets the estimated dependencies of the txs from dependencies between their positions in
+// reqs, such as the ones of the access-control dependency dag of the txs. The positions are mapped to the absolute
+// indexes of the txs, since the batch may not start at the first tx of the block. Txs whose estimated dependencies
+// are set already are left as they are.
+func SetEstimatedDependencies(reqs []*sdk.DeliverTxEntry, txDependencies map[int][]int) {
+	for pos, depPositions := range txDependencies {
+		if pos < 0 || pos >= len(reqs) || reqs[pos].EstimatedDependencies != nil {
+			continue
+		}
+		deps := make([]int, 0, len(depPositions))
+		for _, depPos := range depPositions {
+			if depPos >= 0 && depPos < len(reqs) {
+				deps = append(deps, reqs[depPos].AbsoluteIndex)
+			}
+		}
+		reqs[pos].EstimatedDependencies = deps
+	}
+}
+
+// prefillDependencies seeds the dependencies of tasks from their estimated dependencies.
+// Tasks with known dependencies start out waiting, so they are only executed once
+// the txs they are expected to conflict with have validated.
+func prefillDependencies(reqs []*sdk.DeliverTxEntry, tasksMap map[int]*deliverTxTask) {
+	for _, req := range reqs {
+		var deps []int
+		for _, dep := range req.EstimatedDependencies {
+			// only lower indexes in this batch can be waited on
+			if _, ok := tasksMap[dep]; ok && dep < req.AbsoluteIndex {
+				deps = append(deps, dep)
+			}
+		}
+		if len(deps) == 0 {
+			continue
+		}
+		task := tasksMap[req.AbsoluteIndex]
+		task.AppendDependencies(deps)
+		task.SetStatus(statusWaiting)
+		task.Prefilled = true
+	}
+}
+
 func (s *scheduler) collectResponses(tasks []*deliverTxTask) []types.ResponseDeliverTx {
 	res := make([]types.ResponseDeliverTx, 0, len(tasks))
 	for _, t := range tasks {
@@ -274,11 +392,17 @@ type schedulerMetrics struct {
 	maxIncarnation int
 	// retries is the number of tx attempts beyond the first attempt
 	retries int
+	// parallelExecutions is the number of tx executions that ran concurrently with other txs
+	parallelExecutions int
+	// sequentialExecutions is the number of tx executions that ran sequentially due to conflicts
+	sequentialExecutions int
 }
 
 func (s *scheduler) emitMetrics() {
 	telemetry.IncrCounter(float32(s.metrics.retries), "scheduler", "retries")
 	telemetry.IncrCounter(float32(s.metrics.maxIncarnation), "scheduler", "incarnations")
+	telemetry.IncrCounter(float32(s.metrics.parallelExecutions), "scheduler", "executions", "parallel")
+	telemetry.IncrCounter(float32(s.metrics.sequentialExecutions), "scheduler", "executions", "sequential")
 }
 
 func (s *scheduler) ProcessAll(ctx sdk.Context, reqs []*sdk.DeliverTxEntry) ([]types.ResponseDeliverTx, error) {
@@ -290,6 +414,7 @@ func (s *scheduler) ProcessAll(ctx sdk.Context, reqs []*sdk.DeliverTxEntry) ([]t
 	// This "optimization" path is being disabled because we don't have a strong reason to have it given that it
 	// s.PrefillEstimates(reqs)
 	tasks, tasksMap := toTasks(reqs)
+	prefillDependencies(reqs, tasksMap)
 	s.allTasks = tasks
 	s.allTasksMap = tasksMap
 	s.executeCh = make(chan func(), len(tasks))
@@ -311,10 +436,13 @@ func (s *scheduler) ProcessAll(ctx sdk.Context, reqs []*sdk.DeliverTxEntry) ([]t
 	// validation tasks uses length of tasks to avoid blocking on validation
 	start(workerCtx, s.validateCh, len(tasks))
 
-	toExecute := tasks
+	// tasks waiting on estimated dependencies are picked up by validation once those have validated
+	toExecute := filterTasks(tasks, func(t *deliverTxTask) bool {
+		return t.IsStatus(statusPending)
+	})
 	for !allValidated(tasks) {
-		// if the max incarnation >= x, we should revert to synchronous
-		if iterations >= maximumIterations {
+		// if clustering did not resolve the conflicts, we should revert to synchronous
+		if iterations >= maximumIterations+maximumClusteredIterations {
 			// process synchronously
 			s.synchronous = true
 			startIdx, anyLeft := s.findFirstNonValidated()
@@ -325,32 +453,77 @@ func (s *scheduler) ProcessAll(ctx sdk.Context, reqs []*sdk.DeliverTxEntry) ([]t
 		}
 
 		// execute sets statuses of tasks to either executed or aborted
-		if err := s.executeAll(ctx, toExecute); err != nil {
+		var err error
+		if !s.synchronous && iterations >= maximumIterations {
+			// only the conflicting clusters run sequentially, unrelated tasks stay parallel
+			err = s.executeClusters(ctx, s.findConflictClusters(tasks))
+		} else {
+			err = s.executeAll(ctx, toExecute)
+		}
+		if err != nil {
 			return nil, err
 		}
 
 		// validate returns any that should be re-executed
 		// note this processes ALL tasks, not just those recently executed
-		var err error
 		toExecute, err = s.validateAll(ctx, tasks)
 		if err != nil {
 			return nil, err
 		}
-		// these are retries which apply to metrics
-		s.metrics.retries += len(toExecute)
 		iterations++
 	}
 
+	if s.recordWritesets {
+		s.writesets = s.collectWritesets(tasks)
+	}
 	for _, mv := range s.multiVersionStores {
 		mv.WriteLatestToStore()
 	}
 	s.metrics.maxIncarnation = s.maxIncarnation
 
-	ctx.Logger().Info("occ scheduler", "height", ctx.BlockHeight(), "txs", len(tasks), "latency_ms", time.Since(startTime).Milliseconds(), "retries", s.metrics.retries, "maxIncarnation", s.maxIncarnation, "iterations", iterations, "sync", s.synchronous, "workers", s.workers)
+	s.report = s.buildReport(ctx, tasks, iterations, time.Since(startTime))
+
+	ctx.Logger().Info("occ scheduler", "height", ctx.BlockHeight(), "txs", len(tasks), "latency_ms", s.report.Latency.Milliseconds(), "retries", s.metrics.retries, "maxIncarnation", s.maxIncarnation, "iterations", iterations, "sync", s.synchronous, "parallel", s.metrics.parallelExecutions, "sequential", s.metrics.sequentialExecutions, "workers", s.workers)
 
 	return s.collectResponses(tasks), nil
 }
 
+// Report implements Scheduler.Report
+func (s *scheduler) Report() *BlockReport {
+	return s.report
+}
+
+// Writesets implements Scheduler.Writesets
+func (s *scheduler) Writesets() []TxWriteset {
+	return s.writesets
+}
+
+func (s *scheduler) buildReport(ctx sdk.Context, tasks []*deliverTxTask, iterations int, latency time.Duration) *BlockReport {
+	report := &BlockReport{
+		Height:               ctx.BlockHeight(),
+		Txs:                  len(tasks),
+		Latency:              latency,
+		Iterations:           iterations,
+		Retries:              s.metrics.retries,
+		MaxIncarnation:       s.maxIncarnation,
+		Synchronous:          s.synchronous,
+		ParallelExecutions:   s.metrics.parallelExecutions,
+		SequentialExecutions: s.metrics.sequentialExecutions,
+	}
+	for _, t := range tasks {
+		if t.Incarnation == 0 {
+			continue
+		}
+		report.TxReports = append(report.TxReports, TxReport{
+			AbsoluteIndex: t.AbsoluteIndex,
+			TxHash:        fmt.Sprintf("%X", sha256.Sum256(t.Request.Tx)),
+			Incarnation:   t.Incarnation,
+			Conflicts:     t.Conflicts,
+		})
+	}
+	return report
+}
+
 func (s *scheduler) shouldRerun(task *deliverTxTask) bool {
 	switch task.Status {
 
@@ -365,6 +538,7 @@ func (s *scheduler) shouldRerun(task *deliverTxTask) bool {
 		if valid, conflicts := s.findConflicts(task); !valid {
 			s.invalidateTask(task)
 			task.AppendDependencies(conflicts)
+			task.UnknownConflict = len(conflicts) == 0
 
 			// if the conflicts are now validated, then rerun this task
 			if dependenciesValidated(s.allTasksMap, task.Dependencies) {
@@ -431,7 +605,10 @@ func (s *scheduler) validateAll(ctx sdk.Context, tasks []*deliverTxTask) ([]*del
 				mx.Lock()
 				defer mx.Unlock()
 				t.Reset()
-				t.Increment()
+				// prefilled tasks run their first incarnation, which is no retry
+				if t.Increment() {
+					s.metrics.retries++
+				}
 				// update max incarnation for scheduler
 				if t.Incarnation > s.maxIncarnation {
 					s.maxIncarnation = t.Incarnation
@@ -454,6 +631,12 @@ func (s *scheduler) executeAll(ctx sdk.Context, tasks []*deliverTxTask) error {
 	span.SetAttributes(attribute.Bool("synchronous", s.synchronous))
 	defer span.End()
 
+	if s.synchronous {
+		s.metrics.sequentialExecutions += len(tasks)
+	} else {
+		s.metrics.parallelExecutions += len(tasks)
+	}
+
 	// validationWg waits for all validations to complete
 	// validations happen in separate goroutines in order to wait on previous index
 	wg := &sync.WaitGroup{}
@@ -471,6 +654,123 @@ func (s *scheduler) executeAll(ctx sdk.Context, tasks []*deliverTxTask) error {
 	return nil
 }
 
+// executeClusters executes each cluster of conflicting tasks sequentially in index order,
+// while separate clusters are executed concurrently
+func (s *scheduler) executeClusters(ctx sdk.Context, clusters [][]*deliverTxTask) error {
+	if len(clusters) == 0 {
+		return nil
+	}
+	ctx, span := s.traceSpan(ctx, "SchedulerExecuteClusters", nil)
+	span.SetAttributes(attribute.Int("clusters", len(clusters)))
+	defer span.End()
+
+	wg := &sync.WaitGroup{}
+	for _, cluster := range clusters {
+		if len(cluster) > 1 {
+			s.metrics.sequentialExecutions += len(cluster)
+		} else {
+			s.metrics.parallelExecutions += len(cluster)
+		}
+		wg.Add(len(cluster))
+	}
+
+	for _, cluster := range clusters {
+		c := cluster
+		s.DoExecute(func() {
+			for _, t := range c {
+				// waiting tasks run right after their dependencies in the same cluster,
+				// so they have not been reset and incremented by validation yet
+				if t.IsStatus(statusWaiting) {
+					t.Reset()
+					t.Increment()
+				}
+				s.prepareAndRunTask(wg, ctx, t)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// findConflictClusters groups the tasks that still need to execute into clusters of
+// transitively dependent tasks based on their dependencies. Each cluster is ordered by
+// index, and clusters are ordered by their lowest index.
+func (s *scheduler) findConflictClusters(tasks []*deliverTxTask) [][]*deliverTxTask {
+	pending := filterTasks(tasks, func(t *deliverTxTask) bool {
+		return t.IsStatus(statusPending) || t.IsStatus(statusWaiting)
+	})
+
+	parent := make(map[int]int, len(pending))
+	for _, t := range pending {
+		parent[t.AbsoluteIndex] = t.AbsoluteIndex
+	}
+	find := func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+		return i
+	}
+	union := func(i, j int) {
+		a, b := find(i), find(j)
+		// keep the lowest index as the root
+		if b < a {
+			a, b = b, a
+		}
+		parent[b] = a
+	}
+	for _, t := range pending {
+		deps := make([]int, 0, len(t.Dependencies)+1)
+		for dep := range t.Dependencies {
+			deps = append(deps, dep)
+		}
+		if t.Abort != nil {
+			deps = append(deps, t.Abort.DependentTxIdx)
+		}
+		for _, dep := range deps {
+			// only dependencies that still need to execute tie tasks together
+			if _, ok := parent[dep]; ok {
+				union(t.AbsoluteIndex, dep)
+			}
+		}
+	}
+
+	// a task that failed validation without naming its conflicts (e.g. an iterated range changed)
+	// is ordered after every lower task that still needs to execute
+	united := 0
+	for i, t := range pending {
+		if !t.UnknownConflict {
+			continue
+		}
+		for ; united < i; united++ {
+			union(pending[united].AbsoluteIndex, t.AbsoluteIndex)
+		}
+	}
+
+	var roots []int
+	byRoot := make(map[int][]*deliverTxTask)
+	for _, t := range pending {
+		root := find(t.AbsoluteIndex)
+		if _, ok := byRoot[root]; !ok {
+			roots = append(roots, root)
+		}
+		byRoot[root] = append(byRoot[root], t)
+	}
+	sort.Ints(roots)
+
+	clusters := make([][]*deliverTxTask, 0, len(roots))
+	for _, root := range roots {
+		cluster := byRoot[root]
+		sort.Slice(cluster, func(i, j int) bool {
+			return cluster[i].AbsoluteIndex < cluster[j].AbsoluteIndex
+		})
+		clusters = append(clusters, cluster)
+	}
+	return clusters
+}
+
 func (s *scheduler) prepareAndRunTask(wg *sync.WaitGroup, ctx sdk.Context, task *deliverTxTask) {
 	eCtx, eSpan := s.traceSpan(ctx, "SchedulerExecute", task)
 	defer eSpan.End()
@@ -561,6 +861,7 @@ func (s *scheduler) executeTask(task *deliverTxTask) {
 		task.SetStatus(statusAborted)
 		task.Abort = &abort
 		task.AppendDependencies([]int{abort.DependentTxIdx})
+		task.AppendConflicts(s.abortConflict(task, abort))
 		// write from version store to multiversion stores
 		for _, v := range task.VersionStores {
 			v.WriteEstimatesToMultiVersionStore()
@@ -2,6 +2,7 @@ package tasks
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -22,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/multiversion"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/occ"
 	"github.com/cosmos/cosmos-sdk/utils/tracing"
@@ -77,6 +79,16 @@ func requestListWithEstimatedWritesets(n int) []*sdk.DeliverTxEntry {
 	return tasks
 }
 
+// requestListWithEstimatedDependencies hints that every tx with an index divisible by
+// hotKeyEvery depends on the previous such tx
+func requestListWithEstimatedDependencies(n int, hotKeyEvery int) []*sdk.DeliverTxEntry {
+	tasks := requestList(n)
+	for i := hotKeyEvery; i < n; i += hotKeyEvery {
+		tasks[i].EstimatedDependencies = []int{i - hotKeyEvery}
+	}
+	return tasks
+}
+
 func initTestCtx(injectStores bool) sdk.Context {
 	ctx := sdk.Context{}.WithContext(context.Background())
 	keys := make(map[string]sdk.StoreKey)
@@ -305,6 +317,76 @@ func TestProcessAll(t *testing.T) {
 			assertions:  func(t *testing.T, ctx sdk.Context, res []types.ResponseDeliverTx) {},
 			expectedErr: nil,
 		},
+		{
+			name:      "Test every tx accesses same key with estimated dependencies",
+			workers:   50,
+			runs:      1,
+			addStores: true,
+			requests:  requestListWithEstimatedDependencies(1000, 1),
+			deliverTxFunc: func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+				defer abortRecoveryFunc(&res)
+				// all txs read and write to the same key to maximize conflicts
+				kv := ctx.MultiStore().GetKVStore(testStoreKey)
+				val := string(kv.Get(itemKey))
+
+				// write to the store with this tx's index
+				kv.Set(itemKey, req.Tx)
+
+				// return what was read from the store (final attempt should be index-1)
+				return types.ResponseDeliverTx{
+					Info: val,
+				}
+			},
+			assertions: func(t *testing.T, ctx sdk.Context, res []types.ResponseDeliverTx) {
+				for idx, response := range res {
+					if idx == 0 {
+						require.Equal(t, "", response.Info)
+					} else {
+						require.Equal(t, fmt.Sprintf("%d", idx-1), response.Info)
+					}
+				}
+				// confirm last write made it to the parent store
+				latest := ctx.MultiStore().GetKVStore(testStoreKey).Get(itemKey)
+				require.Equal(t, []byte(fmt.Sprintf("%d", len(res)-1)), latest)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:      "Test some tx accesses same key with estimated dependencies",
+			workers:   50,
+			runs:      5,
+			addStores: true,
+			requests:  requestListWithEstimatedDependencies(1000, 10),
+			deliverTxFunc: func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+				defer abortRecoveryFunc(&res)
+				kv := ctx.MultiStore().GetKVStore(testStoreKey)
+				if ctx.TxIndex()%10 != 0 {
+					kv.Set(req.Tx, req.Tx)
+					return types.ResponseDeliverTx{
+						Info: "none",
+					}
+				}
+				newVal := string(kv.Get(itemKey)) + fmt.Sprintf("%d,", ctx.TxIndex())
+				kv.Set(itemKey, []byte(newVal))
+				return types.ResponseDeliverTx{
+					Info: newVal,
+				}
+			},
+			assertions: func(t *testing.T, ctx sdk.Context, res []types.ResponseDeliverTx) {
+				expected := ""
+				for idx, response := range res {
+					if idx%10 != 0 {
+						require.Equal(t, "none", response.Info)
+						continue
+					}
+					expected = expected + fmt.Sprintf("%d,", idx)
+					require.Equal(t, expected, response.Info)
+				}
+				latest := ctx.MultiStore().GetKVStore(testStoreKey).Get(itemKey)
+				require.Equal(t, expected, string(latest))
+			},
+			expectedErr: nil,
+		},
 		{
 			name:      "Test no stores on context should not panic",
 			workers:   50,
@@ -468,6 +550,224 @@ func TestProcessAll(t *testing.T) {
 	}
 }
 
+func TestFindConflictClusters(t *testing.T) {
+	tasks, _ := toTasks(requestList(8))
+	statuses := []status{statusValidated, statusPending, statusWaiting, statusPending, statusExecuted, statusPending, statusWaiting, statusPending}
+	for i, st := range statuses {
+		tasks[i].Status = st
+	}
+	// 1 <- 2 <- 6 form a chain of conflicts on a hot key
+	tasks[2].AppendDependencies([]int{0, 1})
+	tasks[6].AppendDependencies([]int{2})
+	// 5 only depends on a validated task and 7 only on an executed one
+	tasks[5].AppendDependencies([]int{0})
+	tasks[7].AppendDependencies([]int{4})
+	// 3 aborted on 5 during its last incarnation
+	tasks[3].Abort = &occ.Abort{DependentTxIdx: 5}
+
+	s := &scheduler{}
+	clusters := s.findConflictClusters(tasks)
+
+	var indexes [][]int
+	for _, c := range clusters {
+		var idxs []int
+		for _, task := range c {
+			idxs = append(idxs, task.AbsoluteIndex)
+		}
+		indexes = append(indexes, idxs)
+	}
+	require.Equal(t, [][]int{{1, 2, 6}, {3, 5}, {7}}, indexes)
+
+	// a task with an unknown conflict is ordered after every lower pending task
+	tasks, _ = toTasks(requestList(5))
+	tasks[1].Status = statusValidated
+	tasks[3].UnknownConflict = true
+	clusters = s.findConflictClusters(tasks)
+	require.Len(t, clusters, 2)
+	require.Len(t, clusters[0], 3)
+	require.Equal(t, 3, clusters[0][2].AbsoluteIndex)
+	require.Equal(t, 4, clusters[1][0].AbsoluteIndex)
+}
+
+func TestProcessAllClustersHotKey(t *testing.T) {
+	tp := trace.NewNoopTracerProvider()
+	tr := tp.Tracer("scheduler-test")
+	ti := &tracing.Info{
+		Tracer: &tr,
+	}
+
+	deliverTx := func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+		defer abortRecoveryFunc(&res)
+		kv := ctx.MultiStore().GetKVStore(testStoreKey)
+		if ctx.TxIndex()%2 != 0 {
+			// odd txs only touch their own key
+			kv.Set(req.Tx, req.Tx)
+			return types.ResponseDeliverTx{
+				Info: "none",
+			}
+		}
+		// even txs append to a hot key
+		time.Sleep(time.Duration(rand.Intn(3)) * time.Millisecond)
+		newVal := string(kv.Get(itemKey)) + fmt.Sprintf("%d,", ctx.TxIndex())
+		kv.Set(itemKey, []byte(newVal))
+		return types.ResponseDeliverTx{
+			Info: newVal,
+		}
+	}
+
+	s := NewScheduler(20, ti, deliverTx)
+	ctx := initTestCtx(true)
+	res, err := s.ProcessAll(ctx, requestList(200))
+	require.NoError(t, err)
+	require.Len(t, res, 200)
+
+	expected := ""
+	for idx, response := range res {
+		if idx%2 != 0 {
+			require.Equal(t, "none", response.Info)
+			continue
+		}
+		expected = expected + fmt.Sprintf("%d,", idx)
+		require.Equal(t, expected, response.Info)
+	}
+	require.Equal(t, expected, string(ctx.MultiStore().GetKVStore(testStoreKey).Get(itemKey)))
+
+	metrics := s.(*scheduler).metrics
+	require.Greater(t, metrics.parallelExecutions, 0)
+
+	report := s.Report()
+	require.NotNil(t, report)
+	require.Equal(t, 200, report.Txs)
+	require.Equal(t, metrics.retries, report.Retries)
+	require.Equal(t, metrics.parallelExecutions, report.ParallelExecutions)
+	for _, txReport := range report.TxReports {
+		require.Greater(t, txReport.Incarnation, 0)
+		// the synchronous fallback re-executes txs regardless of conflicts
+		if !report.Synchronous {
+			require.NotEmpty(t, txReport.Conflicts)
+		}
+		for _, conflict := range txReport.Conflicts {
+			// only the hot key can conflict
+			require.Equal(t, testStoreKey.Name(), conflict.Store)
+			require.Equal(t, itemKey, conflict.Key)
+			require.Less(t, conflict.DependentTxIdx, txReport.AbsoluteIndex)
+		}
+	}
+}
+
+func TestProcessAllEstimatedDependenciesOffset(t *testing.T) {
+	tp := trace.NewNoopTracerProvider()
+	tr := tp.Tracer("scheduler-test")
+	ti := &tracing.Info{
+		Tracer: &tr,
+	}
+
+	deliverTx := func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+		defer abortRecoveryFunc(&res)
+		kv := ctx.MultiStore().GetKVStore(testStoreKey)
+		newVal := string(kv.Get(itemKey)) + fmt.Sprintf("%d,", ctx.TxIndex())
+		kv.Set(itemKey, []byte(newVal))
+		return types.ResponseDeliverTx{
+			Info: newVal,
+		}
+	}
+
+	// the batch starts in the middle of the block, while the dag dependencies are between positions in the batch
+	const offset = 500
+	reqs := requestList(100)
+	for i, req := range reqs {
+		req.AbsoluteIndex = offset + i
+	}
+	txDependencies := map[int][]int{}
+	for i := 1; i < len(reqs); i++ {
+		txDependencies[i] = []int{i - 1}
+	}
+	SetEstimatedDependencies(reqs, txDependencies)
+	require.Nil(t, reqs[0].EstimatedDependencies)
+	require.Equal(t, []int{offset}, reqs[1].EstimatedDependencies)
+	require.Equal(t, []int{offset + 98}, reqs[99].EstimatedDependencies)
+
+	// the tasks wait for the txs they depend on in the batch
+	tasks, tasksMap := toTasks(reqs)
+	prefillDependencies(reqs, tasksMap)
+	require.Equal(t, statusPending, tasks[0].Status)
+	for i, task := range tasks[1:] {
+		require.Equal(t, statusWaiting, task.Status)
+		require.Equal(t, map[int]struct{}{offset + i: {}}, task.Dependencies)
+	}
+
+	s := NewScheduler(20, ti, deliverTx)
+	ctx := initTestCtx(true)
+	res, err := s.ProcessAll(ctx, reqs)
+	require.NoError(t, err)
+	require.Len(t, res, 100)
+
+	expected := ""
+	for idx, response := range res {
+		expected = expected + fmt.Sprintf("%d,", offset+idx)
+		require.Equal(t, expected, response.Info)
+	}
+
+	// the tasks waiting on their estimated dependencies ran their first incarnation only
+	report := s.Report()
+	require.Zero(t, report.Retries)
+	require.Zero(t, report.MaxIncarnation)
+	require.Empty(t, report.TxReports)
+}
+
+func BenchmarkProcessAllEstimatedDependencies(b *testing.B) {
+	tp := trace.NewNoopTracerProvider()
+	tr := tp.Tracer("scheduler-benchmark")
+	ti := &tracing.Info{
+		Tracer: &tr,
+	}
+
+	// every 4th tx transfers to the same exchange address, the rest touch their own keys
+	const txs, hotKeyEvery = 1000, 4
+	deliverTx := func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+		defer abortRecoveryFunc(&res)
+		kv := ctx.MultiStore().GetKVStore(testStoreKey)
+		// simulate signature verification and message handling work
+		sum := sha256.Sum256(req.Tx)
+		for i := 0; i < 200; i++ {
+			sum = sha256.Sum256(sum[:])
+		}
+		if ctx.TxIndex()%hotKeyEvery != 0 {
+			kv.Set(req.Tx, sum[:])
+			return types.ResponseDeliverTx{}
+		}
+		balance := kv.Get(itemKey)
+		kv.Set(itemKey, append(balance, sum[0]))
+		return types.ResponseDeliverTx{}
+	}
+
+	for _, bm := range []struct {
+		name     string
+		requests func() []*sdk.DeliverTxEntry
+	}{
+		{name: "optimistic", requests: func() []*sdk.DeliverTxEntry { return requestList(txs) }},
+		{name: "estimated dependencies", requests: func() []*sdk.DeliverTxEntry {
+			return requestListWithEstimatedDependencies(txs, hotKeyEvery)
+		}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			var retries int
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				ctx := initTestCtx(true)
+				reqs := bm.requests()
+				s := NewScheduler(20, ti, deliverTx)
+				b.StartTimer()
+
+				_, err := s.ProcessAll(ctx, reqs)
+				require.NoError(b, err)
+				retries += s.(*scheduler).metrics.retries
+			}
+			b.ReportMetric(float64(retries)/float64(b.N), "retries/op")
+		})
+	}
+}
+
 func addTxTracerToTxEntries(txEntries []*sdk.DeliverTxEntry) []*sdk.DeliverTxEntry {
 	for _, txEntry := range txEntries {
 		txEntry.TxTracer = newTestTxTracer(txEntry.AbsoluteIndex)
@@ -506,3 +806,59 @@ func (t *testTxTracer) OnTxExecute() {
 
 	t.canExecute = false
 }
+
+func TestProcessAllHotKeyDeltas(t *testing.T) {
+	tp := trace.NewNoopTracerProvider()
+	tr := tp.Tracer("scheduler-test")
+	ti := &tracing.Info{
+		Tracer: &tr,
+	}
+	addUint64 := func(value, delta []byte) []byte {
+		var counter uint64
+		if value != nil {
+			counter = sdk.BigEndianToUint64(value)
+		}
+		return sdk.Uint64ToBigEndian(counter + sdk.BigEndianToUint64(delta))
+	}
+
+	deliverTx := func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+		defer abortRecoveryFunc(&res)
+		// branch the store like a tx execution does
+		msCache := ctx.MultiStore().CacheMultiStore()
+		kv := msCache.GetKVStore(testStoreKey)
+		kv.Set(req.Tx, req.Tx)
+		storetypes.AddDelta(kv, itemKey, sdk.Uint64ToBigEndian(1), addUint64)
+		if ctx.TxIndex()%10 != 0 {
+			msCache.Write()
+			return types.ResponseDeliverTx{}
+		}
+		// every tenth tx reads the hot key
+		counter := sdk.BigEndianToUint64(kv.Get(itemKey))
+		msCache.Write()
+		return types.ResponseDeliverTx{
+			Info: fmt.Sprintf("%d", counter),
+		}
+	}
+
+	s := NewScheduler(20, ti, deliverTx)
+	ctx := initTestCtx(true)
+	res, err := s.ProcessAll(ctx, requestList(100))
+	require.NoError(t, err)
+	require.Len(t, res, 100)
+
+	for idx, response := range res {
+		if idx%10 == 0 {
+			require.Equal(t, fmt.Sprintf("%d", idx+1), response.Info)
+		}
+	}
+	require.Equal(t, sdk.Uint64ToBigEndian(100), ctx.MultiStore().GetKVStore(testStoreKey).Get(itemKey))
+
+	report := s.Report()
+	require.NotNil(t, report)
+	if !report.Synchronous {
+		for _, txReport := range report.TxReports {
+			// only the txs reading the hot key can conflict
+			require.Zero(t, txReport.AbsoluteIndex%10)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package tasks
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+
+	store "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// TxWriteset is the final writeset of a tx executed by the scheduler, i.e. the writes of the incarnation that was
+// validated, with the deltas it added merged into the values they apply to.
+type TxWriteset struct {
+	AbsoluteIndex int
+	TxHash        []byte
+	Incarnation   int
+	// Changes are sorted by store key and key
+	Changes []*store.StoreKVPair
+}
+
+// SchedulerOption configures a scheduler
+type SchedulerOption func(s *scheduler)
+
+// WithWritesets records the final writesets of the txs, which are returned by Writesets after ProcessAll.
+func WithWritesets() SchedulerOption {
+	return func(s *scheduler) {
+		s.recordWritesets = true
+	}
+}
+
+// collectWritesets collects the final writesets of the tasks from the multiversion stores. It must be called before
+// the multiversion stores are written to their parents, since the deltas of a key apply to the parent value if no
+// tx wrote the key before.
+func (s *scheduler) collectWritesets(tasks []*deliverTxTask) []TxWriteset {
+	writesets := make([]TxWriteset, len(tasks))
+	positions := make(map[int]int, len(tasks))
+	for i, task := range tasks {
+		hash := sha256.Sum256(task.Request.Tx)
+		writesets[i] = TxWriteset{
+			AbsoluteIndex: task.AbsoluteIndex,
+			TxHash:        hash[:],
+			Incarnation:   task.Incarnation,
+		}
+		positions[task.AbsoluteIndex] = i
+	}
+	for storeKey, mv := range s.multiVersionStores {
+		for index, keys := range mv.GetAllWritesetKeys() {
+			i, ok := positions[index]
+			if !ok {
+				continue
+			}
+			for _, key := range keys {
+				item := mv.GetLatestBeforeIndex(index+1, []byte(key))
+				if item == nil || item.Index() != index || item.IsEstimate() {
+					continue
+				}
+				pair := &store.StoreKVPair{StoreKey: storeKey.Name(), Key: []byte(key)}
+				if item.IsDeleted() {
+					pair.Delete = true
+				} else {
+					pair.Value = item.Value()
+				}
+				writesets[i].Changes = append(writesets[i].Changes, pair)
+			}
+		}
+	}
+	for _, writeset := range writesets {
+		changes := writeset.Changes
+		sort.Slice(changes, func(i, j int) bool {
+			if changes[i].StoreKey != changes[j].StoreKey {
+				return changes[i].StoreKey < changes[j].StoreKey
+			}
+			return bytes.Compare(changes[i].Key, changes[j].Key) < 0
+		})
+	}
+	return writesets
+}
@@ -0,0 +1,74 @@
+package tasks
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/abci/types"
+	"go.opentelemetry.io/otel/trace"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/utils/tracing"
+)
+
+func TestProcessAllWritesets(t *testing.T) {
+	counterKey := []byte("counter")
+	addUint64 := func(value, delta []byte) []byte {
+		var counter uint64
+		if value != nil {
+			counter = sdk.BigEndianToUint64(value)
+		}
+		return sdk.Uint64ToBigEndian(counter + sdk.BigEndianToUint64(delta))
+	}
+	deliverTx := func(ctx sdk.Context, req types.RequestDeliverTx, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+		defer abortRecoveryFunc(&res)
+		msCache := ctx.MultiStore().CacheMultiStore()
+		kv := msCache.GetKVStore(testStoreKey)
+		// every tx reads and writes the same key, so that incarnations are discarded
+		_ = kv.Get(itemKey)
+		kv.Set(itemKey, req.Tx)
+		storetypes.AddDelta(kv, counterKey, sdk.Uint64ToBigEndian(1), addUint64)
+		if ctx.TxIndex() > 0 {
+			// delete the key of the previous tx, which was written before the batch
+			kv.Delete([]byte(fmt.Sprintf("%d", ctx.TxIndex()-1)))
+		}
+		msCache.Write()
+		return types.ResponseDeliverTx{}
+	}
+
+	tr := trace.NewNoopTracerProvider().Tracer("scheduler-test")
+	s := NewScheduler(10, &tracing.Info{Tracer: &tr}, deliverTx, WithWritesets())
+	ctx := initTestCtx(true)
+	for i := 0; i < 20; i++ {
+		ctx.MultiStore().GetKVStore(testStoreKey).Set([]byte(fmt.Sprintf("%d", i)), []byte("before"))
+	}
+	_, err := s.ProcessAll(ctx, requestList(20))
+	require.NoError(t, err)
+
+	incarnations := make(map[int]int)
+	for _, txReport := range s.Report().TxReports {
+		incarnations[txReport.AbsoluteIndex] = txReport.Incarnation
+	}
+	writesets := s.Writesets()
+	require.Len(t, writesets, 20)
+	for i, writeset := range writesets {
+		tx := []byte(fmt.Sprintf("%d", i))
+		hash := sha256.Sum256(tx)
+		require.Equal(t, i, writeset.AbsoluteIndex)
+		require.Equal(t, hash[:], writeset.TxHash)
+		require.Equal(t, incarnations[i], writeset.Incarnation)
+
+		expected := []*storetypes.StoreKVPair{
+			{StoreKey: testStoreKey.Name(), Key: counterKey, Value: sdk.Uint64ToBigEndian(uint64(i + 1))},
+			{StoreKey: testStoreKey.Name(), Key: itemKey, Value: tx},
+		}
+		if i > 0 {
+			deleted := &storetypes.StoreKVPair{StoreKey: testStoreKey.Name(), Key: []byte(fmt.Sprintf("%d", i-1)), Delete: true}
+			expected = append([]*storetypes.StoreKVPair{deleted}, expected...)
+		}
+		require.Equal(t, expected, writeset.Changes)
+	}
+}
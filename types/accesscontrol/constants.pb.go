@@ -185,6 +185,7 @@ const (
 	ResourceType_KV_BANK_WEI_BALANCE                      ResourceType = 108
 	ResourceType_KV_CT                                    ResourceType = 111
 	ResourceType_KV_CT_ACCOUNT                            ResourceType = 112
+	ResourceType_KV_FEEMARKET                             ResourceType = 116
 )
 
 var ResourceType_name = map[int32]string{
@@ -270,6 +271,7 @@ var ResourceType_name = map[int32]string{
 	108: "KV_BANK_WEI_BALANCE",
 	111: "KV_CT",
 	112: "KV_CT_ACCOUNT",
+	116: "KV_FEEMARKET",
 }
 
 var ResourceType_value = map[string]int32{
@@ -355,6 +357,7 @@ var ResourceType_value = map[string]int32{
 	"KV_BANK_WEI_BALANCE":                      108,
 	"KV_CT":                                    111,
 	"KV_CT_ACCOUNT":                            112,
+	"KV_FEEMARKET":                             116,
 }
 
 func (x ResourceType) String() string {
@@ -402,90 +405,91 @@ func init() {
 }
 
 var fileDescriptor_36568f7561081112 = []byte{
-	// 1357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xdd, 0x72, 0x13, 0xb9,
-	0x12, 0xce, 0x8f, 0x93, 0x38, 0x4a, 0x80, 0x8e, 0xf8, 0x27, 0xc1, 0x80, 0xc9, 0x01, 0x4e, 0x80,
-	0x04, 0xc2, 0xdd, 0xb9, 0x93, 0x67, 0x3a, 0xf6, 0x64, 0x66, 0xa4, 0xb1, 0xa4, 0xb1, 0x63, 0xea,
-	0x1c, 0x74, 0x12, 0xe3, 0x05, 0x16, 0x12, 0x67, 0x63, 0xb3, 0x55, 0xfb, 0x0c, 0x7b, 0xb3, 0x8f,
-	0xb5, 0x37, 0x5b, 0xc5, 0xe5, 0x5e, 0x6e, 0xc1, 0x8b, 0x6c, 0x69, 0x46, 0x76, 0x9c, 0x21, 0x2c,
-	0x57, 0x2e, 0xf7, 0xf7, 0x75, 0x6b, 0xfa, 0xeb, 0x56, 0xb7, 0xc8, 0x7a, 0xb7, 0x3f, 0x38, 0xec,
-	0x0f, 0xb6, 0xf6, 0xbb, 0xdd, 0xde, 0x60, 0xd0, 0xed, 0x1f, 0x0d, 0x4f, 0xfa, 0x1f, 0xb6, 0xba,
-	0xfd, 0xa3, 0xc1, 0x70, 0xff, 0x68, 0x38, 0xd8, 0x3c, 0x3e, 0xe9, 0x0f, 0xfb, 0x74, 0x2d, 0x67,
-	0x6d, 0x9e, 0x61, 0x6d, 0xfe, 0xfc, 0xfc, 0xa0, 0x37, 0xdc, 0x7f, 0xbe, 0xf1, 0x1f, 0x42, 0x58,
-	0x06, 0xe8, 0x5f, 0x8e, 0x7b, 0x74, 0x89, 0x2c, 0xa4, 0x3c, 0xe4, 0xa2, 0xcd, 0x61, 0x8a, 0x96,
-	0x49, 0x49, 0x22, 0xf3, 0x61, 0x9a, 0x2e, 0x92, 0xb9, 0xb6, 0x0c, 0x34, 0xc2, 0x0c, 0x25, 0x64,
-	0xde, 0x13, 0x71, 0x1c, 0x68, 0x98, 0xdd, 0xf8, 0x75, 0x86, 0xac, 0xe6, 0xce, 0xe2, 0xb8, 0x77,
-	0xb2, 0x3f, 0x7c, 0xd7, 0x3f, 0x52, 0xbd, 0x0f, 0xbd, 0xee, 0xb0, 0x7f, 0x92, 0x45, 0x2b, 0x93,
-	0x12, 0x17, 0x1c, 0x61, 0x8a, 0xce, 0x93, 0x99, 0xdd, 0x26, 0x4c, 0xd3, 0xab, 0x64, 0x65, 0xb7,
-	0x69, 0x6a, 0xe8, 0x35, 0x5e, 0x6c, 0x1b, 0xe6, 0xfb, 0x12, 0x95, 0x82, 0x19, 0x5a, 0x21, 0xb7,
-	0x76, 0x9b, 0x26, 0x42, 0x5e, 0xd7, 0x0d, 0x93, 0x48, 0xdc, 0x09, 0xf6, 0xd0, 0x1f, 0xe3, 0xb3,
-	0xf4, 0x26, 0xb9, 0xaa, 0x90, 0xfb, 0x28, 0x8b, 0xae, 0x25, 0x5a, 0x25, 0x15, 0x07, 0x7d, 0xcb,
-	0x7d, 0x8e, 0x5e, 0x21, 0xe0, 0x09, 0xae, 0x25, 0xf3, 0xf4, 0xd8, 0x3a, 0x4f, 0x6f, 0x91, 0x6b,
-	0xbb, 0x4d, 0x13, 0xa3, 0x52, 0xac, 0x8e, 0xc6, 0x13, 0xdc, 0x0f, 0x74, 0x20, 0x38, 0x8b, 0x60,
-	0xc1, 0x62, 0x9e, 0xe0, 0x4a, 0x33, 0xae, 0x8d, 0xd2, 0x32, 0xe0, 0x75, 0xa3, 0x85, 0x69, 0xe0,
-	0x1e, 0x94, 0xe9, 0x35, 0x42, 0xc7, 0xd1, 0x24, 0xee, 0xa0, 0x44, 0xee, 0x21, 0x2c, 0x6e, 0xfc,
-	0xb1, 0x42, 0x96, 0x65, 0x6f, 0xd0, 0xff, 0x78, 0xd2, 0xed, 0x65, 0xe9, 0x2f, 0x90, 0x59, 0xc6,
-	0x3b, 0x79, 0xf6, 0x61, 0x0b, 0xa6, 0xad, 0x21, 0xee, 0x1d, 0xc2, 0x8c, 0x95, 0x39, 0x6c, 0x99,
-	0x1a, 0xe3, 0x21, 0x94, 0xe8, 0x45, 0x42, 0xc2, 0x96, 0x51, 0x9a, 0x85, 0x01, 0xaf, 0xc3, 0x9c,
-	0x03, 0xdb, 0x4c, 0xc5, 0x30, 0x4f, 0x2f, 0x90, 0xc5, 0xb0, 0x65, 0x84, 0x64, 0x5e, 0x84, 0xb0,
-	0x40, 0x97, 0x49, 0x39, 0x6c, 0x19, 0x4c, 0x84, 0xd7, 0x80, 0x45, 0x7a, 0x99, 0x5c, 0x0a, 0x5b,
-	0x46, 0x8b, 0x10, 0xf9, 0x0e, 0xf3, 0xb4, 0x90, 0x1d, 0x20, 0xf6, 0xd3, 0xc7, 0x1e, 0xa6, 0x25,
-	0x34, 0x1a, 0xcd, 0x64, 0x1d, 0xb5, 0x82, 0x25, 0x7a, 0x9b, 0xdc, 0x3c, 0xc5, 0x58, 0xbd, 0x2e,
-	0xb1, 0xce, 0x74, 0xce, 0x52, 0xb0, 0x6c, 0xab, 0x73, 0x0a, 0xef, 0x20, 0xfa, 0x28, 0x15, 0x5c,
-	0xb0, 0xea, 0x9f, 0x7e, 0xa0, 0xf1, 0x31, 0xb2, 0x5e, 0x81, 0xe0, 0x70, 0x91, 0xde, 0x20, 0x57,
-	0x26, 0xa0, 0x16, 0x8b, 0x02, 0x9f, 0x69, 0x21, 0xe1, 0x92, 0xcb, 0x82, 0xa5, 0xba, 0x01, 0xe0,
-	0x22, 0xd8, 0x3f, 0x23, 0xfd, 0x8d, 0xd2, 0x42, 0x22, 0xac, 0x50, 0x4a, 0x2e, 0x3a, 0x29, 0x8c,
-	0x4a, 0x93, 0x24, 0xea, 0x00, 0xa5, 0x2b, 0xe4, 0xc2, 0xc8, 0xe6, 0x23, 0x17, 0x31, 0x5c, 0xb6,
-	0x25, 0x1c, 0x99, 0x6a, 0x2c, 0x62, 0xdc, 0x43, 0x05, 0x57, 0x5c, 0xdc, 0x49, 0x01, 0x9c, 0xc3,
-	0x55, 0xba, 0x46, 0x6e, 0x14, 0xa1, 0x18, 0x35, 0xf3, 0x99, 0x66, 0x70, 0xed, 0x3c, 0x47, 0xe6,
-	0xc7, 0x01, 0x87, 0xeb, 0x74, 0x95, 0x5c, 0x2f, 0x42, 0x9e, 0xc4, 0x2c, 0xab, 0x1b, 0x0e, 0x74,
-	0x0a, 0xe1, 0x9e, 0xd7, 0x60, 0xbc, 0x8e, 0x46, 0x32, 0x8d, 0x70, 0xd3, 0xb6, 0x62, 0x41, 0xf9,
-	0x04, 0x39, 0x8b, 0x74, 0xc7, 0x78, 0x22, 0xe5, 0x1a, 0x25, 0xdc, 0x72, 0x9f, 0xe5, 0x38, 0x89,
-	0x0c, 0x3c, 0x34, 0x8a, 0xb3, 0x44, 0x35, 0x84, 0x86, 0x55, 0x7a, 0x87, 0xac, 0x7e, 0x2d, 0x67,
-	0x20, 0xb8, 0x49, 0x44, 0x1b, 0x25, 0xac, 0xb9, 0xe2, 0x8e, 0x08, 0x5a, 0x68, 0x16, 0x39, 0xec,
-	0xb6, 0x3b, 0xfe, 0xab, 0x5a, 0x28, 0xdb, 0xda, 0x99, 0xec, 0x50, 0xa1, 0xf7, 0xc9, 0x9d, 0x09,
-	0x4e, 0xca, 0x6b, 0xb6, 0xeb, 0xcf, 0x16, 0xf5, 0x0e, 0x7d, 0x48, 0xee, 0x7f, 0x87, 0x64, 0xa3,
-	0xc3, 0x5d, 0xa7, 0xc6, 0x88, 0x28, 0x71, 0x22, 0xca, 0xbd, 0xc2, 0x51, 0x93, 0xa0, 0xf5, 0x36,
-	0x4a, 0x7a, 0x50, 0xfd, 0x1e, 0xc9, 0x57, 0x1a, 0xee, 0xd3, 0x7b, 0xe4, 0xf6, 0xb7, 0x48, 0xcd,
-	0x14, 0x53, 0x84, 0x75, 0x3b, 0x40, 0xce, 0xcb, 0xdd, 0xe1, 0xff, 0x2a, 0xe0, 0x8d, 0xc0, 0x76,
-	0x5f, 0xe0, 0xb1, 0xc8, 0x04, 0x7c, 0x47, 0xc0, 0x83, 0x42, 0x1f, 0x8f, 0x53, 0x86, 0x87, 0xdf,
-	0x56, 0xb5, 0xd6, 0x71, 0xca, 0xff, 0xdb, 0xdd, 0x43, 0x3f, 0xb0, 0x93, 0xa2, 0x96, 0x66, 0xf9,
-	0x3f, 0x72, 0x95, 0x9e, 0x34, 0xda, 0x2b, 0x65, 0x12, 0x21, 0x22, 0xd8, 0xa0, 0x77, 0xc9, 0x5a,
-	0x11, 0x4d, 0xa4, 0x48, 0x84, 0x42, 0x69, 0x42, 0xec, 0xc0, 0x63, 0x57, 0x85, 0x33, 0x0c, 0x91,
-	0x6a, 0x3b, 0x92, 0xfc, 0x5c, 0x86, 0x36, 0x93, 0xbe, 0x82, 0x27, 0xf4, 0x31, 0x79, 0x58, 0x24,
-	0x3a, 0x85, 0x84, 0x34, 0xed, 0x40, 0x37, 0x7c, 0xc9, 0xda, 0x79, 0x03, 0x3c, 0xfd, 0x67, 0xb2,
-	0xd2, 0x4c, 0x6a, 0x1b, 0x3c, 0x53, 0x65, 0x93, 0x6e, 0x90, 0x07, 0x45, 0xb2, 0xad, 0xca, 0x84,
-	0x7c, 0xa3, 0xaf, 0xd8, 0x3a, 0xef, 0x73, 0x2d, 0xd7, 0x4b, 0xa5, 0x44, 0xae, 0xc7, 0xc4, 0x67,
-	0xf4, 0x11, 0x59, 0x3f, 0x8f, 0xc8, 0x3c, 0x2f, 0x8d, 0x4d, 0xb6, 0x5a, 0x94, 0xb2, 0x0a, 0x3e,
-	0x77, 0xb7, 0xe1, 0x0c, 0x53, 0x45, 0x4c, 0x35, 0x0c, 0xb6, 0x90, 0x6b, 0xd8, 0x76, 0x43, 0x81,
-	0x79, 0x1e, 0x2a, 0x95, 0x8d, 0x64, 0x11, 0x41, 0x9d, 0x3e, 0x21, 0x8f, 0x8a, 0xd6, 0x6c, 0x9a,
-	0x1a, 0x1f, 0x13, 0xbb, 0x29, 0xb8, 0xd7, 0x31, 0x31, 0x4b, 0x12, 0x5b, 0xdf, 0x06, 0x05, 0xb2,
-	0xec, 0xa6, 0xad, 0xf1, 0x84, 0x8f, 0x10, 0xb8, 0xc2, 0x39, 0x4b, 0x61, 0x6b, 0xec, 0xba, 0x1b,
-	0x78, 0x16, 0xcd, 0x67, 0x59, 0xe8, 0xba, 0x28, 0xc3, 0x14, 0x36, 0x53, 0xbb, 0x17, 0xb2, 0x62,
-	0x46, 0xae, 0x85, 0xcf, 0x7a, 0xd9, 0xe3, 0x9c, 0x96, 0x1d, 0x88, 0x5d, 0xb6, 0x67, 0x29, 0xb5,
-	0x4e, 0xce, 0x0a, 0x7c, 0xe0, 0x6e, 0x78, 0x67, 0x84, 0x24, 0xe0, 0x1c, 0x7d, 0x87, 0x71, 0x1f,
-	0xf7, 0x40, 0xb8, 0x23, 0xb2, 0x19, 0x5b, 0x8f, 0x44, 0x2d, 0x97, 0xd4, 0xce, 0x1d, 0xc3, 0xd3,
-	0xb8, 0x86, 0x12, 0x12, 0xb7, 0x3d, 0x2c, 0xe5, 0x25, 0x34, 0xe9, 0x25, 0xb2, 0x14, 0xb6, 0x6c,
-	0x4f, 0xd6, 0x25, 0xe3, 0x1a, 0xa4, 0x1b, 0x8a, 0x23, 0x83, 0x61, 0x51, 0x24, 0xda, 0x76, 0xd2,
-	0x82, 0x72, 0xdc, 0x4c, 0x7d, 0x2b, 0x9b, 0x76, 0xfd, 0x3b, 0x32, 0xe4, 0x37, 0x3a, 0xa8, 0xf3,
-	0x71, 0xf3, 0xa4, 0xae, 0xce, 0x63, 0x86, 0x55, 0xd0, 0x24, 0x69, 0x2d, 0xc4, 0x8e, 0x91, 0x18,
-	0xe5, 0xd7, 0xd7, 0x8a, 0xd3, 0x9a, 0x9c, 0xed, 0xbe, 0xdd, 0xa7, 0x12, 0x7d, 0xf8, 0x1f, 0x5d,
-	0x27, 0x77, 0x8b, 0x56, 0x13, 0x0b, 0x3f, 0x8d, 0xd0, 0xe8, 0x3d, 0x97, 0xb5, 0xb1, 0xcf, 0x11,
-	0xbb, 0x10, 0x5b, 0x31, 0xfc, 0xdf, 0xad, 0x12, 0x6c, 0xc5, 0xa3, 0x15, 0x01, 0xfb, 0x2e, 0xb6,
-	0xb5, 0x69, 0xc9, 0xb8, 0x0a, 0x6c, 0xe3, 0x1c, 0xb8, 0x12, 0x5b, 0xeb, 0x48, 0xa3, 0x53, 0xb4,
-	0xeb, 0xc6, 0x9a, 0x45, 0x47, 0xe7, 0x8d, 0xc1, 0xd7, 0xae, 0x5f, 0x2c, 0xc8, 0x85, 0x3d, 0xa2,
-	0x37, 0x71, 0xac, 0x44, 0x0f, 0x83, 0x44, 0xc3, 0x0f, 0x6e, 0xa7, 0x5b, 0x9b, 0xda, 0x46, 0x78,
-	0x33, 0xf1, 0x1f, 0xb7, 0x15, 0xbc, 0x9d, 0xf8, 0xac, 0xbc, 0x0b, 0x98, 0x6a, 0xc0, 0x3b, 0xa7,
-	0xf2, 0xc8, 0x0a, 0x3f, 0x16, 0x69, 0x2a, 0x78, 0x89, 0xf0, 0x9e, 0x5e, 0x27, 0x97, 0x47, 0xca,
-	0xb4, 0x31, 0x18, 0x27, 0xfb, 0xc1, 0x3e, 0xd3, 0xc2, 0x96, 0xf1, 0x34, 0xf4, 0xdd, 0x0a, 0xb5,
-	0x9d, 0x9b, 0x27, 0x08, 0xc7, 0xd5, 0x52, 0x79, 0x16, 0x66, 0xab, 0xa5, 0x72, 0x19, 0xca, 0xd5,
-	0x52, 0xf9, 0x05, 0xec, 0x54, 0x4b, 0xe5, 0x36, 0xfc, 0xb7, 0x5a, 0x2a, 0xbf, 0x82, 0x57, 0xd5,
-	0x52, 0xf9, 0x10, 0x0e, 0xab, 0xa5, 0xf2, 0x11, 0x1c, 0x55, 0x4b, 0xe5, 0x9f, 0x60, 0xb0, 0xf1,
-	0x84, 0xd0, 0xf6, 0xfe, 0xe0, 0x30, 0xee, 0x0d, 0x06, 0xfb, 0x6f, 0x7a, 0xea, 0xe3, 0xc1, 0xd0,
-	0x3e, 0x6a, 0x16, 0xc9, 0x5c, 0x33, 0x45, 0x69, 0x9f, 0x35, 0x4b, 0x64, 0x01, 0xf7, 0xd0, 0x4b,
-	0x35, 0xc2, 0x74, 0x6d, 0xf7, 0xf7, 0xcf, 0x95, 0xe9, 0x4f, 0x9f, 0x2b, 0xd3, 0x7f, 0x7d, 0xae,
-	0x4c, 0xff, 0xf6, 0xa5, 0x32, 0xf5, 0xe9, 0x4b, 0x65, 0xea, 0xcf, 0x2f, 0x95, 0xa9, 0x97, 0xcf,
-	0xde, 0xbc, 0x1b, 0xbe, 0xfd, 0x78, 0xb0, 0xd9, 0xed, 0x1f, 0x6e, 0xb9, 0x07, 0x6b, 0xfe, 0xf3,
-	0x74, 0xf0, 0xfa, 0xfd, 0x96, 0x0d, 0x5a, 0x78, 0xc1, 0x1e, 0xcc, 0x67, 0x0f, 0xd7, 0x17, 0x7f,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xa5, 0xb9, 0xf2, 0xe0, 0x0a, 0x00, 0x00,
+	// 1361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xcd, 0x76, 0x13, 0xb9,
+	0x12, 0xce, 0x8f, 0x93, 0x38, 0x4a, 0x80, 0x8a, 0xf8, 0x27, 0xc1, 0x80, 0xc9, 0x05, 0x6e, 0x80,
+	0x04, 0xc2, 0xee, 0xee, 0xe4, 0xee, 0x8a, 0xdd, 0xe9, 0x6e, 0xa9, 0x2d, 0xa9, 0xed, 0x98, 0x73,
+	0x2f, 0xba, 0x8e, 0xf1, 0x00, 0x03, 0x89, 0x33, 0xb1, 0x99, 0x73, 0xe6, 0x19, 0x66, 0x33, 0x8f,
+	0x35, 0x4b, 0x96, 0xb3, 0x9b, 0x39, 0xf0, 0x22, 0x73, 0xd4, 0x96, 0x1d, 0xbb, 0x09, 0xc3, 0xca,
+	0xc7, 0xf5, 0x7d, 0x55, 0x52, 0x7d, 0x55, 0x5d, 0x25, 0xb2, 0xd9, 0xe9, 0xf5, 0x8f, 0x7a, 0xfd,
+	0x9d, 0x76, 0xa7, 0xd3, 0xed, 0xf7, 0x3b, 0xbd, 0xe3, 0xc1, 0x69, 0xef, 0xc3, 0x4e, 0xa7, 0x77,
+	0xdc, 0x1f, 0xb4, 0x8f, 0x07, 0xfd, 0xed, 0x93, 0xd3, 0xde, 0xa0, 0x47, 0x37, 0x86, 0xac, 0xed,
+	0x29, 0xd6, 0xf6, 0xcf, 0xcf, 0x0f, 0xbb, 0x83, 0xf6, 0xf3, 0xad, 0xff, 0x10, 0xc2, 0x32, 0x40,
+	0xff, 0x72, 0xd2, 0xa5, 0x2b, 0x64, 0x29, 0xe5, 0x21, 0x17, 0x4d, 0x0e, 0x33, 0xb4, 0x48, 0x0a,
+	0x12, 0x99, 0x0f, 0xb3, 0x74, 0x99, 0x2c, 0x34, 0x65, 0xa0, 0x11, 0xe6, 0x28, 0x21, 0x8b, 0x9e,
+	0x88, 0xe3, 0x40, 0xc3, 0xfc, 0xd6, 0xaf, 0x73, 0x64, 0x7d, 0xe8, 0x2c, 0x4e, 0xba, 0xa7, 0xed,
+	0xc1, 0xbb, 0xde, 0xb1, 0xea, 0x7e, 0xe8, 0x76, 0x06, 0xbd, 0xd3, 0x2c, 0x5a, 0x91, 0x14, 0xb8,
+	0xe0, 0x08, 0x33, 0x74, 0x91, 0xcc, 0xed, 0xd7, 0x61, 0x96, 0x5e, 0x25, 0x6b, 0xfb, 0x75, 0x53,
+	0x41, 0xaf, 0xf6, 0x62, 0xd7, 0x30, 0xdf, 0x97, 0xa8, 0x14, 0xcc, 0xd1, 0x12, 0xb9, 0xb5, 0x5f,
+	0x37, 0x11, 0xf2, 0xaa, 0xae, 0x99, 0x44, 0xe2, 0x5e, 0x70, 0x80, 0xfe, 0x18, 0x9f, 0xa7, 0x37,
+	0xc9, 0x55, 0x85, 0xdc, 0x47, 0x99, 0x77, 0x2d, 0xd0, 0x32, 0x29, 0x39, 0xe8, 0x5b, 0xee, 0x0b,
+	0xf4, 0x0a, 0x01, 0x4f, 0x70, 0x2d, 0x99, 0xa7, 0xc7, 0xd6, 0x45, 0x7a, 0x8b, 0x5c, 0xdb, 0xaf,
+	0x9b, 0x18, 0x95, 0x62, 0x55, 0x34, 0x9e, 0xe0, 0x7e, 0xa0, 0x03, 0xc1, 0x59, 0x04, 0x4b, 0x16,
+	0xf3, 0x04, 0x57, 0x9a, 0x71, 0x6d, 0x94, 0x96, 0x01, 0xaf, 0x1a, 0x2d, 0x4c, 0x0d, 0x0f, 0xa0,
+	0x48, 0xaf, 0x11, 0x3a, 0x8e, 0x26, 0x71, 0x0f, 0x25, 0x72, 0x0f, 0x61, 0x79, 0xeb, 0xcf, 0x35,
+	0xb2, 0x2a, 0xbb, 0xfd, 0xde, 0xc7, 0xd3, 0x4e, 0x37, 0x4b, 0x7f, 0x89, 0xcc, 0x33, 0xde, 0x1a,
+	0x66, 0x1f, 0x36, 0x60, 0xd6, 0x1a, 0xe2, 0xee, 0x11, 0xcc, 0x59, 0x99, 0xc3, 0x86, 0xa9, 0x30,
+	0x1e, 0x42, 0x81, 0x5e, 0x24, 0x24, 0x6c, 0x18, 0xa5, 0x59, 0x18, 0xf0, 0x2a, 0x2c, 0x38, 0xb0,
+	0xc9, 0x54, 0x0c, 0x8b, 0xf4, 0x02, 0x59, 0x0e, 0x1b, 0x46, 0x48, 0xe6, 0x45, 0x08, 0x4b, 0x74,
+	0x95, 0x14, 0xc3, 0x86, 0xc1, 0x44, 0x78, 0x35, 0x58, 0xa6, 0x97, 0xc9, 0xa5, 0xb0, 0x61, 0xb4,
+	0x08, 0x91, 0xef, 0x31, 0x4f, 0x0b, 0xd9, 0x02, 0x62, 0xaf, 0x3e, 0xf6, 0x30, 0x0d, 0xa1, 0xd1,
+	0x68, 0x26, 0xab, 0xa8, 0x15, 0xac, 0xd0, 0xdb, 0xe4, 0xe6, 0x19, 0xc6, 0xaa, 0x55, 0x89, 0x55,
+	0xa6, 0x87, 0x2c, 0x05, 0xab, 0xb6, 0x3a, 0x67, 0xf0, 0x1e, 0xa2, 0x8f, 0x52, 0xc1, 0x05, 0xab,
+	0xfe, 0xd9, 0x05, 0x8d, 0x8f, 0x91, 0xf5, 0x0a, 0x04, 0x87, 0x8b, 0xf4, 0x06, 0xb9, 0x32, 0x01,
+	0x35, 0x58, 0x14, 0xf8, 0x4c, 0x0b, 0x09, 0x97, 0x5c, 0x16, 0x2c, 0xd5, 0x35, 0x00, 0x17, 0xc1,
+	0xfe, 0x19, 0xe9, 0x6f, 0x94, 0x16, 0x12, 0x61, 0x8d, 0x52, 0x72, 0xd1, 0x49, 0x61, 0x54, 0x9a,
+	0x24, 0x51, 0x0b, 0x28, 0x5d, 0x23, 0x17, 0x46, 0x36, 0x1f, 0xb9, 0x88, 0xe1, 0xb2, 0x2d, 0xe1,
+	0xc8, 0x54, 0x61, 0x11, 0xe3, 0x1e, 0x2a, 0xb8, 0xe2, 0xe2, 0x4e, 0x0a, 0xe0, 0x1c, 0xae, 0xd2,
+	0x0d, 0x72, 0x23, 0x0f, 0xc5, 0xa8, 0x99, 0xcf, 0x34, 0x83, 0x6b, 0xe7, 0x39, 0x32, 0x3f, 0x0e,
+	0x38, 0x5c, 0xa7, 0xeb, 0xe4, 0x7a, 0x1e, 0xf2, 0x24, 0x66, 0x59, 0xdd, 0x70, 0xa0, 0x53, 0x08,
+	0x0f, 0xbc, 0x1a, 0xe3, 0x55, 0x34, 0x92, 0x69, 0x84, 0x9b, 0xb6, 0x15, 0x73, 0xca, 0x27, 0xc8,
+	0x59, 0xa4, 0x5b, 0xc6, 0x13, 0x29, 0xd7, 0x28, 0xe1, 0x96, 0xbb, 0x96, 0xe3, 0x24, 0x32, 0xf0,
+	0xd0, 0x28, 0xce, 0x12, 0x55, 0x13, 0x1a, 0xd6, 0xe9, 0x1d, 0xb2, 0xfe, 0xb5, 0x9c, 0x81, 0xe0,
+	0x26, 0x11, 0x4d, 0x94, 0xb0, 0xe1, 0x8a, 0x3b, 0x22, 0x68, 0xa1, 0x59, 0xe4, 0xb0, 0xdb, 0xee,
+	0xf8, 0xaf, 0x6a, 0xa1, 0x6c, 0x6b, 0x67, 0xb2, 0x43, 0x89, 0xde, 0x27, 0x77, 0x26, 0x38, 0x29,
+	0xaf, 0xd8, 0xae, 0x9f, 0x2e, 0xea, 0x1d, 0xfa, 0x90, 0xdc, 0xff, 0x0e, 0xc9, 0x46, 0x87, 0xbb,
+	0x4e, 0x8d, 0x11, 0x51, 0xe2, 0x44, 0x94, 0x7b, 0xb9, 0xa3, 0x24, 0x4e, 0x7b, 0x1b, 0x25, 0x3d,
+	0x28, 0x7f, 0x8f, 0xe4, 0x2b, 0x0d, 0xf7, 0xe9, 0x3d, 0x72, 0xfb, 0x5b, 0xa4, 0x7a, 0x8a, 0x29,
+	0xc2, 0xa6, 0x1d, 0x20, 0xe7, 0xe5, 0xee, 0xf0, 0x7f, 0xe5, 0xf0, 0x5a, 0x60, 0xbb, 0x2f, 0xf0,
+	0x58, 0x64, 0x02, 0xbe, 0x27, 0xe0, 0x41, 0xae, 0x8f, 0xc7, 0x29, 0xc3, 0xc3, 0x6f, 0xab, 0x5a,
+	0x69, 0x39, 0xe5, 0xff, 0xed, 0xbe, 0x43, 0x3f, 0xb0, 0x93, 0xa2, 0x92, 0x66, 0xf9, 0x3f, 0x72,
+	0x95, 0x9e, 0x34, 0xda, 0x4f, 0xca, 0x24, 0x42, 0x44, 0xb0, 0x45, 0xef, 0x92, 0x8d, 0x3c, 0x9a,
+	0x48, 0x91, 0x08, 0x85, 0xd2, 0x84, 0xd8, 0x82, 0xc7, 0xae, 0x0a, 0x53, 0x0c, 0x91, 0x6a, 0x3b,
+	0x92, 0xfc, 0xa1, 0x0c, 0x4d, 0x26, 0x7d, 0x05, 0x4f, 0xe8, 0x63, 0xf2, 0x30, 0x4f, 0x74, 0x0a,
+	0x09, 0x69, 0x9a, 0x81, 0xae, 0xf9, 0x92, 0x35, 0x87, 0x0d, 0xf0, 0xf4, 0x9f, 0xc9, 0x4a, 0x33,
+	0xa9, 0x6d, 0xf0, 0x4c, 0x95, 0x6d, 0xba, 0x45, 0x1e, 0xe4, 0xc9, 0xb6, 0x2a, 0x13, 0xf2, 0x8d,
+	0x6e, 0xb1, 0x73, 0xde, 0x75, 0x2d, 0xd7, 0x4b, 0xa5, 0x44, 0xae, 0xc7, 0xc4, 0x67, 0xf4, 0x11,
+	0xd9, 0x3c, 0x8f, 0xc8, 0x3c, 0x2f, 0x8d, 0x4d, 0xb6, 0x5a, 0x94, 0xb2, 0x0a, 0x3e, 0x77, 0x5f,
+	0xc3, 0x14, 0x53, 0x45, 0x4c, 0xd5, 0x0c, 0x36, 0x90, 0x6b, 0xd8, 0x75, 0x43, 0x81, 0x79, 0x1e,
+	0x2a, 0x95, 0x8d, 0x64, 0x11, 0x41, 0x95, 0x3e, 0x21, 0x8f, 0xf2, 0xd6, 0x6c, 0x9a, 0x1a, 0x1f,
+	0x13, 0xbb, 0x29, 0xb8, 0xd7, 0x32, 0x31, 0x4b, 0x12, 0x5b, 0xdf, 0x1a, 0x05, 0xb2, 0xea, 0xa6,
+	0xad, 0xf1, 0x84, 0x8f, 0x10, 0xb8, 0xc2, 0x39, 0x4b, 0x6e, 0x6b, 0xec, 0xbb, 0x2f, 0x70, 0x1a,
+	0x1d, 0xce, 0xb2, 0xd0, 0x75, 0x51, 0x86, 0x29, 0xac, 0xa7, 0x76, 0x2f, 0x64, 0xc5, 0x8c, 0x5c,
+	0x0b, 0x4f, 0x7b, 0xd9, 0xe3, 0x9c, 0x96, 0x2d, 0x88, 0x5d, 0xb6, 0xd3, 0x94, 0x4a, 0x6b, 0xc8,
+	0x0a, 0x7c, 0xe0, 0x6e, 0x78, 0x67, 0x84, 0x24, 0xe0, 0x1c, 0x7d, 0x87, 0x71, 0x1f, 0x0f, 0x40,
+	0xb8, 0x23, 0xb2, 0x19, 0x5b, 0x8d, 0x44, 0x65, 0x28, 0xa9, 0x9d, 0x3b, 0x86, 0xa7, 0x71, 0x05,
+	0x25, 0x24, 0x6e, 0x7b, 0x58, 0xca, 0x4b, 0xa8, 0xd3, 0x4b, 0x64, 0x25, 0x6c, 0xd8, 0x9e, 0xac,
+	0x4a, 0xc6, 0x35, 0x48, 0x37, 0x14, 0x47, 0x06, 0xc3, 0xa2, 0x48, 0x34, 0xed, 0xa4, 0x05, 0xe5,
+	0xb8, 0x99, 0xfa, 0x56, 0x36, 0xed, 0xfa, 0x77, 0x64, 0x18, 0x7e, 0xd1, 0x41, 0x95, 0x8f, 0x9b,
+	0x27, 0x75, 0x75, 0x1e, 0x33, 0xac, 0x82, 0x26, 0x49, 0x2b, 0x21, 0xb6, 0x8c, 0xc4, 0x68, 0xf8,
+	0xf9, 0x5a, 0x71, 0x1a, 0x93, 0xb3, 0xdd, 0xb7, 0xfb, 0x54, 0xa2, 0x0f, 0xff, 0xa3, 0x9b, 0xe4,
+	0x6e, 0xde, 0x6a, 0x62, 0xe1, 0xa7, 0x11, 0x1a, 0x7d, 0xe0, 0xb2, 0x36, 0xf6, 0x39, 0x62, 0x17,
+	0x62, 0x23, 0x86, 0xff, 0xbb, 0x55, 0x82, 0x8d, 0x78, 0xb4, 0x22, 0xa0, 0xed, 0x62, 0x5b, 0x9b,
+	0x96, 0x8c, 0xab, 0xc0, 0x36, 0xce, 0xa1, 0x2b, 0xb1, 0xb5, 0x8e, 0x34, 0x3a, 0x43, 0x3b, 0x6e,
+	0xac, 0x59, 0x74, 0x74, 0xde, 0x18, 0x7c, 0xed, 0xfa, 0xc5, 0x82, 0x5c, 0xd8, 0x23, 0xba, 0x13,
+	0xc7, 0x4a, 0xf4, 0x30, 0x48, 0x34, 0xfc, 0xe0, 0x76, 0xba, 0xb5, 0xa9, 0x5d, 0x84, 0x37, 0x13,
+	0xff, 0x71, 0x57, 0xc1, 0xdb, 0x89, 0x6b, 0x0d, 0xbb, 0x80, 0xa9, 0x1a, 0xbc, 0x73, 0x2a, 0x8f,
+	0xac, 0xf0, 0x63, 0x9e, 0xa6, 0x82, 0x97, 0x08, 0xef, 0xe9, 0x75, 0x72, 0x79, 0xa4, 0x4c, 0x13,
+	0x83, 0x71, 0xb2, 0x1f, 0xec, 0x33, 0x2d, 0x6c, 0x18, 0x4f, 0x43, 0xcf, 0xad, 0x50, 0x4f, 0x8f,
+	0x12, 0x84, 0x13, 0x77, 0xf3, 0x3d, 0xc4, 0x98, 0xc9, 0x10, 0x35, 0x0c, 0xca, 0x85, 0xe2, 0x3c,
+	0xcc, 0x97, 0x0b, 0xc5, 0x22, 0x14, 0xcb, 0x85, 0xe2, 0x0b, 0xd8, 0x2b, 0x17, 0x8a, 0x4d, 0xf8,
+	0x6f, 0xb9, 0x50, 0x7c, 0x05, 0xaf, 0xca, 0x85, 0xe2, 0x11, 0x1c, 0x95, 0x0b, 0xc5, 0x63, 0x38,
+	0x2e, 0x17, 0x8a, 0x3f, 0x41, 0x7f, 0xeb, 0x09, 0xa1, 0xcd, 0x76, 0xff, 0x28, 0xee, 0xf6, 0xfb,
+	0xed, 0x37, 0x5d, 0xf5, 0xf1, 0x70, 0x60, 0x9f, 0x39, 0xcb, 0x64, 0xa1, 0x9e, 0xa2, 0xb4, 0x0f,
+	0x9d, 0x15, 0xb2, 0x84, 0x07, 0xe8, 0xa5, 0x1a, 0x61, 0xb6, 0xb2, 0xff, 0xfb, 0xe7, 0xd2, 0xec,
+	0xa7, 0xcf, 0xa5, 0xd9, 0xbf, 0x3e, 0x97, 0x66, 0x7f, 0xfb, 0x52, 0x9a, 0xf9, 0xf4, 0xa5, 0x34,
+	0xf3, 0xc7, 0x97, 0xd2, 0xcc, 0xcb, 0x67, 0x6f, 0xde, 0x0d, 0xde, 0x7e, 0x3c, 0xdc, 0xee, 0xf4,
+	0x8e, 0x76, 0xdc, 0x13, 0x76, 0xf8, 0xf3, 0xb4, 0xff, 0xfa, 0xfd, 0x8e, 0x0d, 0x9a, 0x7b, 0xd3,
+	0x1e, 0x2e, 0x66, 0x4f, 0xd9, 0x17, 0x7f, 0x0f, 0x00, 0xf1, 0xc4, 0x00, 0xc9, 0xf2, 0x0a, 0x00,
+	0x00,
 }
@@ -0,0 +1,309 @@
+package accesscontrol
+
+import (
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	EventTypeAccessMismatch = "access_mismatch"
+
+	AttributeKeyMessageKey   = "message_key"
+	AttributeKeyMismatchType = "mismatch_type"
+	AttributeKeyStoreKey     = "store_key"
+	AttributeKeyResourceType = "resource_type"
+	AttributeKeyIdentifier   = "identifier"
+	AttributeKeyAccessType   = "access_type"
+
+	// maxMismatchesPerMessage bounds the distinct mismatches kept per message key by a ValidationReport, since the
+	// identifiers of the observed keys are usually unbounded (e.g. balances keyed by address)
+	maxMismatchesPerMessage = 100
+)
+
+// AccessRecorder records the store keys accessed by the execution of a message
+type AccessRecorder struct {
+	mtx      sync.Mutex
+	accesses map[Comparator]struct{}
+	order    []Comparator
+}
+
+func NewAccessRecorder() *AccessRecorder {
+	return &AccessRecorder{accesses: make(map[Comparator]struct{})}
+}
+
+// RecordAccess records an access to a key of a store. Iterations are recorded as reads of their start key.
+func (r *AccessRecorder) RecordAccess(storeKey string, accessType AccessType, key []byte) {
+	access := Comparator{AccessType: accessType, Identifier: hex.EncodeToString(key), StoreKey: storeKey}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if _, ok := r.accesses[access]; ok {
+		return
+	}
+	r.accesses[access] = struct{}{}
+	r.order = append(r.order, access)
+}
+
+// Accesses returns the distinct accesses recorded in the order they happened
+func (r *AccessRecorder) Accesses() []Comparator {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return append([]Comparator{}, r.order...)
+}
+
+// MismatchType is the kind of difference between the declared access operations of a message and its execution
+type MismatchType int
+
+const (
+	// MismatchType_MISSING_WRITE is an observed write that no declared access operation covers
+	MismatchType_MISSING_WRITE MismatchType = iota + 1
+	// MismatchType_MISSING_READ is an observed read that no declared access operation covers
+	MismatchType_MISSING_READ
+	// MismatchType_OVERBROAD_UNKNOWN is a declared UNKNOWN access operation that only covered reads, which a READ
+	// access operation would have covered without serializing the message with the other readers of the resource
+	MismatchType_OVERBROAD_UNKNOWN
+)
+
+func (m MismatchType) String() string {
+	switch m {
+	case MismatchType_MISSING_WRITE:
+		return "MISSING_WRITE"
+	case MismatchType_MISSING_READ:
+		return "MISSING_READ"
+	case MismatchType_OVERBROAD_UNKNOWN:
+		return "OVERBROAD_UNKNOWN"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// AccessMismatch is a difference between the declared access operations of a message and its execution. Access is the
+// observed access of the missing reads and writes, and AccessOp is the declared access operation of the over-broad
+// UNKNOWNs.
+type AccessMismatch struct {
+	Type     MismatchType
+	Access   Comparator
+	AccessOp AccessOperation
+}
+
+// CompareAccessOperations compares the access operations declared for a message with the accesses observed while it
+// was executed, and returns the observed accesses no access operation covers and the over-broad UNKNOWN access
+// operations
+func (validator *MsgValidator) CompareAccessOperations(accessOps []AccessOperation, accesses []Comparator) []AccessMismatch {
+	// If it's using default synchronous access op mapping then no need to verify
+	if IsDefaultSynchronousAccessOps(accessOps) {
+		return nil
+	}
+
+	var mismatches []AccessMismatch
+	// the access types covered by each UNKNOWN access operation
+	unknownCovers := make(map[int]map[AccessType]bool)
+	for _, access := range accesses {
+		if access.IsConcurrentSafeIdentifier() {
+			continue
+		}
+		matched := false
+		for i, accessOp := range accessOps {
+			prefix, ok := validator.GetPrefix(access.StoreKey, accessOp.GetResourceType())
+			if !ok || !access.DependencyMatch(accessOp, prefix) {
+				continue
+			}
+			matched = true
+			if accessOp.AccessType == AccessType_UNKNOWN {
+				if unknownCovers[i] == nil {
+					unknownCovers[i] = make(map[AccessType]bool)
+				}
+				unknownCovers[i][access.AccessType] = true
+			}
+		}
+		if matched {
+			continue
+		}
+		mismatchType := MismatchType_MISSING_READ
+		if access.AccessType == AccessType_WRITE {
+			mismatchType = MismatchType_MISSING_WRITE
+		}
+		mismatches = append(mismatches, AccessMismatch{Type: mismatchType, Access: access})
+	}
+	for i, accessOp := range accessOps {
+		if covers, ok := unknownCovers[i]; ok && covers[AccessType_READ] && !covers[AccessType_WRITE] {
+			mismatches = append(mismatches, AccessMismatch{Type: MismatchType_OVERBROAD_UNKNOWN, AccessOp: accessOp})
+		}
+	}
+	return mismatches
+}
+
+// ResourceTypeOf returns the most specific resource type of the store key whose prefix the identifier starts with,
+// and ResourceType_KV if the store key isn't mapped
+func (validator *MsgValidator) ResourceTypeOf(storeKey string, identifier string) ResourceType {
+	resourceType, prefixLen := ResourceType_KV, -1
+	for candidate, prefix := range validator.storeKeyToResourceTypePrefixMap[storeKey] {
+		encodedPrefix := hex.EncodeToString(prefix)
+		if !strings.HasPrefix(identifier, encodedPrefix) {
+			continue
+		}
+		// prefer the longest prefix, then the leaves of the resource tree, then the lowest resource type so that the
+		// result doesn't depend on the map iteration order
+		switch {
+		case len(encodedPrefix) != prefixLen:
+			if len(encodedPrefix) < prefixLen {
+				continue
+			}
+		case candidate.HasChildren() != resourceType.HasChildren():
+			if candidate.HasChildren() {
+				continue
+			}
+		case candidate > resourceType:
+			continue
+		}
+		resourceType, prefixLen = candidate, len(encodedPrefix)
+	}
+	return resourceType
+}
+
+// TracedAccess is an access of an AccessTrace
+type TracedAccess struct {
+	StoreKey     string `json:"store_key"`
+	ResourceType string `json:"resource_type"`
+	Identifier   string `json:"identifier"`
+	AccessType   string `json:"access_type"`
+}
+
+// AccessTrace is a line of the access observer's trace, the accesses of an execution of a message
+type AccessTrace struct {
+	Height     int64  `json:"height"`
+	MessageKey string `json:"message_key"`
+	// ContractAddress is the contract executed by the message, if any
+	ContractAddress string         `json:"contract_address,omitempty"`
+	Accesses        []TracedAccess `json:"accesses"`
+}
+
+// NewAccessTrace creates the trace of the accesses of an execution of a message, resolving their resource types
+func (validator *MsgValidator) NewAccessTrace(height int64, messageKey string, contractAddress string, accesses []Comparator) AccessTrace {
+	trace := AccessTrace{
+		Height:          height,
+		MessageKey:      messageKey,
+		ContractAddress: contractAddress,
+		Accesses:        make([]TracedAccess, 0, len(accesses)),
+	}
+	for _, access := range accesses {
+		trace.Accesses = append(trace.Accesses, TracedAccess{
+			StoreKey:     access.StoreKey,
+			ResourceType: validator.ResourceTypeOf(access.StoreKey, access.Identifier).String(),
+			Identifier:   access.Identifier,
+			AccessType:   access.AccessType.String(),
+		})
+	}
+	return trace
+}
+
+// MismatchRecord is a mismatch of a message key with the number of times and the last height it was observed
+type MismatchRecord struct {
+	AccessMismatch
+	Count      uint64
+	LastHeight int64
+}
+
+// MessageValidationReport aggregates the mismatches observed for the executions of a message key
+type MessageValidationReport struct {
+	MessageKey string
+	// Executions is the number of executions observed
+	Executions uint64
+	// Mismatches are sorted by type, store key and identifier
+	Mismatches []MismatchRecord
+	// DroppedMismatches is the number of distinct mismatches that weren't kept since the report was full
+	DroppedMismatches uint64
+}
+
+// ValidationReport aggregates the mismatches observed for the executed messages per message key
+type ValidationReport struct {
+	mtx     sync.RWMutex
+	reports map[string]*messageValidationReport
+}
+
+type messageValidationReport struct {
+	executions uint64
+	mismatches map[AccessMismatch]*MismatchRecord
+	dropped    uint64
+}
+
+func NewValidationReport() *ValidationReport {
+	return &ValidationReport{reports: make(map[string]*messageValidationReport)}
+}
+
+// Add adds the mismatches of an execution of a message key at a height
+func (r *ValidationReport) Add(messageKey string, height int64, mismatches []AccessMismatch) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	report, ok := r.reports[messageKey]
+	if !ok {
+		report = &messageValidationReport{mismatches: make(map[AccessMismatch]*MismatchRecord)}
+		r.reports[messageKey] = report
+	}
+	report.executions++
+	for _, mismatch := range mismatches {
+		record, ok := report.mismatches[mismatch]
+		if !ok {
+			if len(report.mismatches) >= maxMismatchesPerMessage {
+				report.dropped++
+				continue
+			}
+			record = &MismatchRecord{AccessMismatch: mismatch}
+			report.mismatches[mismatch] = record
+		}
+		record.Count++
+		record.LastHeight = height
+	}
+}
+
+// Get returns the report of a message key, and false if no execution of the message key was observed
+func (r *ValidationReport) Get(messageKey string) (MessageValidationReport, bool) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	report, ok := r.reports[messageKey]
+	if !ok {
+		return MessageValidationReport{}, false
+	}
+	return report.export(messageKey), true
+}
+
+// All returns the reports of all the message keys sorted by message key
+func (r *ValidationReport) All() []MessageValidationReport {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	reports := make([]MessageValidationReport, 0, len(r.reports))
+	for messageKey, report := range r.reports {
+		reports = append(reports, report.export(messageKey))
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].MessageKey < reports[j].MessageKey
+	})
+	return reports
+}
+
+func (r *messageValidationReport) export(messageKey string) MessageValidationReport {
+	mismatches := make([]MismatchRecord, 0, len(r.mismatches))
+	for _, record := range r.mismatches {
+		mismatches = append(mismatches, *record)
+	}
+	sort.Slice(mismatches, func(i, j int) bool {
+		a, b := mismatches[i], mismatches[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Access.StoreKey != b.Access.StoreKey {
+			return a.Access.StoreKey < b.Access.StoreKey
+		}
+		if a.Access.Identifier != b.Access.Identifier {
+			return a.Access.Identifier < b.Access.Identifier
+		}
+		return a.AccessOp.String() < b.AccessOp.String()
+	})
+	return MessageValidationReport{
+		MessageKey:        messageKey,
+		Executions:        r.executions,
+		Mismatches:        mismatches,
+		DroppedMismatches: r.dropped,
+	}
+}
@@ -0,0 +1,131 @@
+package accesscontrol
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessRecorder(t *testing.T) {
+	recorder := NewAccessRecorder()
+	recorder.RecordAccess("bank", AccessType_READ, []byte{1, 2})
+	recorder.RecordAccess("bank", AccessType_WRITE, []byte{1, 2})
+	recorder.RecordAccess("bank", AccessType_READ, []byte{1, 2})
+	require.Equal(t, []Comparator{
+		{AccessType: AccessType_READ, Identifier: "0102", StoreKey: "bank"},
+		{AccessType: AccessType_WRITE, Identifier: "0102", StoreKey: "bank"},
+	}, recorder.Accesses())
+}
+
+func TestCompareAccessOperations(t *testing.T) {
+	balances := hex.EncodeToString([]byte{2})
+	prefixMap := StoreKeyToResourceTypePrefixMap{
+		ParentNodeKey: {ResourceType_ANY: EmptyPrefix, ResourceType_KV: EmptyPrefix},
+		"bank":        {ResourceType_KV_BANK_BALANCES: []byte{2}, ResourceType_KV_BANK_SUPPLY: []byte{0}},
+	}
+	read := Comparator{AccessType: AccessType_READ, Identifier: balances + "aa", StoreKey: "bank"}
+	write := Comparator{AccessType: AccessType_WRITE, Identifier: balances + "bb", StoreKey: "bank"}
+	tests := []struct {
+		name      string
+		accessOps []AccessOperation
+		accesses  []Comparator
+		want      []AccessMismatch
+	}{
+		{
+			name:      "synchronous",
+			accessOps: SynchronousAccessOps(),
+			accesses:  []Comparator{read, write},
+		},
+		{
+			name: "covered",
+			accessOps: []AccessOperation{
+				{AccessType: AccessType_READ, ResourceType: ResourceType_KV_BANK_BALANCES, IdentifierTemplate: balances + "aa"},
+				{AccessType: AccessType_WRITE, ResourceType: ResourceType_KV_BANK_BALANCES, IdentifierTemplate: balances},
+			},
+			accesses: []Comparator{read, write},
+		},
+		{
+			name: "missing read and write",
+			accessOps: []AccessOperation{
+				{AccessType: AccessType_WRITE, ResourceType: ResourceType_KV_BANK_BALANCES, IdentifierTemplate: balances + "aa"},
+				{AccessType: AccessType_READ, ResourceType: ResourceType_KV_BANK_BALANCES, IdentifierTemplate: balances + "bb"},
+			},
+			accesses: []Comparator{read, write, {AccessType: AccessType_WRITE, Identifier: "aa", StoreKey: "params"}},
+			want: []AccessMismatch{
+				{Type: MismatchType_MISSING_READ, Access: read},
+				{Type: MismatchType_MISSING_WRITE, Access: write},
+			},
+		},
+		{
+			name: "over-broad unknown",
+			accessOps: []AccessOperation{
+				{AccessType: AccessType_UNKNOWN, ResourceType: ResourceType_KV_BANK_BALANCES, IdentifierTemplate: balances + "aa"},
+				{AccessType: AccessType_UNKNOWN, ResourceType: ResourceType_KV_BANK_BALANCES, IdentifierTemplate: balances + "bb"},
+			},
+			accesses: []Comparator{read, write},
+			want: []AccessMismatch{{
+				Type:     MismatchType_OVERBROAD_UNKNOWN,
+				AccessOp: AccessOperation{AccessType: AccessType_UNKNOWN, ResourceType: ResourceType_KV_BANK_BALANCES, IdentifierTemplate: balances + "aa"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewMsgValidator(prefixMap)
+			require.Equal(t, tt.want, validator.CompareAccessOperations(tt.accessOps, tt.accesses))
+		})
+	}
+}
+
+func TestValidationReport(t *testing.T) {
+	report := NewValidationReport()
+	missingRead := AccessMismatch{Type: MismatchType_MISSING_READ, Access: Comparator{AccessType: AccessType_READ, Identifier: "aa", StoreKey: "bank"}}
+	missingWrite := AccessMismatch{Type: MismatchType_MISSING_WRITE, Access: Comparator{AccessType: AccessType_WRITE, Identifier: "bb", StoreKey: "bank"}}
+	report.Add("msgA", 1, []AccessMismatch{missingRead})
+	report.Add("msgA", 2, []AccessMismatch{missingWrite, missingRead})
+	report.Add("msgB", 2, nil)
+
+	msgA, ok := report.Get("msgA")
+	require.True(t, ok)
+	require.Equal(t, MessageValidationReport{
+		MessageKey: "msgA",
+		Executions: 2,
+		Mismatches: []MismatchRecord{
+			{AccessMismatch: missingWrite, Count: 1, LastHeight: 2},
+			{AccessMismatch: missingRead, Count: 2, LastHeight: 2},
+		},
+	}, msgA)
+	_, ok = report.Get("msgC")
+	require.False(t, ok)
+
+	all := report.All()
+	require.Len(t, all, 2)
+	require.Equal(t, "msgB", all[1].MessageKey)
+	require.Empty(t, all[1].Mismatches)
+
+	// the distinct mismatches kept per message key are bounded
+	for i := 0; i < maxMismatchesPerMessage; i++ {
+		access := Comparator{AccessType: AccessType_READ, Identifier: hex.EncodeToString([]byte{byte(i)}), StoreKey: "acc"}
+		report.Add("msgA", 3, []AccessMismatch{{Type: MismatchType_MISSING_READ, Access: access}})
+	}
+	msgA, _ = report.Get("msgA")
+	require.Len(t, msgA.Mismatches, maxMismatchesPerMessage)
+	require.Equal(t, uint64(2), msgA.DroppedMismatches)
+}
+
+func TestResourceTypeOf(t *testing.T) {
+	validator := NewMsgValidator(StoreKeyToResourceTypePrefixMap{
+		"bank": {ResourceType_KV_BANK: EmptyPrefix, ResourceType_KV_BANK_BALANCES: []byte{2}, ResourceType_KV_BANK_SUPPLY: []byte{0}},
+	})
+	require.Equal(t, ResourceType_KV_BANK_BALANCES, validator.ResourceTypeOf("bank", "02aa"))
+	require.Equal(t, ResourceType_KV_BANK, validator.ResourceTypeOf("bank", "05aa"))
+	require.Equal(t, ResourceType_KV, validator.ResourceTypeOf("acc", "01"))
+
+	trace := validator.NewAccessTrace(3, "msg", "", []Comparator{{AccessType: AccessType_WRITE, Identifier: "02aa", StoreKey: "bank"}})
+	require.Equal(t, AccessTrace{
+		Height:     3,
+		MessageKey: "msg",
+		Accesses:   []TracedAccess{{StoreKey: "bank", ResourceType: "KV_BANK_BALANCES", Identifier: "02aa", AccessType: "WRITE"}},
+	}, trace)
+}
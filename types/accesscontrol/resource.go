@@ -22,6 +22,7 @@ var ResourceTree = map[ResourceType]TreeNode{
 		ResourceType_KV_SLASHING,
 		ResourceType_KV_BANK_DEFERRED,
 		ResourceType_KV_EVM,
+		ResourceType_KV_FEEMARKET,
 	}},
 	ResourceType_Mem: {ResourceType_ANY, []ResourceType{}},
 	ResourceType_KV_BANK: {ResourceType_KV, []ResourceType{
@@ -169,6 +170,7 @@ var ResourceTree = map[ResourceType]TreeNode{
 	ResourceType_KV_EVM_CODE_HASH:         {ResourceType_KV_EVM, []ResourceType{}},
 	ResourceType_KV_EVM_CODE:              {ResourceType_KV_EVM, []ResourceType{}},
 	ResourceType_KV_EVM_CODE_SIZE:         {ResourceType_KV_EVM, []ResourceType{}},
+	ResourceType_KV_FEEMARKET:             {ResourceType_KV, []ResourceType{}},
 }
 
 // This returns a slice of all resource types that are dependent to a specific resource type
@@ -10,6 +10,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/store/accesskv"
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
 	stypes "github.com/cosmos/cosmos-sdk/store/types"
 	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
@@ -34,6 +35,7 @@ type Context struct {
 	logger            log.Logger
 	voteInfo          []abci.VoteInfo
 	gasMeter          GasMeter
+	gasSchedule       *GasSchedule
 	occEnabled        bool
 	blockGasMeter     GasMeter
 	checkTx           bool
@@ -61,9 +63,10 @@ type Context struct {
 	evmEntryViaWasmdPrecompile          bool   // EVM is entered via wasmd precompile directly
 	evmPrecompileCalledFromDelegateCall bool   // EVM precompile is called from a delegate call
 
-	msgValidator *acltypes.MsgValidator
-	messageIndex int // Used to track current message being processed
-	txIndex      int
+	msgValidator   *acltypes.MsgValidator
+	accessRecorder *acltypes.AccessRecorder // Records the store accesses of the message being processed
+	messageIndex   int                      // Used to track current message being processed
+	txIndex        int
 
 	traceSpanContext context.Context
 }
@@ -112,6 +115,12 @@ func (c Context) GasMeter() GasMeter {
 	return c.gasMeter
 }
 
+// GasSchedule returns the gas schedule the stores are charged with, or nil if they are charged the default
+// gas configs.
+func (c Context) GasSchedule() *GasSchedule {
+	return c.gasSchedule
+}
+
 func (c Context) IsCheckTx() bool {
 	return c.checkTx
 }
@@ -208,6 +217,10 @@ func (c Context) MsgValidator() *acltypes.MsgValidator {
 	return c.msgValidator
 }
 
+func (c Context) AccessRecorder() *acltypes.AccessRecorder {
+	return c.accessRecorder
+}
+
 // clone the header before returning
 func (c Context) BlockHeader() tmproto.Header {
 	msg := proto.Clone(&c.header).(*tmproto.Header)
@@ -343,6 +356,12 @@ func (c Context) WithGasMeter(meter GasMeter) Context {
 	return c
 }
 
+// WithGasSchedule returns a Context with an updated gas schedule of the stores.
+func (c Context) WithGasSchedule(schedule *GasSchedule) Context {
+	c.gasSchedule = schedule
+	return c
+}
+
 // WithIsCheckTx enables or disables CheckTx value for verifying transactions and returns an updated Context
 func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	c.checkTx = isCheckTx
@@ -423,6 +442,12 @@ func (c Context) WithMsgValidator(msgValidator *acltypes.MsgValidator) Context {
 	return c
 }
 
+// WithAccessRecorder returns a Context recording the accesses to its KVStores with the recorder
+func (c Context) WithAccessRecorder(recorder *acltypes.AccessRecorder) Context {
+	c.accessRecorder = recorder
+	return c
+}
+
 func (c Context) WithTraceSpanContext(ctx context.Context) Context {
 	c.traceSpanContext = ctx
 	return c
@@ -519,12 +544,28 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	gasConfig := stypes.KVGasConfig()
+	if c.gasSchedule != nil {
+		gasConfig = c.gasSchedule.KVGasConfig(key.Name())
+	}
+	return gaskv.NewStore(c.recordedKVStore(key), c.GasMeter(), gasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig())
+	gasConfig := stypes.TransientGasConfig()
+	if c.gasSchedule != nil {
+		gasConfig = c.gasSchedule.TransientGasConfig(key.Name())
+	}
+	return gaskv.NewStore(c.recordedKVStore(key), c.GasMeter(), gasConfig)
+}
+
+func (c Context) recordedKVStore(key StoreKey) KVStore {
+	store := c.MultiStore().GetKVStore(key)
+	if c.accessRecorder != nil {
+		return accesskv.NewStore(store, key, c.accessRecorder)
+	}
+	return store
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -12,6 +12,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -52,6 +53,34 @@ func (s *contextTestSuite) TestCacheContext() {
 	s.Require().Equal(v2, store.Get(k2))
 }
 
+func (s *contextTestSuite) TestContextGasSchedule() {
+	key := types.NewKVStoreKey(s.T().Name() + "_TestContextGasSchedule")
+	tkey := types.NewTransientStoreKey("transient_" + s.T().Name())
+	ctx := testutil.DefaultContext(key, tkey)
+	s.Require().Nil(ctx.GasSchedule())
+
+	readCost := func(ctx types.Context, transient bool) types.Gas {
+		ctx = ctx.WithGasMeter(types.NewInfiniteGasMeter(1, 1))
+		if transient {
+			ctx.TransientStore(tkey).Get([]byte("key"))
+		} else {
+			ctx.KVStore(key).Get([]byte("key"))
+		}
+		return ctx.GasMeter().GasConsumed()
+	}
+	kvGasConfig := storetypes.KVGasConfig()
+	s.Require().Equal(kvGasConfig.ReadCostFlat+3*kvGasConfig.ReadCostPerByte, readCost(ctx, false))
+	s.Require().Equal(storetypes.TransientGasConfig().ReadCostFlat, readCost(ctx, true))
+
+	schedule := storetypes.DefaultGasSchedule()
+	schedule.Stores[key.Name()] = types.GasConfig{ReadCostFlat: 7}
+	schedule.Transient.ReadCostFlat = 5
+	ctx = ctx.WithGasSchedule(schedule)
+	s.Require().Equal(schedule, ctx.GasSchedule())
+	s.Require().Equal(types.Gas(7), readCost(ctx, false))
+	s.Require().Equal(types.Gas(5), readCost(ctx, true))
+}
+
 func (s *contextTestSuite) TestLogContext() {
 	key := types.NewKVStoreKey(s.T().Name())
 	ctx := testutil.DefaultContext(key, types.NewTransientStoreKey("transient_"+s.T().Name()))
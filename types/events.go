@@ -294,6 +294,7 @@ var (
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
 	AttributeKeyFeePayer        = "fee_payer"
+	AttributeKeyBaseFee         = "base_fee"
 
 	EventTypeMessage = "message"
 
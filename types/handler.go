@@ -12,6 +12,12 @@ type Handler func(ctx Context, msg Msg) (*Result, error)
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
 type AnteDepGenerator func(txDeps []sdkacltypes.AccessOperation, tx Tx, txIndex int) (newTxDeps []sdkacltypes.AccessOperation, err error)
 
+// TxSigVerifier verifies the signatures of txs ahead of their AnteHandler, so that the signature verification
+// of the AnteHandler can reuse the results. checksums holds the sha256 checksums of the tx bytes.
+type TxSigVerifier interface {
+	VerifyTxs(ctx Context, txs []Tx, checksums [][32]byte)
+}
+
 // AnteDecorator wraps the next AnteHandler to perform custom pre- and post-processing.
 type AnteDecorator interface {
 	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, err error)
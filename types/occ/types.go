@@ -12,6 +12,8 @@ var (
 type Abort struct {
 	DependentTxIdx int
 	Err            error
+	// Key is the key whose estimate was read
+	Key []byte
 }
 
 func NewEstimateAbort(dependentTxIdx int) Abort {
@@ -20,3 +22,10 @@ func NewEstimateAbort(dependentTxIdx int) Abort {
 		Err:            ErrReadEstimate,
 	}
 }
+
+// NewEstimateAbortWithKey returns an estimate abort that records the key whose estimate was read.
+func NewEstimateAbortWithKey(dependentTxIdx int, key []byte) Abort {
+	abort := NewEstimateAbort(dependentTxIdx)
+	abort.Key = key
+	return abort
+}
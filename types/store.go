@@ -176,9 +176,10 @@ type TraceContext = types.TraceContext
 // --------------------------------------
 
 type (
-	Gas       = types.Gas
-	GasMeter  = types.GasMeter
-	GasConfig = types.GasConfig
+	Gas         = types.Gas
+	GasMeter    = types.GasMeter
+	GasConfig   = types.GasConfig
+	GasSchedule = types.GasSchedule
 )
 
 func NewGasMeter(limit Gas, multiplierNumerator uint64, multiplierDenominator uint64) GasMeter {
@@ -13,7 +13,10 @@ type DeliverTxEntry struct {
 	Checksum           [32]byte
 	AbsoluteIndex      int
 	EstimatedWritesets MappedWritesets
-	TxTracer           TxTracer
+	// EstimatedDependencies are the absolute indexes of lower txs this tx is expected to conflict with.
+	// The scheduler delays the tx until they have validated.
+	EstimatedDependencies []int
+	TxTracer              TxTracer
 }
 
 // EstimatedWritesets represents an estimated writeset for a transaction mapped by storekey to the writeset estimate.
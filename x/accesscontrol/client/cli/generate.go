@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+	"github.com/cosmos/cosmos-sdk/x/accesscontrol/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
+)
+
+const (
+	FlagTitle       = "title"
+	FlagDescription = "description"
+	FlagDeposit     = "deposit"
+	FlagMessageKeys = "message-keys"
+	FlagContract    = "contract"
+)
+
+func GenerateDependencyMappingProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-dependency-mapping-proposal [trace-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Generate a dependency mapping proposal file from the access trace of a node",
+		Long: "Generate a resource dependency mapping proposal file from the store accesses recorded by a node running with " +
+			"access-observer-enabled and access-observer-trace-file, e.g. while it replays a range of blocks, see " +
+			"replay-dependency-mapping-proposal. " +
+			"Every message key of the trace is mapped unless --message-keys is set. " +
+			"With --contract, a wasm dependency mapping proposal file is generated for the contract instead.\n" +
+			"E.g. $ plume tx accesscontrol generate-dependency-mapping-proposal access-trace.jsonl " +
+			"--message-keys cosmos.bank.v1beta1.MsgSend --deposit 10uplume > proposal.json\n" +
+			"The generated file can be submitted with update-resource-dependency-mapping.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			traces, err := utils.ReadAccessTraces(args[0])
+			if err != nil {
+				return err
+			}
+			return printDependencyMappingProposal(client.GetClientContextFromCmd(cmd), cmd, traces)
+		},
+	}
+
+	addDependencyMappingProposalFlags(cmd)
+	return cmd
+}
+
+// printDependencyMappingProposal prints the dependency mapping proposal file generated from the traces, as configured
+// by the flags of addDependencyMappingProposalFlags
+func printDependencyMappingProposal(clientCtx client.Context, cmd *cobra.Command, traces []acltypes.AccessTrace) error {
+	title, err := cmd.Flags().GetString(FlagTitle)
+	if err != nil {
+		return err
+	}
+	description, err := cmd.Flags().GetString(FlagDescription)
+	if err != nil {
+		return err
+	}
+	deposit, err := cmd.Flags().GetString(FlagDeposit)
+	if err != nil {
+		return err
+	}
+	messageKeys, err := cmd.Flags().GetStringSlice(FlagMessageKeys)
+	if err != nil {
+		return err
+	}
+	contract, err := cmd.Flags().GetString(FlagContract)
+	if err != nil {
+		return err
+	}
+
+	if contract != "" {
+		mapping, err := utils.GenerateWasmDependencyMapping(traces, contract)
+		if err != nil {
+			return err
+		}
+		return clientCtx.PrintProto(&types.MsgUpdateWasmDependencyMappingProposalJsonFile{
+			Title:                 title,
+			Description:           description,
+			Deposit:               deposit,
+			ContractAddress:       contract,
+			WasmDependencyMapping: mapping,
+		})
+	}
+
+	mappings, err := utils.GenerateMessageDependencyMappings(traces, messageKeys)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintProto(&types.MsgUpdateResourceDependencyMappingProposalJsonFile{
+		Title:                    title,
+		Description:              description,
+		Deposit:                  deposit,
+		MessageDependencyMapping: mappings,
+	})
+}
+
+func addDependencyMappingProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagTitle, "Updating Resource Dependency Mapping", "title of the proposal")
+	cmd.Flags().String(FlagDescription, "Dependency mappings generated from observed executions", "description of the proposal")
+	cmd.Flags().String(FlagDeposit, "", "deposit of the proposal")
+	cmd.Flags().StringSlice(FlagMessageKeys, nil, "message keys to generate the mappings of, all the traced ones if empty")
+	cmd.Flags().String(FlagContract, "", "contract address to generate a wasm dependency mapping of")
+}
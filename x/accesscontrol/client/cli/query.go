@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -25,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 		ListResourceDependencyMapping(),
 		GetWasmDependencyAccessOps(),
 		ListWasmDependencyMapping(),
+		GetValidationReport(),
+		GetDependencyDag(),
 	)
 
 	return cmd
@@ -179,3 +182,99 @@ func ListWasmDependencyMapping() *cobra.Command {
 
 	return cmd
 }
+
+func GetValidationReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validation-report [messageKey] [flags]",
+		Short: "Get the dependency mapping mismatches observed by the node",
+		Long: "Get the mismatches between the dependency mappings and the store accesses of the messages executed by the node, " +
+			"for a message key or for all of them. The node must run with access-observer-enabled. E.g.\n" +
+			"$ plume q accesscontrol validation-report cosmos.bank.v1beta1.MsgSend [flags]",
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.ValidationReportRequest{}
+			if len(args) == 1 {
+				req.MessageKey = args[0]
+			}
+			res, err := queryClient.ValidationReport(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const FlagDagFormat = "dag-format"
+
+func GetDependencyDag() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dependency-dag [height] [flags]",
+		Short: "Export the dependency dag of the txs of a block",
+		Long: "Export the dependency dag the node builds for the txs of a block from the dependency mappings of the state " +
+			"preceding it, as a Graphviz DOT graph of the tx dependencies or as JSON. E.g.\n" +
+			"$ plume q accesscontrol dependency-dag 100 --dag-format dot | dot -Tsvg > dag.svg",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			if height < 2 {
+				return fmt.Errorf("height must be greater than 1, got %d", height)
+			}
+			format, err := cmd.Flags().GetString(FlagDagFormat)
+			if err != nil {
+				return err
+			}
+			if format != "dot" && format != "json" {
+				return fmt.Errorf("unknown dag format %q, expected dot or json", format)
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			block, err := node.Block(cmd.Context(), &height)
+			if err != nil {
+				return err
+			}
+			req := &types.DependencyDagRequest{Txs: make([][]byte, 0, len(block.Block.Txs))}
+			for _, tx := range block.Block.Txs {
+				req.Txs = append(req.Txs, tx)
+			}
+
+			// the dependency mappings applied to the block are the ones of the state preceding it
+			queryClient := types.NewQueryClient(clientCtx.WithHeight(height - 1))
+			res, err := queryClient.DependencyDag(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if format == "dot" {
+				return clientCtx.PrintString(res.DOT())
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDagFormat, "dot", "The format of the exported dag (dot|json)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
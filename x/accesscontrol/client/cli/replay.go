@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accesscontrol/client/utils"
+)
+
+// maxValidatorsPerPage is the maximum page size of the validators rpc query
+const maxValidatorsPerPage = 100
+
+func ReplayDependencyMappingProposalCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-dependency-mapping-proposal [from-height] [to-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Generate a dependency mapping proposal file by replaying a range of blocks with the access observer",
+		Long: "Replay the blocks from-height to to-height, fetched from --node, on the application state of --home with " +
+			"the access observer enabled, then generate a resource dependency mapping proposal file from the store " +
+			"accesses of their messages like generate-dependency-mapping-proposal.\n" +
+			"The application state of --home must be at from-height - 1, and the replayed blocks are committed to it, " +
+			"so use a scratch home, e.g. restored from a snapshot, and not the home of a running node. The replay " +
+			"fails if its app hashes diverge from the ones of the fetched blocks.\n" +
+			"E.g. $ plume replay-dependency-mapping-proposal 1001 2000 --home scratch --node tcp://localhost:26657 " +
+			"--message-keys cosmos.bank.v1beta1.MsgSend --deposit 10uplume > proposal.json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from-height %s: %w", args[0], err)
+			}
+			toHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to-height %s: %w", args[1], err)
+			}
+			if fromHeight <= 1 || toHeight < fromHeight {
+				return fmt.Errorf("invalid block range %d to %d", fromHeight, toHeight)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			traceDir, err := os.MkdirTemp("", "access-trace")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(traceDir)
+			traceFile := filepath.Join(traceDir, "access-trace.jsonl")
+
+			first, err := node.Block(cmd.Context(), &fromHeight)
+			if err != nil {
+				return err
+			}
+			serverCtx.Viper.Set(flags.FlagChainID, first.Block.ChainID)
+			serverCtx.Viper.Set(baseapp.FlagAccessObserverEnabled, true)
+			serverCtx.Viper.Set(baseapp.FlagAccessObserverTraceFile, traceFile)
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(serverCtx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			app := appCreator(serverCtx.Logger, db, nil, nil, serverCtx.Viper)
+			if version := app.CommitMultiStore().LastCommitID().Version; version != fromHeight-1 {
+				app.Close()
+				return fmt.Errorf("the application state is at height %d instead of %d", version, fromHeight-1)
+			}
+
+			replayErr := replayBlocks(cmd.Context(), node, app, fromHeight, toHeight)
+			if err := app.Close(); err != nil {
+				return err
+			}
+			if replayErr != nil {
+				return replayErr
+			}
+
+			traces, err := utils.ReadAccessTraces(traceFile)
+			if err != nil {
+				return err
+			}
+			return printDependencyMappingProposal(clientCtx, cmd, traces)
+		},
+	}
+
+	addDependencyMappingProposalFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// replayBlocks finalizes and commits the blocks from fromHeight to toHeight like tendermint does, checking that the
+// app hash of the application before every block is the one of the block
+func replayBlocks(ctx context.Context, node client.TendermintRPC, app servertypes.Application, fromHeight, toHeight int64) error {
+	for height := fromHeight; height <= toHeight; height++ {
+		res, err := node.Block(ctx, &height)
+		if err != nil {
+			return err
+		}
+		block := res.Block
+		if appHash := app.CommitMultiStore().LastCommitID().Hash; !bytes.Equal(appHash, block.AppHash) {
+			return fmt.Errorf("the app hash %X before block %d differs from the app hash %X of the block", appHash, height, block.AppHash)
+		}
+		lastCommit, err := lastCommitInfo(ctx, node, block)
+		if err != nil {
+			return err
+		}
+		if _, err := app.FinalizeBlock(ctx, &abci.RequestFinalizeBlock{
+			Hash:                  block.Hash(),
+			Height:                block.Height,
+			Time:                  block.Time,
+			Txs:                   block.Txs.ToSliceOfBytes(),
+			DecidedLastCommit:     lastCommit,
+			ByzantineValidators:   block.Evidence.ToABCI(),
+			ProposerAddress:       block.ProposerAddress,
+			NextValidatorsHash:    block.NextValidatorsHash,
+			AppHash:               block.AppHash,
+			ValidatorsHash:        block.ValidatorsHash,
+			ConsensusHash:         block.ConsensusHash,
+			DataHash:              block.DataHash,
+			EvidenceHash:          block.EvidenceHash,
+			LastBlockHash:         block.LastBlockID.Hash,
+			LastBlockPartSetTotal: int64(block.LastBlockID.PartSetHeader.Total),
+			LastBlockPartSetHash:  block.LastBlockID.Hash,
+			LastCommitHash:        block.LastCommitHash,
+			LastResultsHash:       block.LastResultsHash,
+		}); err != nil {
+			return fmt.Errorf("failed to finalize block %d: %w", height, err)
+		}
+		if _, err := app.Commit(ctx); err != nil {
+			return fmt.Errorf("failed to commit block %d: %w", height, err)
+		}
+	}
+	return nil
+}
+
+// lastCommitInfo builds the votes of the last commit of a block over the validator set of the previous height
+func lastCommitInfo(ctx context.Context, node client.TendermintRPC, block *tmtypes.Block) (abci.CommitInfo, error) {
+	if block.LastCommit == nil || block.LastCommit.Size() == 0 {
+		return abci.CommitInfo{}, nil
+	}
+	var (
+		height     = block.Height - 1
+		perPage    = maxValidatorsPerPage
+		validators []*tmtypes.Validator
+	)
+	for page := 1; ; page++ {
+		res, err := node.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return abci.CommitInfo{}, err
+		}
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) == 0 || len(validators) >= res.Total {
+			break
+		}
+	}
+	if len(validators) != block.LastCommit.Size() {
+		return abci.CommitInfo{}, fmt.Errorf("the last commit of block %d has %d signatures for %d validators", block.Height, block.LastCommit.Size(), len(validators))
+	}
+
+	votes := make([]abci.VoteInfo, len(validators))
+	for i, val := range validators {
+		votes[i] = abci.VoteInfo{
+			Validator:       tmtypes.TM2PB.Validator(val),
+			SignedLastBlock: block.LastCommit.Signatures[i].BlockIDFlag != tmtypes.BlockIDFlagAbsent,
+		}
+	}
+	return abci.CommitInfo{Round: block.LastCommit.Round, Votes: votes}, nil
+}
@@ -32,6 +32,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		updateResourceDependencyMappingProposalCmd,
 		registerWasmDependencyMappingCmd,
+		GenerateDependencyMappingProposalCmd(),
 	)
 
 	return cmd
@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+	"github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
+)
+
+// maxTraceLineSize bounds the size of a line of an access trace, i.e. the accesses of a message
+const maxTraceLineSize = 64 * 1024 * 1024
+
+// ReadAccessTraces reads the access traces written by a node running the access observer with a trace file
+func ReadAccessTraces(traceFile string) ([]acltypes.AccessTrace, error) {
+	f, err := os.Open(traceFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var traces []acltypes.AccessTrace
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxTraceLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var trace acltypes.AccessTrace
+		if err := json.Unmarshal(scanner.Bytes(), &trace); err != nil {
+			return nil, fmt.Errorf("invalid access trace at line %d: %w", line, err)
+		}
+		traces = append(traces, trace)
+	}
+	return traces, scanner.Err()
+}
+
+// GenerateMessageDependencyMappings generates the dependency mapping of every message key of the traces, or only of
+// the provided message keys. Each mapping has a READ and/or WRITE access operation for each resource type the
+// executions of the message accessed, followed by the COMMIT access operation. The identifier template of an access
+// operation is the longest common prefix of the identifiers accessed, or "*" if they have none or the resource type
+// has children. Accesses to stores without a resource type mapping are rejected.
+func GenerateMessageDependencyMappings(traces []acltypes.AccessTrace, messageKeys []string) ([]acltypes.MessageDependencyMapping, error) {
+	wanted := make(map[string]bool, len(messageKeys))
+	for _, messageKey := range messageKeys {
+		wanted[messageKey] = true
+	}
+	tracesByMessageKey := make(map[string][]acltypes.AccessTrace)
+	for _, trace := range traces {
+		if len(wanted) == 0 || wanted[trace.MessageKey] {
+			tracesByMessageKey[trace.MessageKey] = append(tracesByMessageKey[trace.MessageKey], trace)
+		}
+	}
+	for _, messageKey := range messageKeys {
+		if _, ok := tracesByMessageKey[messageKey]; !ok {
+			return nil, fmt.Errorf("no execution of %s in the access traces", messageKey)
+		}
+	}
+
+	mappings := make([]acltypes.MessageDependencyMapping, 0, len(tracesByMessageKey))
+	for messageKey, messageTraces := range tracesByMessageKey {
+		accessOps, err := generateAccessOps(messageTraces)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", messageKey, err)
+		}
+		mapping := acltypes.MessageDependencyMapping{MessageKey: messageKey, AccessOps: accessOps}
+		if err := types.ValidateMessageDependencyMapping(mapping); err != nil {
+			return nil, fmt.Errorf("%s: %w", messageKey, err)
+		}
+		mappings = append(mappings, mapping)
+	}
+	sort.Slice(mappings, func(i, j int) bool {
+		return mappings[i].MessageKey < mappings[j].MessageKey
+	})
+	return mappings, nil
+}
+
+// GenerateWasmDependencyMapping generates the dependency mapping of a contract from the traces of the messages that
+// executed it. The base access operations are generated like the ones of GenerateMessageDependencyMappings.
+func GenerateWasmDependencyMapping(traces []acltypes.AccessTrace, contractAddress string) (acltypes.WasmDependencyMapping, error) {
+	var contractTraces []acltypes.AccessTrace
+	for _, trace := range traces {
+		if trace.ContractAddress == contractAddress {
+			contractTraces = append(contractTraces, trace)
+		}
+	}
+	if len(contractTraces) == 0 {
+		return acltypes.WasmDependencyMapping{}, fmt.Errorf("no execution of contract %s in the access traces", contractAddress)
+	}
+
+	accessOps, err := generateAccessOps(contractTraces)
+	if err != nil {
+		return acltypes.WasmDependencyMapping{}, err
+	}
+	mapping := acltypes.WasmDependencyMapping{ContractAddress: contractAddress}
+	for i := range accessOps {
+		mapping.BaseAccessOps = append(mapping.BaseAccessOps, &acltypes.WasmAccessOperation{
+			Operation:    &accessOps[i],
+			SelectorType: acltypes.AccessOperationSelectorType_NONE,
+		})
+	}
+	return mapping, types.ValidateWasmDependencyMapping(mapping)
+}
+
+func generateAccessOps(traces []acltypes.AccessTrace) ([]acltypes.AccessOperation, error) {
+	type resourceAccess struct {
+		accessType   acltypes.AccessType
+		resourceType acltypes.ResourceType
+	}
+	// the longest common prefix of the identifiers accessed for every resource access
+	identifierPrefixes := make(map[resourceAccess]string)
+	for _, trace := range traces {
+		for _, access := range trace.Accesses {
+			comparator := acltypes.Comparator{StoreKey: access.StoreKey, Identifier: access.Identifier}
+			if comparator.IsConcurrentSafeIdentifier() {
+				continue
+			}
+			resourceType, ok := acltypes.ResourceType_value[access.ResourceType]
+			if !ok {
+				return nil, fmt.Errorf("unknown resource type %s at height %d", access.ResourceType, trace.Height)
+			}
+			if acltypes.ResourceType(resourceType) == acltypes.ResourceType_KV {
+				return nil, fmt.Errorf("store %s identifier %s at height %d has no resource type mapping", access.StoreKey, access.Identifier, trace.Height)
+			}
+			accessType, ok := acltypes.AccessType_value[access.AccessType]
+			if !ok || (accessType != int32(acltypes.AccessType_READ) && accessType != int32(acltypes.AccessType_WRITE)) {
+				return nil, fmt.Errorf("invalid access type %s at height %d", access.AccessType, trace.Height)
+			}
+			key := resourceAccess{accessType: acltypes.AccessType(accessType), resourceType: acltypes.ResourceType(resourceType)}
+			if prefix, ok := identifierPrefixes[key]; ok {
+				identifierPrefixes[key] = commonHexPrefix(prefix, access.Identifier)
+			} else {
+				identifierPrefixes[key] = access.Identifier
+			}
+		}
+	}
+
+	accessOps := make([]acltypes.AccessOperation, 0, len(identifierPrefixes)+1)
+	for key, prefix := range identifierPrefixes {
+		// the access operations of resource types with children can only use the "*" identifier template
+		identifierTemplate := prefix
+		if identifierTemplate == "" || key.resourceType.HasChildren() {
+			identifierTemplate = "*"
+		}
+		accessOps = append(accessOps, acltypes.AccessOperation{
+			AccessType:         key.accessType,
+			ResourceType:       key.resourceType,
+			IdentifierTemplate: identifierTemplate,
+		})
+	}
+	sort.Slice(accessOps, func(i, j int) bool {
+		if accessOps[i].ResourceType != accessOps[j].ResourceType {
+			return accessOps[i].ResourceType < accessOps[j].ResourceType
+		}
+		return accessOps[i].AccessType < accessOps[j].AccessType
+	})
+	return append(accessOps, *types.CommitAccessOp()), nil
+}
+
+// commonHexPrefix returns the longest common prefix of two hex encoded identifiers, cut at a byte boundary
+func commonHexPrefix(a, b string) string {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return a[:n-n%2]
+}
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+	"github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
+)
+
+const testTraces = `{"height":1,"message_key":"msgA","accesses":[{"store_key":"bank","resource_type":"KV_BANK_BALANCES","identifier":"02aa","access_type":"READ"},{"store_key":"bank","resource_type":"KV_BANK_BALANCES","identifier":"02aa","access_type":"WRITE"}]}
+{"height":1,"message_key":"msgA","accesses":[{"store_key":"bank","resource_type":"KV_BANK_BALANCES","identifier":"02ab","access_type":"READ"}]}
+
+{"height":2,"message_key":"msgA","accesses":[{"store_key":"acc","resource_type":"KV_AUTH_ADDRESS_STORE","identifier":"01bb","access_type":"READ"},{"store_key":"params","resource_type":"KV","identifier":"aa","access_type":"READ"}]}
+{"height":2,"message_key":"cosmwasm.wasm.v1.MsgExecuteContract","contract_address":"contract","accesses":[{"store_key":"wasm","resource_type":"KV_WASM","identifier":"03cc","access_type":"WRITE"}]}
+`
+
+func TestGenerateDependencyMappings(t *testing.T) {
+	traceFile := filepath.Join(t.TempDir(), "trace.jsonl")
+	require.NoError(t, os.WriteFile(traceFile, []byte(testTraces), 0o600))
+	traces, err := ReadAccessTraces(traceFile)
+	require.NoError(t, err)
+	require.Len(t, traces, 4)
+
+	mappings, err := GenerateMessageDependencyMappings(traces, []string{"msgA"})
+	require.NoError(t, err)
+	require.Equal(t, []acltypes.MessageDependencyMapping{{
+		MessageKey: "msgA",
+		AccessOps: []acltypes.AccessOperation{
+			{AccessType: acltypes.AccessType_READ, ResourceType: acltypes.ResourceType_KV_AUTH_ADDRESS_STORE, IdentifierTemplate: "01bb"},
+			{AccessType: acltypes.AccessType_READ, ResourceType: acltypes.ResourceType_KV_BANK_BALANCES, IdentifierTemplate: "02"},
+			{AccessType: acltypes.AccessType_WRITE, ResourceType: acltypes.ResourceType_KV_BANK_BALANCES, IdentifierTemplate: "02aa"},
+			*types.CommitAccessOp(),
+		},
+	}}, mappings)
+
+	mappings, err = GenerateMessageDependencyMappings(traces, nil)
+	require.NoError(t, err)
+	require.Len(t, mappings, 2)
+	_, err = GenerateMessageDependencyMappings(traces, []string{"msgB"})
+	require.Error(t, err)
+
+	wasmMapping, err := GenerateWasmDependencyMapping(traces, "contract")
+	require.NoError(t, err)
+	require.Equal(t, "contract", wasmMapping.ContractAddress)
+	require.Len(t, wasmMapping.BaseAccessOps, 2)
+	require.Equal(t, acltypes.AccessOperation{AccessType: acltypes.AccessType_WRITE, ResourceType: acltypes.ResourceType_KV_WASM, IdentifierTemplate: "*"}, *wasmMapping.BaseAccessOps[0].Operation)
+	_, err = GenerateWasmDependencyMapping(traces, "other")
+	require.Error(t, err)
+
+	// accesses to stores without a resource type mapping can't be mapped
+	traces = append(traces, acltypes.AccessTrace{
+		Height:     3,
+		MessageKey: "msgA",
+		Accesses:   []acltypes.TracedAccess{{StoreKey: "unmapped", ResourceType: "KV", Identifier: "04dd", AccessType: "READ"}},
+	})
+	_, err = GenerateMessageDependencyMappings(traces, []string{"msgA"})
+	require.ErrorContains(t, err, "store unmapped identifier 04dd at height 3 has no resource type mapping")
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
 	"github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
@@ -59,3 +62,53 @@ func (k Keeper) ListWasmDependencyMapping(ctx context.Context, req *types.ListWa
 
 	return &types.ListWasmDependencyMappingResponse{WasmDependencyMappingList: wasmDependencyMappings}, nil
 }
+
+func (k Keeper) ValidationReport(ctx context.Context, req *types.ValidationReportRequest) (*types.ValidationReportResponse, error) {
+	if k.validationReport == nil {
+		return nil, status.Error(codes.Unavailable, "the access observer is not enabled on this node")
+	}
+	var reports []acltypes.MessageValidationReport
+	if req.MessageKey != "" {
+		if report, ok := k.validationReport.Get(req.MessageKey); ok {
+			reports = append(reports, report)
+		}
+	} else {
+		reports = k.validationReport.All()
+	}
+
+	res := &types.ValidationReportResponse{Reports: make([]types.MessageValidationReport, 0, len(reports))}
+	for _, report := range reports {
+		res.Reports = append(res.Reports, types.NewMessageValidationReport(report))
+	}
+	return res, nil
+}
+
+func (k Keeper) DependencyDag(ctx context.Context, req *types.DependencyDagRequest) (*types.DependencyDagResponse, error) {
+	if k.txDecoder == nil || k.anteDepGenerator == nil {
+		return nil, status.Error(codes.Unimplemented, "dependency dags are not served by this node")
+	}
+	if len(req.Txs) > types.MaxDependencyDagTxs {
+		return nil, status.Errorf(codes.InvalidArgument, "too many txs: %d > %d", len(req.Txs), types.MaxDependencyDagTxs)
+	}
+	txBytesSize := 0
+	for _, txBytes := range req.Txs {
+		txBytesSize += len(txBytes)
+	}
+	if txBytesSize > types.MaxDependencyDagTxBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "txs too large: %d bytes > %d bytes", txBytesSize, types.MaxDependencyDagTxBytes)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	txs := make([]sdk.Tx, 0, len(req.Txs))
+	for i, txBytes := range req.Txs {
+		tx, err := k.txDecoder(txBytes)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to decode tx %d: %s", i, err)
+		}
+		txs = append(txs, tx)
+	}
+	dag, err := k.BuildDependencyDag(sdkCtx, k.anteDepGenerator(), txs)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return types.NewDependencyDagResponse(dag, len(txs)), nil
+}
@@ -6,9 +6,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+	aclkeeper "github.com/cosmos/cosmos-sdk/x/accesscontrol/keeper"
 	"github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestParams(t *testing.T) {
@@ -120,3 +123,61 @@ func TestListWasmDependencyMapping(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, result.WasmDependencyMappingList, 1)
 }
+
+func TestValidationReport(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	keeper := app.AccessControlKeeper
+
+	// the report is only served by nodes running the access observer
+	_, err := keeper.ValidationReport(sdk.WrapSDKContext(ctx), &types.ValidationReportRequest{})
+	require.Error(t, err)
+
+	report := acltypes.NewValidationReport()
+	aclkeeper.WithValidationReport(report).Apply(&keeper)
+	missingWrite := acltypes.AccessMismatch{
+		Type:   acltypes.MismatchType_MISSING_WRITE,
+		Access: acltypes.Comparator{AccessType: acltypes.AccessType_WRITE, Identifier: "0102", StoreKey: "bank"},
+	}
+	overbroad := acltypes.AccessMismatch{
+		Type:     acltypes.MismatchType_OVERBROAD_UNKNOWN,
+		AccessOp: acltypes.AccessOperation{AccessType: acltypes.AccessType_UNKNOWN, ResourceType: acltypes.ResourceType_KV_BANK_BALANCES, IdentifierTemplate: "02"},
+	}
+	report.Add("msgA", 5, []acltypes.AccessMismatch{missingWrite, overbroad})
+	report.Add("msgB", 6, nil)
+
+	response, err := keeper.ValidationReport(sdk.WrapSDKContext(ctx), &types.ValidationReportRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Reports, 2)
+
+	response, err = keeper.ValidationReport(sdk.WrapSDKContext(ctx), &types.ValidationReportRequest{MessageKey: "msgA"})
+	require.NoError(t, err)
+	require.Equal(t, []types.MessageValidationReport{{
+		MessageKey: "msgA",
+		Executions: 1,
+		Mismatches: []types.AccessMismatch{
+			{MismatchType: "MISSING_WRITE", StoreKey: "bank", Identifier: "0102", AccessType: acltypes.AccessType_WRITE, Count: 1, LastHeight: 5},
+			{MismatchType: "OVERBROAD_UNKNOWN", AccessOp: &overbroad.AccessOp, Count: 1, LastHeight: 5},
+		},
+	}}, response.Reports)
+
+	response, err = keeper.ValidationReport(sdk.WrapSDKContext(ctx), &types.ValidationReportRequest{MessageKey: "msgC"})
+	require.NoError(t, err)
+	require.Empty(t, response.Reports)
+}
+
+func TestDependencyDagLimits(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	keeper := app.AccessControlKeeper
+
+	_, err := keeper.DependencyDag(sdk.WrapSDKContext(ctx), &types.DependencyDagRequest{
+		Txs: make([][]byte, types.MaxDependencyDagTxs+1),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.DependencyDag(sdk.WrapSDKContext(ctx), &types.DependencyDagRequest{
+		Txs: [][]byte{make([]byte, types.MaxDependencyDagTxBytes/2), make([]byte, types.MaxDependencyDagTxBytes/2+1)},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
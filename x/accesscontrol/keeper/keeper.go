@@ -42,6 +42,11 @@ type (
 		AccountKeeper                    authkeeper.AccountKeeper
 		StakingKeeper                    stakingkeeper.Keeper
 		ResourceTypeStoreKeyMapping      acltypes.ResourceTypeToStoreKeyMap
+		// validationReport is the report of the node's access observer, nil if it isn't enabled
+		validationReport *acltypes.ValidationReport
+		// txDecoder and anteDepGenerator rebuild the dependency dags served by the DependencyDag query
+		txDecoder        sdk.TxDecoder
+		anteDepGenerator func() sdk.AnteDepGenerator
 	}
 )
 
@@ -608,6 +613,17 @@ func (k Keeper) BuildDependencyDag(ctx sdk.Context, anteDepGen sdk.AnteDepGenera
 	return &dependencyDag, nil
 }
 
+// GenerateEstimatedTxDependencies builds the dependency dag for the txs and returns, for the position of each tx in
+// txs, the positions of the txs it is expected to depend on. These can be used as dependency hints for the scheduler
+// once they are mapped to the absolute indexes of the txs, see tasks.SetEstimatedDependencies.
+func (k Keeper) GenerateEstimatedTxDependencies(ctx sdk.Context, anteDepGen sdk.AnteDepGenerator, txs []sdk.Tx) (map[int][]int, error) {
+	dependencyDag, err := k.BuildDependencyDag(ctx, anteDepGen, txs)
+	if err != nil {
+		return nil, err
+	}
+	return dependencyDag.GetTxDependencies(), nil
+}
+
 // Measures the time taken to build dependency dag
 // Metric Names:
 //
@@ -2113,6 +2113,31 @@ func TestBuildDependencyDag_MultipleTransactions(t *testing.T) {
 	require.ErrorContains(t, err, "Mocked error")
 }
 
+func TestGenerateEstimatedTxDependencies(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	accounts := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+
+	txBuilder := simapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+	txs := []sdk.Tx{}
+	// every tx sends to the same account
+	for _, from := range []sdk.AccAddress{accounts[0], accounts[1], accounts[0]} {
+		err := txBuilder.SetMsgs(banktypes.NewMsgSend(from, accounts[2], sdk.NewCoins(sdk.NewCoin("uplume", sdk.NewInt(1)))))
+		require.NoError(t, err)
+		txs = append(txs, txBuilder.GetTx())
+	}
+
+	txDependencies, err := app.AccessControlKeeper.GenerateEstimatedTxDependencies(ctx, app.GetAnteDepGenerator(), txs)
+	require.NoError(t, err)
+	require.Empty(t, txDependencies[0])
+	require.Contains(t, txDependencies[1], 0)
+	require.Contains(t, txDependencies[2], 1)
+
+	_, err = app.AccessControlKeeper.GenerateEstimatedTxDependencies(ctx, app.GetAnteDepGenerator(), []sdk.Tx{nil})
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+}
+
 func BenchmarkAccessOpsBuildDependencyDag(b *testing.B) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -1,6 +1,9 @@
 package keeper
 
-import acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+)
 
 type optsFn func(*Keeper)
 
@@ -33,3 +36,20 @@ func WithResourceTypeToStoreKeyMap(resourceTypeStoreKeyMapping acltypes.Resource
 		k.ResourceTypeStoreKeyMapping = resourceTypeStoreKeyMapping
 	})
 }
+
+// WithValidationReport serves the report of the node's access observer in the ValidationReport query
+func WithValidationReport(report *acltypes.ValidationReport) optsFn {
+	return optsFn(func(k *Keeper) {
+		k.validationReport = report
+	})
+}
+
+// WithDependencyDagBuilder serves the DependencyDag query, decoding the txs with txDecoder and generating their ante
+// dependencies with the generator returned by anteDepGenerator, which is usually only set on the app after the keeper
+// is created
+func WithDependencyDagBuilder(txDecoder sdk.TxDecoder, anteDepGenerator func() sdk.AnteDepGenerator) optsFn {
+	return optsFn(func(k *Keeper) {
+		k.txDecoder = txDecoder
+		k.anteDepGenerator = anteDepGenerator
+	})
+}
@@ -12,6 +12,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -57,6 +58,9 @@ var TestingStoreKeyToResourceTypePrefixMap = acltypes.StoreKeyToResourceTypePref
 		acltypes.ResourceType_KV_FEEGRANT:           acltypes.EmptyPrefix,
 		acltypes.ResourceType_KV_FEEGRANT_ALLOWANCE: feegranttypes.FeeAllowanceKeyPrefix,
 	},
+	feemarkettypes.StoreKey: {
+		acltypes.ResourceType_KV_FEEMARKET: acltypes.EmptyPrefix,
+	},
 	stakingtypes.StoreKey: {
 		acltypes.ResourceType_KV_STAKING:                          acltypes.EmptyPrefix,
 		acltypes.ResourceType_KV_STAKING_VALIDATION_POWER:         stakingtypes.LastValidatorPowerKey,
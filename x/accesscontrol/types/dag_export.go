@@ -0,0 +1,170 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+)
+
+const (
+	// MaxDependencyDagTxs bounds the number of txs of a DependencyDag query
+	MaxDependencyDagTxs = 10_000
+	// MaxDependencyDagTxBytes bounds the total size of the txs of a DependencyDag query, it is the default max block
+	// size of tendermint
+	MaxDependencyDagTxBytes = 22020096
+)
+
+// NewDependencyDagResponse exports the nodes and edges of the dag of txCount txs with the resource types behind each
+// edge, and the critical path length and width per level of the dependencies between the txs
+func NewDependencyDagResponse(dag *Dag, txCount int) *DependencyDagResponse {
+	res := &DependencyDagResponse{}
+	nodeIDs := make([]DagNodeID, 0, len(dag.NodeMap))
+	for nodeID := range dag.NodeMap {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool { return nodeIDs[i] < nodeIDs[j] })
+
+	for _, nodeID := range nodeIDs {
+		node := dag.NodeMap[nodeID]
+		res.Nodes = append(res.Nodes, DependencyDagNode{
+			NodeId:       int64(node.NodeID),
+			TxIndex:      int64(node.TxIndex),
+			MessageIndex: int64(node.MessageIndex),
+			AccessOp:     node.AccessOperation,
+		})
+		for _, edge := range dag.EdgesMap[nodeID] {
+			from, to := dag.NodeMap[edge.FromNodeID], dag.NodeMap[edge.ToNodeID]
+			res.Edges = append(res.Edges, DependencyDagEdge{
+				FromNodeId:    int64(from.NodeID),
+				ToNodeId:      int64(to.NodeID),
+				FromTxIndex:   int64(from.TxIndex),
+				ToTxIndex:     int64(to.TxIndex),
+				ResourceTypes: dag.conflictingResourceTypes(from.TxIndex, to.AccessOperation),
+			})
+		}
+	}
+	sort.Slice(res.Edges, func(i, j int) bool {
+		if res.Edges[i].ToNodeId != res.Edges[j].ToNodeId {
+			return res.Edges[i].ToNodeId < res.Edges[j].ToNodeId
+		}
+		return res.Edges[i].FromNodeId < res.Edges[j].FromNodeId
+	})
+
+	// a tx only depends on previous txs, so the level of its dependencies is known when it's reached
+	txDependencies := dag.GetTxDependencies()
+	levels := make([]int, txCount)
+	for txIndex := range levels {
+		for _, dep := range txDependencies[txIndex] {
+			if levels[dep]+1 > levels[txIndex] {
+				levels[txIndex] = levels[dep] + 1
+			}
+		}
+		for len(res.LevelWidths) <= levels[txIndex] {
+			res.LevelWidths = append(res.LevelWidths, 0)
+		}
+		res.LevelWidths[levels[txIndex]]++
+	}
+	res.CriticalPathLength = uint64(len(res.LevelWidths))
+	return res
+}
+
+// conflictingResourceTypes returns the sorted resource types of the access operations of a tx that the blocked access
+// operation of a later tx depends on, following the rules of GetNodeDependencies
+func (dag *Dag) conflictingResourceTypes(txIndex int, blocked acltypes.AccessOperation) []acltypes.ResourceType {
+	dependentResources := make(map[acltypes.ResourceType]bool)
+	for _, resourceType := range blocked.ResourceType.GetResourceDependencies() {
+		dependentResources[resourceType] = true
+	}
+	conflicting := make(map[acltypes.ResourceType]bool)
+	for _, accessOps := range dag.TxMsgAccessOpMapping[txIndex] {
+		for _, accessOp := range accessOps {
+			if accessOp.AccessType == acltypes.AccessType_COMMIT || !dependentResources[accessOp.ResourceType] {
+				continue
+			}
+			// reads only block reads through writes and unknowns
+			if blocked.AccessType == acltypes.AccessType_READ && accessOp.AccessType == acltypes.AccessType_READ {
+				continue
+			}
+			if accessOp.ResourceType == blocked.ResourceType && blocked.IdentifierTemplate != "*" &&
+				accessOp.IdentifierTemplate != "*" && accessOp.IdentifierTemplate != blocked.IdentifierTemplate {
+				continue
+			}
+			conflicting[accessOp.ResourceType] = true
+		}
+	}
+	resourceTypes := make([]acltypes.ResourceType, 0, len(conflicting))
+	for resourceType := range conflicting {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Slice(resourceTypes, func(i, j int) bool { return resourceTypes[i] < resourceTypes[j] })
+	return resourceTypes
+}
+
+// DOT renders the dependencies between the txs of the dag in the Graphviz DOT language. The txs of a level are ranked
+// together and the edges are labeled with the resource types behind them.
+func (res *DependencyDagResponse) DOT() string {
+	txs := make(map[int64]bool)
+	for _, node := range res.Nodes {
+		txs[node.TxIndex] = true
+	}
+	type txEdge struct{ from, to int64 }
+	edgeResources := make(map[txEdge]map[acltypes.ResourceType]bool)
+	levels := make(map[int64]int)
+	var edges []txEdge
+	for _, edge := range res.Edges {
+		key := txEdge{edge.FromTxIndex, edge.ToTxIndex}
+		if _, ok := edgeResources[key]; !ok {
+			edgeResources[key] = make(map[acltypes.ResourceType]bool)
+			edges = append(edges, key)
+		}
+		for _, resourceType := range edge.ResourceTypes {
+			edgeResources[key][resourceType] = true
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].to != edges[j].to {
+			return edges[i].to < edges[j].to
+		}
+		return edges[i].from < edges[j].from
+	})
+	// the edges are sorted by the tx they block, so the levels of the blocking txs are known
+	for _, edge := range edges {
+		if levels[edge.from]+1 > levels[edge.to] {
+			levels[edge.to] = levels[edge.from] + 1
+		}
+	}
+	txIndexes := make([]int64, 0, len(txs))
+	for txIndex := range txs {
+		txIndexes = append(txIndexes, txIndex)
+	}
+	sort.Slice(txIndexes, func(i, j int) bool { return txIndexes[i] < txIndexes[j] })
+
+	var sb strings.Builder
+	sb.WriteString("digraph dependency_dag {\n")
+	fmt.Fprintf(&sb, "  label=\"critical path length: %d, level widths: %v\";\n", res.CriticalPathLength, res.LevelWidths)
+	sb.WriteString("  node [shape=box];\n")
+	byLevel := make(map[int][]int64)
+	for _, txIndex := range txIndexes {
+		fmt.Fprintf(&sb, "  tx%d [label=\"tx %d\"];\n", txIndex, txIndex)
+		byLevel[levels[txIndex]] = append(byLevel[levels[txIndex]], txIndex)
+	}
+	for level := 0; level < len(byLevel); level++ {
+		fmt.Fprintf(&sb, "  { rank=same;")
+		for _, txIndex := range byLevel[level] {
+			fmt.Fprintf(&sb, " tx%d;", txIndex)
+		}
+		sb.WriteString(" }\n")
+	}
+	for _, edge := range edges {
+		resourceTypes := make([]string, 0, len(edgeResources[edge]))
+		for resourceType := range edgeResources[edge] {
+			resourceTypes = append(resourceTypes, resourceType.String())
+		}
+		sort.Strings(resourceTypes)
+		fmt.Fprintf(&sb, "  tx%d -> tx%d [label=\"%s\"];\n", edge.from, edge.to, strings.Join(resourceTypes, "\\n"))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDependencyDagResponse(t *testing.T) {
+	dag := NewDag()
+	writeA := acltypes.AccessOperation{AccessType: acltypes.AccessType_WRITE, ResourceType: acltypes.ResourceType_KV, IdentifierTemplate: "ResourceA"}
+	readA := acltypes.AccessOperation{AccessType: acltypes.AccessType_READ, ResourceType: acltypes.ResourceType_KV, IdentifierTemplate: "ResourceA"}
+	readB := acltypes.AccessOperation{AccessType: acltypes.AccessType_READ, ResourceType: acltypes.ResourceType_KV, IdentifierTemplate: "ResourceB"}
+	txAccessOps := [][]acltypes.AccessOperation{
+		{writeA, *CommitAccessOp()},
+		{readA, *CommitAccessOp()},
+		{readB, *CommitAccessOp()},
+	}
+	for txIndex, accessOps := range txAccessOps {
+		dag.AddAccessOpsForMsg(0, txIndex, accessOps)
+		for _, accessOp := range accessOps {
+			dag.AddNodeBuildDependency(0, txIndex, accessOp)
+		}
+	}
+
+	res := NewDependencyDagResponse(&dag, len(txAccessOps))
+	require.Len(t, res.Nodes, 6)
+	require.Equal(t, DependencyDagNode{NodeId: 2, TxIndex: 1, AccessOp: readA}, res.Nodes[2])
+	require.Equal(t, []DependencyDagEdge{{
+		FromNodeId:    1,
+		ToNodeId:      2,
+		FromTxIndex:   0,
+		ToTxIndex:     1,
+		ResourceTypes: []acltypes.ResourceType{acltypes.ResourceType_KV},
+	}}, res.Edges)
+	require.Equal(t, uint64(2), res.CriticalPathLength)
+	require.Equal(t, []uint64{2, 1}, res.LevelWidths)
+
+	require.Equal(t, `digraph dependency_dag {
+  label="critical path length: 2, level widths: [2 1]";
+  node [shape=box];
+  tx0 [label="tx 0"];
+  tx1 [label="tx 1"];
+  tx2 [label="tx 2"];
+  { rank=same; tx0; tx2; }
+  { rank=same; tx1; }
+  tx0 -> tx1 [label="KV"];
+}
+`, res.DOT())
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
@@ -326,6 +327,48 @@ func (dag *Dag) GetNodeDependencies(node DagNode) []DagNodeID {
 	return nodeDependencies
 }
 
+// GetTxDependencies returns the sorted indexes of the txs each tx index depends on through the dag edges.
+// Txs with an UNKNOWN access operation get no estimate either way: they neither depend on nor are depended on by
+// other txs, since an unknown access would otherwise make them conflict with the whole block.
+func (dag *Dag) GetTxDependencies() map[int][]int {
+	unknownTxs := make(map[int]struct{})
+	for _, node := range dag.NodeMap {
+		if node.AccessOperation.AccessType == acltypes.AccessType_UNKNOWN {
+			unknownTxs[node.TxIndex] = struct{}{}
+		}
+	}
+	depSets := make(map[int]map[int]struct{})
+	for _, edges := range dag.EdgesMap {
+		for _, edge := range edges {
+			fromTx := dag.NodeMap[edge.FromNodeID].TxIndex
+			toTx := dag.NodeMap[edge.ToNodeID].TxIndex
+			if _, ok := unknownTxs[fromTx]; ok {
+				continue
+			}
+			if _, ok := unknownTxs[toTx]; ok {
+				continue
+			}
+			if fromTx == toTx {
+				continue
+			}
+			if _, ok := depSets[toTx]; !ok {
+				depSets[toTx] = make(map[int]struct{})
+			}
+			depSets[toTx][fromTx] = struct{}{}
+		}
+	}
+	txDependencies := make(map[int][]int, len(depSets))
+	for txIndex, depSet := range depSets {
+		deps := make([]int, 0, len(depSet))
+		for dep := range depSet {
+			deps = append(deps, dep)
+		}
+		sort.Ints(deps)
+		txDependencies[txIndex] = deps
+	}
+	return txDependencies
+}
+
 func (dag *Dag) AddCompletionSignal(completionSignal CompletionSignal) {
 	toNode := dag.NodeMap[completionSignal.ToNodeID]
 	if _, exists := dag.BlockingSignalsMap[toNode.TxIndex]; !exists {
@@ -88,6 +88,13 @@ func TestCreateGraph(t *testing.T) {
 	acyclic := graph.Acyclic(dag)
 	require.True(t, acyclic)
 
+	// test tx dependencies
+	require.Equal(
+		t,
+		map[int][]int{1: {0}, 2: {0}, 3: {0, 1, 2}},
+		dag.GetTxDependencies(),
+	)
+
 	// test completion signals
 	completionSignalsMap, blockingSignalsMap := dag.CompletionSignalingMap, dag.BlockingSignalsMap
 
@@ -247,6 +254,62 @@ func TestHierarchyDag(t *testing.T) {
 	)
 }
 
+func TestTxDependenciesSkipUnknown(t *testing.T) {
+	dag := NewDag()
+	/**
+	tx1: write A, commit 1
+	tx2: unknown, commit 2
+	tx3: read A, commit 3
+	tx4: write B, commit 4
+	tx5: read B, commit 5
+	tx2 has no known mapping, so neither it nor the txs after it estimate a dependency through it
+	**/
+
+	commit := *CommitAccessOp()
+	writeA := acltypes.AccessOperation{
+		AccessType:         acltypes.AccessType_WRITE,
+		ResourceType:       acltypes.ResourceType_KV,
+		IdentifierTemplate: "ResourceA",
+	}
+	readA := acltypes.AccessOperation{
+		AccessType:         acltypes.AccessType_READ,
+		ResourceType:       acltypes.ResourceType_KV,
+		IdentifierTemplate: "ResourceA",
+	}
+	writeB := acltypes.AccessOperation{
+		AccessType:         acltypes.AccessType_WRITE,
+		ResourceType:       acltypes.ResourceType_KV,
+		IdentifierTemplate: "ResourceB",
+	}
+	readB := acltypes.AccessOperation{
+		AccessType:         acltypes.AccessType_READ,
+		ResourceType:       acltypes.ResourceType_KV,
+		IdentifierTemplate: "ResourceB",
+	}
+	unknown := acltypes.AccessOperation{
+		AccessType:         acltypes.AccessType_UNKNOWN,
+		ResourceType:       acltypes.ResourceType_ANY,
+		IdentifierTemplate: "*",
+	}
+
+	dag.AddNodeBuildDependency(0, 0, writeA)
+	dag.AddNodeBuildDependency(0, 0, commit)
+	dag.AddNodeBuildDependency(0, 1, unknown)
+	dag.AddNodeBuildDependency(0, 1, commit)
+	dag.AddNodeBuildDependency(0, 2, readA)
+	dag.AddNodeBuildDependency(0, 2, commit)
+	dag.AddNodeBuildDependency(0, 3, writeB)
+	dag.AddNodeBuildDependency(0, 3, commit)
+	dag.AddNodeBuildDependency(0, 4, readB)
+	dag.AddNodeBuildDependency(0, 4, commit)
+
+	require.Equal(
+		t,
+		map[int][]int{2: {0}, 4: {3}},
+		dag.GetTxDependencies(),
+	)
+}
+
 func TestDagResourceIdentifiers(t *testing.T) {
 	dag := NewDag()
 
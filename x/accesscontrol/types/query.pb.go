@@ -456,736 +456,2576 @@ func (m *ListWasmDependencyMappingResponse) GetWasmDependencyMappingList() []acc
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.accesscontrol_x.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.accesscontrol_x.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*ResourceDependencyMappingFromMessageKeyRequest)(nil), "cosmos.accesscontrol_x.v1beta1.ResourceDependencyMappingFromMessageKeyRequest")
-	proto.RegisterType((*ResourceDependencyMappingFromMessageKeyResponse)(nil), "cosmos.accesscontrol_x.v1beta1.ResourceDependencyMappingFromMessageKeyResponse")
-	proto.RegisterType((*WasmDependencyMappingRequest)(nil), "cosmos.accesscontrol_x.v1beta1.WasmDependencyMappingRequest")
-	proto.RegisterType((*WasmDependencyMappingResponse)(nil), "cosmos.accesscontrol_x.v1beta1.WasmDependencyMappingResponse")
-	proto.RegisterType((*ListResourceDependencyMappingRequest)(nil), "cosmos.accesscontrol_x.v1beta1.ListResourceDependencyMappingRequest")
-	proto.RegisterType((*ListResourceDependencyMappingResponse)(nil), "cosmos.accesscontrol_x.v1beta1.ListResourceDependencyMappingResponse")
-	proto.RegisterType((*ListWasmDependencyMappingRequest)(nil), "cosmos.accesscontrol_x.v1beta1.ListWasmDependencyMappingRequest")
-	proto.RegisterType((*ListWasmDependencyMappingResponse)(nil), "cosmos.accesscontrol_x.v1beta1.ListWasmDependencyMappingResponse")
+type ValidationReportRequest struct {
+	// message_key restricts the report to a message key, the reports of all the message keys are returned if it's empty
+	MessageKey string `protobuf:"bytes,1,opt,name=message_key,json=messageKey,proto3" json:"message_key,omitempty" yaml:"message_key"`
 }
 
-func init() {
-	proto.RegisterFile("cosmos/accesscontrol_x/query.proto", fileDescriptor_d83f2274e13e6a16)
+func (m *ValidationReportRequest) Reset()         { *m = ValidationReportRequest{} }
+func (m *ValidationReportRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidationReportRequest) ProtoMessage()    {}
+func (*ValidationReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d83f2274e13e6a16, []int{10}
 }
-
-var fileDescriptor_d83f2274e13e6a16 = []byte{
-	// 781 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xee, 0xf0, 0x7d, 0x1f, 0x09, 0xc3, 0xe1, 0x33, 0x23, 0x08, 0xd4, 0xb2, 0x85, 0x11, 0x0b,
-	0x68, 0xdc, 0x0d, 0x25, 0xd1, 0xc4, 0x48, 0x94, 0x0a, 0x24, 0x06, 0xf0, 0xc7, 0x7a, 0x30, 0x91,
-	0x98, 0x66, 0xba, 0x1d, 0xd6, 0x0d, 0xdd, 0x9d, 0x65, 0x67, 0x2b, 0x34, 0x84, 0x8b, 0x7f, 0x81,
-	0x09, 0x7f, 0x80, 0xd1, 0xbb, 0x7a, 0xf2, 0x6f, 0x90, 0x23, 0x09, 0x07, 0x3d, 0x35, 0x06, 0x4c,
-	0x8c, 0x57, 0x2e, 0x5e, 0xcd, 0xee, 0x4e, 0x49, 0x69, 0xf7, 0x47, 0x01, 0x4f, 0xcb, 0xce, 0xbe,
-	0xef, 0xfb, 0xbc, 0xcf, 0xf3, 0xbe, 0xf3, 0x50, 0x88, 0x35, 0xc6, 0x4d, 0xc6, 0x15, 0xa2, 0x69,
-	0x94, 0x73, 0x8d, 0x59, 0xae, 0xc3, 0x2a, 0xc5, 0x4d, 0x65, 0xbd, 0x4a, 0x9d, 0x9a, 0x6c, 0x3b,
-	0xcc, 0x65, 0x48, 0x0a, 0x62, 0xe4, 0x96, 0x18, 0xf9, 0xd5, 0x54, 0x89, 0xba, 0x64, 0x2a, 0xdd,
-	0xa7, 0x33, 0x9d, 0xf9, 0xa1, 0x8a, 0xf7, 0x57, 0x90, 0x95, 0xce, 0xe8, 0x8c, 0xe9, 0x15, 0xaa,
-	0x10, 0xdb, 0x50, 0x88, 0x65, 0x31, 0x97, 0xb8, 0x06, 0xb3, 0xb8, 0xf8, 0x7a, 0x4d, 0xe0, 0x96,
-	0x08, 0xa7, 0x01, 0x98, 0x22, 0xca, 0x29, 0x36, 0xd1, 0x0d, 0xcb, 0x0f, 0x16, 0xb1, 0x13, 0x61,
-	0x3d, 0x9e, 0x7c, 0x13, 0x91, 0x63, 0x11, 0x6c, 0x74, 0x6a, 0x51, 0x6e, 0x08, 0x6c, 0xdc, 0x07,
-	0xd1, 0x13, 0x0f, 0xf1, 0x31, 0x71, 0x88, 0xc9, 0x55, 0xba, 0x5e, 0xa5, 0xdc, 0xc5, 0x2b, 0xf0,
-	0xe2, 0x89, 0x53, 0x6e, 0x33, 0x8b, 0x53, 0x34, 0x07, 0xbb, 0x6d, 0xff, 0x64, 0x10, 0x8c, 0x80,
-	0x89, 0xde, 0x7c, 0x4e, 0x8e, 0x57, 0x43, 0x0e, 0xf2, 0x0b, 0xff, 0xee, 0xd6, 0xb3, 0x29, 0x55,
-	0xe4, 0x62, 0x03, 0xca, 0x2a, 0xe5, 0xac, 0xea, 0x68, 0x74, 0x8e, 0xda, 0xd4, 0x2a, 0x53, 0x4b,
-	0xab, 0x2d, 0x13, 0xdb, 0x36, 0x2c, 0x7d, 0xc1, 0x61, 0xe6, 0x32, 0xe5, 0x9c, 0xe8, 0x74, 0x91,
-	0xd6, 0x44, 0x3b, 0xe8, 0x16, 0xec, 0x35, 0x83, 0xc3, 0xe2, 0x1a, 0xad, 0xf9, 0xe0, 0x3d, 0x85,
-	0x4b, 0x47, 0xf5, 0x2c, 0xaa, 0x11, 0xb3, 0x72, 0x1b, 0x37, 0x7d, 0xc4, 0x2a, 0x34, 0x8f, 0xf3,
-	0xf1, 0x3e, 0x80, 0x4a, 0xc7, 0x58, 0x82, 0xe4, 0x5b, 0x00, 0xd3, 0x8d, 0x82, 0xe5, 0xe3, 0x9c,
-	0xa2, 0x19, 0x24, 0x09, 0xe6, 0x37, 0x43, 0x99, 0x1f, 0xf3, 0x16, 0x65, 0xdb, 0x20, 0x0b, 0x93,
-	0x9e, 0x12, 0x47, 0xf5, 0xec, 0xe8, 0xc9, 0xc6, 0xdb, 0x71, 0xb0, 0x3a, 0x68, 0x46, 0x14, 0xc1,
-	0xab, 0x30, 0xf3, 0x8c, 0x70, 0xb3, 0xed, 0x43, 0x43, 0xae, 0x05, 0x78, 0xc1, 0x6f, 0x88, 0x68,
-	0x6e, 0x91, 0x94, 0xcb, 0x0e, 0xe5, 0x5c, 0x68, 0x76, 0xf9, 0xa8, 0x9e, 0x1d, 0x08, 0xa0, 0x5b,
-	0x23, 0xb0, 0xfa, 0x7f, 0xe3, 0x68, 0x56, 0x9c, 0x7c, 0x06, 0x70, 0x38, 0x02, 0x48, 0x68, 0xb5,
-	0x03, 0xe0, 0xc0, 0x06, 0xe1, 0x66, 0xb4, 0x50, 0xd3, 0xf1, 0x42, 0x85, 0x96, 0x2f, 0xe4, 0x84,
-	0x4a, 0x52, 0xd0, 0x6a, 0x04, 0x02, 0x56, 0xfb, 0x37, 0xc2, 0xd2, 0x71, 0x0e, 0x8e, 0x2d, 0x19,
-	0xdc, 0x8d, 0x1c, 0x7c, 0x63, 0xcb, 0xbf, 0x02, 0x78, 0x35, 0x21, 0x50, 0xf0, 0xfc, 0x00, 0x60,
-	0x36, 0x7a, 0x56, 0xc5, 0x8a, 0xc1, 0xdd, 0x41, 0x30, 0xf2, 0xcf, 0x39, 0x16, 0x43, 0x16, 0x94,
-	0x73, 0x49, 0x8b, 0xe1, 0x83, 0x61, 0x35, 0x13, 0xb5, 0x1d, 0x1e, 0x21, 0x8c, 0xe1, 0x88, 0xf7,
-	0x8c, 0xdb, 0x12, 0xfc, 0x05, 0xc0, 0xd1, 0x98, 0x20, 0xc1, 0xfc, 0x1d, 0x80, 0x99, 0x08, 0xfd,
-	0x9b, 0x69, 0x9f, 0x69, 0xcc, 0xd7, 0x05, 0xe7, 0x2b, 0xb1, 0x63, 0x16, 0x84, 0x87, 0x42, 0x67,
-	0xed, 0x11, 0xc8, 0x7f, 0xec, 0x81, 0xff, 0xf9, 0x76, 0x85, 0xde, 0x03, 0xd8, 0x1d, 0x78, 0x0e,
-	0xca, 0x27, 0x79, 0x53, 0xbb, 0xed, 0xa5, 0xa7, 0x4f, 0x95, 0x13, 0x28, 0x84, 0x95, 0xd7, 0xfb,
-	0x3f, 0x76, 0xba, 0x26, 0xd1, 0xb8, 0x22, 0x0c, 0x37, 0x78, 0xdc, 0xe0, 0xe5, 0xb5, 0x16, 0x97,
-	0x0e, 0xfc, 0x0f, 0x7d, 0xea, 0x82, 0xe3, 0x1d, 0x9a, 0x12, 0x7a, 0x98, 0xd4, 0xd1, 0xe9, 0x9c,
-	0x34, 0xfd, 0xe8, 0xaf, 0xd5, 0x13, 0xec, 0x35, 0x9f, 0xfd, 0x0b, 0xb4, 0x92, 0xc8, 0xde, 0x11,
-	0x95, 0xc3, 0x46, 0xbc, 0xea, 0x30, 0xb3, 0xd8, 0xe4, 0xe2, 0xca, 0x56, 0xd3, 0xcb, 0x36, 0xfa,
-	0x0d, 0xe0, 0x70, 0xec, 0x45, 0x45, 0x73, 0x49, 0xbc, 0x3a, 0x31, 0x84, 0xf4, 0xfc, 0x39, 0xab,
-	0x08, 0x4d, 0x1e, 0xf8, 0x9a, 0xdc, 0x47, 0xb3, 0x89, 0x9a, 0x78, 0xab, 0x5d, 0x8c, 0x11, 0x06,
-	0xfd, 0x02, 0xb0, 0x3f, 0xf4, 0xf2, 0xa0, 0x3b, 0x49, 0xbd, 0xc6, 0x5d, 0xfe, 0xf4, 0xcc, 0x19,
-	0xb3, 0x05, 0xc3, 0xa7, 0x3e, 0xc3, 0x65, 0xb4, 0x98, 0xc8, 0x30, 0xe2, 0x52, 0x2b, 0x5b, 0xad,
-	0xff, 0x7f, 0xb6, 0xd1, 0x4f, 0x00, 0x87, 0x22, 0x0d, 0x09, 0xdd, 0xeb, 0x64, 0x36, 0xb1, 0x9c,
-	0x67, 0xcf, 0x51, 0x41, 0xf0, 0x9e, 0xf7, 0x79, 0xdf, 0x45, 0x33, 0x9d, 0x4d, 0x36, 0x82, 0x7c,
-	0x61, 0x69, 0xf7, 0x40, 0x02, 0x7b, 0x07, 0x12, 0xf8, 0x7e, 0x20, 0x81, 0x37, 0x87, 0x52, 0x6a,
-	0xef, 0x50, 0x4a, 0x7d, 0x3b, 0x94, 0x52, 0xcf, 0xf3, 0xba, 0xe1, 0xbe, 0xac, 0x96, 0x64, 0x8d,
-	0x99, 0x21, 0x10, 0x9b, 0x2d, 0x20, 0x6e, 0xcd, 0xa6, 0xbc, 0xd4, 0xed, 0xff, 0x92, 0x9b, 0xfe,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x74, 0x69, 0xa8, 0xbf, 0x0a, 0x00, 0x00,
+func (m *ValidationReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidationReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidationReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationReportRequest.Merge(m, src)
+}
+func (m *ValidationReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidationReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationReportRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ValidationReportRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	ResourceDependencyMappingFromMessageKey(ctx context.Context, in *ResourceDependencyMappingFromMessageKeyRequest, opts ...grpc.CallOption) (*ResourceDependencyMappingFromMessageKeyResponse, error)
-	ListResourceDependencyMapping(ctx context.Context, in *ListResourceDependencyMappingRequest, opts ...grpc.CallOption) (*ListResourceDependencyMappingResponse, error)
-	WasmDependencyMapping(ctx context.Context, in *WasmDependencyMappingRequest, opts ...grpc.CallOption) (*WasmDependencyMappingResponse, error)
-	ListWasmDependencyMapping(ctx context.Context, in *ListWasmDependencyMappingRequest, opts ...grpc.CallOption) (*ListWasmDependencyMappingResponse, error)
+func (m *ValidationReportRequest) GetMessageKey() string {
+	if m != nil {
+		return m.MessageKey
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type ValidationReportResponse struct {
+	Reports []MessageValidationReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports" yaml:"reports"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ValidationReportResponse) Reset()         { *m = ValidationReportResponse{} }
+func (m *ValidationReportResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidationReportResponse) ProtoMessage()    {}
+func (*ValidationReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d83f2274e13e6a16, []int{11}
+}
+func (m *ValidationReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidationReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidationReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationReportResponse.Merge(m, src)
+}
+func (m *ValidationReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidationReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationReportResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidationReportResponse proto.InternalMessageInfo
+
+func (m *ValidationReportResponse) GetReports() []MessageValidationReport {
+	if m != nil {
+		return m.Reports
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ResourceDependencyMappingFromMessageKey(ctx context.Context, in *ResourceDependencyMappingFromMessageKeyRequest, opts ...grpc.CallOption) (*ResourceDependencyMappingFromMessageKeyResponse, error) {
-	out := new(ResourceDependencyMappingFromMessageKeyResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/ResourceDependencyMappingFromMessageKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MessageValidationReport aggregates the mismatches observed for the executions of a message key
+type MessageValidationReport struct {
+	MessageKey string `protobuf:"bytes,1,opt,name=message_key,json=messageKey,proto3" json:"message_key,omitempty" yaml:"message_key"`
+	// executions is the number of executions observed since the node started
+	Executions uint64           `protobuf:"varint,2,opt,name=executions,proto3" json:"executions,omitempty"`
+	Mismatches []AccessMismatch `protobuf:"bytes,3,rep,name=mismatches,proto3" json:"mismatches"`
+	// dropped_mismatches is the number of distinct mismatches that weren't kept since the report was full
+	DroppedMismatches uint64 `protobuf:"varint,4,opt,name=dropped_mismatches,json=droppedMismatches,proto3" json:"dropped_mismatches,omitempty" yaml:"dropped_mismatches"`
+}
+
+func (m *MessageValidationReport) Reset()         { *m = MessageValidationReport{} }
+func (m *MessageValidationReport) String() string { return proto.CompactTextString(m) }
+func (*MessageValidationReport) ProtoMessage()    {}
+func (*MessageValidationReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d83f2274e13e6a16, []int{12}
+}
+func (m *MessageValidationReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageValidationReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageValidationReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MessageValidationReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageValidationReport.Merge(m, src)
+}
+func (m *MessageValidationReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageValidationReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageValidationReport.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListResourceDependencyMapping(ctx context.Context, in *ListResourceDependencyMappingRequest, opts ...grpc.CallOption) (*ListResourceDependencyMappingResponse, error) {
-	out := new(ListResourceDependencyMappingResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/ListResourceDependencyMapping", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MessageValidationReport proto.InternalMessageInfo
+
+func (m *MessageValidationReport) GetMessageKey() string {
+	if m != nil {
+		return m.MessageKey
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) WasmDependencyMapping(ctx context.Context, in *WasmDependencyMappingRequest, opts ...grpc.CallOption) (*WasmDependencyMappingResponse, error) {
-	out := new(WasmDependencyMappingResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/WasmDependencyMapping", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MessageValidationReport) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ListWasmDependencyMapping(ctx context.Context, in *ListWasmDependencyMappingRequest, opts ...grpc.CallOption) (*ListWasmDependencyMappingResponse, error) {
-	out := new(ListWasmDependencyMappingResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/ListWasmDependencyMapping", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MessageValidationReport) GetMismatches() []AccessMismatch {
+	if m != nil {
+		return m.Mismatches
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	ResourceDependencyMappingFromMessageKey(context.Context, *ResourceDependencyMappingFromMessageKeyRequest) (*ResourceDependencyMappingFromMessageKeyResponse, error)
-	ListResourceDependencyMapping(context.Context, *ListResourceDependencyMappingRequest) (*ListResourceDependencyMappingResponse, error)
-	WasmDependencyMapping(context.Context, *WasmDependencyMappingRequest) (*WasmDependencyMappingResponse, error)
-	ListWasmDependencyMapping(context.Context, *ListWasmDependencyMappingRequest) (*ListWasmDependencyMappingResponse, error)
+func (m *MessageValidationReport) GetDroppedMismatches() uint64 {
+	if m != nil {
+		return m.DroppedMismatches
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// AccessMismatch is a difference between the declared access operations of a message and its execution
+type AccessMismatch struct {
+	// mismatch_type is MISSING_WRITE, MISSING_READ or OVERBROAD_UNKNOWN
+	MismatchType string `protobuf:"bytes,1,opt,name=mismatch_type,json=mismatchType,proto3" json:"mismatch_type,omitempty" yaml:"mismatch_type"`
+	// store_key, identifier and access_type are the observed access of the missing writes and reads
+	StoreKey   string                   `protobuf:"bytes,2,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty" yaml:"store_key"`
+	Identifier string                   `protobuf:"bytes,3,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	AccessType accesscontrol.AccessType `protobuf:"varint,4,opt,name=access_type,json=accessType,proto3,enum=cosmos.accesscontrol.v1beta1.AccessType" json:"access_type,omitempty" yaml:"access_type"`
+	// access_op is the declared access operation of the over-broad UNKNOWNs
+	AccessOp *accesscontrol.AccessOperation `protobuf:"bytes,5,opt,name=access_op,json=accessOp,proto3" json:"access_op,omitempty" yaml:"access_op"`
+	// count is the number of executions the mismatch was observed for
+	Count      uint64 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	LastHeight int64  `protobuf:"varint,7,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty" yaml:"last_height"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *AccessMismatch) Reset()         { *m = AccessMismatch{} }
+func (m *AccessMismatch) String() string { return proto.CompactTextString(m) }
+func (*AccessMismatch) ProtoMessage()    {}
+func (*AccessMismatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d83f2274e13e6a16, []int{13}
 }
-func (*UnimplementedQueryServer) ResourceDependencyMappingFromMessageKey(ctx context.Context, req *ResourceDependencyMappingFromMessageKeyRequest) (*ResourceDependencyMappingFromMessageKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResourceDependencyMappingFromMessageKey not implemented")
+func (m *AccessMismatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListResourceDependencyMapping(ctx context.Context, req *ListResourceDependencyMappingRequest) (*ListResourceDependencyMappingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListResourceDependencyMapping not implemented")
+func (m *AccessMismatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessMismatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) WasmDependencyMapping(ctx context.Context, req *WasmDependencyMappingRequest) (*WasmDependencyMappingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WasmDependencyMapping not implemented")
+func (m *AccessMismatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessMismatch.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ListWasmDependencyMapping(ctx context.Context, req *ListWasmDependencyMappingRequest) (*ListWasmDependencyMappingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListWasmDependencyMapping not implemented")
+func (m *AccessMismatch) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *AccessMismatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessMismatch.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_AccessMismatch proto.InternalMessageInfo
+
+func (m *AccessMismatch) GetMismatchType() string {
+	if m != nil {
+		return m.MismatchType
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ResourceDependencyMappingFromMessageKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourceDependencyMappingFromMessageKeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ResourceDependencyMappingFromMessageKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/ResourceDependencyMappingFromMessageKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ResourceDependencyMappingFromMessageKey(ctx, req.(*ResourceDependencyMappingFromMessageKeyRequest))
+func (m *AccessMismatch) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListResourceDependencyMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListResourceDependencyMappingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListResourceDependencyMapping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/ListResourceDependencyMapping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListResourceDependencyMapping(ctx, req.(*ListResourceDependencyMappingRequest))
+func (m *AccessMismatch) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_WasmDependencyMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WasmDependencyMappingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WasmDependencyMapping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/WasmDependencyMapping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WasmDependencyMapping(ctx, req.(*WasmDependencyMappingRequest))
+func (m *AccessMismatch) GetAccessType() accesscontrol.AccessType {
+	if m != nil {
+		return m.AccessType
 	}
-	return interceptor(ctx, in, info, handler)
+	return accesscontrol.AccessType_UNKNOWN
 }
 
-func _Query_ListWasmDependencyMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListWasmDependencyMappingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListWasmDependencyMapping(ctx, in)
+func (m *AccessMismatch) GetAccessOp() *accesscontrol.AccessOperation {
+	if m != nil {
+		return m.AccessOp
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/ListWasmDependencyMapping",
+	return nil
+}
+
+func (m *AccessMismatch) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListWasmDependencyMapping(ctx, req.(*ListWasmDependencyMappingRequest))
+	return 0
+}
+
+func (m *AccessMismatch) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.accesscontrol_x.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ResourceDependencyMappingFromMessageKey",
-			Handler:    _Query_ResourceDependencyMappingFromMessageKey_Handler,
-		},
-		{
-			MethodName: "ListResourceDependencyMapping",
-			Handler:    _Query_ListResourceDependencyMapping_Handler,
-		},
-		{
-			MethodName: "WasmDependencyMapping",
-			Handler:    _Query_WasmDependencyMapping_Handler,
-		},
-		{
-			MethodName: "ListWasmDependencyMapping",
-			Handler:    _Query_ListWasmDependencyMapping_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/accesscontrol_x/query.proto",
+type DependencyDagRequest struct {
+	// txs are the encoded txs in block order
+	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DependencyDagRequest) Reset()         { *m = DependencyDagRequest{} }
+func (m *DependencyDagRequest) String() string { return proto.CompactTextString(m) }
+func (*DependencyDagRequest) ProtoMessage()    {}
+func (*DependencyDagRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d83f2274e13e6a16, []int{14}
+}
+func (m *DependencyDagRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DependencyDagRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DependencyDagRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DependencyDagRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DependencyDagRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *DependencyDagRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DependencyDagRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DependencyDagRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DependencyDagRequest proto.InternalMessageInfo
+
+func (m *DependencyDagRequest) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DependencyDagResponse struct {
+	Nodes []DependencyDagNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+	Edges []DependencyDagEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges"`
+	// critical_path_length is the number of txs of the longest chain of dependent txs
+	CriticalPathLength uint64 `protobuf:"varint,3,opt,name=critical_path_length,json=criticalPathLength,proto3" json:"critical_path_length,omitempty" yaml:"critical_path_length"`
+	// level_widths is the number of txs per level, the level of a tx being the length of the longest chain of txs it
+	// depends on
+	LevelWidths []uint64 `protobuf:"varint,4,rep,packed,name=level_widths,json=levelWidths,proto3" json:"level_widths,omitempty" yaml:"level_widths"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *DependencyDagResponse) Reset()         { *m = DependencyDagResponse{} }
+func (m *DependencyDagResponse) String() string { return proto.CompactTextString(m) }
+func (*DependencyDagResponse) ProtoMessage()    {}
+func (*DependencyDagResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d83f2274e13e6a16, []int{15}
+}
+func (m *DependencyDagResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DependencyDagResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DependencyDagResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *DependencyDagResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DependencyDagResponse.Merge(m, src)
+}
+func (m *DependencyDagResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DependencyDagResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DependencyDagResponse.DiscardUnknown(m)
 }
 
-func (m *ResourceDependencyMappingFromMessageKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DependencyDagResponse proto.InternalMessageInfo
+
+func (m *DependencyDagResponse) GetNodes() []DependencyDagNode {
+	if m != nil {
+		return m.Nodes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResourceDependencyMappingFromMessageKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DependencyDagResponse) GetEdges() []DependencyDagEdge {
+	if m != nil {
+		return m.Edges
+	}
+	return nil
 }
 
-func (m *ResourceDependencyMappingFromMessageKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MessageKey) > 0 {
-		i -= len(m.MessageKey)
-		copy(dAtA[i:], m.MessageKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageKey)))
-		i--
-		dAtA[i] = 0xa
+func (m *DependencyDagResponse) GetCriticalPathLength() uint64 {
+	if m != nil {
+		return m.CriticalPathLength
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ResourceDependencyMappingFromMessageKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DependencyDagResponse) GetLevelWidths() []uint64 {
+	if m != nil {
+		return m.LevelWidths
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResourceDependencyMappingFromMessageKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DependencyDagNode is an access operation of a message of a tx, the ante handler's being at message index -1
+type DependencyDagNode struct {
+	NodeId       int64                         `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty" yaml:"node_id"`
+	TxIndex      int64                         `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty" yaml:"tx_index"`
+	MessageIndex int64                         `protobuf:"varint,3,opt,name=message_index,json=messageIndex,proto3" json:"message_index,omitempty" yaml:"message_index"`
+	AccessOp     accesscontrol.AccessOperation `protobuf:"bytes,4,opt,name=access_op,json=accessOp,proto3" json:"access_op" yaml:"access_op"`
 }
 
-func (m *ResourceDependencyMappingFromMessageKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MessageDependencyMapping.MarshalToSizedBuffer(dAtA[:i])
+func (m *DependencyDagNode) Reset()         { *m = DependencyDagNode{} }
+func (m *DependencyDagNode) String() string { return proto.CompactTextString(m) }
+func (*DependencyDagNode) ProtoMessage()    {}
+func (*DependencyDagNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d83f2274e13e6a16, []int{16}
+}
+func (m *DependencyDagNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DependencyDagNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DependencyDagNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *DependencyDagNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DependencyDagNode.Merge(m, src)
+}
+func (m *DependencyDagNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *DependencyDagNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_DependencyDagNode.DiscardUnknown(m)
 }
 
-func (m *WasmDependencyMappingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DependencyDagNode proto.InternalMessageInfo
+
+func (m *DependencyDagNode) GetNodeId() int64 {
+	if m != nil {
+		return m.NodeId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *WasmDependencyMappingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DependencyDagNode) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
 }
 
-func (m *WasmDependencyMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *DependencyDagNode) GetMessageIndex() int64 {
+	if m != nil {
+		return m.MessageIndex
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *WasmDependencyMappingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DependencyDagNode) GetAccessOp() accesscontrol.AccessOperation {
+	if m != nil {
+		return m.AccessOp
 	}
-	return dAtA[:n], nil
+	return accesscontrol.AccessOperation{}
 }
 
-func (m *WasmDependencyMappingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DependencyDagEdge blocks the access operation of the to node until the from node of a previous tx completes
+type DependencyDagEdge struct {
+	FromNodeId  int64 `protobuf:"varint,1,opt,name=from_node_id,json=fromNodeId,proto3" json:"from_node_id,omitempty" yaml:"from_node_id"`
+	ToNodeId    int64 `protobuf:"varint,2,opt,name=to_node_id,json=toNodeId,proto3" json:"to_node_id,omitempty" yaml:"to_node_id"`
+	FromTxIndex int64 `protobuf:"varint,3,opt,name=from_tx_index,json=fromTxIndex,proto3" json:"from_tx_index,omitempty" yaml:"from_tx_index"`
+	ToTxIndex   int64 `protobuf:"varint,4,opt,name=to_tx_index,json=toTxIndex,proto3" json:"to_tx_index,omitempty" yaml:"to_tx_index"`
+	// resource_types are the resource types of the access operations of the from tx that conflict with the access
+	// operation of the to node
+	ResourceTypes []accesscontrol.ResourceType `protobuf:"varint,5,rep,packed,name=resource_types,json=resourceTypes,proto3,enum=cosmos.accesscontrol.v1beta1.ResourceType" json:"resource_types,omitempty" yaml:"resource_types"`
 }
 
-func (m *WasmDependencyMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.WasmDependencyMapping.MarshalToSizedBuffer(dAtA[:i])
+func (m *DependencyDagEdge) Reset()         { *m = DependencyDagEdge{} }
+func (m *DependencyDagEdge) String() string { return proto.CompactTextString(m) }
+func (*DependencyDagEdge) ProtoMessage()    {}
+func (*DependencyDagEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d83f2274e13e6a16, []int{17}
+}
+func (m *DependencyDagEdge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DependencyDagEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DependencyDagEdge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *DependencyDagEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DependencyDagEdge.Merge(m, src)
+}
+func (m *DependencyDagEdge) XXX_Size() int {
+	return m.Size()
+}
+func (m *DependencyDagEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_DependencyDagEdge.DiscardUnknown(m)
 }
 
-func (m *ListResourceDependencyMappingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DependencyDagEdge proto.InternalMessageInfo
+
+func (m *DependencyDagEdge) GetFromNodeId() int64 {
+	if m != nil {
+		return m.FromNodeId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ListResourceDependencyMappingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DependencyDagEdge) GetToNodeId() int64 {
+	if m != nil {
+		return m.ToNodeId
+	}
+	return 0
 }
 
-func (m *ListResourceDependencyMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *DependencyDagEdge) GetFromTxIndex() int64 {
+	if m != nil {
+		return m.FromTxIndex
+	}
+	return 0
 }
 
-func (m *ListResourceDependencyMappingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DependencyDagEdge) GetToTxIndex() int64 {
+	if m != nil {
+		return m.ToTxIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ListResourceDependencyMappingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListResourceDependencyMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MessageDependencyMappingList) > 0 {
-		for iNdEx := len(m.MessageDependencyMappingList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MessageDependencyMappingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *DependencyDagEdge) GetResourceTypes() []accesscontrol.ResourceType {
+	if m != nil {
+		return m.ResourceTypes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ListWasmDependencyMappingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.accesscontrol_x.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.accesscontrol_x.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*ResourceDependencyMappingFromMessageKeyRequest)(nil), "cosmos.accesscontrol_x.v1beta1.ResourceDependencyMappingFromMessageKeyRequest")
+	proto.RegisterType((*ResourceDependencyMappingFromMessageKeyResponse)(nil), "cosmos.accesscontrol_x.v1beta1.ResourceDependencyMappingFromMessageKeyResponse")
+	proto.RegisterType((*WasmDependencyMappingRequest)(nil), "cosmos.accesscontrol_x.v1beta1.WasmDependencyMappingRequest")
+	proto.RegisterType((*WasmDependencyMappingResponse)(nil), "cosmos.accesscontrol_x.v1beta1.WasmDependencyMappingResponse")
+	proto.RegisterType((*ListResourceDependencyMappingRequest)(nil), "cosmos.accesscontrol_x.v1beta1.ListResourceDependencyMappingRequest")
+	proto.RegisterType((*ListResourceDependencyMappingResponse)(nil), "cosmos.accesscontrol_x.v1beta1.ListResourceDependencyMappingResponse")
+	proto.RegisterType((*ListWasmDependencyMappingRequest)(nil), "cosmos.accesscontrol_x.v1beta1.ListWasmDependencyMappingRequest")
+	proto.RegisterType((*ListWasmDependencyMappingResponse)(nil), "cosmos.accesscontrol_x.v1beta1.ListWasmDependencyMappingResponse")
+	proto.RegisterType((*ValidationReportRequest)(nil), "cosmos.accesscontrol_x.v1beta1.ValidationReportRequest")
+	proto.RegisterType((*ValidationReportResponse)(nil), "cosmos.accesscontrol_x.v1beta1.ValidationReportResponse")
+	proto.RegisterType((*MessageValidationReport)(nil), "cosmos.accesscontrol_x.v1beta1.MessageValidationReport")
+	proto.RegisterType((*AccessMismatch)(nil), "cosmos.accesscontrol_x.v1beta1.AccessMismatch")
+	proto.RegisterType((*DependencyDagRequest)(nil), "cosmos.accesscontrol_x.v1beta1.DependencyDagRequest")
+	proto.RegisterType((*DependencyDagResponse)(nil), "cosmos.accesscontrol_x.v1beta1.DependencyDagResponse")
+	proto.RegisterType((*DependencyDagNode)(nil), "cosmos.accesscontrol_x.v1beta1.DependencyDagNode")
+	proto.RegisterType((*DependencyDagEdge)(nil), "cosmos.accesscontrol_x.v1beta1.DependencyDagEdge")
 }
 
-func (m *ListWasmDependencyMappingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("cosmos/accesscontrol_x/query.proto", fileDescriptor_d83f2274e13e6a16)
 }
 
-func (m *ListWasmDependencyMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var fileDescriptor_d83f2274e13e6a16 = []byte{
+	// 1517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6c, 0x13, 0xc7,
+	0x17, 0xcf, 0xc6, 0xf9, 0x7c, 0xf9, 0x20, 0x0c, 0x09, 0x71, 0x4c, 0xb0, 0xc3, 0xfc, 0xf9, 0x07,
+	0x03, 0xc2, 0x56, 0x12, 0x0a, 0x6d, 0x04, 0x6a, 0xe3, 0x06, 0x54, 0x44, 0xc2, 0xc7, 0x16, 0x15,
+	0xa9, 0xa8, 0xda, 0x6e, 0x76, 0x07, 0x7b, 0x85, 0xbd, 0xb3, 0xec, 0x4c, 0xc0, 0x16, 0xe2, 0x52,
+	0xa9, 0xf7, 0x4a, 0xa8, 0xbd, 0x56, 0xed, 0x1d, 0xf5, 0xd4, 0xaa, 0xd7, 0x4a, 0x95, 0xca, 0x11,
+	0x89, 0x43, 0x7b, 0xb2, 0x2a, 0xa8, 0x84, 0x7a, 0xf5, 0xa5, 0xd7, 0x6a, 0x67, 0xc6, 0xce, 0xfa,
+	0x73, 0xf3, 0xd1, 0x53, 0x3c, 0xf3, 0xde, 0xfb, 0xcd, 0xfb, 0xfd, 0xe6, 0xbd, 0xd9, 0x99, 0x00,
+	0xb6, 0x28, 0x2b, 0x51, 0x96, 0x35, 0x2d, 0x8b, 0x30, 0x66, 0x51, 0x97, 0xfb, 0xb4, 0x68, 0x94,
+	0xb3, 0x0f, 0xb7, 0x89, 0x5f, 0xc9, 0x78, 0x3e, 0xe5, 0x14, 0x25, 0xa5, 0x4f, 0xa6, 0xc5, 0x27,
+	0xf3, 0x68, 0x69, 0x8b, 0x70, 0x73, 0x29, 0x31, 0x9d, 0xa7, 0x79, 0x2a, 0x5c, 0xb3, 0xc1, 0x2f,
+	0x19, 0x95, 0x98, 0xcf, 0x53, 0x9a, 0x2f, 0x92, 0xac, 0xe9, 0x39, 0x59, 0xd3, 0x75, 0x29, 0x37,
+	0xb9, 0x43, 0x5d, 0xa6, 0xac, 0x67, 0xd4, 0xba, 0x5b, 0x26, 0x23, 0x72, 0xb1, 0xac, 0x82, 0xcb,
+	0x7a, 0x66, 0xde, 0x71, 0x85, 0xb3, 0xf2, 0x4d, 0x77, 0xca, 0xb1, 0x79, 0xa4, 0x3c, 0x4f, 0x76,
+	0xf4, 0xb4, 0xa8, 0xcb, 0xb8, 0xe9, 0x72, 0xd6, 0xcb, 0xcb, 0x28, 0x67, 0xf3, 0xc4, 0x25, 0xcc,
+	0x51, 0x5e, 0x78, 0x1a, 0xd0, 0xed, 0x20, 0xaf, 0x5b, 0xa6, 0x6f, 0x96, 0x98, 0x4e, 0x1e, 0x6e,
+	0x13, 0xc6, 0xf1, 0x3d, 0x38, 0xd2, 0x34, 0xcb, 0x3c, 0xea, 0x32, 0x82, 0xd6, 0x61, 0xc8, 0x13,
+	0x33, 0x71, 0x6d, 0x41, 0x4b, 0x8f, 0x2d, 0x2f, 0x66, 0x7a, 0x6b, 0x96, 0x91, 0xf1, 0xb9, 0x81,
+	0x17, 0xd5, 0x54, 0x9f, 0xae, 0x62, 0xb1, 0x03, 0x19, 0x9d, 0x30, 0xba, 0xed, 0x5b, 0x64, 0x9d,
+	0x78, 0xc4, 0xb5, 0x89, 0x6b, 0x55, 0x36, 0x4d, 0xcf, 0x73, 0xdc, 0xfc, 0x55, 0x9f, 0x96, 0x36,
+	0x09, 0x63, 0x66, 0x9e, 0x5c, 0x27, 0x15, 0x95, 0x0e, 0xba, 0x08, 0x63, 0x25, 0x39, 0x69, 0x3c,
+	0x20, 0x15, 0xb1, 0xf8, 0x68, 0xee, 0x68, 0xad, 0x9a, 0x42, 0x15, 0xb3, 0x54, 0x5c, 0xc5, 0x21,
+	0x23, 0xd6, 0xa1, 0xd4, 0x88, 0xc7, 0xaf, 0x34, 0xc8, 0xee, 0x7a, 0x2d, 0x45, 0xf2, 0x5b, 0x0d,
+	0x12, 0x75, 0x40, 0xbb, 0x11, 0x63, 0x94, 0x64, 0x90, 0x62, 0x7e, 0xa1, 0x23, 0xf3, 0x06, 0x6f,
+	0x05, 0xdb, 0xb6, 0x64, 0xee, 0x74, 0xa0, 0x44, 0xad, 0x9a, 0x3a, 0xd1, 0x9c, 0x78, 0xfb, 0x3a,
+	0x58, 0x8f, 0x97, 0xba, 0x80, 0xe0, 0xfb, 0x30, 0x7f, 0xd7, 0x64, 0xa5, 0x36, 0x43, 0x5d, 0xae,
+	0xab, 0x30, 0x25, 0x12, 0x32, 0x2d, 0x6e, 0x98, 0xb6, 0xed, 0x13, 0xc6, 0x94, 0x66, 0xc7, 0x6a,
+	0xd5, 0xd4, 0xac, 0x5c, 0xba, 0xd5, 0x03, 0xeb, 0x87, 0xea, 0x53, 0x6b, 0x6a, 0xe6, 0x47, 0x0d,
+	0x8e, 0x77, 0x59, 0x48, 0x69, 0xf5, 0x4c, 0x83, 0xd9, 0xc7, 0x26, 0x2b, 0x75, 0x17, 0x6a, 0xa5,
+	0xb7, 0x50, 0x1d, 0xe1, 0x73, 0x8b, 0x4a, 0xa5, 0xa4, 0x4c, 0xb5, 0xcb, 0x0a, 0x58, 0x9f, 0x79,
+	0xdc, 0x29, 0x1c, 0x2f, 0xc2, 0xc9, 0x0d, 0x87, 0xf1, 0xae, 0x1b, 0x5f, 0xaf, 0xf2, 0xdf, 0x35,
+	0xf8, 0x7f, 0x84, 0xa3, 0xe2, 0xf9, 0x5c, 0x83, 0x54, 0xf7, 0xbd, 0x32, 0x8a, 0x0e, 0xe3, 0x71,
+	0x6d, 0x21, 0x76, 0x80, 0xc2, 0xc8, 0x28, 0xca, 0x8b, 0x51, 0x85, 0x21, 0x16, 0xc3, 0xfa, 0x7c,
+	0xb7, 0xea, 0x08, 0x08, 0x61, 0x0c, 0x0b, 0xc1, 0xdf, 0x5e, 0x55, 0x82, 0x7f, 0xd3, 0xe0, 0x44,
+	0x0f, 0x27, 0xc5, 0xfc, 0x3b, 0x0d, 0xe6, 0xbb, 0xe8, 0x1f, 0xa6, 0xbd, 0xaf, 0x6d, 0x3e, 0xab,
+	0x38, 0xff, 0xaf, 0xe7, 0x36, 0x2b, 0xc2, 0x73, 0x1d, 0xf7, 0x5a, 0xb0, 0xd5, 0x61, 0xf6, 0x13,
+	0xb3, 0xe8, 0xd8, 0xe2, 0x34, 0xd5, 0x89, 0x47, 0x7d, 0x7e, 0xe0, 0x93, 0xe3, 0x4b, 0x0d, 0xe2,
+	0xed, 0xa0, 0x4a, 0x14, 0x07, 0x86, 0x7d, 0x31, 0xc3, 0x14, 0xfd, 0x8b, 0x51, 0x07, 0xa1, 0xda,
+	0xf7, 0x56, 0xc4, 0xdc, 0x51, 0x25, 0xc1, 0xa4, 0x4c, 0x47, 0xa1, 0x62, 0xbd, 0x8e, 0x8f, 0xbf,
+	0xe9, 0x87, 0xd9, 0x2e, 0xc1, 0xfb, 0x26, 0x87, 0x92, 0x00, 0xa4, 0x4c, 0xac, 0xed, 0x00, 0x8b,
+	0xc5, 0xfb, 0x17, 0xb4, 0xf4, 0x80, 0x1e, 0x9a, 0x41, 0x77, 0x00, 0x4a, 0x0e, 0x2b, 0x99, 0xdc,
+	0x2a, 0x10, 0x16, 0x8f, 0x09, 0x8a, 0x99, 0x28, 0x8a, 0x6b, 0x62, 0x7e, 0x53, 0xc5, 0xa9, 0x33,
+	0x3f, 0x84, 0x83, 0x36, 0x00, 0xd9, 0x3e, 0xf5, 0x3c, 0x62, 0x1b, 0x21, 0xf4, 0x81, 0x60, 0xf5,
+	0xdc, 0xf1, 0x5a, 0x35, 0x35, 0x27, 0xb3, 0x6e, 0xf7, 0xc1, 0xfa, 0x61, 0x35, 0xb9, 0xb9, 0x33,
+	0xf7, 0x53, 0x0c, 0x26, 0x9b, 0x97, 0x44, 0x97, 0x61, 0xa2, 0x1e, 0x64, 0xf0, 0x8a, 0x47, 0x94,
+	0x22, 0xf1, 0x5a, 0x35, 0x35, 0xad, 0x14, 0x09, 0x9b, 0xb1, 0x3e, 0x5e, 0x1f, 0xdf, 0xa9, 0x78,
+	0x04, 0x2d, 0xc1, 0x28, 0xe3, 0xd4, 0x97, 0x62, 0xf6, 0x8b, 0xd0, 0xe9, 0x5a, 0x35, 0x35, 0x25,
+	0x43, 0x1b, 0x26, 0xac, 0x8f, 0x88, 0xdf, 0x4a, 0x48, 0xc7, 0x26, 0x2e, 0x77, 0xee, 0x3b, 0xc4,
+	0x8f, 0xc7, 0x82, 0x18, 0x3d, 0x34, 0x83, 0x4c, 0x18, 0x93, 0x72, 0xc9, 0x7c, 0x02, 0xae, 0x93,
+	0xcb, 0xe9, 0xde, 0xbd, 0x22, 0x49, 0x05, 0x19, 0x85, 0xf7, 0x32, 0x04, 0x83, 0x75, 0x30, 0x1b,
+	0x3e, 0xe8, 0x73, 0x18, 0x55, 0x36, 0xea, 0xc5, 0x07, 0xc5, 0x99, 0x7b, 0x6e, 0x37, 0x0b, 0xdc,
+	0xf4, 0x88, 0x2f, 0x8a, 0x29, 0x4c, 0xb2, 0x81, 0x84, 0xf5, 0x11, 0x53, 0xb9, 0xa1, 0x69, 0x18,
+	0xb4, 0xe8, 0xb6, 0xcb, 0xe3, 0x43, 0xa2, 0x50, 0xe4, 0x20, 0x28, 0xbe, 0xa2, 0xc9, 0xb8, 0x51,
+	0x20, 0x4e, 0xbe, 0xc0, 0xe3, 0xc3, 0x0b, 0x5a, 0x3a, 0x16, 0x4e, 0x38, 0x64, 0xc4, 0x3a, 0x04,
+	0xa3, 0x8f, 0xe4, 0x20, 0x0d, 0xd3, 0x3b, 0x6d, 0xbc, 0x6e, 0x36, 0xbe, 0x5a, 0x53, 0x10, 0xe3,
+	0x65, 0xd9, 0x50, 0xe3, 0x7a, 0xf0, 0x13, 0xff, 0xda, 0x0f, 0x33, 0x2d, 0xae, 0xaa, 0x01, 0x37,
+	0x61, 0xd0, 0xa5, 0x36, 0xa9, 0xb7, 0xdf, 0x52, 0x54, 0x6d, 0x36, 0xa1, 0xdc, 0xa0, 0x36, 0x51,
+	0xe5, 0x29, 0x51, 0x02, 0x38, 0x62, 0xe7, 0x49, 0xd0, 0x0a, 0x7b, 0x87, 0xbb, 0x62, 0xe7, 0x1b,
+	0x70, 0x02, 0x05, 0xdd, 0x86, 0x69, 0xcb, 0x77, 0xb8, 0x63, 0x99, 0x45, 0xc3, 0x33, 0x79, 0xc1,
+	0x28, 0x12, 0x37, 0xcf, 0x0b, 0xa2, 0x3e, 0x06, 0x72, 0xa9, 0x5a, 0x35, 0x75, 0x4c, 0x7d, 0x83,
+	0x3b, 0x78, 0x61, 0x1d, 0xd5, 0xa7, 0x6f, 0x99, 0xbc, 0xb0, 0x21, 0x26, 0xd1, 0x2a, 0x8c, 0x17,
+	0xc9, 0x23, 0x52, 0x34, 0x1e, 0x3b, 0x36, 0x2f, 0x04, 0x5d, 0x13, 0x4b, 0x0f, 0xe4, 0x66, 0x6b,
+	0xd5, 0xd4, 0x11, 0x25, 0x77, 0xc8, 0x8a, 0xf5, 0x31, 0x31, 0xbc, 0x2b, 0x47, 0x5f, 0xf7, 0xc3,
+	0xe1, 0x36, 0x01, 0xd0, 0x59, 0x18, 0x0e, 0xc8, 0x1b, 0x8e, 0x2d, 0xda, 0x24, 0x96, 0x43, 0x3b,
+	0xc7, 0x90, 0x32, 0x60, 0x7d, 0x28, 0xf8, 0x75, 0xcd, 0x46, 0x19, 0x18, 0xe1, 0x65, 0xc3, 0x71,
+	0x6d, 0x52, 0x16, 0x9d, 0x11, 0xcb, 0x1d, 0xa9, 0x55, 0x53, 0x87, 0xa4, 0x77, 0xdd, 0x82, 0xf5,
+	0x61, 0x5e, 0xbe, 0x16, 0xfc, 0x12, 0x9d, 0xa8, 0x0e, 0x1f, 0x19, 0x14, 0x13, 0x41, 0xe1, 0x4e,
+	0x0c, 0x9b, 0x83, 0x4e, 0x94, 0x63, 0x19, 0x6e, 0x87, 0x6b, 0x7a, 0x60, 0x3f, 0x35, 0x1d, 0x57,
+	0xe7, 0x6a, 0x8f, 0xba, 0xc6, 0x6f, 0x5b, 0x75, 0x09, 0x76, 0x12, 0xbd, 0x07, 0xe3, 0xf7, 0x7d,
+	0x5a, 0x32, 0x9a, 0xc5, 0x09, 0x29, 0x1d, 0xb6, 0x62, 0x1d, 0x82, 0xe1, 0x0d, 0xa9, 0xd2, 0x0a,
+	0x00, 0xa7, 0x8d, 0x40, 0xa9, 0xd3, 0x4c, 0xad, 0x9a, 0x3a, 0xac, 0x74, 0xa2, 0x3b, 0x61, 0x23,
+	0x9c, 0xaa, 0xa0, 0x4b, 0x30, 0x21, 0x10, 0x79, 0xb9, 0x9b, 0x54, 0x4d, 0x66, 0xac, 0x8f, 0x05,
+	0xe3, 0x3b, 0x4a, 0xe8, 0x0b, 0x30, 0xc6, 0xe9, 0x4e, 0xec, 0x40, 0x6b, 0x17, 0x86, 0x8c, 0x58,
+	0x1f, 0xe5, 0xb4, 0x1e, 0x57, 0x84, 0x49, 0x5f, 0xdd, 0x7a, 0xc4, 0x99, 0xc2, 0xe2, 0x83, 0x0b,
+	0xb1, 0xf4, 0xe4, 0xf2, 0x99, 0xde, 0x32, 0xd7, 0x6f, 0x4a, 0xe2, 0x74, 0x9a, 0xab, 0x55, 0x53,
+	0x33, 0xf5, 0xef, 0x56, 0x18, 0x0b, 0xeb, 0x13, 0x7e, 0xc8, 0x91, 0x2d, 0x3f, 0x1f, 0x87, 0x41,
+	0xf1, 0x9e, 0x40, 0xdf, 0x6b, 0x30, 0x24, 0x1f, 0x05, 0x68, 0x39, 0xaa, 0xcb, 0xda, 0xdf, 0x25,
+	0x89, 0x95, 0x3d, 0xc5, 0xc8, 0xc3, 0x02, 0x67, 0xbf, 0x78, 0xf5, 0xd7, 0xb3, 0xfe, 0xd3, 0xe8,
+	0x54, 0x56, 0xbd, 0x88, 0xe4, 0x9f, 0x73, 0xcc, 0x7e, 0xd0, 0xf2, 0x84, 0x92, 0x0f, 0x14, 0xf4,
+	0x43, 0x3f, 0x9c, 0xda, 0xe5, 0xab, 0x01, 0xdd, 0x88, 0xca, 0x68, 0x6f, 0x4f, 0x9d, 0xc4, 0xcd,
+	0xff, 0x0c, 0x4f, 0xb1, 0xb7, 0x04, 0xfb, 0xcf, 0xd0, 0xbd, 0x48, 0xf6, 0x8d, 0x4d, 0xec, 0x70,
+	0x07, 0x13, 0x35, 0x18, 0xba, 0x4f, 0x64, 0x9f, 0x84, 0x06, 0x4f, 0xd1, 0x3f, 0x1a, 0x1c, 0xef,
+	0x79, 0x93, 0x46, 0xeb, 0x51, 0xbc, 0x76, 0x73, 0x63, 0x4f, 0x5c, 0x39, 0x20, 0x8a, 0xd2, 0xe4,
+	0x9a, 0xd0, 0xe4, 0x43, 0xb4, 0x16, 0xa9, 0x49, 0x70, 0xf7, 0x34, 0x7a, 0x08, 0x83, 0xfe, 0xd6,
+	0x60, 0xa6, 0xe3, 0xed, 0x16, 0x5d, 0x8a, 0xca, 0xb5, 0xd7, 0xed, 0x3c, 0x71, 0x79, 0x9f, 0xd1,
+	0x8a, 0xe1, 0xc7, 0x82, 0xe1, 0x26, 0xba, 0x1e, 0xc9, 0xb0, 0xcb, 0xad, 0x3b, 0xfb, 0xa4, 0xf5,
+	0x81, 0xf8, 0x14, 0xbd, 0xd5, 0x60, 0xae, 0xeb, 0x8b, 0x01, 0x7d, 0xb0, 0x9b, 0xbd, 0xe9, 0xc9,
+	0x79, 0xed, 0x00, 0x08, 0x8a, 0xf7, 0x15, 0xc1, 0xfb, 0x7d, 0x74, 0x79, 0x77, 0x3b, 0xdb, 0x85,
+	0x3c, 0xfa, 0x45, 0x83, 0xa9, 0xf6, 0xeb, 0x76, 0x54, 0x7a, 0x5d, 0x1e, 0x21, 0x89, 0x77, 0xf7,
+	0x1e, 0xa8, 0xe8, 0xac, 0x0a, 0x3a, 0xe7, 0xd1, 0x72, 0x24, 0x9d, 0x47, 0x0d, 0x08, 0x43, 0x3e,
+	0x1d, 0xd0, 0xcf, 0x1a, 0x4c, 0x34, 0x7d, 0xde, 0xd0, 0xf9, 0x3d, 0xdd, 0x6b, 0xea, 0xd9, 0xbf,
+	0xb3, 0xc7, 0xa8, 0xe6, 0xd4, 0x71, 0x36, 0x32, 0xf5, 0x90, 0xfe, 0xb6, 0x99, 0x5f, 0xd5, 0xce,
+	0xe4, 0x36, 0x5e, 0xbc, 0x4e, 0x6a, 0x2f, 0x5f, 0x27, 0xb5, 0x3f, 0x5f, 0x27, 0xb5, 0xaf, 0xde,
+	0x24, 0xfb, 0x5e, 0xbe, 0x49, 0xf6, 0xfd, 0xf1, 0x26, 0xd9, 0xf7, 0xe9, 0x72, 0xde, 0xe1, 0x85,
+	0xed, 0xad, 0x8c, 0x45, 0x4b, 0x1d, 0x70, 0xcb, 0x2d, 0xc8, 0xe2, 0x6b, 0xb4, 0x35, 0x24, 0xfe,
+	0xd3, 0xb5, 0xf2, 0xef, 0x00, 0x17, 0x56, 0x42, 0x50, 0x05, 0x14, 0x00, 0x00,
 }
 
-func (m *ListWasmDependencyMappingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	ResourceDependencyMappingFromMessageKey(ctx context.Context, in *ResourceDependencyMappingFromMessageKeyRequest, opts ...grpc.CallOption) (*ResourceDependencyMappingFromMessageKeyResponse, error)
+	ListResourceDependencyMapping(ctx context.Context, in *ListResourceDependencyMappingRequest, opts ...grpc.CallOption) (*ListResourceDependencyMappingResponse, error)
+	WasmDependencyMapping(ctx context.Context, in *WasmDependencyMappingRequest, opts ...grpc.CallOption) (*WasmDependencyMappingResponse, error)
+	ListWasmDependencyMapping(ctx context.Context, in *ListWasmDependencyMappingRequest, opts ...grpc.CallOption) (*ListWasmDependencyMappingResponse, error)
+	// ValidationReport returns the mismatches between the dependency mappings and the store accesses
+	// of the executed messages observed by the node. The node must run with the access observer enabled.
+	ValidationReport(ctx context.Context, in *ValidationReportRequest, opts ...grpc.CallOption) (*ValidationReportResponse, error)
+	// DependencyDag builds the dependency DAG of txs with the dependency mappings at the queried height. The DAG of a
+	// block is rebuilt by querying it with the txs of the block at the height before it.
+	DependencyDag(ctx context.Context, in *DependencyDagRequest, opts ...grpc.CallOption) (*DependencyDagResponse, error)
 }
 
-func (m *ListWasmDependencyMappingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *ListWasmDependencyMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WasmDependencyMappingList) > 0 {
-		for iNdEx := len(m.WasmDependencyMappingList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WasmDependencyMappingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) ResourceDependencyMappingFromMessageKey(ctx context.Context, in *ResourceDependencyMappingFromMessageKeyRequest, opts ...grpc.CallOption) (*ResourceDependencyMappingFromMessageKeyResponse, error) {
+	out := new(ResourceDependencyMappingFromMessageKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/ResourceDependencyMappingFromMessageKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ListResourceDependencyMapping(ctx context.Context, in *ListResourceDependencyMappingRequest, opts ...grpc.CallOption) (*ListResourceDependencyMappingResponse, error) {
+	out := new(ListResourceDependencyMappingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/ListResourceDependencyMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *ResourceDependencyMappingFromMessageKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MessageKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) WasmDependencyMapping(ctx context.Context, in *WasmDependencyMappingRequest, opts ...grpc.CallOption) (*WasmDependencyMappingResponse, error) {
+	out := new(WasmDependencyMappingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/WasmDependencyMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ResourceDependencyMappingFromMessageKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ListWasmDependencyMapping(ctx context.Context, in *ListWasmDependencyMappingRequest, opts ...grpc.CallOption) (*ListWasmDependencyMappingResponse, error) {
+	out := new(ListWasmDependencyMappingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/ListWasmDependencyMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MessageDependencyMapping.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *WasmDependencyMappingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ValidationReport(ctx context.Context, in *ValidationReportRequest, opts ...grpc.CallOption) (*ValidationReportResponse, error) {
+	out := new(ValidationReportResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/ValidationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *WasmDependencyMappingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DependencyDag(ctx context.Context, in *DependencyDagRequest, opts ...grpc.CallOption) (*DependencyDagResponse, error) {
+	out := new(DependencyDagResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accesscontrol_x.v1beta1.Query/DependencyDag", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.WasmDependencyMapping.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *ListResourceDependencyMappingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	ResourceDependencyMappingFromMessageKey(context.Context, *ResourceDependencyMappingFromMessageKeyRequest) (*ResourceDependencyMappingFromMessageKeyResponse, error)
+	ListResourceDependencyMapping(context.Context, *ListResourceDependencyMappingRequest) (*ListResourceDependencyMappingResponse, error)
+	WasmDependencyMapping(context.Context, *WasmDependencyMappingRequest) (*WasmDependencyMappingResponse, error)
+	ListWasmDependencyMapping(context.Context, *ListWasmDependencyMappingRequest) (*ListWasmDependencyMappingResponse, error)
+	// ValidationReport returns the mismatches between the dependency mappings and the store accesses
+	// of the executed messages observed by the node. The node must run with the access observer enabled.
+	ValidationReport(context.Context, *ValidationReportRequest) (*ValidationReportResponse, error)
+	// DependencyDag builds the dependency DAG of txs with the dependency mappings at the queried height. The DAG of a
+	// block is rebuilt by querying it with the txs of the block at the height before it.
+	DependencyDag(context.Context, *DependencyDagRequest) (*DependencyDagResponse, error)
 }
 
-func (m *ListResourceDependencyMappingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MessageDependencyMappingList) > 0 {
-		for _, e := range m.MessageDependencyMappingList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *ListWasmDependencyMappingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ResourceDependencyMappingFromMessageKey(ctx context.Context, req *ResourceDependencyMappingFromMessageKeyRequest) (*ResourceDependencyMappingFromMessageKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceDependencyMappingFromMessageKey not implemented")
+}
+func (*UnimplementedQueryServer) ListResourceDependencyMapping(ctx context.Context, req *ListResourceDependencyMappingRequest) (*ListResourceDependencyMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceDependencyMapping not implemented")
+}
+func (*UnimplementedQueryServer) WasmDependencyMapping(ctx context.Context, req *WasmDependencyMappingRequest) (*WasmDependencyMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WasmDependencyMapping not implemented")
+}
+func (*UnimplementedQueryServer) ListWasmDependencyMapping(ctx context.Context, req *ListWasmDependencyMappingRequest) (*ListWasmDependencyMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWasmDependencyMapping not implemented")
+}
+func (*UnimplementedQueryServer) ValidationReport(ctx context.Context, req *ValidationReportRequest) (*ValidationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidationReport not implemented")
+}
+func (*UnimplementedQueryServer) DependencyDag(ctx context.Context, req *DependencyDagRequest) (*DependencyDagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DependencyDag not implemented")
 }
 
-func (m *ListWasmDependencyMappingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceDependencyMappingFromMessageKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceDependencyMappingFromMessageKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceDependencyMappingFromMessageKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/ResourceDependencyMappingFromMessageKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceDependencyMappingFromMessageKey(ctx, req.(*ResourceDependencyMappingFromMessageKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListResourceDependencyMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceDependencyMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListResourceDependencyMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/ListResourceDependencyMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListResourceDependencyMapping(ctx, req.(*ListResourceDependencyMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WasmDependencyMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WasmDependencyMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WasmDependencyMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/WasmDependencyMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WasmDependencyMapping(ctx, req.(*WasmDependencyMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListWasmDependencyMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWasmDependencyMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListWasmDependencyMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/ListWasmDependencyMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListWasmDependencyMapping(ctx, req.(*ListWasmDependencyMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/ValidationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidationReport(ctx, req.(*ValidationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DependencyDag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DependencyDagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DependencyDag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accesscontrol_x.v1beta1.Query/DependencyDag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DependencyDag(ctx, req.(*DependencyDagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.accesscontrol_x.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ResourceDependencyMappingFromMessageKey",
+			Handler:    _Query_ResourceDependencyMappingFromMessageKey_Handler,
+		},
+		{
+			MethodName: "ListResourceDependencyMapping",
+			Handler:    _Query_ListResourceDependencyMapping_Handler,
+		},
+		{
+			MethodName: "WasmDependencyMapping",
+			Handler:    _Query_WasmDependencyMapping_Handler,
+		},
+		{
+			MethodName: "ListWasmDependencyMapping",
+			Handler:    _Query_ListWasmDependencyMapping_Handler,
+		},
+		{
+			MethodName: "ValidationReport",
+			Handler:    _Query_ValidationReport_Handler,
+		},
+		{
+			MethodName: "DependencyDag",
+			Handler:    _Query_DependencyDag_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/accesscontrol_x/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.WasmDependencyMappingList) > 0 {
-		for _, e := range m.WasmDependencyMappingList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceDependencyMappingFromMessageKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceDependencyMappingFromMessageKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceDependencyMappingFromMessageKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageKey) > 0 {
+		i -= len(m.MessageKey)
+		copy(dAtA[i:], m.MessageKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResourceDependencyMappingFromMessageKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceDependencyMappingFromMessageKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceDependencyMappingFromMessageKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MessageDependencyMapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WasmDependencyMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmDependencyMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmDependencyMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WasmDependencyMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WasmDependencyMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WasmDependencyMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.WasmDependencyMapping.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListResourceDependencyMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListResourceDependencyMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListResourceDependencyMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListResourceDependencyMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListResourceDependencyMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListResourceDependencyMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageDependencyMappingList) > 0 {
+		for iNdEx := len(m.MessageDependencyMappingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageDependencyMappingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListWasmDependencyMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListWasmDependencyMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListWasmDependencyMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListWasmDependencyMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListWasmDependencyMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListWasmDependencyMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WasmDependencyMappingList) > 0 {
+		for iNdEx := len(m.WasmDependencyMappingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WasmDependencyMappingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidationReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidationReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidationReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageKey) > 0 {
+		i -= len(m.MessageKey)
+		copy(dAtA[i:], m.MessageKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidationReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidationReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidationReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageValidationReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageValidationReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageValidationReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DroppedMismatches != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DroppedMismatches))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Mismatches) > 0 {
+		for iNdEx := len(m.Mismatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mismatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Executions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MessageKey) > 0 {
+		i -= len(m.MessageKey)
+		copy(dAtA[i:], m.MessageKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MessageKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessMismatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessMismatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessMismatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AccessOp != nil {
+		{
+			size, err := m.AccessOp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AccessType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccessType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MismatchType) > 0 {
+		i -= len(m.MismatchType)
+		copy(dAtA[i:], m.MismatchType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MismatchType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DependencyDagRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DependencyDagRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DependencyDagRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DependencyDagResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DependencyDagResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DependencyDagResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LevelWidths) > 0 {
+		dAtA6 := make([]byte, len(m.LevelWidths)*10)
+		var j5 int
+		for _, num := range m.LevelWidths {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CriticalPathLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CriticalPathLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Edges) > 0 {
+		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Edges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DependencyDagNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DependencyDagNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DependencyDagNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AccessOp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MessageIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MessageIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NodeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DependencyDagEdge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DependencyDagEdge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DependencyDagEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResourceTypes) > 0 {
+		dAtA9 := make([]byte, len(m.ResourceTypes)*10)
+		var j8 int
+		for _, num := range m.ResourceTypes {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToTxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToTxIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromTxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromTxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToNodeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToNodeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromNodeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromNodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ResourceDependencyMappingFromMessageKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ResourceDependencyMappingFromMessageKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MessageDependencyMapping.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *WasmDependencyMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *WasmDependencyMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WasmDependencyMapping.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ListResourceDependencyMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListResourceDependencyMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MessageDependencyMappingList) > 0 {
+		for _, e := range m.MessageDependencyMappingList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListWasmDependencyMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListWasmDependencyMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WasmDependencyMappingList) > 0 {
+		for _, e := range m.WasmDependencyMappingList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidationReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidationReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageValidationReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovQuery(uint64(m.Executions))
+	}
+	if len(m.Mismatches) > 0 {
+		for _, e := range m.Mismatches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.DroppedMismatches != 0 {
+		n += 1 + sovQuery(uint64(m.DroppedMismatches))
+	}
+	return n
+}
+
+func (m *AccessMismatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MismatchType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AccessType != 0 {
+		n += 1 + sovQuery(uint64(m.AccessType))
+	}
+	if m.AccessOp != nil {
+		l = m.AccessOp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func (m *DependencyDagRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DependencyDagResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CriticalPathLength != 0 {
+		n += 1 + sovQuery(uint64(m.CriticalPathLength))
+	}
+	if len(m.LevelWidths) > 0 {
+		l = 0
+		for _, e := range m.LevelWidths {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *DependencyDagNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeId != 0 {
+		n += 1 + sovQuery(uint64(m.NodeId))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	if m.MessageIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MessageIndex))
+	}
+	l = m.AccessOp.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *DependencyDagEdge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromNodeId != 0 {
+		n += 1 + sovQuery(uint64(m.FromNodeId))
+	}
+	if m.ToNodeId != 0 {
+		n += 1 + sovQuery(uint64(m.ToNodeId))
+	}
+	if m.FromTxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.FromTxIndex))
+	}
+	if m.ToTxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.ToTxIndex))
+	}
+	if len(m.ResourceTypes) > 0 {
+		l = 0
+		for _, e := range m.ResourceTypes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceDependencyMappingFromMessageKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceDependencyMappingFromMessageKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceDependencyMappingFromMessageKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceDependencyMappingFromMessageKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceDependencyMappingFromMessageKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceDependencyMappingFromMessageKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDependencyMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MessageDependencyMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WasmDependencyMappingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmDependencyMappingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmDependencyMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WasmDependencyMappingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WasmDependencyMappingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WasmDependencyMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmDependencyMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WasmDependencyMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListResourceDependencyMappingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListResourceDependencyMappingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListResourceDependencyMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListResourceDependencyMappingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListResourceDependencyMappingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListResourceDependencyMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDependencyMappingList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageDependencyMappingList = append(m.MessageDependencyMappingList, accesscontrol.MessageDependencyMapping{})
+			if err := m.MessageDependencyMappingList[len(m.MessageDependencyMappingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *ListWasmDependencyMappingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1208,10 +3048,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListWasmDependencyMappingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListWasmDependencyMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1235,7 +3075,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ListWasmDependencyMappingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1258,15 +3098,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListWasmDependencyMappingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListWasmDependencyMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmDependencyMappingList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1293,7 +3133,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WasmDependencyMappingList = append(m.WasmDependencyMappingList, accesscontrol.WasmDependencyMapping{})
+			if err := m.WasmDependencyMappingList[len(m.WasmDependencyMappingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1318,7 +3159,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceDependencyMappingFromMessageKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidationReportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,10 +3182,10 @@ func (m *ResourceDependencyMappingFromMessageKeyRequest) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDependencyMappingFromMessageKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidationReportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDependencyMappingFromMessageKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidationReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1400,7 +3241,7 @@ func (m *ResourceDependencyMappingFromMessageKeyRequest) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *ResourceDependencyMappingFromMessageKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidationReportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1423,15 +3264,15 @@ func (m *ResourceDependencyMappingFromMessageKeyResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDependencyMappingFromMessageKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidationReportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDependencyMappingFromMessageKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidationReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageDependencyMapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1458,7 +3299,8 @@ func (m *ResourceDependencyMappingFromMessageKeyResponse) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MessageDependencyMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reports = append(m.Reports, MessageValidationReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1483,7 +3325,7 @@ func (m *ResourceDependencyMappingFromMessageKeyResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *WasmDependencyMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *MessageValidationReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1506,15 +3348,15 @@ func (m *WasmDependencyMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WasmDependencyMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageValidationReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WasmDependencyMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageValidationReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1542,8 +3384,80 @@ func (m *WasmDependencyMappingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.MessageKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mismatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mismatches = append(m.Mismatches, AccessMismatch{})
+			if err := m.Mismatches[len(m.Mismatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedMismatches", wireType)
+			}
+			m.DroppedMismatches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedMismatches |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1565,7 +3479,7 @@ func (m *WasmDependencyMappingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WasmDependencyMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *AccessMismatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1588,17 +3502,187 @@ func (m *WasmDependencyMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WasmDependencyMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccessMismatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WasmDependencyMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccessMismatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WasmDependencyMapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MismatchType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MismatchType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+			}
+			m.AccessType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessType |= accesscontrol.AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessOp == nil {
+				m.AccessOp = &accesscontrol.AccessOperation{}
+			}
+			if err := m.AccessOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1608,25 +3692,11 @@ func (m *WasmDependencyMappingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.WasmDependencyMapping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1648,7 +3718,7 @@ func (m *WasmDependencyMappingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListResourceDependencyMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *DependencyDagRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1671,12 +3741,44 @@ func (m *ListResourceDependencyMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListResourceDependencyMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DependencyDagRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListResourceDependencyMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DependencyDagRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1698,7 +3800,7 @@ func (m *ListResourceDependencyMappingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListResourceDependencyMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *DependencyDagResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1721,15 +3823,15 @@ func (m *ListResourceDependencyMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListResourceDependencyMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DependencyDagResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListResourceDependencyMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DependencyDagResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageDependencyMappingList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1756,11 +3858,140 @@ func (m *ListResourceDependencyMappingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MessageDependencyMappingList = append(m.MessageDependencyMappingList, accesscontrol.MessageDependencyMapping{})
-			if err := m.MessageDependencyMappingList[len(m.MessageDependencyMappingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, DependencyDagNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Edges = append(m.Edges, DependencyDagEdge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalPathLength", wireType)
+			}
+			m.CriticalPathLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CriticalPathLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LevelWidths = append(m.LevelWidths, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LevelWidths) == 0 {
+					m.LevelWidths = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LevelWidths = append(m.LevelWidths, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LevelWidths", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1782,7 +4013,7 @@ func (m *ListResourceDependencyMappingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListWasmDependencyMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *DependencyDagNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1805,12 +4036,102 @@ func (m *ListWasmDependencyMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListWasmDependencyMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DependencyDagNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListWasmDependencyMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DependencyDagNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			m.NodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageIndex", wireType)
+			}
+			m.MessageIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessOp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessOp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1832,7 +4153,7 @@ func (m *ListWasmDependencyMappingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListWasmDependencyMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *DependencyDagEdge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1855,17 +4176,17 @@ func (m *ListWasmDependencyMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListWasmDependencyMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DependencyDagEdge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListWasmDependencyMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DependencyDagEdge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WasmDependencyMappingList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromNodeId", wireType)
 			}
-			var msglen int
+			m.FromNodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1875,26 +4196,137 @@ func (m *ListWasmDependencyMappingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FromNodeId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToNodeId", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.ToNodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToNodeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTxIndex", wireType)
 			}
-			m.WasmDependencyMappingList = append(m.WasmDependencyMappingList, accesscontrol.WasmDependencyMapping{})
-			if err := m.WasmDependencyMappingList[len(m.WasmDependencyMappingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FromTxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTxIndex", wireType)
+			}
+			m.ToTxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v accesscontrol.ResourceType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= accesscontrol.ResourceType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ResourceTypes = append(m.ResourceTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.ResourceTypes) == 0 {
+					m.ResourceTypes = make([]accesscontrol.ResourceType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v accesscontrol.ResourceType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= accesscontrol.ResourceType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ResourceTypes = append(m.ResourceTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceTypes", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
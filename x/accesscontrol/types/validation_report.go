@@ -0,0 +1,32 @@
+package types
+
+import (
+	acltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+)
+
+// NewMessageValidationReport converts the report of the access observer for a message key to its query type
+func NewMessageValidationReport(report acltypes.MessageValidationReport) MessageValidationReport {
+	mismatches := make([]AccessMismatch, 0, len(report.Mismatches))
+	for _, record := range report.Mismatches {
+		mismatch := AccessMismatch{
+			MismatchType: record.Type.String(),
+			Count:        record.Count,
+			LastHeight:   record.LastHeight,
+		}
+		if record.Type == acltypes.MismatchType_OVERBROAD_UNKNOWN {
+			accessOp := record.AccessOp
+			mismatch.AccessOp = &accessOp
+		} else {
+			mismatch.StoreKey = record.Access.StoreKey
+			mismatch.Identifier = record.Access.Identifier
+			mismatch.AccessType = record.Access.AccessType
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+	return MessageValidationReport{
+		MessageKey:        report.MessageKey,
+		Executions:        report.Executions,
+		Mismatches:        mismatches,
+		DroppedMismatches: report.DroppedMismatches,
+	}
+}
@@ -14,10 +14,15 @@ type HandlerOptions struct {
 	AccountKeeper   AccountKeeper
 	BankKeeper      types.BankKeeper
 	FeegrantKeeper  FeegrantKeeper
+	FeeMarketKeeper FeeMarketKeeper
 	ParamsKeeper    ParamsKeeper
+	// CircuitKeeper, if set, rejects the txs containing messages whose circuit breaker is tripped
+	CircuitKeeper   CircuitKeeper
 	SignModeHandler authsigning.SignModeHandler
 	SigGasConsumer  func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
-	TxFeeChecker    TxFeeChecker
+	// SigVerificationCache, if set, is shared by the signature verifications of CheckTx, ProcessProposal and DeliverTx
+	SigVerificationCache *SigVerificationCache
+	TxFeeChecker         TxFeeChecker
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -41,17 +46,19 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, sdk.AnteDepGenerat
 	}
 
 	var sigVerifyDecorator sdk.AnteDecorator
-	sequentialVerifyDecorator := NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler)
+	sequentialVerifyDecorator := NewSigVerificationDecoratorWithCache(options.AccountKeeper, options.SignModeHandler, options.SigVerificationCache)
 	sigVerifyDecorator = sequentialVerifyDecorator
 
 	anteDecorators := []sdk.AnteFullDecorator{
 		sdk.DefaultWrappedAnteDecorator(NewDefaultSetUpContextDecorator()), // outermost AnteDecorator. SetUpContext must be called first
+		sdk.DefaultWrappedAnteDecorator(NewSetGasScheduleDecorator(options.ParamsKeeper)),
 		sdk.DefaultWrappedAnteDecorator(NewRejectExtensionOptionsDecorator()),
 		sdk.DefaultWrappedAnteDecorator(NewValidateBasicDecorator()),
+		sdk.DefaultWrappedAnteDecorator(NewCircuitBreakerDecorator(options.CircuitKeeper)),
 		sdk.DefaultWrappedAnteDecorator(NewTxTimeoutHeightDecorator()),
 		sdk.DefaultWrappedAnteDecorator(NewValidateMemoDecorator(options.AccountKeeper)),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.ParamsKeeper.(paramskeeper.Keeper), options.TxFeeChecker),
+		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.ParamsKeeper.(paramskeeper.Keeper), options.TxFeeChecker).WithFeeMarketKeeper(options.FeeMarketKeeper),
 		sdk.DefaultWrappedAnteDecorator(NewSetPubKeyDecorator(options.AccountKeeper)), // SetPubKeyDecorator must be called before all signature verification decorators
 		sdk.DefaultWrappedAnteDecorator(NewValidateSigCountDecorator(options.AccountKeeper)),
 		sdk.DefaultWrappedAnteDecorator(NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer)),
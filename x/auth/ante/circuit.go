@@ -0,0 +1,61 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// msgsExecutor is implemented by the messages executing other messages, e.g. authz.MsgExec.
+type msgsExecutor interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// CircuitBreakerDecorator rejects the txs containing a message, possibly nested in a message
+// executing other messages, whose circuit breaker is tripped. The MsgServiceRouter enforces the
+// circuit breakers on execution as well, this decorator keeps such txs out of the mempool. It is a
+// no-op without a circuit keeper.
+type CircuitBreakerDecorator struct {
+	circuitKeeper CircuitKeeper
+}
+
+func NewCircuitBreakerDecorator(ck CircuitKeeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: ck,
+	}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if cbd.circuitKeeper == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := cbd.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (cbd CircuitBreakerDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		allowed, err := cbd.circuitKeeper.IsAllowed(ctx, typeURL)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is disabled by the circuit breaker", typeURL)
+		}
+
+		if executor, ok := msg.(msgsExecutor); ok {
+			nestedMsgs, err := executor.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := cbd.checkMsgs(ctx, nestedMsgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *AnteTestSuite) TestCircuitBreakerDecorator() {
+	suite.SetupTest(true) // setup
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	sendMsg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	execMsg := authz.NewMsgExec(addr1, []sdk.Msg{sendMsg})
+	nestedExecMsg := authz.NewMsgExec(addr1, []sdk.Msg{&execMsg})
+
+	cbd := ante.NewCircuitBreakerDecorator(suite.app.CircuitKeeper)
+	antehandler, _ := sdk.ChainAnteDecorators(sdk.DefaultWrappedAnteDecorator(cbd))
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		disabled []string
+		expErr   bool
+	}{
+		{"no breaker tripped", []sdk.Msg{sendMsg, &nestedExecMsg}, nil, false},
+		{"other breaker tripped", []sdk.Msg{sendMsg}, []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}, false},
+		{"message disabled", []sdk.Msg{sendMsg}, []string{sdk.MsgTypeURL(sendMsg)}, true},
+		{"executing message disabled", []sdk.Msg{&execMsg}, []string{sdk.MsgTypeURL(&execMsg)}, true},
+		{"nested message disabled", []sdk.Msg{&execMsg}, []string{sdk.MsgTypeURL(sendMsg)}, true},
+		{"deeply nested message disabled", []sdk.Msg{&nestedExecMsg}, []string{sdk.MsgTypeURL(sendMsg)}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			for _, typeURL := range tc.disabled {
+				suite.app.CircuitKeeper.DisableMsg(ctx, typeURL)
+			}
+
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(tc.msgs...))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, ctx.ChainID())
+			suite.Require().NoError(err)
+
+			_, err = antehandler(ctx, tx, false)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -26,4 +26,16 @@ type ParamsKeeper interface {
 	GetFeesParams(ctx sdk.Context) paramtypes.FeesParams
 	SetCosmosGasParams(ctx sdk.Context, cosmosGasParams paramtypes.CosmosGasParams)
 	GetCosmosGasParams(ctx sdk.Context) paramtypes.CosmosGasParams
+	GetGasSchedule(ctx sdk.Context) paramtypes.GasSchedule
+}
+
+// FeeMarketKeeper defines the expected feemarket keeper.
+type FeeMarketKeeper interface {
+	GetBaseFees(ctx sdk.Context) sdk.DecCoins
+	AddTxGasUsed(ctx sdk.Context, gasUsed uint64)
+}
+
+// CircuitKeeper defines the expected circuit keeper.
+type CircuitKeeper interface {
+	IsAllowed(ctx sdk.Context, typeURL string) (bool, error)
 }
@@ -11,6 +11,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 )
 
@@ -21,13 +22,18 @@ type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx, simulate bool, paramsKeeper p
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
+// If a FeeMarketKeeper is set with WithFeeMarketKeeper, the fee must also cover the base fee of the fee market for the
+// gas limit of the tx in one of the denoms with a base fee. That part of the fee goes to the feemarket module account,
+// the tx priority is computed from the rest, and the gas used by the tx is added to the gas used by the block once it
+// is delivered.
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
-	accountKeeper  AccountKeeper
-	bankKeeper     types.BankKeeper
-	feegrantKeeper FeegrantKeeper
-	paramsKeeper   paramskeeper.Keeper
-	txFeeChecker   TxFeeChecker
+	accountKeeper   AccountKeeper
+	bankKeeper      types.BankKeeper
+	feegrantKeeper  FeegrantKeeper
+	paramsKeeper    paramskeeper.Keeper
+	feeMarketKeeper FeeMarketKeeper
+	txFeeChecker    TxFeeChecker
 }
 
 func NewDeductFeeDecorator(
@@ -50,6 +56,12 @@ func NewDeductFeeDecorator(
 	}
 }
 
+// WithFeeMarketKeeper returns a copy of the decorator that enforces the base fees of the fee market
+func (dfd DeductFeeDecorator) WithFeeMarketKeeper(fmk FeeMarketKeeper) DeductFeeDecorator {
+	dfd.feeMarketKeeper = fmk
+	return dfd
+}
+
 func (d DeductFeeDecorator) AnteDeps(txDeps []sdkacltypes.AccessOperation, tx sdk.Tx, txIndex int, next sdk.AnteDepGenerator) (newTxDeps []sdkacltypes.AccessOperation, err error) {
 	feeTx, _ := tx.(sdk.FeeTx)
 	deps := []sdkacltypes.AccessOperation{}
@@ -62,18 +74,30 @@ func (d DeductFeeDecorator) AnteDeps(txDeps []sdkacltypes.AccessOperation, tx sd
 			ResourceType:       sdkacltypes.ResourceType_KV_AUTH_ADDRESS_STORE,
 			IdentifierTemplate: hex.EncodeToString(authtypes.AddressStoreKey(moduleAdr)),
 		},
-		{
-			AccessType:         sdkacltypes.AccessType_READ,
-			ResourceType:       sdkacltypes.ResourceType_KV_BANK_DEFERRED_MODULE_TX_INDEX,
-			IdentifierTemplate: hex.EncodeToString(banktypes.CreateDeferredCacheModuleTxIndexedPrefix(moduleAdr, uint64(txIndex))),
-		},
+		// the fees are added to the fee collector balances as deltas, without reading them
 		{
 			AccessType:         sdkacltypes.AccessType_WRITE,
-			ResourceType:       sdkacltypes.ResourceType_KV_BANK_DEFERRED_MODULE_TX_INDEX,
-			IdentifierTemplate: hex.EncodeToString(banktypes.CreateDeferredCacheModuleTxIndexedPrefix(moduleAdr, uint64(txIndex))),
+			ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
+			IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefix(moduleAdr)),
 		},
 	}...)
 
+	if d.feeMarketKeeper != nil {
+		feeMarketAdr := d.accountKeeper.GetModuleAddress(feemarkettypes.ModuleName)
+		deps = append(deps, []sdkacltypes.AccessOperation{
+			{
+				AccessType:         sdkacltypes.AccessType_READ,
+				ResourceType:       sdkacltypes.ResourceType_KV_FEEMARKET,
+				IdentifierTemplate: hex.EncodeToString(feemarkettypes.BaseFeesKey),
+			},
+			{
+				AccessType:         sdkacltypes.AccessType_WRITE,
+				ResourceType:       sdkacltypes.ResourceType_KV_BANK_BALANCES,
+				IdentifierTemplate: hex.EncodeToString(banktypes.CreateAccountBalancesPrefix(feeMarketAdr)),
+			},
+		}...)
+	}
+
 	if feeTx.FeePayer() != nil {
 		deps = append(deps,
 			[]sdkacltypes.AccessOperation{
@@ -136,16 +160,66 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	if err != nil {
 		return ctx, err
 	}
-	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
+	baseFee, err := dfd.checkBaseFee(ctx, tx, fee, simulate)
+	if err != nil {
+		return ctx, err
+	}
+	if !baseFee.IsZero() {
+		// only the tip above the base fee prioritizes the tx
+		priority = 0
+		if gas := tx.(sdk.FeeTx).GetGas(); gas > 0 {
+			priority = GetTxPriority(fee.Sub(baseFee), int64(gas))
+		}
+	}
+	if err := dfd.checkDeductFee(ctx, tx, fee, baseFee); err != nil {
 		return ctx, err
 	}
 
 	newCtx := ctx.WithPriority(priority)
+	if dfd.feeMarketKeeper != nil && !simulate && !ctx.IsCheckTx() {
+		newCtx = newCtx.WithDeliverTxCallback(dfd.recordTxGasUsed(ctx))
+	}
 
 	return next(newCtx, tx, simulate)
 }
 
-func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
+// recordTxGasUsed returns a deliver tx callback adding the gas used by the tx to the gas used by the block, after
+// calling the callback already set if any. It runs whether the tx succeeds or not.
+func (dfd DeductFeeDecorator) recordTxGasUsed(ctx sdk.Context) func(sdk.Context) {
+	gasMeter, callback := ctx.GasMeter(), ctx.DeliverTxCallback()
+	return func(callbackCtx sdk.Context) {
+		if callback != nil {
+			callback(callbackCtx)
+		}
+		dfd.feeMarketKeeper.AddTxGasUsed(callbackCtx, gasMeter.GasConsumed())
+	}
+}
+
+// checkBaseFee returns the part of the fee that pays the base fee of the fee market, in the first denom the fee covers
+// it in, and an error if the fee covers it in none of the denoms with a base fee
+func (dfd DeductFeeDecorator) checkBaseFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins, simulate bool) (sdk.Coins, error) {
+	if dfd.feeMarketKeeper == nil || simulate {
+		return sdk.Coins{}, nil
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	baseFees := dfd.feeMarketKeeper.GetBaseFees(ctx)
+	if baseFees.Empty() {
+		return sdk.Coins{}, nil
+	}
+	requiredFees := feemarkettypes.RequiredBaseFees(baseFees, feeTx.GetGas())
+	for _, required := range requiredFees {
+		if fee.AmountOf(required.Denom).GTE(required.Amount) {
+			return sdk.NewCoins(required), nil
+		}
+	}
+	return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the base fee; got: %s required one of: %s", fee, requiredFees)
+}
+
+func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins, baseFee sdk.Coins) error {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
 		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
@@ -179,22 +253,32 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
-	// deduct the fees
-	if !fee.IsZero() {
-		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
+	// deduct the fees, the base fee goes to the fee market
+	tip := fee
+	if !baseFee.IsZero() {
+		tip = fee.Sub(baseFee)
+	}
+	if !tip.IsZero() {
+		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, tip)
 		if err != nil {
 			return err
 		}
 	}
+	if !baseFee.IsZero() {
+		err := dfd.bankKeeper.DeltaSendCoinsFromAccountToModule(ctx, deductFeesFromAcc.GetAddress(), feemarkettypes.ModuleName, baseFee)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+	}
 
-	events := sdk.Events{
-		sdk.NewEvent(
-			sdk.EventTypeTx,
-			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
-			sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFeesFrom.String()),
-		),
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFeesFrom.String()),
+	}
+	if !baseFee.IsZero() {
+		attributes = append(attributes, sdk.NewAttribute(sdk.AttributeKeyBaseFee, baseFee.String()))
 	}
-	ctx.EventManager().EmitEvents(events)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeTx, attributes...))
 
 	return nil
 }
@@ -205,7 +289,7 @@ func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
 	}
 
-	err := bankKeeper.DeferredSendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
+	err := bankKeeper.DeltaSendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
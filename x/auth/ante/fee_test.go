@@ -9,10 +9,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/accesscontrol"
 	sdkacltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	acltestutil "github.com/cosmos/cosmos-sdk/x/accesscontrol/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -172,16 +174,7 @@ func (suite *AnteTestSuite) TestLazySendToModuleAccount() {
 
 	suite.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
 
-	// Fee Collector actual account balance should not have increased
-	resultFeeCollectorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAcc.GetAddress(), "atom")
-	suite.Assert().Equal(
-		expectedFeeCollectorBalance,
-		resultFeeCollectorBalance,
-	)
-
-	// Fee Collector actual account balance deposit coins into the fee collector account
-	suite.app.BankKeeper.WriteDeferredBalances(suite.ctx)
-
+	// the fees are added to the fee collector balance right away
 	depositFeeCollectorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAcc.GetAddress(), "atom")
 
 	expectedAtomFee := feeAmount.AmountOf("atom")
@@ -193,6 +186,49 @@ func (suite *AnteTestSuite) TestLazySendToModuleAccount() {
 	)
 }
 
+func (suite *AnteTestSuite) TestDeductFeesWithBaseFee() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	feeMarketParams := feemarkettypes.DefaultParams()
+	feeMarketParams.MinBaseFees = sdk.NewDecCoins(sdk.NewDecCoin("atom", sdk.NewInt(1)))
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams)
+	suite.app.FeeMarketKeeper.SetBaseFees(suite.ctx, feeMarketParams.MinBaseFees)
+
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, suite.app.ParamsKeeper, nil).WithFeeMarketKeeper(suite.app.FeeMarketKeeper)
+	antehandler, _ := sdk.ChainAnteDecorators(sdk.DefaultWrappedAnteDecorator(dfd))
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 900))))
+	msg := testdata.NewTestMsg(addr1)
+
+	// the fee doesn't cover the base fee for the gas limit
+	tx, err := suite.createTestTxWithGas(msg, 9, 10, priv1, "atom")
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	// the base fee goes to the fee market and the tip to the fee collector, and only the tip prioritizes the tx
+	tx, err = suite.createTestTxWithGas(msg, 150, 10, priv1, "atom")
+	suite.Require().NoError(err)
+	feeCollectorAddr := suite.app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	feeCollectorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAddr, "atom")
+	newCtx, err := antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ante.GetTxPriority(sdk.NewCoins(sdk.NewInt64Coin("atom", 140)), 10), newCtx.Priority())
+
+	suite.Require().Equal(feeCollectorBalance.AddAmount(sdk.NewInt(140)), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAddr, "atom"))
+	feeMarketAddr := suite.app.AccountKeeper.GetModuleAddress(feemarkettypes.ModuleName)
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 10), suite.app.BankKeeper.GetBalance(suite.ctx, feeMarketAddr, "atom"))
+
+	// the gas used by the tx is added to the gas used by the block once it is delivered
+	suite.Require().NotNil(newCtx.DeliverTxCallback())
+	newCtx.DeliverTxCallback()(suite.ctx)
+	suite.Require().Equal(newCtx.GasMeter().GasConsumed(), suite.app.FeeMarketKeeper.GetBlockGasUsed(suite.ctx))
+}
+
 func (suite *AnteTestSuite) createTestTxWithGas(msg *testdata.TestMsg, fee, gasLimit uint64, priv cryptotypes.PrivKey, denom string) (sdk.Tx, error) {
 	feeAmount := sdk.NewCoins(sdk.NewInt64Coin(denom, int64(fee)))
 	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
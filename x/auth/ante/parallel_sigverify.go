@@ -0,0 +1,164 @@
+package ante
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ sdk.TxSigVerifier = (*ParallelSigVerifier)(nil)
+
+// ParallelSigVerifier verifies the signatures of txs on a pool of workers ahead of their ante handler. Any
+// key type of crypto/keys, including multisigs, is supported since every signature is verified with
+// authsigning.VerifySignature. The successful verifications are added to a SigVerificationCache shared with
+// the SigVerificationDecorator, while an invalid signature is left for the decorator to report.
+type ParallelSigVerifier struct {
+	ak              AccountKeeper
+	signModeHandler authsigning.SignModeHandler
+	workers         int
+	cache           *SigVerificationCache
+}
+
+// sigVerification is a signature to verify, along with the data to verify it with.
+type sigVerification struct {
+	txIndex int
+	tx      sdk.Tx
+	sig     signing.SignatureV2
+	result  verifiedSig
+	ok      bool
+}
+
+// NewParallelSigVerifier returns a ParallelSigVerifier verifying signatures on the given number of
+// workers, or on one worker per CPU if workers isn't positive, and adding them to cache.
+func NewParallelSigVerifier(ak AccountKeeper, signModeHandler authsigning.SignModeHandler, workers int, cache *SigVerificationCache) *ParallelSigVerifier {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return &ParallelSigVerifier{
+		ak:              ak,
+		signModeHandler: signModeHandler,
+		workers:         workers,
+		cache:           cache,
+	}
+}
+
+// VerifyTxs verifies the signatures of txs against the accounts of ctx. The txs are expected in execution
+// order, so that a signer's sequence is incremented for each of its txs. Txs that cannot be verified ahead
+// of their execution, e.g. because a signer account doesn't exist yet, are skipped.
+func (v *ParallelSigVerifier) VerifyTxs(ctx sdk.Context, txs []sdk.Tx, checksums [][32]byte) {
+	if ctx.IsReCheckTx() || len(txs) != len(checksums) {
+		return
+	}
+	// accounts are read outside of any tx, so the reads must not be charged
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter(1, 1))
+
+	verifications := v.collect(ctx, txs, checksums)
+	if len(verifications) == 0 {
+		return
+	}
+
+	jobs := make(chan *sigVerification)
+	wg := sync.WaitGroup{}
+	for i := 0; i < v.workers && i < len(verifications); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				job.ok = authsigning.VerifySignature(job.result.pubKey, job.result.signerData, job.sig.Data, v.signModeHandler, job.tx) == nil
+			}
+		}()
+	}
+	for _, verification := range verifications {
+		jobs <- verification
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, verification := range verifications {
+		if verification.ok {
+			result := verification.result
+			v.cache.Add(checksums[verification.txIndex], result.signerIndex, result.pubKey, result.signerData)
+		}
+	}
+}
+
+// collect returns the signatures of txs that still have to be verified.
+func (v *ParallelSigVerifier) collect(ctx sdk.Context, txs []sdk.Tx, checksums [][32]byte) []*sigVerification {
+	genesis := ctx.BlockHeight() == 0
+	chainID := ctx.ChainID()
+	// sequences and pubkeys of the signers of the previous txs of the batch
+	sequences := map[string]uint64{}
+	pubKeys := map[string]cryptotypes.PubKey{}
+
+	verifications := []*sigVerification{}
+	for i, tx := range txs {
+		sigTx, ok := tx.(authsigning.SigVerifiableTx)
+		if !ok {
+			continue
+		}
+		sigs, err := sigTx.GetSignaturesV2()
+		if err != nil {
+			continue
+		}
+		signerAddrs := sigTx.GetSigners()
+		txPubKeys, err := sigTx.GetPubKeys()
+		if err != nil || len(sigs) != len(signerAddrs) || len(txPubKeys) != len(signerAddrs) {
+			continue
+		}
+		for j, sig := range sigs {
+			addr := signerAddrs[j]
+			key := string(addr)
+			acc, err := GetSignerAcc(ctx, v.ak, addr)
+			if err != nil {
+				break
+			}
+
+			pubKey, seen := pubKeys[key]
+			if !seen {
+				pubKey = acc.GetPubKey()
+			}
+			if pubKey == nil {
+				pubKey = txPubKeys[j]
+			}
+			sequence, seen := sequences[key]
+			if !seen {
+				sequence = acc.GetSequence()
+			}
+			pubKeys[key] = pubKey
+			sequences[key] = sequence + 1
+			if pubKey == nil || !bytes.Equal(pubKey.Address(), addr) {
+				continue
+			}
+
+			var accNum uint64
+			if !genesis {
+				accNum = acc.GetAccountNumber()
+			}
+			signerData := authsigning.SignerData{
+				ChainID:       chainID,
+				AccountNumber: accNum,
+				Sequence:      sequence,
+			}
+			// lookups of the verifier are left out of the cache metrics
+			if v.cache.get(checksums[i], j, pubKey, signerData) {
+				continue
+			}
+			verifications = append(verifications, &sigVerification{
+				txIndex: i,
+				tx:      tx,
+				sig:     sig,
+				result: verifiedSig{
+					signerIndex: j,
+					pubKey:      pubKey,
+					signerData:  signerData,
+				},
+			})
+		}
+	}
+	return verifications
+}
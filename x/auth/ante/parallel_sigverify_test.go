@@ -0,0 +1,127 @@
+package ante_test
+
+import (
+	"crypto/sha256"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func (suite *AnteTestSuite) TestParallelSigVerifier() {
+	suite.SetupTest(false) // setup
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	signModeHandler := suite.clientCtx.TxConfig.SignModeHandler()
+
+	// a secp256k1 and a secp256r1 signer
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddrSecp256R1(suite.Require())
+	privs := []cryptotypes.PrivKey{priv1, priv2}
+	for i, addr := range []sdk.AccAddress{addr1, addr2} {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.Require().NoError(acc.SetAccountNumber(uint64(i)))
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	}
+	msgs := []sdk.Msg{testdata.NewTestMsg(addr1, addr2)}
+
+	createTx := func(seqs []uint64, memo string, invalidSig bool) (sdk.Tx, [32]byte) {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(msgs...))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetMemo(memo)
+		tx, err := suite.CreateTestTx(privs, []uint64{0, 1}, seqs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		if invalidSig {
+			sigs, err := tx.GetSignaturesV2()
+			suite.Require().NoError(err)
+			badSig, err := priv1.Sign([]byte("unrelated message"))
+			suite.Require().NoError(err)
+			sigs[0].Data = &signing.SingleSignatureData{
+				SignMode:  signModeHandler.DefaultMode(),
+				Signature: badSig,
+			}
+			suite.Require().NoError(suite.txBuilder.SetSignatures(sigs...))
+			tx = suite.txBuilder.GetTx()
+		}
+		bz, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+		return tx, sha256.Sum256(bz)
+	}
+	signerData := func(accNum, seq uint64) authsigning.SignerData {
+		return authsigning.SignerData{ChainID: suite.ctx.ChainID(), AccountNumber: accNum, Sequence: seq}
+	}
+
+	// the second tx of the signers follows the first one in the batch
+	tx1, sum1 := createTx([]uint64{0, 0}, "", false)
+	tx2, sum2 := createTx([]uint64{1, 1}, "", false)
+	invalidTx, invalidSum := createTx([]uint64{2, 2}, "", true)
+
+	cache := ante.NewSigVerificationCache(3)
+	verifier := ante.NewParallelSigVerifier(suite.app.AccountKeeper, signModeHandler, 2, cache)
+	verifier.VerifyTxs(suite.ctx, []sdk.Tx{tx1, tx2, invalidTx}, [][32]byte{sum1, sum2, invalidSum})
+
+	suite.Require().True(cache.Get(sum1, 0, priv1.PubKey(), signerData(0, 0)))
+	suite.Require().True(cache.Get(sum1, 1, priv2.PubKey(), signerData(1, 0)))
+	suite.Require().True(cache.Get(sum2, 0, priv1.PubKey(), signerData(0, 1)))
+	suite.Require().True(cache.Get(sum2, 1, priv2.PubKey(), signerData(1, 1)))
+	// only the valid signature of the invalid tx is kept
+	suite.Require().False(cache.Get(invalidSum, 0, priv1.PubKey(), signerData(0, 2)))
+	suite.Require().True(cache.Get(invalidSum, 1, priv2.PubKey(), signerData(1, 2)))
+
+	// the least recently used tx is evicted once the cache is full
+	tx3, sum3 := createTx([]uint64{0, 0}, "another tx", false)
+	verifier.VerifyTxs(suite.ctx, []sdk.Tx{tx3}, [][32]byte{sum3})
+	suite.Require().Equal(3, cache.Len())
+	suite.Require().True(cache.Get(sum3, 0, priv1.PubKey(), signerData(0, 0)))
+	suite.Require().False(cache.Get(sum2, 0, priv1.PubKey(), signerData(0, 1)))
+	suite.Require().True(cache.Get(sum1, 0, priv1.PubKey(), signerData(0, 0)))
+
+	// nothing is verified on recheck
+	cache = ante.NewSigVerificationCache(0)
+	verifier = ante.NewParallelSigVerifier(suite.app.AccountKeeper, signModeHandler, 0, cache)
+	verifier.VerifyTxs(suite.ctx.WithIsReCheckTx(true), []sdk.Tx{tx1}, [][32]byte{sum1})
+	suite.Require().Equal(0, cache.Len())
+}
+
+func (suite *AnteTestSuite) TestSigVerificationWithVerifiedSigs() {
+	suite.SetupTest(false) // setup
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	signModeHandler := suite.clientCtx.TxConfig.SignModeHandler()
+
+	priv1, pub1, addr1 := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.Require().NoError(acc.SetPubKey(pub1))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	bz, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	checksum := sha256.Sum256(bz)
+
+	cache := ante.NewSigVerificationCache(0)
+	verifier := ante.NewParallelSigVerifier(suite.app.AccountKeeper, signModeHandler, 0, cache)
+	verifier.VerifyTxs(suite.ctx, []sdk.Tx{tx}, [][32]byte{checksum})
+
+	svd := ante.NewSigVerificationDecoratorWithCache(suite.app.AccountKeeper, signModeHandler, cache)
+	antehandler, _ := sdk.ChainAnteDecorators(sdk.DefaultWrappedAnteDecorator(svd))
+	_, err = antehandler(suite.ctx.WithTxSum(checksum), tx, false)
+	suite.Require().NoError(err)
+
+	// a tx that wasn't verified ahead is verified by the decorator
+	suite.txBuilder.SetMemo("not verified ahead")
+	tx, err = suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{acc.GetAccountNumber()}, []uint64{0}, "wrong-chain")
+	suite.Require().NoError(err)
+	bz, err = suite.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx.WithTxSum(sha256.Sum256(bz)), tx, false)
+	suite.Require().Error(err)
+}
@@ -81,6 +81,25 @@ func (sud SetUpContextDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(newCtx, tx, simulate)
 }
 
+// SetGasScheduleDecorator sets the gas schedule of the x/params module in the Context, so that the stores
+// accessed by the rest of the tx are charged the gas configs of their store key.
+// The gas schedule is read with an infinite gas meter, so that the tx isn't charged for it.
+// CONTRACT: Must be right after the SetUpContextDecorator in the chain
+type SetGasScheduleDecorator struct {
+	paramsKeeper ParamsKeeper
+}
+
+func NewSetGasScheduleDecorator(paramsKeeper ParamsKeeper) SetGasScheduleDecorator {
+	return SetGasScheduleDecorator{
+		paramsKeeper: paramsKeeper,
+	}
+}
+
+func (sgd SetGasScheduleDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	gasSchedule := sgd.paramsKeeper.GetGasSchedule(ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)))
+	return next(ctx.WithGasSchedule(gasSchedule.StoreGasSchedule()), tx, simulate)
+}
+
 // SetGasMeter returns a new context with a gas meter set from a given context.
 func SetGasMeter(simulate bool, ctx sdk.Context, gasLimit uint64, _ sdk.Tx) sdk.Context {
 	// In various cases such as simulation and during the genesis block, we do not
@@ -2,10 +2,14 @@ package ante_test
 
 import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 func (suite *AnteTestSuite) TestSetup() {
@@ -97,3 +101,40 @@ type PanicDecorator struct{}
 func (pd PanicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	panic("random error")
 }
+
+func (suite *AnteTestSuite) TestSetGasSchedule() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	accGasConfig := paramstypes.StoreGasConfig{ReadCostFlat: 1, WriteCostFlat: 2}
+	gasSchedule := paramstypes.DefaultGasSchedule()
+	gasSchedule.StoreGasConfigs = []paramstypes.StoreKeyGasConfig{{StoreKey: authtypes.StoreKey, GasConfig: accGasConfig}}
+	suite.app.ParamsKeeper.SetGasSchedule(suite.ctx, *gasSchedule)
+
+	sgd := ante.NewSetGasScheduleDecorator(suite.app.ParamsKeeper)
+	antehandler, _ := sdk.ChainAnteDecorators(sdk.DefaultWrappedAnteDecorator(ante.NewDefaultSetUpContextDecorator()), sdk.DefaultWrappedAnteDecorator(sgd))
+
+	// Set height to non-zero value for GasMeter to be set
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	suite.Require().Nil(suite.ctx.GasSchedule())
+
+	newCtx, err := antehandler(suite.ctx, tx, false)
+	suite.Require().Nil(err, "SetGasScheduleDecorator returned error")
+	suite.Require().Equal(accGasConfig.GasConfig(), newCtx.GasSchedule().KVGasConfig(authtypes.StoreKey))
+	suite.Require().Equal(storetypes.KVGasConfig(), newCtx.GasSchedule().KVGasConfig(banktypes.StoreKey))
+	// reading the gas schedule isn't charged to the tx
+	suite.Require().Zero(newCtx.GasMeter().GasConsumed())
+}
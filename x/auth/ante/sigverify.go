@@ -204,6 +204,7 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 type SigVerificationDecorator struct {
 	ak              AccountKeeper
 	signModeHandler authsigning.SignModeHandler
+	cache           *SigVerificationCache
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler) SigVerificationDecorator {
@@ -213,6 +214,17 @@ func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.S
 	}
 }
 
+// NewSigVerificationDecoratorWithCache returns a SigVerificationDecorator that records its successful
+// verifications in cache, keyed by the tx checksum of the context, and doesn't verify again the signatures
+// found in it for the same pubkey and signer data.
+func NewSigVerificationDecoratorWithCache(ak AccountKeeper, signModeHandler authsigning.SignModeHandler, cache *SigVerificationCache) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		ak:              ak,
+		signModeHandler: signModeHandler,
+		cache:           cache,
+	}
+}
+
 // OnlyLegacyAminoSigners checks SignatureData to see if all
 // signers are using SIGN_MODE_LEGACY_AMINO_JSON. If this is the case
 // then the corresponding SignatureV2 struct will not have account sequence
@@ -290,8 +302,8 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			Sequence:      acc.GetSequence(),
 		}
 
-		// no need to verify signatures on recheck tx
-		if !simulate && !ctx.IsReCheckTx() {
+		// no need to verify signatures on recheck tx, nor signatures already verified for the same signer data
+		if !simulate && !ctx.IsReCheckTx() && !svd.cachedVerification(ctx, i, pubKey, signerData) {
 			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
 			if err != nil {
 				var errMsg string
@@ -305,12 +317,24 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
 
 			}
+			if svd.cache != nil {
+				svd.cache.Add(ctx.TxSum(), i, pubKey, signerData)
+			}
 		}
 	}
 
 	return next(ctx, tx, simulate)
 }
 
+// cachedVerification returns whether the signature of the signer at signerIndex was already verified with
+// the same pubkey and signer data.
+func (svd SigVerificationDecorator) cachedVerification(ctx sdk.Context, signerIndex int, pubKey cryptotypes.PubKey, signerData authsigning.SignerData) bool {
+	if svd.cache == nil {
+		return false
+	}
+	return svd.cache.Get(ctx.TxSum(), signerIndex, pubKey, signerData)
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
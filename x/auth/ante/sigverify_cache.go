@@ -0,0 +1,165 @@
+package ante
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// DefaultSigVerificationCacheSize is the default number of txs whose verified signatures are kept by a
+// SigVerificationCache.
+const DefaultSigVerificationCacheSize = 20000
+
+// SigVerificationCache is a bounded cache of the successful signature verifications of txs, keyed by the
+// checksum of the tx bytes. It is shared by CheckTx, ProcessProposal and DeliverTx, so that a signature is
+// verified once as long as the state of its signer doesn't change.
+//
+// A verification is only reused for the exact pubkey and signer data it was done with: an entry whose
+// signer pubkey, account number or sequence changed since is invalidated when it is looked up. Once the
+// cache is full, the least recently used txs are evicted.
+type SigVerificationCache struct {
+	size int
+
+	mtx     sync.Mutex
+	entries map[[32]byte]*list.Element
+	// txs from the most to the least recently used
+	lru *list.List
+
+	hits   uint64
+	misses uint64
+}
+
+// sigCacheEntry holds the verified signatures of a tx.
+type sigCacheEntry struct {
+	checksum [32]byte
+	sigs     []verifiedSig
+}
+
+// verifiedSig is the data a signature was successfully verified with.
+type verifiedSig struct {
+	signerIndex int
+	pubKey      cryptotypes.PubKey
+	signerData  authsigning.SignerData
+}
+
+// NewSigVerificationCache returns a SigVerificationCache keeping the verified signatures of up to size txs,
+// or DefaultSigVerificationCacheSize txs if size isn't positive.
+func NewSigVerificationCache(size int) *SigVerificationCache {
+	if size <= 0 {
+		size = DefaultSigVerificationCacheSize
+	}
+	return &SigVerificationCache{
+		size:    size,
+		entries: map[[32]byte]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+// Get returns whether the signature of the signer at signerIndex of the tx with the checksum was verified
+// against pubKey and signerData. A verification of the same signature with a different pubkey or signer
+// data is invalidated.
+func (c *SigVerificationCache) Get(checksum [32]byte, signerIndex int, pubKey cryptotypes.PubKey, signerData authsigning.SignerData) bool {
+	// the checksum is unset when the tx bytes are unknown, e.g. on simulation
+	if checksum == ([32]byte{}) || pubKey == nil {
+		return false
+	}
+	hit := c.get(checksum, signerIndex, pubKey, signerData)
+	if hit {
+		atomic.AddUint64(&c.hits, 1)
+		telemetry.IncrCounter(1, "tx", "sig_verification_cache", "hit")
+	} else {
+		atomic.AddUint64(&c.misses, 1)
+		telemetry.IncrCounter(1, "tx", "sig_verification_cache", "miss")
+	}
+	_, _, rate := c.HitRate()
+	telemetry.SetGauge(float32(rate), "tx", "sig_verification_cache", "hit_rate")
+	return hit
+}
+
+func (c *SigVerificationCache) get(checksum [32]byte, signerIndex int, pubKey cryptotypes.PubKey, signerData authsigning.SignerData) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	elem, ok := c.entries[checksum]
+	if !ok {
+		return false
+	}
+	entry := elem.Value.(*sigCacheEntry)
+	for i, sig := range entry.sigs {
+		if sig.signerIndex != signerIndex {
+			continue
+		}
+		if sig.signerData == signerData && sig.pubKey.Equals(pubKey) {
+			c.lru.MoveToFront(elem)
+			return true
+		}
+		// the pubkey or the sequence of the signer changed since the verification
+		entry.sigs = append(entry.sigs[:i], entry.sigs[i+1:]...)
+		if len(entry.sigs) == 0 {
+			c.remove(elem)
+		}
+		telemetry.IncrCounter(1, "tx", "sig_verification_cache", "invalidated")
+		return false
+	}
+	return false
+}
+
+// Add records that the signature of the signer at signerIndex of the tx with the checksum was successfully
+// verified against pubKey and signerData.
+func (c *SigVerificationCache) Add(checksum [32]byte, signerIndex int, pubKey cryptotypes.PubKey, signerData authsigning.SignerData) {
+	if checksum == ([32]byte{}) || pubKey == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	sig := verifiedSig{
+		signerIndex: signerIndex,
+		pubKey:      pubKey,
+		signerData:  signerData,
+	}
+	if elem, ok := c.entries[checksum]; ok {
+		entry := elem.Value.(*sigCacheEntry)
+		for i, cached := range entry.sigs {
+			if cached.signerIndex == signerIndex {
+				entry.sigs = append(entry.sigs[:i], entry.sigs[i+1:]...)
+				break
+			}
+		}
+		entry.sigs = append(entry.sigs, sig)
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[checksum] = c.lru.PushFront(&sigCacheEntry{
+		checksum: checksum,
+		sigs:     []verifiedSig{sig},
+	})
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+		telemetry.IncrCounter(1, "tx", "sig_verification_cache", "evicted")
+	}
+}
+
+func (c *SigVerificationCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*sigCacheEntry).checksum)
+}
+
+// Len returns the number of txs with verified signatures in the cache.
+func (c *SigVerificationCache) Len() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.lru.Len()
+}
+
+// HitRate returns the number of cache hits and misses so far, and the resulting hit rate.
+func (c *SigVerificationCache) HitRate() (hits uint64, misses uint64, rate float64) {
+	hits = atomic.LoadUint64(&c.hits)
+	misses = atomic.LoadUint64(&c.misses)
+	if hits+misses > 0 {
+		rate = float64(hits) / float64(hits+misses)
+	}
+	return hits, misses, rate
+}
@@ -0,0 +1,71 @@
+package ante_test
+
+import (
+	"crypto/sha256"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func (suite *AnteTestSuite) TestSigVerificationWithCache() {
+	suite.SetupTest(false) // setup
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	signModeHandler := suite.clientCtx.TxConfig.SignModeHandler()
+
+	priv1, pub1, addr1 := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.Require().NoError(acc.SetPubKey(pub1))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	bz, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	checksum := sha256.Sum256(bz)
+
+	cache := ante.NewSigVerificationCache(0)
+	svd := ante.NewSigVerificationDecoratorWithCache(suite.app.AccountKeeper, signModeHandler, cache)
+	antehandler, _ := sdk.ChainAnteDecorators(sdk.DefaultWrappedAnteDecorator(svd))
+
+	// the first verification is cached, the second one is a hit
+	_, err = antehandler(suite.ctx.WithTxSum(checksum), tx, false)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx.WithTxSum(checksum), tx, false)
+	suite.Require().NoError(err)
+	hits, misses, rate := cache.HitRate()
+	suite.Require().Equal(uint64(1), hits)
+	suite.Require().Equal(uint64(1), misses)
+	suite.Require().Equal(0.5, rate)
+
+	// the verification is invalidated once the sequence of the signer changes
+	acc = suite.app.AccountKeeper.GetAccount(suite.ctx, addr1)
+	suite.Require().NoError(acc.SetSequence(1))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	signerData := authsigning.SignerData{ChainID: suite.ctx.ChainID(), AccountNumber: acc.GetAccountNumber(), Sequence: 1}
+	suite.Require().False(cache.Get(checksum, 0, pub1, signerData))
+	suite.Require().Equal(0, cache.Len())
+
+	// the cache is not used without the tx checksum
+	suite.Require().NoError(acc.SetSequence(0))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, cache.Len())
+
+	// a tx with an invalid signature is not cached
+	suite.txBuilder.SetMemo("not verified ahead")
+	tx, err = suite.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{acc.GetAccountNumber()}, []uint64{0}, "wrong-chain")
+	suite.Require().NoError(err)
+	bz, err = suite.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx.WithTxSum(sha256.Sum256(bz)), tx, false)
+	suite.Require().Error(err)
+	suite.Require().Equal(0, cache.Len())
+}
@@ -0,0 +1,33 @@
+package bank
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MidBlocker settles the deferred balances if the keeper settles them at mid-block.
+func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
+	settleDeferredBalances(ctx, k, types.DeferredSettlementMidBlock)
+}
+
+// EndBlocker settles the deferred balances if the keeper settles them at end-block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	settleDeferredBalances(ctx, k, types.DeferredSettlementEndBlock)
+}
+
+// settleDeferredBalances credits the deferred balances to their accounts if the keeper settles them at the given
+// point of the block, and emits the resulting events.
+func settleDeferredBalances(ctx sdk.Context, k keeper.Keeper, at types.DeferredSettlement) {
+	if k.DeferredSettlement() != at {
+		return
+	}
+	for _, event := range k.WriteDeferredBalances(ctx) {
+		ctx.EventManager().EmitEvent(sdk.Event(event))
+	}
+}
@@ -33,6 +33,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdQueryDeferredBalances(),
 	)
 
 	return cmd
@@ -101,6 +102,53 @@ Example:
 	return cmd
 }
 
+// GetCmdQueryDeferredBalances defines the cobra command to query the balances pending the settlement of the
+// deferred cache.
+func GetCmdQueryDeferredBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deferred-balances [address]",
+		Short: "Query the balances deferred to accounts and pending settlement",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balances credited to accounts through the deferred cache that are not settled yet,
+for all accounts or for a single account.
+
+Example:
+  $ %s query %s deferred-balances
+  $ %s query %s deferred-balances [address]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDeferredBalancesRequest{}
+			if len(args) > 0 {
+				if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+					return err
+				}
+				req.Address = args[0]
+			}
+
+			res, err := queryClient.DeferredBalances(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdDenomsMetadata defines the cobra command to query client denomination metadata.
 func GetCmdDenomsMetadata() *cobra.Command {
 	cmd := &cobra.Command{
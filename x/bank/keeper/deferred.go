@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// WithDeferredSettlement sets the point of the block at which the bank module settles the deferred balances.
+// The bank module doesn't settle them by default, i.e. the app has to opt into a settlement or call
+// WriteDeferredBalances itself.
+func (k BaseKeeper) WithDeferredSettlement(settlement types.DeferredSettlement) BaseKeeper {
+	k.deferredSettlement = settlement
+	return k
+}
+
+// DeferredSettlement returns the point of the block at which the bank module settles the deferred balances,
+// which is DeferredSettlementManual for a keeper without deferred cache.
+func (k BaseKeeper) DeferredSettlement() types.DeferredSettlement {
+	if k.deferredCache == nil {
+		return types.DeferredSettlementManual
+	}
+	return k.deferredSettlement
+}
+
+// DeferredSendCoins deducts amt from fromAddr right away and defers the credit of toAddr to the settlement of the
+// deferred balances. The credit is recorded under the tx index of the context, so that the txs of a block crediting
+// the same account don't conflict with each other. Like a MsgSend, it fails if toAddr is a blocked address or if
+// sends of one of the denoms are disabled.
+func (k BaseKeeper) DeferredSendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.IsSendEnabledCoins(ctx, amt...); err != nil {
+		return err
+	}
+	if k.BlockedAddr(toAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
+	}
+
+	return k.deferredSendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// deferredSendCoins is DeferredSendCoins without the checks of the recipient and the denoms, which the module
+// variants skip like their SendCoins counterparts since module accounts are blocked addresses.
+func (k BaseKeeper) deferredSendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.deferredCache == nil {
+		panic("bank keeper created without deferred cache")
+	}
+	if !amt.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	err := k.SubUnlockedCoins(ctx, fromAddr, amt, true)
+	if err != nil {
+		return err
+	}
+
+	return k.deferredCache.UpsertBalances(ctx, toAddr, uint64(ctx.TxIndex()), amt)
+}
+
+// DeferredSendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress, deferring the credit
+// of the recipient to the settlement of the deferred balances.
+// It will panic if the module account does not exist.
+func (k BaseKeeper) DeferredSendCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	senderAddr := k.ak.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+
+	if k.BlockedAddr(recipientAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
+	}
+
+	return k.deferredSendCoins(ctx, senderAddr, recipientAddr, amt)
+}
+
+// DeferredSendCoinsFromModuleToModule transfers coins from a ModuleAccount to another, deferring the credit of
+// the recipient to the settlement of the deferred balances.
+// It will panic if either module account does not exist.
+func (k BaseKeeper) DeferredSendCoinsFromModuleToModule(
+	ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins,
+) error {
+	senderAddr := k.ak.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+
+	recipientAcc := k.ak.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+
+	return k.deferredSendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+}
+
+// GetDeferredBalances returns the coins deferred to an account over all the txs of the block so far.
+func (k BaseKeeper) GetDeferredBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	if k.deferredCache == nil {
+		panic("bank keeper created without deferred cache")
+	}
+	return k.deferredCache.GetBalances(ctx, addr)
+}
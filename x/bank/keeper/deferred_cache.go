@@ -41,6 +41,22 @@ func (d *DeferredCache) GetBalance(ctx sdk.Context, moduleAddr sdk.AccAddress, t
 	return balance
 }
 
+// GetBalances returns the coins deferred to an address, summed over all tx indices.
+func (d *DeferredCache) GetBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	deferredStore := prefix.NewStore(ctx.KVStore(d.storeKey), types.CreateDeferredCacheModulePrefix(addr))
+
+	iterator := deferredStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	balances := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var balance sdk.Coin
+		d.cdc.MustUnmarshal(iterator.Value(), &balance)
+		balances = balances.Add(balance)
+	}
+	return balances
+}
+
 // setBalance sets the coin balance for a module and tx Index.
 func (d *DeferredCache) setBalance(ctx sdk.Context, moduleAddr sdk.AccAddress, txIndex uint64, balance sdk.Coin) error {
 	if !balance.IsValid() {
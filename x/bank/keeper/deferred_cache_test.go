@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -83,3 +86,95 @@ func (suite *IntegrationTestSuite) TestDeferredCacheUpsertBalances() {
 	bals = app.BankKeeper.GetAllBalances(ctx, randomPermAcc.GetAddress())
 	suite.Require().Equal(expectedBankBalances, bals)
 }
+
+func (suite *IntegrationTestSuite) TestDeferredSendCoinsToAnyAccount() {
+	ctx := suite.ctx
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+	authKeeper.SetModuleAccount(ctx, multiPermAcc)
+	holderAcc := authtypes.NewEmptyModuleAccount(holder)
+	authKeeper.SetModuleAccount(ctx, holderAcc)
+	app := suite.app
+	app.BankKeeper = keeper.WithDeferredSettlement(types.DeferredSettlementMidBlock)
+
+	moduleBalances := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+	suite.Require().NoError(simapp.FundModuleAccount(app.BankKeeper, ctx, multiPerm, moduleBalances))
+
+	// the recipient account doesn't exist before the settlement
+	addr1 := sdk.AccAddress("addr1_______________")
+	suite.Require().NoError(app.BankKeeper.DeferredSendCoinsFromModuleToAccount(ctx.WithTxIndex(1), multiPerm, addr1, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().NoError(app.BankKeeper.DeferredSendCoinsFromModuleToAccount(ctx.WithTxIndex(2), multiPerm, addr1, sdk.NewCoins(newFooCoin(5), newBarCoin(5))))
+	suite.Require().NoError(app.BankKeeper.DeferredSendCoinsFromModuleToModule(ctx.WithTxIndex(2), multiPerm, holder, sdk.NewCoins(newBarCoin(20))))
+	suite.Require().Error(app.BankKeeper.DeferredSendCoinsFromModuleToAccount(ctx, multiPerm, addr1, sdk.NewCoins(newFooCoin(1000))))
+
+	// senders are debited right away, recipients once the deferred balances are settled
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(85), newBarCoin(75)), app.BankKeeper.GetAllBalances(ctx, multiPermAcc.GetAddress()))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr1).IsZero())
+	suite.Require().Nil(authKeeper.GetAccount(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(15), newBarCoin(5)), app.BankKeeper.GetDeferredBalances(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(20)), app.BankKeeper.GetDeferredBalances(ctx, holderAcc.GetAddress()))
+
+	res, err := suite.queryClient.DeferredBalances(sdk.WrapSDKContext(ctx), &types.QueryDeferredBalancesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Balances, 2)
+	res, err = suite.queryClient.DeferredBalances(sdk.WrapSDKContext(ctx), &types.QueryDeferredBalancesRequest{Address: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DeferredBalance{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(15), newBarCoin(5))}}, res.Balances)
+
+	_, broken := bankkeeper.DeferredBalancesInvariant(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+	_, broken = bankkeeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+
+	// the keeper settles at mid-block only
+	bank.EndBlocker(ctx, app.BankKeeper)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(15), newBarCoin(5)), app.BankKeeper.GetDeferredBalances(ctx, addr1))
+	bank.MidBlocker(ctx, app.BankKeeper)
+	suite.Require().True(app.BankKeeper.GetDeferredBalances(ctx, addr1).IsZero())
+	suite.Require().NotNil(authKeeper.GetAccount(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(15), newBarCoin(5)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(20)), app.BankKeeper.GetAllBalances(ctx, holderAcc.GetAddress()))
+
+	res, err = suite.queryClient.DeferredBalances(sdk.WrapSDKContext(ctx), &types.QueryDeferredBalancesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Balances)
+	_, broken = bankkeeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *IntegrationTestSuite) TestDeferredSendCoinsChecks() {
+	ctx := suite.ctx
+	blockedAddr := sdk.AccAddress("blocked_____________")
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(map[string]bool{blockedAddr.String(): true})
+	authKeeper.SetModuleAccount(ctx, multiPermAcc)
+	app := suite.app
+	app.BankKeeper = keeper
+	suite.Require().Equal(types.DeferredSettlementManual, app.BankKeeper.DeferredSettlement())
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	// blocked addresses can't receive funds
+	err := app.BankKeeper.DeferredSendCoins(ctx, addr1, blockedAddr, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = app.BankKeeper.DeferredSendCoinsFromModuleToAccount(ctx, multiPerm, blockedAddr, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// denoms with sends disabled can't be sent to user accounts, but still go to module accounts
+	params := app.BankKeeper.GetParams(ctx).SetSendEnabledParam(barDenom, false)
+	app.BankKeeper.SetParams(ctx, params)
+	err = app.BankKeeper.DeferredSendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10)))
+	suite.Require().ErrorIs(err, types.ErrSendDisabled)
+	suite.Require().NoError(app.BankKeeper.DeferredSendCoinsFromAccountToModule(ctx, addr1, multiPerm, sdk.NewCoins(newBarCoin(10))))
+
+	suite.Require().NoError(app.BankKeeper.DeferredSendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(90)), app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// the bank module leaves the settlement to the app by default
+	bank.MidBlocker(ctx, app.BankKeeper)
+	bank.EndBlocker(ctx, app.BankKeeper)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetDeferredBalances(ctx, addr2))
+	app.BankKeeper.WriteDeferredBalances(ctx)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, multiPermAcc.GetAddress()))
+}
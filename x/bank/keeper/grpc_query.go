@@ -200,3 +200,42 @@ func (k BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetada
 		Metadata: metadata,
 	}, nil
 }
+
+// DeferredBalances implements the Query/DeferredBalances gRPC method
+func (k BaseKeeper) DeferredBalances(ctx context.Context, req *types.QueryDeferredBalancesRequest) (*types.QueryDeferredBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if k.deferredCache == nil {
+		return nil, status.Error(codes.Unimplemented, "bank keeper created without deferred cache")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Address != "" {
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+		}
+
+		balances := []types.DeferredBalance{}
+		if coins := k.deferredCache.GetBalances(sdkCtx, addr); !coins.IsZero() {
+			balances = append(balances, types.DeferredBalance{Address: req.Address, Coins: coins})
+		}
+		return &types.QueryDeferredBalancesResponse{Balances: balances}, nil
+	}
+
+	// the deferred balances of an address are contiguous in the deferred cache
+	balances := []types.DeferredBalance{}
+	k.deferredCache.IterateDeferredBalances(sdkCtx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		if last := len(balances) - 1; last >= 0 && balances[last].Address == addr.String() {
+			balances[last].Coins = balances[last].Coins.Add(balance)
+			return false
+		}
+		balances = append(balances, types.DeferredBalance{Address: addr.String(), Coins: sdk.NewCoins(balance)})
+		return false
+	})
+
+	return &types.QueryDeferredBalancesResponse{Balances: balances}, nil
+}
@@ -12,12 +12,18 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", NonnegativeBalanceInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+	ir.RegisterRoute(types.ModuleName, "deferred-balances", DeferredBalancesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the X/bank module.
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return TotalSupply(k)(ctx)
+		res, stop := TotalSupply(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DeferredBalancesInvariant(k)(ctx)
 	}
 }
 
@@ -103,3 +109,39 @@ func TotalSupply(k Keeper) sdk.Invariant {
 				expectedTotal, supply)), broken
 	}
 }
+
+// DeferredBalancesInvariant checks that all the balances of the deferred cache are positive, and that the
+// coins deferred to accounts don't exceed the supply of their denom.
+func DeferredBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		deferredTotal := sdk.Coins{}
+		k.IterateDeferredBalances(ctx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+			if !balance.IsValid() || balance.IsZero() {
+				count++
+				msg += fmt.Sprintf("\t%s has an invalid deferred balance of %s\n", addr, balance)
+				return false
+			}
+			deferredTotal = deferredTotal.Add(balance)
+			return false
+		})
+
+		for _, coin := range deferredTotal {
+			if supply := k.GetSupply(ctx, coin.Denom); supply.IsLT(coin) {
+				count++
+				msg += fmt.Sprintf("\tdeferred balances of %s exceed the supply of %s\n", coin, supply)
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "deferred-balances",
+			fmt.Sprintf("amount of invalid deferred balances found %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -45,7 +45,14 @@ type Keeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 
+	DeltaSendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+
+	DeferredSendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	DeferredSendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	DeferredSendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	DeferredSendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	GetDeferredBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	DeferredSettlement() types.DeferredSettlement
 	WriteDeferredBalances(ctx sdk.Context) []abci.Event
 	IterateDeferredBalances(ctx sdk.Context, cb func(addr sdk.AccAddress, coin sdk.Coin) bool)
 
@@ -63,6 +70,7 @@ type BaseKeeper struct {
 
 	ak                     types.AccountKeeper
 	deferredCache          *DeferredCache
+	deferredSettlement     types.DeferredSettlement
 	cdc                    codec.BinaryCodec
 	storeKey               sdk.StoreKey
 	paramSpace             paramtypes.Subspace
@@ -400,34 +408,35 @@ func (k BaseKeeper) SendCoinsFromAccountToModule(
 	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
+// DeltaSendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount.
+// The module account is credited with deltas to its balances instead of reading and writing them, so that the
+// txs of a block paying into the same module account, like the fee collector, don't conflict with each other.
+// It will panic if the module account does not exist.
+func (k BaseKeeper) DeltaSendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	recipientAcc := k.ak.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+
+	return k.deltaSendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+}
+
 // DeferredSendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount.
 // It deducts the balance from an accAddress and stores the balance in a mapping for ModuleAccounts.
-// In the EndBlocker, it will then perform one deposit for each module account.
+// The module account is credited when the deferred balances are settled.
 // It will panic if the module account does not exist.
 func (k BaseKeeper) DeferredSendCoinsFromAccountToModule(
 	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amount sdk.Coins,
 ) error {
-	if k.deferredCache == nil {
-		panic("bank keeper created without deferred cache")
-	}
-	// Deducts Fees from the Sender Account
-	err := k.SubUnlockedCoins(ctx, senderAddr, amount, true)
-	if err != nil {
-		return err
-	}
 	// get recipient module address
 	moduleAcc := k.ak.GetModuleAccount(ctx, recipientModule)
 	if moduleAcc == nil {
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
-	// get txIndex
-	txIndex := ctx.TxIndex()
-	err = k.deferredCache.UpsertBalances(ctx, moduleAcc.GetAddress(), uint64(txIndex), amount)
-	if err != nil {
-		return err
-	}
 
-	return nil
+	return k.deferredSendCoins(ctx, senderAddr, moduleAcc.GetAddress(), amount)
 }
 
 // WriteDeferredDepositsToModuleAccounts Iterates on all the deferred deposits and deposit them into the store
@@ -469,7 +478,12 @@ func (k BaseKeeper) WriteDeferredBalances(ctx sdk.Context) []abci.Event {
 			ctx.Logger().Error(err.Error())
 			panic(err)
 		}
-		err := k.AddCoins(ctx, sdk.MustAccAddressFromBech32(moduleBech32Addr), amount, true)
+		addr := sdk.MustAccAddressFromBech32(moduleBech32Addr)
+		// user accounts can be credited before they exist
+		if !k.ak.HasAccount(ctx, addr) {
+			k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, addr))
+		}
+		err := k.AddCoins(ctx, addr, amount, true)
 		if err != nil {
 			ctx.Logger().Error(fmt.Sprintf("Failed to add coin=%s to module address=%s, error is: %s", amount, moduleBech32Addr, err))
 			panic(err)
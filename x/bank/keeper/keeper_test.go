@@ -8,7 +8,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	tmtime "github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store/multiversion"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/occ"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -849,6 +853,60 @@ func (suite *IntegrationTestSuite) TestWriteDeferredOperations() {
 	suite.Require().Error(app.BankKeeper.DeferredSendCoinsFromAccountToModule(ctx, addr2, "asdas", deferredBalances))
 }
 
+func (suite *IntegrationTestSuite) TestDeltaSendCoinsFromAccountToModuleDoesNotConflict() {
+	app, ctx := suite.app, suite.ctx
+	bankKey := app.GetKey(types.StoreKey)
+	feeCollectorAddr := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	fee := sdk.NewCoins(newFooCoin(10))
+
+	addrs := []sdk.AccAddress{sdk.AccAddress("addr1_______________"), sdk.AccAddress("addr2_______________")}
+	for _, addr := range addrs {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+		suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(newFooCoin(20))))
+	}
+	initBalance := app.BankKeeper.GetBalance(ctx, feeCollectorAddr, fooDenom)
+
+	// runs a tx per address paying the fee to the fee collector through send, executing all of them before any
+	// of them is written to the multiversion store, like they would run in parallel
+	execute := func(send func(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error) *multiversion.Store {
+		mvs := multiversion.NewMultiVersionStore(ctx.MultiStore().GetKVStore(bankKey))
+		var versionStores []*multiversion.VersionIndexedStore
+		for i, addr := range addrs {
+			vs := mvs.VersionedIndexedStore(i, 0, make(chan occ.Abort, 1))
+			ms := ctx.MultiStore().CacheMultiStore().SetKVStores(func(k storetypes.StoreKey, kvs sdk.KVStore) storetypes.CacheWrap {
+				if k == bankKey {
+					return vs
+				}
+				return kvs.(storetypes.CacheWrap)
+			})
+			suite.Require().NoError(send(ctx.WithTxIndex(i).WithMultiStore(ms), addr, authtypes.FeeCollectorName, fee))
+			versionStores = append(versionStores, vs)
+		}
+		for _, vs := range versionStores {
+			vs.WriteToMultiVersionStore()
+		}
+		return mvs
+	}
+
+	// crediting the fee collector balance reads it, so the second tx conflicts with the first one
+	mvs := execute(app.BankKeeper.SendCoinsFromAccountToModule)
+	valid, _ := mvs.ValidateTransactionState(1)
+	suite.Require().False(valid)
+
+	// crediting it with deltas doesn't, so neither tx aborts the other
+	mvs = execute(app.BankKeeper.DeltaSendCoinsFromAccountToModule)
+	for i := range addrs {
+		valid, conflicts := mvs.ValidateTransactionState(i)
+		suite.Require().True(valid)
+		suite.Require().Empty(conflicts)
+	}
+	mvs.WriteLatestToStore()
+	suite.Require().Equal(initBalance.Add(newFooCoin(20)), app.BankKeeper.GetBalance(ctx, feeCollectorAddr, fooDenom))
+	for _, addr := range addrs {
+		suite.Require().Equal(newFooCoin(10), app.BankKeeper.GetBalance(ctx, addr, fooDenom))
+	}
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -1718,6 +1776,26 @@ func (suite *IntegrationTestSuite) TestCanSendTo() {
 	suite.Require().NotNil(app.BankKeeper.SendCoinsAndWei(ctx, sourceAddr, badAddr, sdk.OneInt(), sdk.ZeroInt()))
 }
 
+func (suite *IntegrationTestSuite) TestBalanceChangeHook() {
+	app, ctx := suite.app, suite.ctx
+	fromAddr := sdk.AccAddress([]byte("addr1_______________"))
+	toAddr := sdk.AccAddress([]byte("addr2_______________"))
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, fromAddr, sdk.NewCoins(sdk.NewInt64Coin("hooked", 100))))
+
+	// the hook sees the balances before they change, and its error aborts the change
+	var previous []sdk.Coin
+	app.BankKeeper.RegisterBalanceChangeHook(func(ctx sdk.Context, addr sdk.AccAddress, denom string) error {
+		if denom == "rejected" {
+			return sdkerrors.ErrUnauthorized
+		}
+		previous = append(previous, app.BankKeeper.GetBalance(ctx, addr, denom))
+		return nil
+	})
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("hooked", 10))))
+	suite.Require().Equal([]sdk.Coin{sdk.NewInt64Coin("hooked", 100), sdk.NewInt64Coin("hooked", 0)}, previous)
+	suite.Require().ErrorIs(simapp.FundAccount(app.BankKeeper, ctx, fromAddr, sdk.NewCoins(sdk.NewInt64Coin("rejected", 1))), sdkerrors.ErrUnauthorized)
+}
+
 func (suite *IntegrationTestSuite) TestIterateAllDenomMetaData() {
 	app, ctx := suite.app, suite.ctx
 
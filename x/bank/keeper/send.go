@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -41,10 +42,15 @@ type SendKeeper interface {
 
 	BlockedAddr(addr sdk.AccAddress) bool
 	RegisterRecipientChecker(RecipientChecker)
+	RegisterBalanceChangeHook(BalanceChangeHook)
 }
 
 type RecipientChecker = func(ctx sdk.Context, recipient sdk.AccAddress) bool
 
+// BalanceChangeHook is called before the balance of an account in a denom changes, while it still holds the
+// previous balance
+type BalanceChangeHook = func(ctx sdk.Context, addr sdk.AccAddress, denom string) error
+
 var _ SendKeeper = (*BaseSendKeeper)(nil)
 var OneUplumeInWei sdk.Int = sdk.NewInt(1_000_000_000_000)
 
@@ -59,8 +65,9 @@ type BaseSendKeeper struct {
 	paramSpace paramtypes.Subspace
 
 	// list of addresses that are restricted from receiving transactions
-	blockedAddrs      map[string]bool
-	recipientCheckers *[]RecipientChecker
+	blockedAddrs       map[string]bool
+	recipientCheckers  *[]RecipientChecker
+	balanceChangeHooks *[]BalanceChangeHook
 }
 
 func NewBaseSendKeeper(
@@ -68,13 +75,14 @@ func NewBaseSendKeeper(
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper:    NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:               cdc,
-		ak:                ak,
-		storeKey:          storeKey,
-		paramSpace:        paramSpace,
-		blockedAddrs:      blockedAddrs,
-		recipientCheckers: &[]RecipientChecker{},
+		BaseViewKeeper:     NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:                cdc,
+		ak:                 ak,
+		storeKey:           storeKey,
+		paramSpace:         paramSpace,
+		blockedAddrs:       blockedAddrs,
+		recipientCheckers:  &[]RecipientChecker{},
+		balanceChangeHooks: &[]BalanceChangeHook{},
 	}
 }
 
@@ -200,6 +208,34 @@ func (k BaseSendKeeper) sendCoinsWithoutAccCreation(ctx sdk.Context, fromAddr sd
 	return nil
 }
 
+// deltaSendCoins deducts amt from fromAddr and credits toAddr with deltas to its balances, see addCoinsAsDelta.
+func (k BaseSendKeeper) deltaSendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.SubUnlockedCoins(ctx, fromAddr, amt, true)
+	if err != nil {
+		return err
+	}
+
+	err = k.addCoinsAsDelta(ctx, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+		),
+	})
+
+	return nil
+}
+
 // SubUnlockedCoins removes the unlocked amt coins of the given account. An error is
 // returned if the resulting balance is negative or the initial amount is invalid.
 // A coin_spent event is emitted after.
@@ -270,6 +306,57 @@ func (k BaseSendKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.C
 	return nil
 }
 
+// addCoinsAsDelta increases the addr balance by the given amt without reading it. The coins are added as deltas
+// to the balance keys of addr, which are merged into the balances when they are read or the store is written, so
+// that the txs of a block crediting the same account don't conflict with each other.
+func (k BaseSendKeeper) addCoinsAsDelta(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	if !k.CanSendTo(ctx, addr) {
+		return sdkerrors.ErrInvalidRecipient
+	}
+	if !amt.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	accountStore := k.getAccountStore(ctx, addr)
+	for i := range amt {
+		if err := k.beforeBalanceChange(ctx, addr, amt[i].Denom); err != nil {
+			return err
+		}
+		storetypes.AddDelta(accountStore, []byte(amt[i].Denom), k.cdc.MustMarshal(&amt[i]), mergeBalance)
+	}
+
+	// emit coin received event
+	ctx.EventManager().EmitEvent(
+		types.NewCoinReceivedEvent(addr, amt),
+	)
+
+	return nil
+}
+
+// mergeBalance adds a coin to the balance of its denom.
+func mergeBalance(value []byte, delta []byte) []byte {
+	var amount sdk.Coin
+	if err := amount.Unmarshal(delta); err != nil {
+		panic(err)
+	}
+	balance := sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	if value != nil {
+		if err := balance.Unmarshal(value); err != nil {
+			panic(err)
+		}
+	}
+	balance = balance.Add(amount)
+	// Bank invariants require to not store zero balances.
+	if balance.IsZero() {
+		return nil
+	}
+	bz, err := balance.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
 // initBalances sets the balance (multiple coins) for an account by address.
 // An error is returned upon failure.
 func (k BaseSendKeeper) initBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error {
@@ -296,6 +383,10 @@ func (k BaseSendKeeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, balance.String())
 	}
 
+	if err := k.beforeBalanceChange(ctx, addr, balance.Denom); err != nil {
+		return err
+	}
+
 	accountStore := k.getAccountStore(ctx, addr)
 
 	// Bank invariants require to not store zero balances.
@@ -428,6 +519,20 @@ func (k BaseSendKeeper) RegisterRecipientChecker(rc RecipientChecker) {
 	*k.recipientCheckers = append(*k.recipientCheckers, rc)
 }
 
+// RegisterBalanceChangeHook registers a hook called before every change of the balance of an account in a denom
+func (k BaseSendKeeper) RegisterBalanceChangeHook(h BalanceChangeHook) {
+	*k.balanceChangeHooks = append(*k.balanceChangeHooks, h)
+}
+
+func (k BaseSendKeeper) beforeBalanceChange(ctx sdk.Context, addr sdk.AccAddress, denom string) error {
+	for _, h := range *k.balanceChangeHooks {
+		if err := h(ctx, addr, denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k BaseSendKeeper) CanSendTo(ctx sdk.Context, recipient sdk.AccAddress) bool {
 	for _, rc := range *k.recipientCheckers {
 		if !rc(ctx, recipient) {
@@ -0,0 +1,29 @@
+package types
+
+// DeferredSettlement is the point of the block at which the balances of the deferred cache are credited to
+// their accounts.
+type DeferredSettlement int
+
+const (
+	// DeferredSettlementManual leaves the settlement to the app, which calls WriteDeferredBalances itself.
+	DeferredSettlementManual DeferredSettlement = iota
+	// DeferredSettlementEndBlock settles the deferred balances in the EndBlocker of the bank module.
+	DeferredSettlementEndBlock
+	// DeferredSettlementMidBlock settles the deferred balances in the MidBlocker of the bank module, i.e.
+	// after all the txs of the block and before any EndBlocker.
+	DeferredSettlementMidBlock
+)
+
+// String implements the Stringer interface.
+func (s DeferredSettlement) String() string {
+	switch s {
+	case DeferredSettlementManual:
+		return "manual"
+	case DeferredSettlementEndBlock:
+		return "end-block"
+	case DeferredSettlementMidBlock:
+		return "mid-block"
+	default:
+		return "unknown"
+	}
+}
@@ -783,6 +783,151 @@ func (m *QueryDenomMetadataResponse) GetMetadata() Metadata {
 	return Metadata{}
 }
 
+// QueryDeferredBalancesRequest is the request type for the Query/DeferredBalances RPC method.
+type QueryDeferredBalancesRequest struct {
+	// address, if set, restricts the query to the balances deferred to this account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryDeferredBalancesRequest) Reset()         { *m = QueryDeferredBalancesRequest{} }
+func (m *QueryDeferredBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeferredBalancesRequest) ProtoMessage()    {}
+func (*QueryDeferredBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{16}
+}
+func (m *QueryDeferredBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeferredBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeferredBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeferredBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeferredBalancesRequest.Merge(m, src)
+}
+func (m *QueryDeferredBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeferredBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeferredBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeferredBalancesRequest proto.InternalMessageInfo
+
+func (m *QueryDeferredBalancesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryDeferredBalancesResponse is the response type for the Query/DeferredBalances RPC method.
+type QueryDeferredBalancesResponse struct {
+	// balances are the pending deferred balances, by account address.
+	Balances []DeferredBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances"`
+}
+
+func (m *QueryDeferredBalancesResponse) Reset()         { *m = QueryDeferredBalancesResponse{} }
+func (m *QueryDeferredBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeferredBalancesResponse) ProtoMessage()    {}
+func (*QueryDeferredBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{17}
+}
+func (m *QueryDeferredBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeferredBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeferredBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeferredBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeferredBalancesResponse.Merge(m, src)
+}
+func (m *QueryDeferredBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeferredBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeferredBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeferredBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryDeferredBalancesResponse) GetBalances() []DeferredBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// DeferredBalance holds the coins deferred to an account, summed over all the txs of the block.
+type DeferredBalance struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *DeferredBalance) Reset()         { *m = DeferredBalance{} }
+func (m *DeferredBalance) String() string { return proto.CompactTextString(m) }
+func (*DeferredBalance) ProtoMessage()    {}
+func (*DeferredBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{18}
+}
+func (m *DeferredBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeferredBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeferredBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeferredBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeferredBalance.Merge(m, src)
+}
+func (m *DeferredBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeferredBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeferredBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeferredBalance proto.InternalMessageInfo
+
+func (m *DeferredBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DeferredBalance) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -800,68 +945,76 @@ func init() {
 	proto.RegisterType((*QueryDenomsMetadataResponse)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataResponse")
 	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataRequest")
 	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataResponse")
+	proto.RegisterType((*QueryDeferredBalancesRequest)(nil), "cosmos.bank.v1beta1.QueryDeferredBalancesRequest")
+	proto.RegisterType((*QueryDeferredBalancesResponse)(nil), "cosmos.bank.v1beta1.QueryDeferredBalancesResponse")
+	proto.RegisterType((*DeferredBalance)(nil), "cosmos.bank.v1beta1.DeferredBalance")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0x5d, 0x6b, 0x23, 0x55,
-	0x18, 0xc7, 0x73, 0xaa, 0x4d, 0xd3, 0x27, 0x28, 0x78, 0x1a, 0x31, 0x9d, 0xda, 0x89, 0x4c, 0xb5,
-	0x4d, 0x6b, 0x3a, 0xd3, 0x24, 0x82, 0xd6, 0x1b, 0x69, 0x2a, 0x7a, 0x21, 0xd2, 0x98, 0x7a, 0x25,
-	0x48, 0x39, 0x49, 0xc6, 0x31, 0x34, 0x99, 0x33, 0xcd, 0x99, 0x88, 0xa1, 0x14, 0x44, 0x10, 0x04,
-	0x41, 0x05, 0x6f, 0x04, 0x6f, 0xea, 0x8d, 0xa0, 0x5f, 0xc0, 0xaf, 0xd0, 0x8b, 0xbd, 0x28, 0xbb,
-	0x37, 0x7b, 0xb5, 0xbb, 0xb4, 0x7b, 0xb1, 0x37, 0xfb, 0x1d, 0x96, 0x9c, 0x97, 0x74, 0x92, 0x4c,
-	0x92, 0x59, 0xc8, 0xb2, 0xec, 0x55, 0x93, 0x33, 0xcf, 0xcb, 0xef, 0xf9, 0x9f, 0x33, 0xff, 0x93,
-	0x42, 0xa6, 0x46, 0x59, 0x8b, 0x32, 0xab, 0x4a, 0xdc, 0x63, 0xeb, 0xbb, 0x7c, 0xd5, 0xf6, 0x49,
-	0xde, 0x3a, 0xe9, 0xd8, 0xed, 0xae, 0xe9, 0xb5, 0xa9, 0x4f, 0xf1, 0x92, 0x08, 0x30, 0x7b, 0x01,
-	0xa6, 0x0c, 0xd0, 0xb6, 0xfa, 0x59, 0xcc, 0x16, 0xd1, 0xfd, 0x5c, 0x8f, 0x38, 0x0d, 0x97, 0xf8,
-	0x0d, 0xea, 0x8a, 0x02, 0x5a, 0xca, 0xa1, 0x0e, 0xe5, 0x1f, 0xad, 0xde, 0x27, 0xb9, 0xfa, 0xa6,
-	0x43, 0xa9, 0xd3, 0xb4, 0x2d, 0xe2, 0x35, 0x2c, 0xe2, 0xba, 0xd4, 0xe7, 0x29, 0x4c, 0x3e, 0xd5,
-	0x83, 0xf5, 0x55, 0xe5, 0x1a, 0x6d, 0xb8, 0x23, 0xcf, 0x03, 0xd4, 0x9c, 0x90, 0x3f, 0x37, 0x0e,
-	0x60, 0xe9, 0x8b, 0x1e, 0x55, 0x89, 0x34, 0x89, 0x5b, 0xb3, 0x2b, 0xf6, 0x49, 0xc7, 0x66, 0x3e,
-	0x4e, 0xc3, 0x02, 0xa9, 0xd7, 0xdb, 0x36, 0x63, 0x69, 0xf4, 0x16, 0xca, 0x2e, 0x56, 0xd4, 0x57,
-	0x9c, 0x82, 0xf9, 0xba, 0xed, 0xd2, 0x56, 0x7a, 0x8e, 0xaf, 0x8b, 0x2f, 0x1f, 0x26, 0x7e, 0x3e,
-	0xcf, 0xc4, 0x1e, 0x9d, 0x67, 0x62, 0xc6, 0x67, 0x90, 0x1a, 0x2c, 0xc8, 0x3c, 0xea, 0x32, 0x1b,
-	0x17, 0x61, 0xa1, 0x2a, 0x96, 0x78, 0xc5, 0x64, 0x61, 0xd9, 0xec, 0xeb, 0xc5, 0x6c, 0xa5, 0x97,
-	0xb9, 0x4f, 0x1b, 0x6e, 0x45, 0x45, 0x1a, 0x3f, 0x21, 0x78, 0x83, 0x57, 0xdb, 0x6b, 0x36, 0x65,
-	0x41, 0x36, 0x1d, 0xf1, 0x13, 0x80, 0x1b, 0x6d, 0x39, 0x67, 0xb2, 0xb0, 0x3e, 0xd0, 0x4d, 0x6c,
-	0x9b, 0xea, 0x59, 0x26, 0x8e, 0x1a, 0xbc, 0x12, 0xc8, 0x0c, 0x0c, 0x75, 0x0b, 0x41, 0x7a, 0x94,
-	0x43, 0x4e, 0xe6, 0x40, 0x42, 0xf2, 0xf6, 0x48, 0x5e, 0x9a, 0x38, 0x5a, 0x69, 0xe7, 0xe2, 0x5e,
-	0x26, 0xf6, 0xdf, 0xfd, 0x4c, 0xd6, 0x69, 0xf8, 0xdf, 0x76, 0xaa, 0x66, 0x8d, 0xb6, 0x2c, 0xb9,
-	0x45, 0xe2, 0xcf, 0x36, 0xab, 0x1f, 0x5b, 0x7e, 0xd7, 0xb3, 0x19, 0x4f, 0x60, 0x95, 0x7e, 0x71,
-	0xfc, 0x69, 0xc8, 0x5c, 0x1b, 0x53, 0xe7, 0x12, 0x94, 0xc1, 0xc1, 0x8c, 0x5f, 0x10, 0xac, 0xf2,
-	0x71, 0x0e, 0x3d, 0xdb, 0xad, 0x93, 0x6a, 0xd3, 0x7e, 0x9e, 0xe2, 0xde, 0x46, 0xa0, 0x8f, 0xa3,
-	0x79, 0x61, 0x25, 0x3e, 0x96, 0x07, 0xf7, 0x4b, 0xea, 0x93, 0xe6, 0x61, 0xc7, 0xf3, 0x9a, 0x5d,
-	0xa5, 0xed, 0xa0, 0x82, 0x68, 0x06, 0x0a, 0x5e, 0xa8, 0xe3, 0x39, 0xd0, 0x4d, 0x6a, 0x57, 0x83,
-	0x38, 0xe3, 0x2b, 0xcf, 0x42, 0x39, 0x59, 0x7a, 0x76, 0xba, 0xe5, 0xa4, 0x7d, 0x88, 0x21, 0x0e,
-	0xbe, 0x51, 0xa2, 0xf5, 0x6d, 0x07, 0x05, 0x6c, 0xc7, 0x28, 0xc3, 0xeb, 0x43, 0xd1, 0x72, 0xe8,
-	0xf7, 0x21, 0x4e, 0x5a, 0xb4, 0xe3, 0xfa, 0x53, 0xcd, 0xa6, 0xf4, 0x72, 0x6f, 0xe8, 0x8a, 0x0c,
-	0x37, 0x52, 0x80, 0x79, 0xc5, 0x32, 0x69, 0x93, 0x96, 0x7a, 0x1d, 0x8c, 0xb2, 0x74, 0x49, 0xb5,
-	0x2a, 0xbb, 0xec, 0x42, 0xdc, 0xe3, 0x2b, 0xb2, 0xcb, 0x8a, 0x19, 0x72, 0x05, 0x98, 0x22, 0x49,
-	0xf5, 0x11, 0x09, 0x46, 0x1d, 0x34, 0x5e, 0xf1, 0xe3, 0xde, 0x1c, 0xec, 0x73, 0xdb, 0x27, 0x75,
-	0xe2, 0x93, 0x19, 0x1f, 0x11, 0xe3, 0x5f, 0x04, 0x2b, 0xa1, 0x6d, 0xe4, 0x00, 0x7b, 0xb0, 0xd8,
-	0x92, 0x6b, 0xea, 0xc5, 0x5a, 0x0d, 0x9d, 0x41, 0x65, 0xca, 0x29, 0x6e, 0xb2, 0x66, 0xb7, 0xf3,
-	0x79, 0x58, 0xbe, 0x41, 0x1d, 0x16, 0x24, 0x7c, 0xfb, 0xbf, 0x0e, 0x8a, 0x38, 0x32, 0xdc, 0x47,
-	0x90, 0x50, 0x98, 0x52, 0xc2, 0x48, 0xb3, 0xf5, 0x93, 0x0a, 0x8f, 0x17, 0x61, 0x9e, 0xd7, 0xc7,
-	0x7f, 0x22, 0x58, 0x90, 0xa6, 0x84, 0xb3, 0xa1, 0x45, 0x42, 0x2e, 0x51, 0x6d, 0x33, 0x42, 0xa4,
-	0x60, 0x35, 0x3e, 0xf8, 0xf1, 0xce, 0xc3, 0x3f, 0xe6, 0x0a, 0x78, 0xc7, 0x0a, 0xbf, 0xaf, 0x85,
-	0x3d, 0x59, 0xa7, 0xd2, 0x85, 0xcf, 0xac, 0x6a, 0xf7, 0x88, 0x6b, 0x80, 0xff, 0x42, 0x90, 0x0c,
-	0xdc, 0x4a, 0x38, 0x37, 0xbe, 0xe9, 0xe8, 0x25, 0xaa, 0x6d, 0x47, 0x8c, 0x96, 0x98, 0x16, 0xc7,
-	0xdc, 0xc4, 0x1b, 0x11, 0x31, 0xf1, 0xff, 0x08, 0x5e, 0x1b, 0xb1, 0x75, 0x5c, 0x18, 0xdf, 0x75,
-	0xdc, 0x8d, 0xa4, 0x15, 0x9f, 0x2a, 0x47, 0xf2, 0xee, 0x72, 0xde, 0x22, 0xce, 0x87, 0xf2, 0x32,
-	0x95, 0x77, 0x14, 0x42, 0xfe, 0x1b, 0x82, 0x64, 0xc0, 0x4e, 0x27, 0xe9, 0x3a, 0xea, 0xf1, 0x93,
-	0x74, 0x0d, 0xf1, 0x68, 0x63, 0x8d, 0x73, 0xae, 0xe2, 0x95, 0x70, 0x4e, 0x41, 0xf0, 0x2b, 0x82,
-	0x84, 0x32, 0x3a, 0x3c, 0xe1, 0x6c, 0x0d, 0x59, 0xa7, 0xb6, 0x15, 0x25, 0x54, 0x82, 0xbc, 0xcb,
-	0x41, 0xde, 0xc1, 0x6b, 0x13, 0x40, 0xac, 0x53, 0x7e, 0xf2, 0xce, 0xf0, 0x0f, 0x08, 0xe2, 0xc2,
-	0xdc, 0xf0, 0xc6, 0xf8, 0x1e, 0x03, 0x4e, 0xaa, 0x65, 0xa7, 0x07, 0x46, 0xd2, 0x44, 0xd8, 0x28,
-	0xfe, 0x07, 0xc1, 0x2b, 0x03, 0x6f, 0x3f, 0x36, 0xc7, 0x37, 0x08, 0x73, 0x16, 0xcd, 0x8a, 0x1c,
-	0x2f, 0xb9, 0xde, 0xe3, 0x5c, 0x26, 0xce, 0x85, 0x72, 0x71, 0x69, 0xd8, 0x91, 0xf2, 0x90, 0xbe,
-	0x56, 0x7f, 0x23, 0x78, 0x75, 0xd0, 0x84, 0xf1, 0xb4, 0xce, 0xc3, 0xb7, 0x82, 0xb6, 0x13, 0x3d,
-	0x41, 0xb2, 0xe6, 0x38, 0xeb, 0x3a, 0x7e, 0x3b, 0x0a, 0x6b, 0x69, 0xff, 0xe2, 0x4a, 0x47, 0x97,
-	0x57, 0x3a, 0x7a, 0x70, 0xa5, 0xa3, 0xdf, 0xaf, 0xf5, 0xd8, 0xe5, 0xb5, 0x1e, 0xbb, 0x7b, 0xad,
-	0xc7, 0xbe, 0xda, 0x9c, 0xf8, 0x83, 0xe0, 0x7b, 0x51, 0x96, 0xff, 0x2e, 0xa8, 0xc6, 0xf9, 0xff,
-	0x15, 0xc5, 0x27, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xfd, 0x88, 0xa1, 0x2f, 0x0d, 0x00, 0x00,
+	// 975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xc7, 0x3d, 0x81, 0x38, 0xe9, 0x63, 0xf1, 0x36, 0x09, 0xc2, 0xdd, 0x34, 0x36, 0xda, 0x96,
+	0xc4, 0x29, 0xc9, 0x6e, 0xec, 0x20, 0xd1, 0x72, 0x41, 0x4d, 0x51, 0x39, 0x20, 0xd4, 0xe0, 0x72,
+	0x42, 0x42, 0xd1, 0xd8, 0x9e, 0x2e, 0x56, 0xec, 0x9d, 0xad, 0x67, 0x8d, 0xb0, 0xaa, 0x4a, 0x08,
+	0x09, 0x09, 0x09, 0xa9, 0x20, 0x71, 0x01, 0x71, 0x29, 0x17, 0xa4, 0xf2, 0x05, 0xf8, 0x0a, 0x39,
+	0x70, 0xa8, 0xe0, 0xc2, 0x09, 0x50, 0xc2, 0x81, 0x8f, 0x81, 0x76, 0x5e, 0x36, 0xbb, 0xf6, 0xac,
+	0xbd, 0x95, 0x8c, 0x50, 0x4f, 0xb1, 0xc7, 0xcf, 0xcb, 0xef, 0xff, 0xdf, 0xdd, 0xe7, 0xd9, 0x40,
+	0xb5, 0xcd, 0x78, 0x9f, 0x71, 0xb7, 0x45, 0xfc, 0x23, 0xf7, 0xe3, 0x7a, 0x8b, 0x86, 0xa4, 0xee,
+	0xde, 0x19, 0xd2, 0xc1, 0xc8, 0x09, 0x06, 0x2c, 0x64, 0x78, 0x45, 0x06, 0x38, 0x51, 0x80, 0xa3,
+	0x02, 0xac, 0xcb, 0x71, 0x16, 0xa7, 0x32, 0x3a, 0xce, 0x0d, 0x88, 0xd7, 0xf5, 0x49, 0xd8, 0x65,
+	0xbe, 0x2c, 0x60, 0xad, 0x7a, 0xcc, 0x63, 0xe2, 0xa3, 0x1b, 0x7d, 0x52, 0xa7, 0x17, 0x3c, 0xc6,
+	0xbc, 0x1e, 0x75, 0x49, 0xd0, 0x75, 0x89, 0xef, 0xb3, 0x50, 0xa4, 0x70, 0xf5, 0x6b, 0x25, 0x59,
+	0x5f, 0x57, 0x6e, 0xb3, 0xae, 0x3f, 0xf1, 0x7b, 0x82, 0x3a, 0xfa, 0x22, 0x7f, 0xb7, 0x6f, 0xc2,
+	0xca, 0x7b, 0x11, 0xd5, 0x3e, 0xe9, 0x11, 0xbf, 0x4d, 0x9b, 0xf4, 0xce, 0x90, 0xf2, 0x10, 0x97,
+	0x61, 0x89, 0x74, 0x3a, 0x03, 0xca, 0x79, 0x19, 0xbd, 0x8c, 0x6a, 0xe7, 0x9a, 0xfa, 0x2b, 0x5e,
+	0x85, 0xc5, 0x0e, 0xf5, 0x59, 0xbf, 0xbc, 0x20, 0xce, 0xe5, 0x97, 0x37, 0x96, 0xbf, 0x78, 0x50,
+	0x2d, 0xfc, 0xf3, 0xa0, 0x5a, 0xb0, 0xdf, 0x81, 0xd5, 0x74, 0x41, 0x1e, 0x30, 0x9f, 0x53, 0xbc,
+	0x07, 0x4b, 0x2d, 0x79, 0x24, 0x2a, 0x96, 0x1a, 0xe7, 0x9d, 0xd8, 0x2f, 0x4e, 0xb5, 0x5f, 0xce,
+	0x75, 0xd6, 0xf5, 0x9b, 0x3a, 0xd2, 0xfe, 0x1c, 0xc1, 0x4b, 0xa2, 0xda, 0xb5, 0x5e, 0x4f, 0x15,
+	0xe4, 0xb3, 0x11, 0x6f, 0x00, 0x9c, 0x79, 0x2b, 0x38, 0x4b, 0x8d, 0x8d, 0x54, 0x37, 0x79, 0xd9,
+	0x74, 0xcf, 0x03, 0xe2, 0x69, 0xe1, 0xcd, 0x44, 0x66, 0x42, 0xd4, 0x2f, 0x08, 0xca, 0x93, 0x1c,
+	0x4a, 0x99, 0x07, 0xcb, 0x8a, 0x37, 0x22, 0x79, 0x6a, 0xaa, 0xb4, 0xfd, 0xdd, 0xe3, 0x3f, 0xaa,
+	0x85, 0x9f, 0xfe, 0xac, 0xd6, 0xbc, 0x6e, 0xf8, 0xd1, 0xb0, 0xe5, 0xb4, 0x59, 0xdf, 0x55, 0x97,
+	0x48, 0xfe, 0xd9, 0xe1, 0x9d, 0x23, 0x37, 0x1c, 0x05, 0x94, 0x8b, 0x04, 0xde, 0x8c, 0x8b, 0xe3,
+	0xb7, 0x0d, 0xba, 0x36, 0x67, 0xea, 0x92, 0x94, 0x49, 0x61, 0xf6, 0x97, 0x08, 0xd6, 0x85, 0x9c,
+	0x5b, 0x01, 0xf5, 0x3b, 0xa4, 0xd5, 0xa3, 0xff, 0xa7, 0xb9, 0xbf, 0x22, 0xa8, 0x64, 0xd1, 0x3c,
+	0xb1, 0x16, 0x1f, 0xa9, 0x1b, 0xf7, 0x7d, 0x16, 0x92, 0xde, 0xad, 0x61, 0x10, 0xf4, 0x46, 0xda,
+	0xdb, 0xb4, 0x83, 0x68, 0x0e, 0x0e, 0x1e, 0xeb, 0xdb, 0x33, 0xd5, 0x4d, 0x79, 0xd7, 0x86, 0x22,
+	0x17, 0x27, 0xff, 0x85, 0x73, 0xaa, 0xf4, 0xfc, 0x7c, 0xdb, 0x56, 0xe3, 0x43, 0x8a, 0xb8, 0x79,
+	0x5b, 0x9b, 0x16, 0x8f, 0x1d, 0x94, 0x18, 0x3b, 0xf6, 0x01, 0xbc, 0x38, 0x16, 0xad, 0x44, 0xbf,
+	0x0e, 0x45, 0xd2, 0x67, 0x43, 0x3f, 0x9c, 0x39, 0x6c, 0xf6, 0x9f, 0x8e, 0x44, 0x37, 0x55, 0xb8,
+	0xbd, 0x0a, 0x58, 0x54, 0x3c, 0x20, 0x03, 0xd2, 0xd7, 0x8f, 0x83, 0x7d, 0x00, 0x2b, 0xa9, 0x53,
+	0xd5, 0xe5, 0x2a, 0x14, 0x03, 0x71, 0xa2, 0xba, 0xac, 0x39, 0x86, 0x15, 0xe0, 0xc8, 0x24, 0xdd,
+	0x47, 0x26, 0xd8, 0x1d, 0xb0, 0x44, 0xc5, 0xb7, 0x22, 0x1d, 0xfc, 0x5d, 0x1a, 0x92, 0x0e, 0x09,
+	0xc9, 0x9c, 0x6f, 0x11, 0xfb, 0x21, 0x82, 0x35, 0x63, 0x1b, 0x25, 0xe0, 0x1a, 0x9c, 0xeb, 0xab,
+	0x33, 0xfd, 0x60, 0xad, 0x1b, 0x35, 0xe8, 0x4c, 0xa5, 0xe2, 0x2c, 0x6b, 0x7e, 0x57, 0xbe, 0x0e,
+	0xe7, 0xcf, 0x50, 0xc7, 0x0d, 0x31, 0x5f, 0xfe, 0x0f, 0xc1, 0x32, 0xa5, 0x28, 0x71, 0x6f, 0xc2,
+	0xb2, 0xc6, 0x54, 0x16, 0xe6, 0xd2, 0x16, 0x27, 0xd9, 0x57, 0xe0, 0x82, 0x2a, 0x7f, 0x9b, 0x0e,
+	0x06, 0xb4, 0x93, 0x7b, 0x48, 0xda, 0x1e, 0xac, 0x67, 0x64, 0x2a, 0xb6, 0x1b, 0x13, 0x03, 0xed,
+	0x92, 0x91, 0x6d, 0xac, 0x80, 0x46, 0xd4, 0xb9, 0xf6, 0x7d, 0x04, 0xcf, 0x8d, 0xc5, 0x4c, 0x99,
+	0xdd, 0x04, 0x16, 0xa3, 0x57, 0x03, 0x5e, 0x5e, 0x98, 0xff, 0x24, 0x90, 0x95, 0x1b, 0xdf, 0x95,
+	0x60, 0x51, 0x48, 0xc7, 0xdf, 0x22, 0x58, 0xd2, 0x48, 0x35, 0xa3, 0x38, 0xc3, 0x8b, 0x87, 0xb5,
+	0x95, 0x23, 0x52, 0x7a, 0x68, 0x5f, 0xf9, 0xec, 0xb7, 0xbf, 0xbf, 0x59, 0x68, 0xe0, 0x5d, 0xd7,
+	0xfc, 0x8e, 0x23, 0x2d, 0x72, 0xef, 0x2a, 0xf5, 0xf7, 0xdc, 0xd6, 0xe8, 0x50, 0xdc, 0x37, 0xf8,
+	0x7b, 0x04, 0xa5, 0xc4, 0x26, 0xc7, 0xdb, 0xd9, 0x4d, 0x27, 0x5f, 0x3c, 0xac, 0x9d, 0x9c, 0xd1,
+	0x0a, 0xd3, 0x15, 0x98, 0x5b, 0x78, 0x33, 0x27, 0x26, 0xfe, 0x19, 0xc1, 0x0b, 0x13, 0xab, 0x10,
+	0x37, 0xb2, 0xbb, 0x66, 0x6d, 0x71, 0x6b, 0xef, 0xb1, 0x72, 0x14, 0xef, 0x55, 0xc1, 0xbb, 0x87,
+	0xeb, 0x46, 0x5e, 0xae, 0xf3, 0x0e, 0x0d, 0xe4, 0x5f, 0x21, 0x28, 0x25, 0x56, 0xd0, 0x34, 0x5f,
+	0x27, 0xf7, 0xa2, 0xb5, 0x93, 0x33, 0x5a, 0x71, 0x5e, 0x14, 0x9c, 0xeb, 0x78, 0xcd, 0xcc, 0x29,
+	0x09, 0xee, 0x23, 0x58, 0xd6, 0xcb, 0x01, 0x4f, 0xb9, 0xb7, 0xc6, 0xd6, 0x8d, 0x75, 0x39, 0x4f,
+	0xa8, 0x02, 0x79, 0x55, 0x80, 0xbc, 0x82, 0x2f, 0x4e, 0x01, 0x71, 0xef, 0x8a, 0x3b, 0xef, 0x1e,
+	0xfe, 0x14, 0x41, 0x51, 0x2e, 0x04, 0xbc, 0x99, 0xdd, 0x23, 0xb5, 0x7d, 0xac, 0xda, 0xec, 0xc0,
+	0x5c, 0x9e, 0xc8, 0xd5, 0x83, 0x7f, 0x44, 0xf0, 0x4c, 0x6a, 0x62, 0x62, 0x27, 0xbb, 0x81, 0x69,
+	0x1a, 0x5b, 0x6e, 0xee, 0x78, 0xc5, 0xf5, 0x9a, 0xe0, 0x72, 0xf0, 0xb6, 0x91, 0x4b, 0x58, 0xc3,
+	0x0f, 0xf5, 0xdc, 0x8d, 0xbd, 0xfa, 0x01, 0xc1, 0xb3, 0xe9, 0xc5, 0x85, 0x67, 0x75, 0x1e, 0xdf,
+	0xa4, 0xd6, 0x6e, 0xfe, 0x04, 0xc5, 0xba, 0x2d, 0x58, 0x37, 0xf0, 0xa5, 0x3c, 0xac, 0xf8, 0x21,
+	0x82, 0xe7, 0xc7, 0xa7, 0x3c, 0xae, 0x4f, 0x6b, 0x6a, 0xdc, 0x25, 0x56, 0xe3, 0x71, 0x52, 0x14,
+	0xa9, 0x23, 0x48, 0x6b, 0x78, 0x23, 0x83, 0x54, 0xa6, 0xc5, 0x0f, 0xea, 0xfe, 0xf5, 0xe3, 0x93,
+	0x0a, 0x7a, 0x74, 0x52, 0x41, 0x7f, 0x9d, 0x54, 0xd0, 0xd7, 0xa7, 0x95, 0xc2, 0xa3, 0xd3, 0x4a,
+	0xe1, 0xf7, 0xd3, 0x4a, 0xe1, 0x83, 0xad, 0xa9, 0x63, 0xfe, 0x13, 0x59, 0x58, 0x4c, 0xfb, 0x56,
+	0x51, 0xfc, 0xdf, 0xb8, 0xf7, 0xef, 0x00, 0xf8, 0xee, 0x54, 0x8f, 0x0f, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -893,6 +1046,9 @@ type QueryClient interface {
 	DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error)
 	// DenomsMetadata queries the client metadata for all registered coin denominations.
 	DenomsMetadata(ctx context.Context, in *QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataResponse, error)
+	// DeferredBalances queries the balances credited to accounts that are pending the settlement of the
+	// deferred cache.
+	DeferredBalances(ctx context.Context, in *QueryDeferredBalancesRequest, opts ...grpc.CallOption) (*QueryDeferredBalancesResponse, error)
 }
 
 type queryClient struct {
@@ -975,6 +1131,15 @@ func (c *queryClient) DenomsMetadata(ctx context.Context, in *QueryDenomsMetadat
 	return out, nil
 }
 
+func (c *queryClient) DeferredBalances(ctx context.Context, in *QueryDeferredBalancesRequest, opts ...grpc.CallOption) (*QueryDeferredBalancesResponse, error) {
+	out := new(QueryDeferredBalancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DeferredBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -994,6 +1159,9 @@ type QueryServer interface {
 	DenomMetadata(context.Context, *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error)
 	// DenomsMetadata queries the client metadata for all registered coin denominations.
 	DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error)
+	// DeferredBalances queries the balances credited to accounts that are pending the settlement of the
+	// deferred cache.
+	DeferredBalances(context.Context, *QueryDeferredBalancesRequest) (*QueryDeferredBalancesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1024,6 +1192,9 @@ func (*UnimplementedQueryServer) DenomMetadata(ctx context.Context, req *QueryDe
 func (*UnimplementedQueryServer) DenomsMetadata(ctx context.Context, req *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadata not implemented")
 }
+func (*UnimplementedQueryServer) DeferredBalances(ctx context.Context, req *QueryDeferredBalancesRequest) (*QueryDeferredBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeferredBalances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1173,6 +1344,24 @@ func _Query_DenomsMetadata_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DeferredBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeferredBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeferredBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/DeferredBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeferredBalances(ctx, req.(*QueryDeferredBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1209,6 +1398,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsMetadata",
 			Handler:    _Query_DenomsMetadata_Handler,
 		},
+		{
+			MethodName: "DeferredBalances",
+			Handler:    _Query_DeferredBalances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -1818,6 +2011,117 @@ func (m *QueryDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDeferredBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeferredBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeferredBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeferredBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeferredBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeferredBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeferredBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeferredBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeferredBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2063,6 +2367,53 @@ func (m *QueryDenomMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDeferredBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeferredBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeferredBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3620,6 +3971,288 @@ func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDeferredBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeferredBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeferredBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeferredBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeferredBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeferredBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, DeferredBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeferredBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeferredBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeferredBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
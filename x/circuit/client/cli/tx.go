@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// GetTxCmd returns the transaction commands for the circuit module.
+func GetTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(
+		NewCmdAuthorize(),
+		NewCmdDisable(),
+		NewCmdReset(),
+	)
+
+	return circuitTxCmd
+}
+
+// NewCmdAuthorize implements a command to set the circuit breaker permissions
+// of an account.
+func NewCmdAuthorize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize [grantee] [level] [msg-type-urls]",
+		Short: "Set the circuit breaker permissions of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the circuit breaker permissions of an account. The level is one of
+none, some-msgs, all-msgs and super-admin; the comma separated message type URLs the grantee
+can trip and reset are only given with the some-msgs level. The signer must be the module
+authority or a super admin.
+
+Example:
+$ %s tx %s authorize cosmos1.. some-msgs /cosmos.bank.v1beta1.MsgSend,/cosmos.bank.v1beta1.MsgMultiSend --from mykey
+$ %s tx %s authorize cosmos1.. none --from mykey
+`, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			level, err := parsePermissionLevel(args[1])
+			if err != nil {
+				return err
+			}
+
+			var limitTypeURLs []string
+			if len(args) == 3 {
+				limitTypeURLs = strings.Split(args[2], ",")
+			}
+
+			msg := types.NewMsgAuthorizeCircuitBreaker(clientCtx.GetFromAddress().String(), grantee.String(), types.NewPermissions(level, limitTypeURLs))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdDisable implements a command to trip the circuit breaker of message
+// types.
+func NewCmdDisable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable [msg-type-url]...",
+		Short: "Trip the circuit breaker of message types, disabling their execution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Trip the circuit breaker of message types, disabling their execution,
+including when nested in other messages. The signer must be the module authority or have the
+permissions to trip the circuit breakers.
+
+Example:
+$ %s tx %s disable /cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend --from mykey
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdReset implements a command to reset the circuit breaker of message
+// types.
+func NewCmdReset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [msg-type-url]...",
+		Short: "Reset the circuit breaker of disabled message types, enabling their execution again",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reset the circuit breaker of disabled message types, enabling their
+execution again. The signer must be the module authority or have the permissions to reset the
+circuit breakers.
+
+Example:
+$ %s tx %s reset /cosmos.bank.v1beta1.MsgSend --from mykey
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parsePermissionLevel parses a permission level given as none, some-msgs,
+// all-msgs or super-admin.
+func parsePermissionLevel(level string) (types.Permissions_Level, error) {
+	if strings.EqualFold(level, "none") {
+		return types.Permissions_LEVEL_NONE_UNSPECIFIED, nil
+	}
+
+	value, ok := types.Permissions_Level_value["LEVEL_"+strings.ToUpper(strings.ReplaceAll(level, "-", "_"))]
+	if !ok {
+		return 0, fmt.Errorf("unknown permission level %s, expected one of none, some-msgs, all-msgs and super-admin", level)
+	}
+	return types.Permissions_Level(value), nil
+}
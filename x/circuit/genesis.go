@@ -0,0 +1,30 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis new circuit genesis
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
+	for _, account := range data.AccountPermissions {
+		keeper.SetPermissions(ctx, sdk.MustAccAddressFromBech32(account.Address), *account.Permissions)
+	}
+	for _, typeURL := range data.DisabledTypeUrls {
+		keeper.DisableMsg(ctx, typeURL)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	var accountPermissions []*types.GenesisAccountPermissions
+	keeper.IterateAccountPermissions(ctx, func(addr sdk.AccAddress, permissions types.Permissions) bool {
+		accountPermissions = append(accountPermissions, &types.GenesisAccountPermissions{
+			Address:     addr.String(),
+			Permissions: &permissions,
+		})
+		return false
+	})
+	return types.NewGenesisState(accountPermissions, keeper.GetDisableList(ctx))
+}
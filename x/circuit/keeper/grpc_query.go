@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Account returns the circuit breaker permissions of an account.
+func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	permissions, found := k.GetPermissions(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no circuit breaker permissions for %s", req.Address)
+	}
+
+	return &types.QueryAccountResponse{Permissions: permissions}, nil
+}
+
+// Accounts returns the circuit breaker permissions of all the accounts.
+func (k Keeper) Accounts(c context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountPermissionPrefix)
+
+	var accounts []*types.GenesisAccountPermissions
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var permissions types.Permissions
+		if err := k.cdc.Unmarshal(value, &permissions); err != nil {
+			return err
+		}
+		accounts = append(accounts, &types.GenesisAccountPermissions{
+			// the first byte of the key is the length of the address
+			Address:     sdk.AccAddress(key[1:]).String(),
+			Permissions: &permissions,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList returns the message type URLs whose circuit breaker is tripped.
+func (k Keeper) DisabledList(c context.Context, _ *types.QueryDisabledListRequest) (*types.QueryDisabledListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDisabledListResponse{DisabledList: k.GetDisableList(ctx)}, nil
+}
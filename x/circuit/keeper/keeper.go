@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// Keeper of the circuit store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+
+	// the address allowed to trip and reset any circuit breaker and to set the permissions of
+	// other accounts, usually the gov module account
+	authority string
+}
+
+// NewKeeper creates a new circuit Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		authority: authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the address allowed to trip and reset any circuit breaker.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetPermissions returns the circuit breaker permissions of an account
+func (k Keeper) GetPermissions(ctx sdk.Context, addr sdk.AccAddress) (permissions types.Permissions, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AccountPermissionKey(addr))
+	if bz == nil {
+		return permissions, false
+	}
+	k.cdc.MustUnmarshal(bz, &permissions)
+	return permissions, true
+}
+
+// SetPermissions sets the circuit breaker permissions of an account, the LEVEL_NONE_UNSPECIFIED
+// level removes them.
+func (k Keeper) SetPermissions(ctx sdk.Context, addr sdk.AccAddress, permissions types.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	if permissions.Level == types.Permissions_LEVEL_NONE_UNSPECIFIED {
+		store.Delete(types.AccountPermissionKey(addr))
+		return
+	}
+	store.Set(types.AccountPermissionKey(addr), k.cdc.MustMarshal(&permissions))
+}
+
+// IterateAccountPermissions iterates over the circuit breaker permissions of all the accounts
+func (k Keeper) IterateAccountPermissions(ctx sdk.Context, cb func(addr sdk.AccAddress, permissions types.Permissions) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountPermissionPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var permissions types.Permissions
+		k.cdc.MustUnmarshal(iterator.Value(), &permissions)
+		// the first byte of the key is the length of the address
+		if cb(sdk.AccAddress(iterator.Key()[1:]), permissions) {
+			break
+		}
+	}
+}
+
+// IsAllowed returns whether the messages of the type URL can be executed, i.e. whether its circuit
+// breaker is not tripped.
+func (k Keeper) IsAllowed(ctx sdk.Context, typeURL string) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	return !store.Has(types.DisableListKey(typeURL)), nil
+}
+
+// DisableMsg trips the circuit breaker of the message type URL
+func (k Keeper) DisableMsg(ctx sdk.Context, typeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DisableListKey(typeURL), []byte{})
+}
+
+// EnableMsg resets the circuit breaker of the message type URL
+func (k Keeper) EnableMsg(ctx sdk.Context, typeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DisableListKey(typeURL))
+}
+
+// IterateDisableList iterates over the message type URLs whose circuit breaker is tripped
+func (k Keeper) IterateDisableList(ctx sdk.Context, cb func(typeURL string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisableListPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// GetDisableList returns the message type URLs whose circuit breaker is tripped
+func (k Keeper) GetDisableList(ctx sdk.Context) (typeURLs []string) {
+	k.IterateDisableList(ctx, func(typeURL string) bool {
+		typeURLs = append(typeURLs, typeURL)
+		return false
+	})
+	return typeURLs
+}
+
+// checkCanTrip returns an error if the account is neither the authority nor has the permissions to
+// trip and reset the circuit breaker of the message type URL.
+func (k Keeper) checkCanTrip(ctx sdk.Context, addr sdk.AccAddress, typeURL string) error {
+	if addr.String() == k.authority {
+		return nil
+	}
+
+	permissions, found := k.GetPermissions(ctx, addr)
+	if !found || !permissions.CanTrip(typeURL) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot trip or reset the circuit breaker of %s", addr, typeURL)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var (
+	authority = sdk.AccAddress("authority")
+	addrs     = []sdk.AccAddress{
+		sdk.AccAddress("addr1"),
+		sdk.AccAddress("addr2"),
+		sdk.AccAddress("addr3"),
+	}
+
+	sendTypeURL      = "/cosmos.bank.v1beta1.MsgSend"
+	multiSendTypeURL = "/cosmos.bank.v1beta1.MsgMultiSend"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	k := keeper.NewKeeper(codec.NewProtoCodec(registry), key, authority.String())
+
+	suite.ctx = ctx
+	suite.keeper = k
+	suite.msgServer = keeper.NewMsgServerImpl(k)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) authorize(granter, grantee sdk.AccAddress, level types.Permissions_Level, limitTypeURLs ...string) error {
+	msg := types.NewMsgAuthorizeCircuitBreaker(granter.String(), grantee.String(), types.NewPermissions(level, limitTypeURLs))
+	_, err := suite.msgServer.AuthorizeCircuitBreaker(sdk.WrapSDKContext(suite.ctx), msg)
+	return err
+}
+
+func (suite *KeeperTestSuite) trip(addr sdk.AccAddress, typeURLs ...string) error {
+	_, err := suite.msgServer.TripCircuitBreaker(sdk.WrapSDKContext(suite.ctx), types.NewMsgTripCircuitBreaker(addr.String(), typeURLs))
+	return err
+}
+
+func (suite *KeeperTestSuite) reset(addr sdk.AccAddress, typeURLs ...string) error {
+	_, err := suite.msgServer.ResetCircuitBreaker(sdk.WrapSDKContext(suite.ctx), types.NewMsgResetCircuitBreaker(addr.String(), typeURLs))
+	return err
+}
+
+func (suite *KeeperTestSuite) isAllowed(typeURL string) bool {
+	allowed, err := suite.keeper.IsAllowed(suite.ctx, typeURL)
+	suite.Require().NoError(err)
+	return allowed
+}
+
+func (suite *KeeperTestSuite) TestAuthorizeCircuitBreaker() {
+	require := suite.Require()
+
+	// only the authority and the super admins can grant permissions
+	require.ErrorIs(suite.authorize(addrs[0], addrs[1], types.Permissions_LEVEL_ALL_MSGS), types.ErrUnauthorized)
+	require.NoError(suite.authorize(authority, addrs[0], types.Permissions_LEVEL_SUPER_ADMIN))
+	require.NoError(suite.authorize(addrs[0], addrs[1], types.Permissions_LEVEL_SOME_MSGS, sendTypeURL))
+
+	permissions, found := suite.keeper.GetPermissions(suite.ctx, addrs[1])
+	require.True(found)
+	require.Equal(*types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, []string{sendTypeURL}), permissions)
+
+	// accounts with all messages permissions cannot grant permissions
+	require.NoError(suite.authorize(addrs[0], addrs[2], types.Permissions_LEVEL_ALL_MSGS))
+	require.ErrorIs(suite.authorize(addrs[2], addrs[1], types.Permissions_LEVEL_ALL_MSGS), types.ErrUnauthorized)
+
+	// the none level revokes the permissions
+	require.NoError(suite.authorize(addrs[0], addrs[1], types.Permissions_LEVEL_NONE_UNSPECIFIED))
+	_, found = suite.keeper.GetPermissions(suite.ctx, addrs[1])
+	require.False(found)
+
+	events := suite.ctx.EventManager().Events()
+	require.Equal(types.EventTypeAuthorizeCircuitBreaker, events[len(events)-2].Type)
+}
+
+func (suite *KeeperTestSuite) TestTripAndResetCircuitBreaker() {
+	require := suite.Require()
+
+	require.NoError(suite.authorize(authority, addrs[0], types.Permissions_LEVEL_SOME_MSGS, sendTypeURL))
+	require.NoError(suite.authorize(authority, addrs[1], types.Permissions_LEVEL_ALL_MSGS))
+
+	// accounts without permissions cannot trip breakers
+	require.ErrorIs(suite.trip(addrs[2], sendTypeURL), types.ErrUnauthorized)
+	require.True(suite.isAllowed(sendTypeURL))
+
+	// accounts with some messages permissions can only trip these breakers
+	require.ErrorIs(suite.trip(addrs[0], sendTypeURL, multiSendTypeURL), types.ErrUnauthorized)
+	require.True(suite.isAllowed(sendTypeURL))
+	require.NoError(suite.trip(addrs[0], sendTypeURL))
+	require.False(suite.isAllowed(sendTypeURL))
+	require.True(suite.isAllowed(multiSendTypeURL))
+
+	// tripped breakers cannot be tripped again
+	require.ErrorIs(suite.trip(addrs[1], sendTypeURL), types.ErrAlreadyTripped)
+	require.NoError(suite.trip(addrs[1], multiSendTypeURL))
+	require.Equal([]string{multiSendTypeURL, sendTypeURL}, suite.keeper.GetDisableList(suite.ctx))
+
+	// resets follow the same permissions
+	require.ErrorIs(suite.reset(addrs[2], sendTypeURL), types.ErrUnauthorized)
+	require.ErrorIs(suite.reset(addrs[0], multiSendTypeURL), types.ErrUnauthorized)
+	require.NoError(suite.reset(addrs[0], sendTypeURL))
+	require.True(suite.isAllowed(sendTypeURL))
+	require.ErrorIs(suite.reset(addrs[1], sendTypeURL), types.ErrNotTripped)
+
+	// the authority can trip and reset any breaker
+	require.NoError(suite.reset(authority, multiSendTypeURL))
+	require.NoError(suite.trip(authority, sendTypeURL, multiSendTypeURL))
+	require.Equal([]string{multiSendTypeURL, sendTypeURL}, suite.keeper.GetDisableList(suite.ctx))
+
+	var tripped []string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeTripCircuitBreaker {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyMsgTypeURL {
+				tripped = append(tripped, string(attr.Value))
+			}
+		}
+	}
+	require.Equal([]string{sendTypeURL, multiSendTypeURL, sendTypeURL, multiSendTypeURL}, tripped)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuery() {
+	require := suite.Require()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	require.NoError(suite.authorize(authority, addrs[0], types.Permissions_LEVEL_SOME_MSGS, sendTypeURL))
+	require.NoError(suite.authorize(authority, addrs[1], types.Permissions_LEVEL_SUPER_ADMIN))
+	require.NoError(suite.trip(authority, sendTypeURL))
+
+	accountRes, err := suite.keeper.Account(goCtx, &types.QueryAccountRequest{Address: addrs[0].String()})
+	require.NoError(err)
+	require.Equal(types.Permissions_LEVEL_SOME_MSGS, accountRes.Permissions.Level)
+	require.Equal([]string{sendTypeURL}, accountRes.Permissions.LimitTypeUrls)
+
+	_, err = suite.keeper.Account(goCtx, &types.QueryAccountRequest{Address: addrs[2].String()})
+	require.Error(err)
+	_, err = suite.keeper.Account(goCtx, &types.QueryAccountRequest{Address: "invalid"})
+	require.Error(err)
+
+	accountsRes, err := suite.keeper.Accounts(goCtx, &types.QueryAccountsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(err)
+	require.Len(accountsRes.Accounts, 1)
+	require.Equal(uint64(2), accountsRes.Pagination.Total)
+
+	accountsRes, err = suite.keeper.Accounts(goCtx, &types.QueryAccountsRequest{})
+	require.NoError(err)
+	require.Len(accountsRes.Accounts, 2)
+	for _, account := range accountsRes.Accounts {
+		addr, err := sdk.AccAddressFromBech32(account.Address)
+		require.NoError(err)
+		permissions, found := suite.keeper.GetPermissions(suite.ctx, addr)
+		require.True(found)
+		require.Equal(permissions, *account.Permissions)
+	}
+
+	disabledRes, err := suite.keeper.DisabledList(goCtx, &types.QueryDisabledListRequest{})
+	require.NoError(err)
+	require.Equal([]string{sendTypeURL}, disabledRes.DisabledList)
+}
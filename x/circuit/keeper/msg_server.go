@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// AuthorizeCircuitBreaker sets the circuit breaker permissions of the grantee, the granter must be
+// the authority or a super admin.
+func (k msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Granter != k.authority {
+		permissions, found := k.GetPermissions(ctx, granter)
+		if !found || permissions.Level != types.Permissions_LEVEL_SUPER_ADMIN {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is neither the authority nor a super admin", msg.Granter)
+		}
+	}
+
+	k.SetPermissions(ctx, grantee, *msg.Permissions)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyPermissionLevel, msg.Permissions.Level.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter),
+		),
+	})
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker disables the execution of the message types.
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range msg.MsgTypeUrls {
+		if err := k.checkCanTrip(ctx, authority, typeURL); err != nil {
+			return nil, err
+		}
+		if allowed, _ := k.IsAllowed(ctx, typeURL); !allowed {
+			return nil, sdkerrors.Wrap(types.ErrAlreadyTripped, typeURL)
+		}
+	}
+
+	for _, typeURL := range msg.MsgTypeUrls {
+		k.DisableMsg(ctx, typeURL)
+		k.Logger(ctx).Info("circuit breaker tripped", "msg_type_url", typeURL, "authority", msg.Authority)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker enables the execution of the disabled message types again.
+func (k msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range msg.MsgTypeUrls {
+		if err := k.checkCanTrip(ctx, authority, typeURL); err != nil {
+			return nil, err
+		}
+		if allowed, _ := k.IsAllowed(ctx, typeURL); allowed {
+			return nil, sdkerrors.Wrap(types.ErrNotTripped, typeURL)
+		}
+	}
+
+	for _, typeURL := range msg.MsgTypeUrls {
+		k.EnableMsg(ctx, typeURL)
+		k.Logger(ctx).Info("circuit breaker reset", "msg_type_url", typeURL, "authority", msg.Authority)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResetCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
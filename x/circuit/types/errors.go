@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrUnauthorized      = sdkerrors.Register(ModuleName, 2, "unauthorized circuit breaker operation")
+	ErrInvalidTypeURL    = sdkerrors.Register(ModuleName, 3, "invalid message type URL")
+	ErrAlreadyTripped    = sdkerrors.Register(ModuleName, 4, "circuit breaker already tripped")
+	ErrNotTripped        = sdkerrors.Register(ModuleName, 5, "circuit breaker not tripped")
+	ErrInvalidPermission = sdkerrors.Register(ModuleName, 6, "invalid circuit breaker permissions")
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(accountPermissions []*GenesisAccountPermissions, disabledTypeURLs []string) *GenesisState {
+	return &GenesisState{
+		AccountPermissions: accountPermissions,
+		DisabledTypeUrls:   disabledTypeURLs,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.AccountPermissions))
+	for _, account := range data.AccountPermissions {
+		if _, err := sdk.AccAddressFromBech32(account.Address); err != nil {
+			return err
+		}
+		if seen[account.Address] {
+			return fmt.Errorf("duplicate circuit breaker permissions for %s", account.Address)
+		}
+		seen[account.Address] = true
+
+		if account.Permissions == nil {
+			return fmt.Errorf("missing circuit breaker permissions for %s", account.Address)
+		}
+		if err := account.Permissions.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(data.DisabledTypeUrls) == 0 {
+		return nil
+	}
+	return ValidateTypeURLs(data.DisabledTypeUrls)
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	superAdmin := &GenesisAccountPermissions{Address: addr1, Permissions: NewPermissions(Permissions_LEVEL_SUPER_ADMIN, nil)}
+	someMsgs := &GenesisAccountPermissions{Address: addr2, Permissions: NewPermissions(Permissions_LEVEL_SOME_MSGS, []string{sendTypeURL})}
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expErr   bool
+	}{
+		{"default", DefaultGenesisState(), false},
+		{"valid", NewGenesisState([]*GenesisAccountPermissions{superAdmin, someMsgs}, []string{sendTypeURL}), false},
+		{"invalid address", NewGenesisState([]*GenesisAccountPermissions{{Address: "invalid", Permissions: superAdmin.Permissions}}, nil), true},
+		{"duplicate account", NewGenesisState([]*GenesisAccountPermissions{superAdmin, superAdmin}, nil), true},
+		{"missing permissions", NewGenesisState([]*GenesisAccountPermissions{{Address: addr1}}, nil), true},
+		{"invalid permissions", NewGenesisState([]*GenesisAccountPermissions{{Address: addr1, Permissions: NewPermissions(Permissions_LEVEL_SOME_MSGS, nil)}}, nil), true},
+		{"invalid disabled type url", NewGenesisState(nil, []string{"MsgSend"}), true},
+		{"duplicate disabled type url", NewGenesisState(nil, []string{sendTypeURL, sendTypeURL}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateGenesis(*tc.genState)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// module name
+	ModuleName = "circuit"
+
+	// StoreKey is the default store key for circuit
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for circuit
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the circuit store.
+	QuerierRoute = StoreKey
+)
+
+var (
+	// AccountPermissionPrefix is the prefix of the circuit breaker permissions, keyed by account
+	AccountPermissionPrefix = []byte{0x01}
+	// DisableListPrefix is the prefix of the message type URLs whose circuit breaker is tripped
+	DisableListPrefix = []byte{0x02}
+)
+
+// AccountPermissionKey returns the key of the circuit breaker permissions of an account
+func AccountPermissionKey(addr sdk.AccAddress) []byte {
+	return append(AccountPermissionPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// DisableListKey returns the key marking the circuit breaker of a message type URL as tripped
+func DisableListKey(typeURL string) []byte {
+	return append(DisableListPrefix, []byte(typeURL)...)
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+// circuit message types
+const (
+	TypeMsgAuthorizeCircuitBreaker = "authorize_circuit_breaker"
+	TypeMsgTripCircuitBreaker      = "trip_circuit_breaker"
+	TypeMsgResetCircuitBreaker     = "reset_circuit_breaker"
+)
+
+var (
+	_, _, _ sdk.Msg            = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+	_, _, _ legacytx.LegacyMsg = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+)
+
+// NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker instance
+func NewMsgAuthorizeCircuitBreaker(granter, grantee string, permissions *Permissions) *MsgAuthorizeCircuitBreaker {
+	return &MsgAuthorizeCircuitBreaker{
+		Granter:     granter,
+		Grantee:     grantee,
+		Permissions: permissions,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgAuthorizeCircuitBreaker) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgAuthorizeCircuitBreaker) Type() string { return TypeMsgAuthorizeCircuitBreaker }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAuthorizeCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address: %s", err)
+	}
+	if msg.Permissions == nil {
+		return sdkerrors.Wrap(ErrInvalidPermission, "missing permissions")
+	}
+	return msg.Permissions.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgAuthorizeCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance
+func NewMsgTripCircuitBreaker(authority string, msgTypeURLs []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgTripCircuitBreaker) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgTripCircuitBreaker) Type() string { return TypeMsgTripCircuitBreaker }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return ValidateTypeURLs(msg.MsgTypeUrls)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance
+func NewMsgResetCircuitBreaker(authority string, msgTypeURLs []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgResetCircuitBreaker) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgResetCircuitBreaker) Type() string { return TypeMsgResetCircuitBreaker }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return ValidateTypeURLs(msg.MsgTypeUrls)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
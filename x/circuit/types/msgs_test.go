@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	addr1 = sdk.AccAddress("addr1").String()
+	addr2 = sdk.AccAddress("addr2").String()
+
+	sendTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+)
+
+func TestMsgAuthorizeCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		granter     string
+		grantee     string
+		permissions *Permissions
+		expectPass  bool
+	}{
+		{addr1, addr2, NewPermissions(Permissions_LEVEL_SUPER_ADMIN, nil), true},
+		{addr1, addr2, NewPermissions(Permissions_LEVEL_NONE_UNSPECIFIED, nil), true},
+		{addr1, addr2, NewPermissions(Permissions_LEVEL_SOME_MSGS, []string{sendTypeURL}), true},
+		{addr1, addr2, NewPermissions(Permissions_LEVEL_SOME_MSGS, nil), false},
+		{addr1, addr2, NewPermissions(Permissions_LEVEL_ALL_MSGS, []string{sendTypeURL}), false},
+		{addr1, addr2, NewPermissions(Permissions_Level(4), nil), false},
+		{addr1, addr2, nil, false},
+		{"", addr2, NewPermissions(Permissions_LEVEL_ALL_MSGS, nil), false},
+		{addr1, "invalid", NewPermissions(Permissions_LEVEL_ALL_MSGS, nil), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgAuthorizeCircuitBreaker(tc.granter, tc.grantee, tc.permissions)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{sdk.AccAddress("addr1")}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgTripAndResetCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		authority  string
+		typeURLs   []string
+		expectPass bool
+	}{
+		{addr1, []string{sendTypeURL}, true},
+		{addr1, []string{sendTypeURL, "/cosmos.bank.v1beta1.MsgMultiSend"}, true},
+		{addr1, nil, false},
+		{addr1, []string{sendTypeURL, sendTypeURL}, false},
+		{addr1, []string{"cosmos.bank.v1beta1.MsgSend"}, false},
+		{addr1, []string{"/"}, false},
+		{addr1, []string{"/cosmos.circuit.v1beta1.MsgResetCircuitBreaker"}, false},
+		{"", []string{sendTypeURL}, false},
+	}
+
+	for i, tc := range tests {
+		msgs := []sdk.Msg{NewMsgTripCircuitBreaker(tc.authority, tc.typeURLs), NewMsgResetCircuitBreaker(tc.authority, tc.typeURLs)}
+		for _, msg := range msgs {
+			if tc.expectPass {
+				require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+				require.Equal(t, []sdk.AccAddress{sdk.AccAddress("addr1")}, msg.GetSigners())
+			} else {
+				require.Error(t, msg.ValidateBasic(), "test: %v", i)
+			}
+		}
+	}
+}
+
+func TestPermissionsCanTrip(t *testing.T) {
+	someMsgs := NewPermissions(Permissions_LEVEL_SOME_MSGS, []string{sendTypeURL})
+	require.True(t, someMsgs.CanTrip(sendTypeURL))
+	require.False(t, someMsgs.CanTrip("/cosmos.bank.v1beta1.MsgMultiSend"))
+
+	require.True(t, NewPermissions(Permissions_LEVEL_ALL_MSGS, nil).CanTrip(sendTypeURL))
+	require.True(t, NewPermissions(Permissions_LEVEL_SUPER_ADMIN, nil).CanTrip(sendTypeURL))
+	require.False(t, NewPermissions(Permissions_LEVEL_NONE_UNSPECIFIED, nil).CanTrip(sendTypeURL))
+}
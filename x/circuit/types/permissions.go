@@ -0,0 +1,77 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// circuitTypeURLPrefix is the type URL prefix of the circuit module messages
+const circuitTypeURLPrefix = "/cosmos.circuit."
+
+// NewPermissions creates new circuit breaker permissions
+func NewPermissions(level Permissions_Level, limitTypeURLs []string) *Permissions {
+	return &Permissions{
+		Level:         level,
+		LimitTypeUrls: limitTypeURLs,
+	}
+}
+
+// Validate checks that the permission level is known and that the limited message type URLs
+// are set with, and only with, the LEVEL_SOME_MSGS level.
+func (p Permissions) Validate() error {
+	if _, ok := Permissions_Level_name[int32(p.Level)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPermission, "unknown permission level %d", p.Level)
+	}
+
+	if p.Level != Permissions_LEVEL_SOME_MSGS {
+		if len(p.LimitTypeUrls) != 0 {
+			return sdkerrors.Wrapf(ErrInvalidPermission, "limit type urls are only allowed with %s", Permissions_LEVEL_SOME_MSGS)
+		}
+		return nil
+	}
+
+	if len(p.LimitTypeUrls) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidPermission, "%s requires limit type urls", Permissions_LEVEL_SOME_MSGS)
+	}
+	return ValidateTypeURLs(p.LimitTypeUrls)
+}
+
+// CanTrip returns whether the permissions allow tripping and resetting the circuit breaker of the
+// message type URL.
+func (p Permissions) CanTrip(typeURL string) bool {
+	switch p.Level {
+	case Permissions_LEVEL_SUPER_ADMIN, Permissions_LEVEL_ALL_MSGS:
+		return true
+	case Permissions_LEVEL_SOME_MSGS:
+		for _, limitTypeURL := range p.LimitTypeUrls {
+			if limitTypeURL == typeURL {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateTypeURLs checks that the message type URLs are well formed, unique and do not belong to
+// the circuit module, whose messages can never be disabled.
+func ValidateTypeURLs(typeURLs []string) error {
+	if len(typeURLs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTypeURL, "no message type urls")
+	}
+
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if len(typeURL) < 2 || !strings.HasPrefix(typeURL, "/") {
+			return sdkerrors.Wrapf(ErrInvalidTypeURL, "%q must start with /", typeURL)
+		}
+		if strings.HasPrefix(typeURL, circuitTypeURLPrefix) {
+			return sdkerrors.Wrapf(ErrInvalidTypeURL, "%s cannot be disabled", typeURL)
+		}
+		if seen[typeURL] {
+			return sdkerrors.Wrapf(ErrInvalidTypeURL, "duplicate %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+	return nil
+}
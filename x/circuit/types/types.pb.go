@@ -0,0 +1,890 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/circuit/v1beta1/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Level is the permission level of an account.
+type Permissions_Level int32
+
+const (
+	// LEVEL_NONE_UNSPECIFIED indicates that the account has no circuit breaker
+	// permissions.
+	Permissions_LEVEL_NONE_UNSPECIFIED Permissions_Level = 0
+	// LEVEL_SOME_MSGS indicates that the account can trip and reset the
+	// circuit breakers of the message types listed in limit_type_urls.
+	Permissions_LEVEL_SOME_MSGS Permissions_Level = 1
+	// LEVEL_ALL_MSGS indicates that the account can trip and reset the circuit
+	// breakers of all message types.
+	Permissions_LEVEL_ALL_MSGS Permissions_Level = 2
+	// LEVEL_SUPER_ADMIN indicates that the account can trip and reset the
+	// circuit breakers of all message types, and grant permissions to other
+	// accounts.
+	Permissions_LEVEL_SUPER_ADMIN Permissions_Level = 3
+)
+
+var Permissions_Level_name = map[int32]string{
+	0: "LEVEL_NONE_UNSPECIFIED",
+	1: "LEVEL_SOME_MSGS",
+	2: "LEVEL_ALL_MSGS",
+	3: "LEVEL_SUPER_ADMIN",
+}
+
+var Permissions_Level_value = map[string]int32{
+	"LEVEL_NONE_UNSPECIFIED": 0,
+	"LEVEL_SOME_MSGS":        1,
+	"LEVEL_ALL_MSGS":         2,
+	"LEVEL_SUPER_ADMIN":      3,
+}
+
+func (x Permissions_Level) String() string {
+	return proto.EnumName(Permissions_Level_name, int32(x))
+}
+
+func (Permissions_Level) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_45b02a6a30e2e950, []int{0, 0}
+}
+
+// Permissions are the permissions of an account to trip and reset circuit
+// breakers.
+type Permissions struct {
+	// level is the permission level of the account.
+	Level Permissions_Level `protobuf:"varint,1,opt,name=level,proto3,enum=cosmos.circuit.v1beta1.Permissions_Level" json:"level,omitempty"`
+	// limit_type_urls are the message type URLs the account can trip and reset
+	// the circuit breakers of, only used with LEVEL_SOME_MSGS.
+	LimitTypeUrls []string `protobuf:"bytes,2,rep,name=limit_type_urls,json=limitTypeUrls,proto3" json:"limit_type_urls,omitempty"`
+}
+
+func (m *Permissions) Reset()         { *m = Permissions{} }
+func (m *Permissions) String() string { return proto.CompactTextString(m) }
+func (*Permissions) ProtoMessage()    {}
+func (*Permissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45b02a6a30e2e950, []int{0}
+}
+func (m *Permissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Permissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Permissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Permissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Permissions.Merge(m, src)
+}
+func (m *Permissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *Permissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Permissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Permissions proto.InternalMessageInfo
+
+func (m *Permissions) GetLevel() Permissions_Level {
+	if m != nil {
+		return m.Level
+	}
+	return Permissions_LEVEL_NONE_UNSPECIFIED
+}
+
+func (m *Permissions) GetLimitTypeUrls() []string {
+	if m != nil {
+		return m.LimitTypeUrls
+	}
+	return nil
+}
+
+// GenesisAccountPermissions are the circuit breaker permissions of an account.
+type GenesisAccountPermissions struct {
+	Address     string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Permissions *Permissions `protobuf:"bytes,2,opt,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (m *GenesisAccountPermissions) Reset()         { *m = GenesisAccountPermissions{} }
+func (m *GenesisAccountPermissions) String() string { return proto.CompactTextString(m) }
+func (*GenesisAccountPermissions) ProtoMessage()    {}
+func (*GenesisAccountPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45b02a6a30e2e950, []int{1}
+}
+func (m *GenesisAccountPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisAccountPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisAccountPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisAccountPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisAccountPermissions.Merge(m, src)
+}
+func (m *GenesisAccountPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisAccountPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisAccountPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisAccountPermissions proto.InternalMessageInfo
+
+func (m *GenesisAccountPermissions) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GenesisAccountPermissions) GetPermissions() *Permissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+// GenesisState defines the circuit module's genesis state.
+type GenesisState struct {
+	// account_permissions are the accounts allowed to trip and reset circuit
+	// breakers, on top of the module authority.
+	AccountPermissions []*GenesisAccountPermissions `protobuf:"bytes,1,rep,name=account_permissions,json=accountPermissions,proto3" json:"account_permissions,omitempty"`
+	// disabled_type_urls are the message type URLs whose circuit breaker is
+	// tripped.
+	DisabledTypeUrls []string `protobuf:"bytes,2,rep,name=disabled_type_urls,json=disabledTypeUrls,proto3" json:"disabled_type_urls,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45b02a6a30e2e950, []int{2}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetAccountPermissions() []*GenesisAccountPermissions {
+	if m != nil {
+		return m.AccountPermissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisabledTypeUrls() []string {
+	if m != nil {
+		return m.DisabledTypeUrls
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.circuit.v1beta1.Permissions_Level", Permissions_Level_name, Permissions_Level_value)
+	proto.RegisterType((*Permissions)(nil), "cosmos.circuit.v1beta1.Permissions")
+	proto.RegisterType((*GenesisAccountPermissions)(nil), "cosmos.circuit.v1beta1.GenesisAccountPermissions")
+	proto.RegisterType((*GenesisState)(nil), "cosmos.circuit.v1beta1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/circuit/v1beta1/types.proto", fileDescriptor_45b02a6a30e2e950)
+}
+
+var fileDescriptor_45b02a6a30e2e950 = []byte{
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0xaa, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0x2d, 0x57, 0xb9, 0x13, 0xbd, 0x37, 0x4e, 0xb1, 0x44, 0x17, 0x21, 0x44, 0x90,
+	0x08, 0x35, 0xa1, 0xf5, 0x01, 0xa4, 0xda, 0x69, 0x29, 0xa4, 0x69, 0x49, 0xac, 0x0b, 0x37, 0x21,
+	0x1f, 0x83, 0x0e, 0x26, 0x4d, 0xc8, 0x4c, 0x8a, 0x05, 0x1f, 0xc2, 0x47, 0xf0, 0x71, 0x5c, 0x76,
+	0x29, 0xb8, 0x91, 0xf6, 0x45, 0xa4, 0xf9, 0xd0, 0x40, 0x2d, 0xdc, 0xd5, 0x30, 0xe7, 0xfc, 0x7f,
+	0xe7, 0xcc, 0x7f, 0xce, 0x81, 0x5a, 0x98, 0xb2, 0x24, 0x65, 0x66, 0x48, 0xf3, 0xb0, 0xa0, 0xdc,
+	0xdc, 0x0e, 0x03, 0xc2, 0xfd, 0xa1, 0xc9, 0x77, 0x19, 0x61, 0x46, 0x96, 0xa7, 0x3c, 0x45, 0xfd,
+	0x4a, 0x63, 0xd4, 0x1a, 0xa3, 0xd6, 0x68, 0xbf, 0x00, 0x14, 0x57, 0x24, 0x4f, 0x28, 0x63, 0x34,
+	0xdd, 0x30, 0xf4, 0x1a, 0x5e, 0xc5, 0x64, 0x4b, 0x62, 0x19, 0xa8, 0x40, 0xbf, 0x19, 0xbd, 0x30,
+	0xfe, 0xcf, 0x19, 0x2d, 0xc6, 0xb0, 0x4e, 0x80, 0x53, 0x71, 0xe8, 0x39, 0xbc, 0x8d, 0x69, 0x42,
+	0xb9, 0x77, 0xea, 0xee, 0x15, 0x79, 0xcc, 0xe4, 0x8e, 0xda, 0xd5, 0xaf, 0x9d, 0x87, 0x65, 0xf8,
+	0xdd, 0x2e, 0x23, 0xeb, 0x3c, 0x66, 0x5a, 0x08, 0xaf, 0x4a, 0x0e, 0x3d, 0x85, 0x7d, 0x0b, 0xbf,
+	0xc7, 0x96, 0x67, 0x2f, 0x6d, 0xec, 0xad, 0x6d, 0x77, 0x85, 0xdf, 0xce, 0xa7, 0x73, 0x3c, 0x91,
+	0x04, 0xd4, 0x83, 0xb7, 0x55, 0xce, 0x5d, 0x2e, 0xb0, 0xb7, 0x70, 0x67, 0xae, 0x04, 0x10, 0x82,
+	0x37, 0x55, 0x70, 0x6c, 0x59, 0x55, 0xac, 0x83, 0x1e, 0xc3, 0x47, 0xb5, 0x70, 0xbd, 0xc2, 0x8e,
+	0x37, 0x9e, 0x2c, 0xe6, 0xb6, 0xd4, 0xd5, 0xbe, 0xc2, 0x27, 0x33, 0xb2, 0x21, 0x8c, 0xb2, 0x71,
+	0x18, 0xa6, 0xc5, 0x86, 0xb7, 0xad, 0xca, 0xf0, 0xbe, 0x1f, 0x45, 0x39, 0x61, 0xac, 0x34, 0x7b,
+	0xed, 0x34, 0x57, 0x84, 0xa1, 0x98, 0xfd, 0x13, 0xca, 0x1d, 0x15, 0xe8, 0xe2, 0xe8, 0xd9, 0x1d,
+	0xbe, 0xc2, 0x69, 0x73, 0xda, 0x77, 0x00, 0x1f, 0xd4, 0xed, 0x5d, 0xee, 0x73, 0x82, 0x02, 0xd8,
+	0xf3, 0xab, 0x77, 0x78, 0xed, 0xfa, 0x40, 0xed, 0xea, 0xe2, 0x68, 0x78, 0xa9, 0xfe, 0x45, 0x07,
+	0x0e, 0xf2, 0xcf, 0x5d, 0x0d, 0x20, 0x8a, 0x28, 0xf3, 0x83, 0x98, 0x44, 0x67, 0x23, 0x90, 0x9a,
+	0x4c, 0x33, 0x85, 0x37, 0xd3, 0x1f, 0x07, 0x05, 0xec, 0x0f, 0x0a, 0xf8, 0x7d, 0x50, 0xc0, 0xb7,
+	0xa3, 0x22, 0xec, 0x8f, 0x8a, 0xf0, 0xf3, 0xa8, 0x08, 0x1f, 0x06, 0x1f, 0x29, 0xff, 0x54, 0x04,
+	0x46, 0x98, 0x26, 0x66, 0xb3, 0x5f, 0xe5, 0xf1, 0x92, 0x45, 0x9f, 0xcd, 0x2f, 0x7f, 0x97, 0xed,
+	0xd4, 0x83, 0x05, 0xf7, 0xca, 0x2d, 0x7b, 0xf5, 0x67, 0x00, 0x5b, 0x73, 0xc9, 0xac, 0x8b, 0x02,
+	0x00, 0x00,
+}
+
+func (m *Permissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Permissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Permissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LimitTypeUrls) > 0 {
+		for iNdEx := len(m.LimitTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LimitTypeUrls[iNdEx])
+			copy(dAtA[i:], m.LimitTypeUrls[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.LimitTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Level != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisAccountPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAccountPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAccountPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permissions != nil {
+		{
+			size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledTypeUrls) > 0 {
+		for iNdEx := len(m.DisabledTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledTypeUrls[iNdEx])
+			copy(dAtA[i:], m.DisabledTypeUrls[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DisabledTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AccountPermissions) > 0 {
+		for iNdEx := len(m.AccountPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Permissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovTypes(uint64(m.Level))
+	}
+	if len(m.LimitTypeUrls) > 0 {
+		for _, s := range m.LimitTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisAccountPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Permissions != nil {
+		l = m.Permissions.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccountPermissions) > 0 {
+		for _, e := range m.AccountPermissions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DisabledTypeUrls) > 0 {
+		for _, s := range m.DisabledTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Permissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Permissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Permissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= Permissions_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitTypeUrls = append(m.LimitTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisAccountPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAccountPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAccountPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Permissions == nil {
+				m.Permissions = &Permissions{}
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPermissions = append(m.AccountPermissions, &GenesisAccountPermissions{})
+			if err := m.AccountPermissions[len(m.AccountPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledTypeUrls = append(m.DisabledTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
@@ -138,6 +138,32 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	return commission, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of all the tokenize share records
+// owned by an address. The rewards of a tokenized delegation accumulate in the record's module
+// address, and the owner is paid the rewards its share tokens of the record accrued while it
+// held them. The other holders of the share tokens are paid whenever their share token balance
+// changes, e.g. when they send or redeem them.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	totalRewards := sdk.Coins{}
+	for _, record := range k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr) {
+		paid, err := k.stakingKeeper.SettleTokenizeShareRecordRewards(ctx, record, ownerAddr)
+		if err != nil {
+			return nil, err
+		}
+		totalRewards = totalRewards.Add(paid...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, ownerAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+		),
+	)
+
+	return totalRewards, nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 	k.IterateValidatorOutstandingRewards(ctx,
@@ -10,6 +10,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -125,3 +128,60 @@ func TestFundCommunityPool(t *testing.T) {
 	assert.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
 	assert.Empty(t, app.BankKeeper.GetAllBalances(ctx, addr[0]))
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewInt64Coin("reward", 2000))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator without commission, and tokenize a delegation worth its self delegation
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.Delegate(addr[1], valAddrs[0], valTokens)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addr[1], valAddrs[0], valTokens, addr[1])
+	require.NoError(t, err)
+	record, found := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	require.True(t, found)
+
+	// the tokenized delegation is paid half of the allocated rewards
+	allocate := func() {
+		val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewInt64DecCoin("reward", 1000)))
+	}
+
+	// the share tokens sent by the owner are settled before, so the owner is paid all the rewards of the first
+	// allocation and the recipient none of them
+	allocate()
+	halfShareTokens := sdk.NewCoin(shareToken.Denom, shareToken.Amount.QuoRaw(2))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addr[1], addr[2], sdk.NewCoins(halfShareTokens)))
+	require.Equal(t, int64(500), app.BankKeeper.GetBalance(ctx, addr[1], "reward").Amount.Int64())
+	require.True(t, app.BankKeeper.GetBalance(ctx, addr[2], "reward").IsZero())
+
+	// the rewards of the second allocation are shared by the holders
+	allocate()
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("reward", 250)), rewards)
+	require.Equal(t, int64(750), app.BankKeeper.GetBalance(ctx, addr[1], "reward").Amount.Int64())
+
+	// withdrawing again doesn't pay the owner twice
+	rewards, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addr[2], halfShareTokens)
+	require.NoError(t, err)
+	require.Equal(t, int64(250), app.BankKeeper.GetBalance(ctx, addr[2], "reward").Amount.Int64())
+	require.True(t, app.BankKeeper.GetBalance(ctx, record.GetModuleAddress(), "reward").IsZero())
+}
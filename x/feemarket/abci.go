@@ -0,0 +1,40 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker records the base fees of the block, adjusts them for the next block from the gas the block used, and
+// disposes of the base fees collected so far.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params := k.GetParams(ctx)
+	baseFees := k.GetBaseFees(ctx)
+	gasUsed := k.GetBlockGasUsed(ctx)
+
+	if params.HistoryBlocks > 0 {
+		k.SetBaseFeeRecord(ctx, types.BaseFeeRecord{Height: ctx.BlockHeight(), BaseFees: baseFees, GasUsed: gasUsed})
+	}
+	k.PruneBaseFeeHistory(ctx, ctx.BlockHeight()-int64(params.HistoryBlocks)+1)
+
+	nextBaseFees := params.NextBaseFees(baseFees, gasUsed)
+	k.SetBaseFees(ctx, nextBaseFees)
+
+	if err := k.DisposeCollectedBaseFees(ctx, params.FeeRecipient); err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyGasUsed, sdk.NewIntFromUint64(gasUsed).String()),
+			sdk.NewAttribute(types.AttributeKeyBaseFees, nextBaseFees.String()),
+		),
+	)
+}
@@ -0,0 +1,25 @@
+package feemarket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// InitGenesis new feemarket genesis
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper, data *types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	baseFees := data.BaseFees
+	if baseFees.Empty() {
+		baseFees = data.Params.MinBaseFees
+	}
+	keeper.SetBaseFees(ctx, baseFees)
+	ak.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	params := keeper.GetParams(ctx)
+	baseFees := keeper.GetBaseFees(ctx)
+	return types.NewGenesisState(params, baseFees)
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type FeeMarketTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *FeeMarketTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	params := types.DefaultParams()
+	params.MinBaseFees = sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(1)))
+	params.HistoryBlocks = 2
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	app.FeeMarketKeeper.SetBaseFees(ctx, params.MinBaseFees)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	suite.app = app
+	suite.ctx = ctx
+
+	suite.queryClient = queryClient
+}
+
+func (suite *FeeMarketTestSuite) TestGRPCParams() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.Params, app.FeeMarketKeeper.GetParams(ctx))
+
+	baseFee, err := queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(baseFee.BaseFees, app.FeeMarketKeeper.GetBaseFees(ctx))
+}
+
+func (suite *FeeMarketTestSuite) TestGRPCBaseFeeHistory() {
+	app, queryClient := suite.app, suite.queryClient
+	params := app.FeeMarketKeeper.GetParams(suite.ctx)
+
+	// three full blocks raise the base fee by 1/8 each, only the last two blocks are kept
+	for height := int64(1); height <= 3; height++ {
+		ctx := suite.ctx.WithBlockHeight(height)
+		app.FeeMarketKeeper.AddTxGasUsed(ctx.WithTxIndex(0), params.TargetBlockGas)
+		app.FeeMarketKeeper.AddTxGasUsed(ctx.WithTxIndex(1), params.TargetBlockGas)
+		feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	}
+
+	res, err := queryClient.BaseFeeHistory(gocontext.Background(), &types.QueryBaseFeeHistoryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.BaseFeeRecord{
+		{Height: 2, BaseFees: sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1125, 3))), GasUsed: 2 * params.TargetBlockGas},
+		{Height: 3, BaseFees: sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.MustNewDecFromStr("1.265625"))), GasUsed: 2 * params.TargetBlockGas},
+	}, res.Records)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.MustNewDecFromStr("1.423828125"))), app.FeeMarketKeeper.GetBaseFees(suite.ctx))
+
+	res, err = queryClient.BaseFeeHistory(gocontext.Background(), &types.QueryBaseFeeHistoryRequest{Pagination: &query.PageRequest{Limit: 1, Reverse: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Records, 1)
+	suite.Require().Equal(int64(3), res.Records[0].Height)
+}
+
+func (suite *FeeMarketTestSuite) TestDisposeCollectedBaseFees() {
+	app, ctx := suite.app, suite.ctx
+	collected := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	moduleAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	// the base fees are burned without a fee recipient
+	suite.Require().NoError(simapp.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, collected))
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	suite.Require().NoError(app.FeeMarketKeeper.DisposeCollectedBaseFees(ctx, ""))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+	suite.Require().Equal(supply.Sub(collected[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+
+	// and redirected otherwise
+	recipient := sdk.AccAddress([]byte("fee_recipient_______"))
+	suite.Require().NoError(simapp.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, collected))
+	suite.Require().NoError(app.FeeMarketKeeper.DisposeCollectedBaseFees(ctx, recipient.String()))
+	suite.Require().Equal(collected, app.BankKeeper.GetAllBalances(ctx, recipient))
+}
+
+func TestFeeMarketTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeMarketTestSuite))
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      sdk.StoreKey
+	transientKey  sdk.StoreKey
+	paramSpace    paramtypes.Subspace
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key, transientKey sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the feemarket module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		transientKey:  transientKey,
+		paramSpace:    paramSpace,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBaseFees returns the base fees per unit of gas the txs of the current block have to pay
+func (k Keeper) GetBaseFees(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BaseFeesKey)
+	if b == nil {
+		return sdk.DecCoins{}
+	}
+
+	var record types.BaseFeeRecord
+	k.cdc.MustUnmarshal(b, &record)
+	return record.BaseFees
+}
+
+// SetBaseFees sets the base fees per unit of gas, stored as a record without height
+func (k Keeper) SetBaseFees(ctx sdk.Context, baseFees sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&types.BaseFeeRecord{BaseFees: baseFees})
+	store.Set(types.BaseFeesKey, b)
+}
+
+// GetBaseFeeRecord returns the base fee record of a height
+func (k Keeper) GetBaseFeeRecord(ctx sdk.Context, height int64) (record types.BaseFeeRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BaseFeeRecordKey(height))
+	if b == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(b, &record)
+	return record, true
+}
+
+// SetBaseFeeRecord adds the base fee record of a block to the history
+func (k Keeper) SetBaseFeeRecord(ctx sdk.Context, record types.BaseFeeRecord) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&record)
+	store.Set(types.BaseFeeRecordKey(record.Height), b)
+}
+
+// PruneBaseFeeHistory deletes the base fee records of the heights below minHeight
+func (k Keeper) PruneBaseFeeHistory(ctx sdk.Context, minHeight int64) {
+	if minHeight <= 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BaseFeeHistoryPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(minHeight)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetBlockGasUsed returns the gas used by the txs of the current block
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.TxGasUsedPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var gasUsed uint64
+	for ; iterator.Valid(); iterator.Next() {
+		gasUsed += sdk.BigEndianToUint64(iterator.Value())
+	}
+	return gasUsed
+}
+
+// AddTxGasUsed adds the gas used by the tx of the context to the gas used by the current block, which the base fees
+// of the next block are computed from in EndBlock. The gas is kept per tx index so that the txs executed concurrently
+// don't conflict. The fee ante decorator adds it once the tx is delivered.
+func (k Keeper) AddTxGasUsed(ctx sdk.Context, gasUsed uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	key := types.TxGasUsedKey(ctx.TxIndex())
+	if b := store.Get(key); b != nil {
+		gasUsed += sdk.BigEndianToUint64(b)
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(gasUsed))
+}
+
+// DisposeCollectedBaseFees burns the base fees collected by the module account, or sends them to the fee recipient if
+// one is set
+func (k Keeper) DisposeCollectedBaseFees(ctx sdk.Context, feeRecipient string) error {
+	collected := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	if collected.IsZero() {
+		return nil
+	}
+
+	if feeRecipient == "" {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, collected); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeBurnBaseFees,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collected.String()),
+		))
+		return nil
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(feeRecipient)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, collected); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRedirectBaseFees,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, collected.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, feeRecipient),
+	))
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// NextBaseFees returns the base fees of the block following a block with the provided base fees that used gasUsed. As
+// in EIP-1559, each base fee changes by 1/BaseFeeChangeDenominator of itself per target block gas of difference
+// between the gas used and the target, without going below its minimum base fee. Only the denoms with a minimum base
+// fee have a base fee, and their base fee starts at the minimum.
+func (p Params) NextBaseFees(baseFees sdk.DecCoins, gasUsed uint64) sdk.DecCoins {
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(p.TargetBlockGas))
+	gasDelta := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).Sub(target)
+	change := gasDelta.Quo(target).QuoInt64(int64(p.BaseFeeChangeDenominator))
+
+	next := make(sdk.DecCoins, 0, len(p.MinBaseFees))
+	for _, minBaseFee := range p.MinBaseFees {
+		baseFee := sdk.MaxDec(baseFees.AmountOf(minBaseFee.Denom), minBaseFee.Amount)
+		baseFee = sdk.MaxDec(baseFee.Add(baseFee.Mul(change)), minBaseFee.Amount)
+		next = append(next, sdk.NewDecCoinFromDec(minBaseFee.Denom, baseFee))
+	}
+	return next
+}
+
+// RequiredBaseFees returns the base fees a tx with the provided gas limit has to pay in one of the denoms, rounded up
+func RequiredBaseFees(baseFees sdk.DecCoins, gas uint64) sdk.Coins {
+	gasDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gas))
+	required := make(sdk.Coins, 0, len(baseFees))
+	for _, baseFee := range baseFees {
+		required = append(required, sdk.NewCoin(baseFee.Denom, baseFee.Amount.Mul(gasDec).Ceil().RoundInt()))
+	}
+	return required
+}
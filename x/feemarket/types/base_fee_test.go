@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNextBaseFees(t *testing.T) {
+	params := DefaultParams()
+	params.MinBaseFees = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 2)), sdk.NewDecCoin("stake", sdk.NewInt(1)))
+	baseFees := sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(8)), sdk.NewDecCoin("ufoo", sdk.NewInt(8)))
+
+	tests := []struct {
+		name    string
+		gasUsed uint64
+		want    sdk.DecCoins
+	}{
+		{
+			name:    "at target",
+			gasUsed: params.TargetBlockGas,
+			want:    sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(8)), sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 2))),
+		},
+		{
+			name:    "twice the target",
+			gasUsed: 2 * params.TargetBlockGas,
+			want:    sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(9)), sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1125, 5))),
+		},
+		{
+			name:    "empty block",
+			gasUsed: 0,
+			want:    sdk.NewDecCoins(sdk.NewDecCoin("stake", sdk.NewInt(7)), sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 2))),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, params.NextBaseFees(baseFees, tt.gasUsed))
+		})
+	}
+
+	// the base fees don't go below their minimum
+	baseFees = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(105, 2)))
+	require.Equal(t, sdk.NewDecCoin("stake", sdk.NewInt(1)), params.NextBaseFees(baseFees, 0)[0])
+}
+
+func TestRequiredBaseFees(t *testing.T) {
+	baseFees := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(15, 1)), sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 3)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("stake", 150002), sdk.NewInt64Coin("uatom", 101)}, RequiredBaseFees(baseFees, 100001))
+}
@@ -0,0 +1,12 @@
+package types
+
+// feemarket module event types
+const (
+	EventTypeBaseFee          = "base_fee"
+	EventTypeBurnBaseFees     = "burn_base_fees"
+	EventTypeRedirectBaseFees = "redirect_base_fees"
+
+	AttributeKeyBaseFees  = "base_fees"
+	AttributeKeyGasUsed   = "gas_used"
+	AttributeKeyRecipient = "recipient"
+)
@@ -0,0 +1,766 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feemarket/v1beta1/feemarket.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params holds parameters for the feemarket module.
+type Params struct {
+	// minimum base fee per unit of gas of every denom fees can be paid in, the
+	// base fees only apply to these denoms and never go below these prices
+	MinBaseFees github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=min_base_fees,json=minBaseFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_base_fees" yaml:"min_base_fees"`
+	// gas used by a block at which the base fees stay the same
+	TargetBlockGas uint64 `protobuf:"varint,2,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+	// bounds the change of the base fees per block to 1/denominator
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,3,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty" yaml:"base_fee_change_denominator"`
+	// address the collected base fees are sent to, they are burned if empty
+	FeeRecipient string `protobuf:"bytes,4,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty" yaml:"fee_recipient"`
+	// number of blocks whose base fees are kept in the history
+	HistoryBlocks uint64 `protobuf:"varint,5,opt,name=history_blocks,json=historyBlocks,proto3" json:"history_blocks,omitempty" yaml:"history_blocks"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3047acb548fa7c8, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinBaseFees() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinBaseFees
+	}
+	return nil
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetHistoryBlocks() uint64 {
+	if m != nil {
+		return m.HistoryBlocks
+	}
+	return 0
+}
+
+// BaseFeeRecord is the base fees of a block and the gas the block used.
+type BaseFeeRecord struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base fees per unit of gas of the block
+	BaseFees github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=base_fees,json=baseFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"base_fees" yaml:"base_fees"`
+	GasUsed  uint64                                      `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+}
+
+func (m *BaseFeeRecord) Reset()         { *m = BaseFeeRecord{} }
+func (m *BaseFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeRecord) ProtoMessage()    {}
+func (*BaseFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3047acb548fa7c8, []int{1}
+}
+func (m *BaseFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeRecord.Merge(m, src)
+}
+func (m *BaseFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeRecord proto.InternalMessageInfo
+
+func (m *BaseFeeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BaseFeeRecord) GetBaseFees() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BaseFees
+	}
+	return nil
+}
+
+func (m *BaseFeeRecord) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.feemarket.v1beta1.Params")
+	proto.RegisterType((*BaseFeeRecord)(nil), "cosmos.feemarket.v1beta1.BaseFeeRecord")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feemarket/v1beta1/feemarket.proto", fileDescriptor_f3047acb548fa7c8)
+}
+
+var fileDescriptor_f3047acb548fa7c8 = []byte{
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0x94, 0x4e,
+	0x1c, 0x65, 0xba, 0xfb, 0xdf, 0x7f, 0x3b, 0x75, 0x6b, 0x83, 0x55, 0xb1, 0x35, 0xb0, 0x99, 0x83,
+	0x21, 0x31, 0x42, 0xaa, 0xb7, 0x26, 0x26, 0x86, 0x56, 0x6b, 0xe2, 0xc5, 0x90, 0x78, 0xf1, 0x42,
+	0x06, 0xf8, 0x2d, 0x4c, 0xb6, 0x30, 0x1b, 0x66, 0x6a, 0xdc, 0xab, 0x77, 0x13, 0x8f, 0x1e, 0x3d,
+	0xfb, 0x49, 0x7a, 0x31, 0xe9, 0xd1, 0x83, 0x41, 0xb3, 0xfb, 0x0d, 0xf8, 0x04, 0x06, 0x06, 0xc4,
+	0xf5, 0xd0, 0xe8, 0x09, 0xe6, 0xbd, 0x37, 0xef, 0xf7, 0x66, 0x1e, 0x60, 0x3b, 0xe2, 0x22, 0xe3,
+	0xc2, 0x9d, 0x02, 0x64, 0xb4, 0x98, 0x81, 0x74, 0xdf, 0x1c, 0x86, 0x20, 0xe9, 0x61, 0x8f, 0x38,
+	0xf3, 0x82, 0x4b, 0xae, 0x1b, 0x4a, 0xe9, 0xf4, 0x78, 0xab, 0xdc, 0xdf, 0x4b, 0x78, 0xc2, 0x1b,
+	0x91, 0x5b, 0xbf, 0x29, 0xfd, 0xbe, 0xd9, 0x3a, 0x87, 0x54, 0xc0, 0x2f, 0xd3, 0x88, 0xb3, 0x5c,
+	0xf1, 0xe4, 0xcb, 0x00, 0x8f, 0x5e, 0xd2, 0x82, 0x66, 0x42, 0x7f, 0x8f, 0xf0, 0x38, 0x63, 0x79,
+	0x50, 0x4b, 0x83, 0x29, 0x80, 0x30, 0xd0, 0x64, 0x60, 0x6f, 0x3f, 0xbc, 0xeb, 0xb4, 0x33, 0x6b,
+	0xa2, 0x1b, 0xe7, 0x9c, 0x40, 0x74, 0xcc, 0x59, 0xee, 0xbd, 0xb8, 0x28, 0x2d, 0xad, 0x2a, 0xad,
+	0xbd, 0x05, 0xcd, 0xce, 0x8e, 0xc8, 0x9a, 0x01, 0xf9, 0xfc, 0xdd, 0xba, 0x9f, 0x30, 0x99, 0x9e,
+	0x87, 0x4e, 0xc4, 0x33, 0xb7, 0xcd, 0xa2, 0x1e, 0x0f, 0x44, 0x3c, 0x73, 0xe5, 0x62, 0x0e, 0xa2,
+	0xf3, 0x12, 0xfe, 0x76, 0xc6, 0x72, 0x8f, 0x0a, 0x78, 0x06, 0x20, 0xf4, 0xa7, 0x78, 0x57, 0xd2,
+	0x22, 0x01, 0x19, 0x84, 0x67, 0x3c, 0x9a, 0x05, 0x09, 0x15, 0xc6, 0xc6, 0x04, 0xd9, 0x43, 0xef,
+	0xa0, 0x2a, 0xad, 0xdb, 0x6a, 0xde, 0x9f, 0x0a, 0xe2, 0xef, 0x28, 0xc8, 0xab, 0x91, 0x53, 0x2a,
+	0x74, 0xc0, 0x07, 0x5d, 0xa0, 0x20, 0x4a, 0x69, 0x9e, 0x40, 0x10, 0x43, 0xce, 0x33, 0x96, 0x53,
+	0xc9, 0x0b, 0x63, 0xd0, 0x38, 0xde, 0xab, 0x4a, 0x8b, 0x28, 0xc7, 0x2b, 0xc4, 0xc4, 0x37, 0x42,
+	0x95, 0xee, 0xb8, 0xe1, 0x4e, 0x7a, 0x4a, 0x7f, 0x8c, 0xc7, 0xf5, 0xa6, 0x02, 0x22, 0x36, 0x67,
+	0x90, 0x4b, 0x63, 0x38, 0x41, 0xf6, 0x96, 0x67, 0xf4, 0x57, 0xb3, 0x46, 0x13, 0xff, 0xda, 0x14,
+	0xc0, 0xef, 0x96, 0xfa, 0x13, 0xbc, 0x93, 0x32, 0x21, 0x79, 0xb1, 0x50, 0x67, 0x11, 0xc6, 0x7f,
+	0x4d, 0xb0, 0x3b, 0x55, 0x69, 0xdd, 0x54, 0xfb, 0xd7, 0x79, 0xe2, 0x8f, 0x5b, 0xa0, 0x39, 0xa9,
+	0x38, 0x1a, 0x7e, 0xfc, 0x64, 0x69, 0xe4, 0x1b, 0xc2, 0xe3, 0xf6, 0x06, 0x7d, 0x88, 0x78, 0x11,
+	0xeb, 0xb7, 0xf0, 0x28, 0x05, 0x96, 0xa4, 0xd2, 0x40, 0x13, 0x64, 0x0f, 0xfc, 0x76, 0xa5, 0xbf,
+	0x43, 0x78, 0xab, 0xaf, 0x7a, 0xe3, 0x2f, 0xaa, 0x3e, 0x6d, 0xab, 0xde, 0x5d, 0xbf, 0xa8, 0x7f,
+	0xaf, 0x79, 0x33, 0xec, 0x3a, 0x76, 0xf0, 0x66, 0x42, 0x45, 0x70, 0x2e, 0x20, 0x6e, 0x9b, 0xb8,
+	0x51, 0x95, 0xd6, 0x75, 0x35, 0xa0, 0x63, 0x88, 0xff, 0x7f, 0x42, 0xc5, 0x2b, 0x01, 0xb1, 0xf7,
+	0xfc, 0x62, 0x69, 0xa2, 0xcb, 0xa5, 0x89, 0x7e, 0x2c, 0x4d, 0xf4, 0x61, 0x65, 0x6a, 0x97, 0x2b,
+	0x53, 0xfb, 0xba, 0x32, 0xb5, 0xd7, 0xce, 0x95, 0x01, 0xde, 0xfe, 0xf6, 0x6b, 0x35, 0x61, 0xc2,
+	0x51, 0xf3, 0xfd, 0x3f, 0xfa, 0x39, 0x00, 0x9c, 0x0d, 0xfb, 0x5d, 0x7b, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HistoryBlocks != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.HistoryBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MinBaseFees) > 0 {
+		for iNdEx := len(m.MinBaseFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinBaseFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BaseFees) > 0 {
+		for iNdEx := len(m.BaseFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinBaseFees) > 0 {
+		for _, e := range m.MinBaseFees {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovFeemarket(uint64(m.TargetBlockGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	if m.HistoryBlocks != 0 {
+		n += 1 + sovFeemarket(uint64(m.HistoryBlocks))
+	}
+	return n
+}
+
+func (m *BaseFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	if len(m.BaseFees) > 0 {
+		for _, e := range m.BaseFees {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBaseFees = append(m.MinBaseFees, types.DecCoin{})
+			if err := m.MinBaseFees[len(m.MinBaseFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBlocks", wireType)
+			}
+			m.HistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFees = append(m.BaseFees, types.DecCoin{})
+			if err := m.BaseFees[len(m.BaseFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
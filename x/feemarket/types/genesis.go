@@ -0,0 +1,28 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseFees sdk.DecCoins) *GenesisState {
+	return &GenesisState{
+		Params:   params,
+		BaseFees: baseFees,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	return validateBaseFees(data.BaseFees)
+}
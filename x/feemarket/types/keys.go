@@ -0,0 +1,37 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	// module name
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+
+	// TStoreKey is the transient store key for feemarket
+	TStoreKey = "transient_" + ModuleName
+
+	// QuerierRoute is the querier route for the feemarket store.
+	QuerierRoute = StoreKey
+)
+
+var (
+	// BaseFeesKey is the key of the base fees of the next block
+	BaseFeesKey = []byte{0x01}
+	// BaseFeeHistoryPrefix is the prefix of the base fee records, keyed by height
+	BaseFeeHistoryPrefix = []byte{0x02}
+
+	// TxGasUsedPrefix is the transient prefix of the gas used by the txs of the block, keyed by tx index
+	TxGasUsedPrefix = []byte{0x01}
+)
+
+// BaseFeeRecordKey returns the key of the base fee record of a height
+func BaseFeeRecordKey(height int64) []byte {
+	return append(BaseFeeHistoryPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// TxGasUsedKey returns the transient key of the gas used by the tx at an index of the block
+func TxGasUsedKey(txIndex int) []byte {
+	return append(TxGasUsedPrefix, sdk.Uint64ToBigEndian(uint64(txIndex))...)
+}
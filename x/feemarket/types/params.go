@@ -0,0 +1,156 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyMinBaseFees              = []byte("MinBaseFees")
+	KeyTargetBlockGas           = []byte("TargetBlockGas")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyFeeRecipient             = []byte("FeeRecipient")
+	KeyHistoryBlocks            = []byte("HistoryBlocks")
+)
+
+// ParamTable for feemarket module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(
+	minBaseFees sdk.DecCoins, targetBlockGas, baseFeeChangeDenominator uint64, feeRecipient string, historyBlocks uint64,
+) Params {
+	return Params{
+		MinBaseFees:              minBaseFees,
+		TargetBlockGas:           targetBlockGas,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		FeeRecipient:             feeRecipient,
+		HistoryBlocks:            historyBlocks,
+	}
+}
+
+// default feemarket module parameters, without any base fee until the denoms they apply to are set
+func DefaultParams() Params {
+	return Params{
+		MinBaseFees:              sdk.DecCoins{},
+		TargetBlockGas:           10_000_000,
+		BaseFeeChangeDenominator: 8,
+		FeeRecipient:             "",
+		HistoryBlocks:            100,
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateMinBaseFees(p.MinBaseFees); err != nil {
+		return err
+	}
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateFeeRecipient(p.FeeRecipient); err != nil {
+		return err
+	}
+	if err := validateHistoryBlocks(p.HistoryBlocks); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinBaseFees, &p.MinBaseFees, validateMinBaseFees),
+		paramtypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyFeeRecipient, &p.FeeRecipient, validateFeeRecipient),
+		paramtypes.NewParamSetPair(KeyHistoryBlocks, &p.HistoryBlocks, validateHistoryBlocks),
+	}
+}
+
+func validateMinBaseFees(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid min base fees: %w", err)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("target block gas must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("base fee change denominator must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateFeeRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid fee recipient: %w", err)
+	}
+
+	return nil
+}
+
+func validateHistoryBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBaseFees(baseFees sdk.DecCoins) error {
+	if err := baseFees.Validate(); err != nil {
+		return fmt.Errorf("invalid base fees: %w", err)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -65,13 +66,12 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
+			// The proposal messages may execute state mutating logic. If any
+			// of them fails, no state mutation is written and the error message
+			// is logged.
+			events, err := executeProposalMessages(cacheCtx, keeper, proposal)
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -82,6 +82,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 				// any events emitted, so we re-emit to "merge" the events into the
 				// original Context's EventManager.
 				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+				ctx.EventManager().EmitEvents(events)
 
 				// write state to the underlying multi-store
 				writeCache()
@@ -138,3 +139,29 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		return false
 	})
 }
+
+// executeProposalMessages executes in order the messages of a passed proposal through the
+// Msg service router and returns the events of their results.
+func executeProposalMessages(ctx sdk.Context, keeper keeper.Keeper, proposal types.Proposal) (sdk.Events, error) {
+	messages, err := proposal.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	var events sdk.Events
+	for idx, msg := range messages {
+		handler := keeper.MsgServiceRouter().Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(types.ErrUnroutableProposalMsg, "message %d: %s", idx, sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "message %d: %s", idx, sdk.MsgTypeURL(msg))
+		}
+
+		events = append(events, res.GetEvents()...)
+	}
+
+	return events, nil
+}
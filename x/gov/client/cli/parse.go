@@ -7,6 +7,8 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 )
 
@@ -43,3 +45,45 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// messagesProposal defines the JSON file of a proposal executing messages
+type messagesProposal struct {
+	// Messages are the JSON encoded sdk.Msgs executed by the proposal
+	Messages    []json.RawMessage `json:"messages"`
+	Metadata    string            `json:"metadata,omitempty"`
+	Deposit     string            `json:"deposit"`
+	IsExpedited bool              `json:"is_expedited,omitempty"`
+}
+
+// parseSubmitMessagesProposal reads and parses the JSON file of a proposal executing messages
+func parseSubmitMessagesProposal(cdc codec.Codec, path string) (messagesProposal, []sdk.Msg, sdk.Coins, error) {
+	var proposal messagesProposal
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return proposal, nil, nil, err
+	}
+
+	err = json.Unmarshal(contents, &proposal)
+	if err != nil {
+		return proposal, nil, nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, anyJSON := range proposal.Messages {
+		var msg sdk.Msg
+		err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
+		if err != nil {
+			return proposal, nil, nil, err
+		}
+
+		msgs[i] = msg
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	if err != nil {
+		return proposal, nil, nil, err
+	}
+
+	return proposal, msgs, deposit, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // Proposal flags
@@ -27,6 +28,7 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	FlagProposal     = "proposal"
+	FlagMetadata     = "metadata"
 )
 
 type proposal struct {
@@ -72,6 +74,8 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 		NewCmdVote(),
 		NewCmdWeightedVote(),
 		cmdSubmitProp,
+		NewCmdSubmitMessagesProposal(),
+		NewCmdCancelProposal(),
 	)
 
 	return govTxCmd
@@ -144,6 +148,103 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	return cmd
 }
 
+// NewCmdSubmitMessagesProposal implements submitting a proposal executing messages transaction command.
+func NewCmdSubmitMessagesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-messages-proposal [path/to/proposal.json]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages along with an initial deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal executing messages along with an initial deposit.
+The messages are executed in order with the gov module account as signer once the proposal passes.
+
+Example:
+$ %s tx gov submit-messages-proposal path/to/proposal.json --from mykey
+
+Where proposal.json contains:
+
+{
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "cosmos1...", // The gov module account address
+      "to_address": "cosmos1...",
+      "amount":[{"denom": "stake","amount": "10"}]
+    }
+  ],
+  "metadata": "ipfs://CID",
+  "deposit": "10stake",
+  "is_expedited": false
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, msgs, deposit, err := parseSubmitMessagesProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal: %w", err)
+			}
+
+			msg, err := v1.NewMsgSubmitProposalWithExpedite(msgs, deposit, clientCtx.GetFromAddress(), proposal.Metadata, proposal.IsExpedited)
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCancelProposal implements cancelling a proposal transaction command.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal before its voting period ends",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal before its voting period ends. Only the proposer
+can cancel a proposal, and a fraction of the deposits set by the deposit
+params is burned while the remainder is refunded to the depositors.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := v1.NewMsgCancelProposal(proposalID, clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdDeposit implements depositing tokens for an active proposal.
 func NewCmdDeposit() *cobra.Command {
 	cmd := &cobra.Command{
@@ -203,7 +304,7 @@ func NewCmdVote() *cobra.Command {
 find the proposal-id by running "%s query gov proposals".
 
 Example:
-$ %s tx gov vote 1 yes --from mykey
+$ %s tx gov vote 1 yes --metadata="ipfs://CID" --from mykey
 `,
 				version.AppName, version.AppName,
 			),
@@ -230,11 +331,13 @@ $ %s tx gov vote 1 yes --from mykey
 
 			// Build vote message and run basic validation
 			msg := types.NewMsgVote(from, proposalID, byteVoteOption)
+			msg.Metadata, _ = cmd.Flags().GetString(FlagMetadata)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the vote, usually an URI to an off-chain document")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -279,6 +382,7 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 
 			// Build vote message and run basic validation
 			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			msg.Metadata, _ = cmd.Flags().GetString(FlagMetadata)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -288,6 +392,7 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 		},
 	}
 
+	cmd.Flags().String(FlagMetadata, "", "The metadata of the vote, usually an URI to an off-chain document")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -24,7 +24,8 @@ func TestIntegrationTestSuite(t *testing.T) {
 	genesisState.DepositParams = types.NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, types.DefaultMinDepositTokens)),
 		sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, types.DefaultMinExpeditedDepositTokens)),
-		time.Duration(15)*time.Second)
+		time.Duration(15)*time.Second,
+		types.DefaultProposalCancelRatio)
 	genesisState.VotingParams = types.NewVotingParams(time.Duration(5)*time.Second, time.Duration(2)*time.Second)
 	bz, err := cfg.Codec.MarshalJSON(genesisState)
 	require.NoError(t, err)
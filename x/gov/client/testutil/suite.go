@@ -88,7 +88,7 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"voting_params":{"voting_period":"172800000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"uplume","amount":"10000000"}],"max_deposit_period":"172800000000000"}, "min_expedited_deposit":[{"denom":"uplume","amount":"20000000"}]}`,
+			`{"voting_params":{"voting_period":"172800000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"uplume","amount":"10000000"}],"max_deposit_period":"172800000000000"}, "min_expedited_deposit":[{"denom":"uplume","amount":"20000000"}],"proposal_cancel_ratio":"0.500000000000000000"}`,
 		},
 		{
 			"text output",
@@ -102,6 +102,7 @@ deposit_params:
   min_expedited_deposit:
   - amount: "20000000"
     denom: stake
+  proposal_cancel_ratio: "0.500000000000000000"
 tally_params:
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
@@ -156,7 +157,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"deposit",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"min_deposit":[{"denom":"uplume","amount":"10000000"}],"max_deposit_period":"172800000000000", "min_expedited_deposit":[{"denom":"uplume","amount":"20000000"}]}`,
+			`{"min_deposit":[{"denom":"uplume","amount":"10000000"}],"max_deposit_period":"172800000000000", "min_expedited_deposit":[{"denom":"uplume","amount":"20000000"}],"proposal_cancel_ratio":"0.500000000000000000"}`,
 		},
 	}
 
@@ -3,9 +3,11 @@ package gov
 import (
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // InitGenesis - store genesis parameters
@@ -31,7 +33,18 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetVote(ctx, vote)
 	}
 
+	authority := moduleAcc.GetAddress().String()
 	for _, proposal := range data.Proposals {
+		// the legacy content of proposals exported before the proposal messages is executed by the gov
+		// module account, like the one of the proposals migrated in the store
+		if proposal.Content != nil && len(proposal.Messages) == 0 {
+			msg, err := codectypes.NewAnyWithValue(v1.NewMsgExecLegacyContent(proposal.Content, authority))
+			if err != nil {
+				panic(err)
+			}
+			proposal.Messages = []*codectypes.Any{msg}
+		}
+
 		switch proposal.Status {
 		case types.StatusDepositPeriod:
 			k.InsertInactiveProposalQueue(ctx, proposal.ProposalId, proposal.DepositEndTime)
@@ -18,6 +18,10 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestImportExportQueues(t *testing.T) {
@@ -109,6 +113,53 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal2.Status == types.StatusRejected)
 }
 
+func TestImportLegacyContentProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDec(0)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	createValidators(t, staking.NewHandler(app.StakingKeeper), ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// a proposal exported before the proposal messages only has a legacy content
+	content := paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1"),
+	}, false)
+	proposal, err := types.NewProposal(content, 1, ctx.BlockTime(), ctx.BlockTime(), false)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	proposal.VotingStartTime = ctx.BlockTime()
+	proposal.VotingEndTime = ctx.BlockTime().Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	require.Empty(t, proposal.Messages)
+
+	genState := gov.ExportGenesis(ctx, app.GovKeeper)
+	genState.StartingProposalId = 2
+	genState.Proposals = types.Proposals{proposal}
+	genState.Votes = types.Votes{types.NewVote(1, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))}
+	gov.InitGenesis(ctx, app.AccountKeeper, app.BankKeeper, app.GovKeeper, genState)
+
+	// the content is wrapped into a message executed by the gov module account
+	proposal, ok := app.GovKeeper.GetProposal(ctx, 1)
+	require.True(t, ok)
+	msgs, err := proposal.GetMessages()
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	legacyMsg, ok := msgs[0].(*v1.MsgExecLegacyContent)
+	require.True(t, ok)
+	require.Equal(t, app.GovKeeper.GetGovernanceAccount(ctx).GetAddress().String(), legacyMsg.Authority)
+
+	// the handler of the content runs once the proposal passes
+	ctx = ctx.WithBlockTime(proposal.VotingEndTime)
+	gov.EndBlocker(ctx, app.GovKeeper)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, uint32(1), app.StakingKeeper.MaxValidators(ctx))
+}
+
 func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -177,3 +177,43 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 		return false
 	})
 }
+
+// ChargeDeposits burns the given ratio of all the deposits on a specific proposal, refunds the remainder
+// to the depositors and deletes the deposits
+func (keeper Keeper) ChargeDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) error {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var (
+		burnAmount sdk.Coins
+		err        error
+	)
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		var charged sdk.Coins
+		for _, coin := range deposit.Amount {
+			charged = charged.Add(sdk.NewCoin(coin.Denom, burnRatio.MulInt(coin.Amount).TruncateInt()))
+		}
+
+		refund := deposit.Amount.Sub(charged)
+		if !refund.IsZero() {
+			err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund)
+			if err != nil {
+				return true
+			}
+		}
+
+		burnAmount = burnAmount.Add(charged...)
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if !burnAmount.IsZero() {
+		return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burnAmount)
+	}
+
+	return nil
+}
@@ -130,3 +130,44 @@ func TestDeposits(t *testing.T) {
 		require.Len(t, deposits, 0)
 	}
 }
+
+func TestChargeDeposits(t *testing.T) {
+	testcases := map[string]struct {
+		burnRatio sdk.Dec
+	}{
+		"burn all":     {burnRatio: sdk.OneDec()},
+		"burn half":    {burnRatio: sdk.NewDecWithPrec(5, 1)},
+		"refund all":   {burnRatio: sdk.ZeroDec()},
+		"burn a third": {burnRatio: sdk.NewDecWithPrec(333, 3)},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+			require.NoError(t, err)
+			proposalID := proposal.ProposalId
+
+			depositValue := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+			for _, addr := range TestAddrs {
+				_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addr, depositValue)
+				require.NoError(t, err)
+			}
+
+			addr0Initial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[0])
+			supplyInitial := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+			require.NoError(t, app.GovKeeper.ChargeDeposits(ctx, proposalID, tc.burnRatio))
+
+			burned := sdk.NewCoin(sdk.DefaultBondDenom, tc.burnRatio.MulInt(depositValue.AmountOf(sdk.DefaultBondDenom)).TruncateInt())
+			refund := depositValue.Sub(sdk.NewCoins(burned))
+
+			require.Len(t, app.GovKeeper.GetDeposits(ctx, proposalID), 0)
+			require.Equal(t, addr0Initial.Add(refund...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+			require.Equal(t, supplyInitial.Sub(burned.Add(burned)), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v043"
 	v3 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v3"
+	v4 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v4"
+	v5 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v5"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -25,3 +27,13 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.paramSpace)
 }
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.paramSpace)
+}
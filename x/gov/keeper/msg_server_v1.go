@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+type msgServerV1 struct {
+	Keeper
+}
+
+// NewMsgServerV1Impl returns an implementation of the gov v1 MsgServer interface
+// for the provided Keeper.
+func NewMsgServerV1Impl(keeper Keeper) v1.MsgServer {
+	return &msgServerV1{Keeper: keeper}
+}
+
+var _ v1.MsgServer = msgServerV1{}
+
+func (k msgServerV1) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitProposal) (*v1.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	messages, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.Keeper.SubmitProposalWithMessages(ctx, messages, msg.Metadata, msg.GetProposer(), msg.IsExpedited)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, "proposal")
+
+	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.ProposalId, msg.GetProposer(), msg.GetInitialDeposit())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetProposer().String()),
+		),
+	)
+
+	msgTypeURLs := make([]string, len(messages))
+	for i, message := range messages {
+		msgTypeURLs[i] = sdk.MsgTypeURL(message)
+	}
+
+	submitEvent := sdk.NewEvent(types.EventTypeSubmitProposal, sdk.NewAttribute(types.AttributeKeyProposalMessages, strings.Join(msgTypeURLs, ",")))
+	if votingStarted {
+		submitEvent = submitEvent.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.ProposalId)),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(submitEvent)
+	return &v1.MsgSubmitProposalResponse{
+		ProposalId: proposal.ProposalId,
+	}, nil
+}
+
+func (k msgServerV1) ExecLegacyContent(goCtx context.Context, msg *v1.MsgExecLegacyContent) (*v1.MsgExecLegacyContentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	govAcct := k.Keeper.GetGovernanceAccount(ctx).GetAddress().String()
+	if msg.Authority != govAcct {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", govAcct, msg.Authority)
+	}
+
+	content := msg.GetContent()
+	if content == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidProposalContent, "missing content")
+	}
+
+	if !k.Keeper.Router().HasRoute(content.ProposalRoute()) {
+		return nil, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+
+	handler := k.Keeper.Router().GetRoute(content.ProposalRoute())
+	if err := handler(ctx, content); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidProposalContent, "failed to run legacy handler %s, %+v", content.ProposalRoute(), err)
+	}
+
+	return &v1.MsgExecLegacyContentResponse{}, nil
+}
+
+func (k msgServerV1) CancelProposal(goCtx context.Context, msg *v1.MsgCancelProposal) (*v1.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelProposal(ctx, msg.ProposalId, proposer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer),
+		),
+	)
+
+	return &v1.MsgCancelProposalResponse{
+		ProposalId:     msg.ProposalId,
+		CanceledTime:   ctx.BlockTime(),
+		CanceledHeight: uint64(ctx.BlockHeight()),
+	}, nil
+}
@@ -2,10 +2,15 @@ package keeper
 
 import (
 	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
@@ -16,26 +21,54 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 
 // SubmitProposalWithExpedite create new proposal given a content and whether expedited or not
 func (keeper Keeper) SubmitProposalWithExpedite(ctx sdk.Context, content types.Content, isExpedited bool) (types.Proposal, error) {
-	if !keeper.router.HasRoute(content.ProposalRoute()) {
-		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+	return keeper.SubmitLegacyProposal(ctx, content, nil, isExpedited)
+}
+
+// SubmitLegacyProposal create new proposal given a content, its proposer and whether expedited or not.
+// The content is executed through a MsgExecLegacyContent message once the proposal passes.
+func (keeper Keeper) SubmitLegacyProposal(ctx sdk.Context, content types.Content, proposer sdk.AccAddress, isExpedited bool) (types.Proposal, error) {
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidProposalContent, "%T does not implement proto.Message", content)
 	}
-	// Ensure that the parameter exists
-	if content.ProposalType() == proposal.ProposalTypeChange {
-		paramProposal, ok := content.(*proposal.ParameterChangeProposal)
-		if !ok {
-			return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, "proposal content is not a ParameterChangeProposal")
-		}
 
-		// Validate each parameter change exists
-		for _, change := range paramProposal.Changes {
-			subspace, ok := keeper.paramsKeeper.GetSubspace(change.Subspace)
-			if !ok {
-				return types.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidProposalContent, "parameter %s/%s does not exist", change.Subspace, change.Key)
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return types.Proposal{}, err
+	}
+
+	authority := keeper.GetGovernanceAccount(ctx).GetAddress().String()
+	return keeper.SubmitProposalWithMessages(ctx, []sdk.Msg{v1.NewMsgExecLegacyContent(any, authority)}, "", proposer, isExpedited)
+}
+
+// SubmitProposalWithMessages create new proposal given the messages to execute with the gov module account
+// as signer, its metadata, its proposer and whether expedited or not
+func (keeper Keeper) SubmitProposalWithMessages(ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress, isExpedited bool) (types.Proposal, error) {
+	if len(messages) == 0 {
+		return types.Proposal{}, types.ErrNoProposalMsgs
+	}
+
+	if err := types.ValidateMetadata(metadata); err != nil {
+		return types.Proposal{}, err
+	}
+
+	// the content of a legacy proposal is kept on the proposal for the legacy queries
+	var content types.Content
+	for _, msg := range messages {
+		if legacyMsg, ok := msg.(*v1.MsgExecLegacyContent); ok {
+			// the keeper doesn't check the validity of the legacy content itself
+			if err := keeper.validateLegacyContent(ctx, legacyMsg.GetContent()); err != nil {
+				return types.Proposal{}, err
 			}
-			validKey := subspace.Has(ctx, []byte(change.Key))
-			if !validKey {
-				return types.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidProposalContent, "parameter %s not found in subspace %s", change.Key, change.Subspace)
+			if len(messages) == 1 {
+				content = legacyMsg.GetContent()
 			}
+		} else if err := msg.ValidateBasic(); err != nil {
+			return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
+		}
+
+		if err := keeper.validateProposalMsg(ctx, msg); err != nil {
+			return types.Proposal{}, err
 		}
 	}
 
@@ -47,11 +80,19 @@ func (keeper Keeper) SubmitProposalWithExpedite(ctx sdk.Context, content types.C
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod), isExpedited)
+	proposal, err := types.NewProposalWithMessages(messages, proposalID, submitTime, submitTime.Add(depositPeriod), isExpedited)
 	if err != nil {
 		return types.Proposal{}, err
 	}
 
+	if content != nil {
+		if err := proposal.SetContent(content); err != nil {
+			return types.Proposal{}, err
+		}
+	}
+	proposal.Proposer = proposer.String()
+	proposal.Metadata = metadata
+
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
 	keeper.SetProposalID(ctx, proposalID+1)
@@ -69,6 +110,52 @@ func (keeper Keeper) SubmitProposalWithExpedite(ctx sdk.Context, content types.C
 	return proposal, nil
 }
 
+// validateProposalMsg returns an error if the message can't be executed by a passed proposal
+func (keeper Keeper) validateProposalMsg(ctx sdk.Context, msg sdk.Msg) error {
+	// the gov module account must be the only signer of the message
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
+		return sdkerrors.Wrap(types.ErrInvalidSigner, sdk.MsgTypeURL(msg))
+	}
+
+	if keeper.msgRouter.Handler(msg) == nil {
+		return sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+
+	return nil
+}
+
+// validateLegacyContent returns an error if the legacy content can't be handled by the proposal router
+func (keeper Keeper) validateLegacyContent(ctx sdk.Context, content types.Content) error {
+	if content == nil {
+		return sdkerrors.Wrap(types.ErrInvalidProposalContent, "missing content")
+	}
+	if !keeper.router.HasRoute(content.ProposalRoute()) {
+		return sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+	// Ensure that the parameter exists
+	if content.ProposalType() == proposal.ProposalTypeChange {
+		paramProposal, ok := content.(*proposal.ParameterChangeProposal)
+		if !ok {
+			return sdkerrors.Wrap(types.ErrInvalidProposalContent, "proposal content is not a ParameterChangeProposal")
+		}
+
+		// Validate each parameter change exists
+		for _, change := range paramProposal.Changes {
+			subspace, ok := keeper.paramsKeeper.GetSubspace(change.Subspace)
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidProposalContent, "parameter %s/%s does not exist", change.Subspace, change.Key)
+			}
+			validKey := subspace.Has(ctx, []byte(change.Key))
+			if !validKey {
+				return sdkerrors.Wrapf(types.ErrInvalidProposalContent, "parameter %s not found in subspace %s", change.Key, change.Subspace)
+			}
+		}
+	}
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -93,6 +180,46 @@ func (keeper Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
 	store.Set(types.ProposalKey(proposal.ProposalId), bz)
 }
 
+// CancelProposal cancels a proposal on behalf of its proposer before the end of its voting period. The
+// cancel ratio of the deposits is burned and the remainder is refunded to the depositors.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer == "" || proposal.Proposer != proposer.String() {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	switch proposal.Status {
+	case types.StatusDepositPeriod:
+	case types.StatusVotingPeriod:
+		if !ctx.BlockTime().Before(proposal.VotingEndTime) {
+			return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "%d", proposalID)
+		}
+	default:
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if err := keeper.ChargeDeposits(ctx, proposalID, keeper.GetDepositParams(ctx).ProposalCancelRatio); err != nil {
+		return err
+	}
+
+	keeper.deleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalProposer, proposal.Proposer),
+		),
+	)
+
+	return nil
+}
+
 // DeleteProposal deletes a proposal from store
 func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -9,8 +9,11 @@ import (
 	"testing"
 	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
@@ -268,3 +271,157 @@ func (suite *KeeperTestSuite) TestParamChangeProposal() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSubmitProposalWithMessages() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addr := sdk.AccAddress("addr________________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	legacyContent, err := codectypes.NewAnyWithValue(TestProposal)
+	suite.Require().NoError(err)
+
+	testCases := map[string]struct {
+		messages    []sdk.Msg
+		expectedErr error
+	}{
+		"gov account signer": {
+			messages: []sdk.Msg{banktypes.NewMsgSend(govAcct, addr, coins)},
+		},
+		"multiple messages": {
+			messages: []sdk.Msg{
+				banktypes.NewMsgSend(govAcct, addr, coins),
+				v1.NewMsgExecLegacyContent(legacyContent, govAcct.String()),
+			},
+		},
+		"no messages": {
+			messages:    []sdk.Msg{},
+			expectedErr: types.ErrNoProposalMsgs,
+		},
+		"invalid signer": {
+			messages:    []sdk.Msg{banktypes.NewMsgSend(addr, govAcct, coins)},
+			expectedErr: types.ErrInvalidSigner,
+		},
+		"invalid message": {
+			messages:    []sdk.Msg{banktypes.NewMsgSend(govAcct, addr, sdk.NewCoins())},
+			expectedErr: types.ErrInvalidProposalMsg,
+		},
+		"legacy content signed by another account": {
+			messages:    []sdk.Msg{v1.NewMsgExecLegacyContent(legacyContent, addr.String())},
+			expectedErr: types.ErrInvalidSigner,
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			proposal, err := suite.app.GovKeeper.SubmitProposalWithMessages(suite.ctx, tc.messages, "", addr, false)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			gotProposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+			suite.Require().True(ok)
+
+			msgs, err := gotProposal.GetMessages()
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.messages, msgs)
+			suite.Require().Nil(gotProposal.GetContent())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitLegacyProposalWrapsContent() {
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal)
+	suite.Require().NoError(err)
+
+	gotProposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	suite.Require().Equal(TestProposal, gotProposal.GetContent())
+
+	msgs, err := gotProposal.GetMessages()
+	suite.Require().NoError(err)
+	suite.Require().Len(msgs, 1)
+
+	legacyMsg, ok := msgs[0].(*v1.MsgExecLegacyContent)
+	suite.Require().True(ok)
+	suite.Require().Equal(suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress().String(), legacyMsg.Authority)
+	suite.Require().Equal(TestProposal, legacyMsg.GetContent())
+}
+
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	proposer := suite.addrs[0]
+	depositor := suite.addrs[1]
+	minDeposit := suite.app.GovKeeper.GetDepositParams(suite.ctx).MinDeposit
+
+	testCases := map[string]struct {
+		malleate    func(proposalID uint64) sdk.AccAddress
+		expectedErr error
+	}{
+		"cancel in deposit period": {
+			malleate: func(uint64) sdk.AccAddress { return proposer },
+		},
+		"cancel in voting period": {
+			malleate: func(proposalID uint64) sdk.AccAddress {
+				_, err := suite.app.GovKeeper.AddDeposit(suite.ctx, proposalID, depositor, minDeposit)
+				suite.Require().NoError(err)
+				suite.Require().NoError(suite.app.GovKeeper.AddVoteWithMetadata(suite.ctx, proposalID, depositor, types.NewNonSplitVoteOption(types.OptionYes), "ipfs://vote"))
+				return proposer
+			},
+		},
+		"not the proposer": {
+			malleate:    func(uint64) sdk.AccAddress { return depositor },
+			expectedErr: types.ErrInvalidProposer,
+		},
+		"unknown proposal": {
+			malleate: func(proposalID uint64) sdk.AccAddress {
+				suite.Require().NoError(suite.app.GovKeeper.CancelProposal(suite.ctx, proposalID, proposer))
+				return proposer
+			},
+			expectedErr: types.ErrUnknownProposal,
+		},
+		"voting period ended": {
+			malleate: func(proposalID uint64) sdk.AccAddress {
+				_, err := suite.app.GovKeeper.AddDeposit(suite.ctx, proposalID, depositor, minDeposit)
+				suite.Require().NoError(err)
+				votingPeriod := suite.app.GovKeeper.GetVotingParams(suite.ctx).VotingPeriod
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(votingPeriod))
+				return proposer
+			},
+			expectedErr: types.ErrVotingPeriodEnded,
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+
+			messages := []sdk.Msg{banktypes.NewMsgSend(suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress(), proposer, minDeposit)}
+			proposal, err := suite.app.GovKeeper.SubmitProposalWithMessages(suite.ctx, messages, "ipfs://proposal", proposer, false)
+			suite.Require().NoError(err)
+			suite.Require().Equal(proposer.String(), proposal.Proposer)
+			suite.Require().Equal("ipfs://proposal", proposal.Metadata)
+			proposalID := proposal.ProposalId
+
+			_, err = suite.app.GovKeeper.AddDeposit(suite.ctx, proposalID, proposer, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+			suite.Require().NoError(err)
+
+			canceller := tc.malleate(proposalID)
+			err = suite.app.GovKeeper.CancelProposal(suite.ctx, proposalID, canceller)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			_, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposalID)
+			suite.Require().False(ok)
+			suite.Require().Len(suite.app.GovKeeper.GetDeposits(suite.ctx, proposalID), 0)
+			suite.Require().Len(suite.app.GovKeeper.GetVotes(suite.ctx, proposalID), 0)
+
+			inactiveIterator := suite.app.GovKeeper.InactiveProposalQueueIterator(suite.ctx, proposal.DepositEndTime)
+			suite.Require().False(inactiveIterator.Valid())
+			suite.Require().NoError(inactiveIterator.Close())
+		})
+	}
+}
@@ -10,6 +10,11 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
+	return keeper.AddVoteWithMetadata(ctx, proposalID, voterAddr, options, "")
+}
+
+// AddVoteWithMetadata adds a vote with its metadata on a specific proposal
+func (keeper Keeper) AddVoteWithMetadata(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions, metadata string) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -24,7 +29,12 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		}
 	}
 
+	if err := types.ValidateMetadata(metadata); err != nil {
+		return err
+	}
+
 	vote := types.NewVote(proposalID, voterAddr, options)
+	vote.Metadata = metadata
 	keeper.SetVote(ctx, vote)
 
 	// called after a vote on a proposal is cast
@@ -136,3 +146,11 @@ func populateLegacyOption(vote *types.Vote) {
 		vote.Option = vote.Options[0].Option //nolint
 	}
 }
+
+// deleteVotes deletes all the votes on a specific proposal
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
+	keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+		keeper.deleteVote(ctx, proposalID, sdk.MustAccAddressFromBech32(vote.Voter))
+		return false
+	})
+}
@@ -92,3 +92,25 @@ func TestVotes(t *testing.T) {
 	require.True(t, votes[1].Options[3].Weight.Equal(sdk.NewDecWithPrec(5, 2)))
 	require.Equal(t, types.OptionEmpty, vote.Option)
 }
+
+func TestVoteMetadata(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(30000000))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	tooLong := string(make([]byte, types.MaxMetadataLength+1))
+	require.ErrorIs(t, app.GovKeeper.AddVoteWithMetadata(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), tooLong), types.ErrMetadataTooLong)
+
+	require.NoError(t, app.GovKeeper.AddVoteWithMetadata(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "ipfs://vote"))
+	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, "ipfs://vote", vote.Metadata)
+}
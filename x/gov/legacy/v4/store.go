@@ -0,0 +1,52 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 4 in the gov module.
+// The migration includes: wrapping the legacy content of the existing proposals into a
+// MsgExecLegacyContent message executed by the gov module account.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	return migrateProposals(store, cdc)
+}
+
+func migrateProposals(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	authority := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposalsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		err := cdc.Unmarshal(iterator.Value(), &proposal)
+		if err != nil {
+			return err
+		}
+
+		if proposal.Content == nil || len(proposal.Messages) > 0 {
+			continue
+		}
+
+		msg := v1.NewMsgExecLegacyContent(proposal.Content, authority)
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+		proposal.Messages = []*codectypes.Any{any}
+
+		bz, err := cdc.Marshal(&proposal)
+		if err != nil {
+			return err
+		}
+		store.Set(iterator.Key(), bz)
+	}
+
+	return nil
+}
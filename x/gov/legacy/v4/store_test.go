@@ -0,0 +1,62 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	v4 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v4"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	govKey := sdk.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	govAcct := authtypes.NewModuleAddress(types.ModuleName)
+	now := time.Now()
+
+	// legacy proposal storing only its content
+	content := types.NewTextProposal("Test", "description", false)
+	legacyProposal, err := types.NewProposal(content, 1, now, now, false)
+	require.NoError(t, err)
+	store.Set(types.ProposalKey(1), cdc.MustMarshal(&legacyProposal))
+
+	// proposal already executing messages
+	msgSend := banktypes.NewMsgSend(govAcct, sdk.AccAddress("addr________________"), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	msgsProposal, err := types.NewProposalWithMessages([]sdk.Msg{msgSend}, 2, now, now, false)
+	require.NoError(t, err)
+	store.Set(types.ProposalKey(2), cdc.MustMarshal(&msgsProposal))
+
+	// Run migrations.
+	err = v4.MigrateStore(ctx, govKey, cdc)
+	require.NoError(t, err)
+
+	// The legacy content is wrapped into a MsgExecLegacyContent executed by the gov account.
+	var proposal types.Proposal
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ProposalKey(1)), &proposal))
+	require.Equal(t, content, proposal.GetContent())
+
+	msgs, err := proposal.GetMessages()
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	legacyMsg, ok := msgs[0].(*v1.MsgExecLegacyContent)
+	require.True(t, ok)
+	require.Equal(t, govAcct.String(), legacyMsg.Authority)
+	require.Equal(t, content, legacyMsg.GetContent())
+
+	// The proposal executing messages is left untouched.
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ProposalKey(2)), &proposal))
+	msgs, err = proposal.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{msgSend}, msgs)
+}
@@ -0,0 +1,17 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 5 in the gov module.
+// The migration includes: Setting the proposal cancel ratio of the deposit params in the paramstore.
+func MigrateStore(ctx sdk.Context, paramstore types.ParamSubspace) error {
+	var depositParams types.DepositParams
+	paramstore.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	depositParams.ProposalCancelRatio = types.DefaultProposalCancelRatio
+	paramstore.Set(ctx, types.ParamStoreKeyDepositParams, depositParams)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v5 "github.com/cosmos/cosmos-sdk/x/gov/legacy/v5"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestGovStoreMigrationToV5ConsensusVersion(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey("gov")
+	transientTestKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, transientTestKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, govKey, transientTestKey, "gov")
+
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	// We assume that all deposit params are set besides the ProposalCancelRatio
+	originalDepositParams := types.DefaultDepositParams()
+	originalDepositParams.ProposalCancelRatio = sdk.Dec{}
+	paramstore.Set(ctx, types.ParamStoreKeyDepositParams, originalDepositParams)
+
+	// Run migrations.
+	err := v5.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new param is set and the others are kept.
+	var depositParams types.DepositParams
+	paramstore.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	require.Equal(t, types.DefaultProposalCancelRatio, depositParams.ProposalCancelRatio)
+	require.Equal(t, originalDepositParams.MinDeposit, depositParams.MinDeposit)
+	require.Equal(t, originalDepositParams.MinExpeditedDeposit, depositParams.MinExpeditedDeposit)
+	require.Equal(t, originalDepositParams.MaxDepositPeriod, depositParams.MaxDepositPeriod)
+}
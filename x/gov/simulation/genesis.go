@@ -26,6 +26,7 @@ const (
 	TallyParamsThreshold              = "tally_params_threshold"
 	TallyParamsExpeditedThreshold     = "tally_params_expedited_threshold"
 	TallyParamsVeto                   = "tally_params_veto"
+	DepositParamsProposalCancelRatio  = "deposit_params_proposal_cancel_ratio"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -78,6 +79,11 @@ func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenDepositParamsProposalCancelRatio randomized DepositParamsProposalCancelRatio
+func GenDepositParamsProposalCancelRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 100)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -142,9 +148,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var proposalCancelRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsProposalCancelRatio, &proposalCancelRatio, simState.Rand,
+		func(r *rand.Rand) { proposalCancelRatio = GenDepositParamsProposalCancelRatio(r) },
+	)
+
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
-		types.NewDepositParams(minDeposit, minExpeditedDeposit, depositPeriod),
+		types.NewDepositParams(minDeposit, minExpeditedDeposit, depositPeriod, proposalCancelRatio),
 		types.NewVotingParams(votingPeriod, expeditedVotingPeriod),
 		types.NewTallyParams(quorum, expeditedQuorum, threshold, expeditedThreshold, veto),
 	)
@@ -13,6 +13,17 @@ const (
 	MaxTitleLength       int = 140
 )
 
+// MaxMetadataLength is the maximum length of the metadata of proposals and votes
+const MaxMetadataLength int = 255
+
+// ValidateMetadata returns an error if the metadata of a proposal or vote is too long
+func ValidateMetadata(metadata string) error {
+	if len(metadata) > MaxMetadataLength {
+		return sdkerrors.Wrapf(ErrMetadataTooLong, "metadata is longer than max length of %d", MaxMetadataLength)
+	}
+	return nil
+}
+
 // Content defines an interface that a proposal must implement. It contains
 // information such as the title and description along with the type and routing
 // information for the appropriate handler to process the proposal. Content can
@@ -14,4 +14,11 @@ var (
 	ErrInvalidVote             = sdkerrors.Register(ModuleName, 7, "invalid vote option")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrUnroutableProposalMsg   = sdkerrors.Register(ModuleName, 10, "proposal message not recognized by router")
+	ErrNoProposalMsgs          = sdkerrors.Register(ModuleName, 11, "no messages proposed")
+	ErrInvalidProposalMsg      = sdkerrors.Register(ModuleName, 12, "invalid proposal message")
+	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 13, "expected gov account as only signer for proposal message")
+	ErrInvalidProposer         = sdkerrors.Register(ModuleName, 14, "invalid proposer")
+	ErrVotingPeriodEnded       = sdkerrors.Register(ModuleName, 15, "voting period already ended")
+	ErrMetadataTooLong         = sdkerrors.Register(ModuleName, 16, "metadata too long")
 )
@@ -7,6 +7,7 @@ const (
 	EventTypeProposalVote     = "proposal_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
+	EventTypeCancelProposal   = "cancel_proposal"
 
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyOption               = "option"
@@ -19,4 +20,6 @@ const (
 	AttributeValueExpeditedConverted = "expedited_proposal_converted" // didn't pass expedition and converted to regular proposal
 	AttributeValueProposalFailed     = "proposal_failed"              // error on proposal handler
 	AttributeKeyProposalType         = "proposal_type"
+	AttributeKeyProposalMessages     = "proposal_messages" // Msg type_urls in the proposal
+	AttributeKeyProposalProposer     = "proposal_proposer"
 )
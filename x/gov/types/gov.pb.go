@@ -253,6 +253,16 @@ type Proposal struct {
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
 	IsExpedited      bool                                     `protobuf:"varint,10,opt,name=is_expedited,json=isExpedited,proto3" json:"is_expedited,omitempty"`
+	// messages are the arbitrary messages executed with the gov module account
+	// as signer if the proposal passes. The content of legacy proposals is
+	// executed through a cosmos.gov.v1.MsgExecLegacyContent message.
+	Messages []*types1.Any `protobuf:"bytes,11,rep,name=messages,proto3" json:"messages,omitempty"`
+	// proposer is the address of the proposal submitter, allowed to cancel the
+	// proposal.
+	Proposer string `protobuf:"bytes,12,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// metadata is any arbitrary metadata attached to the proposal, usually an
+	// URI to an off-chain document.
+	Metadata string `protobuf:"bytes,13,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
@@ -338,6 +348,9 @@ type Vote struct {
 	Option VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"` // Deprecated: Do not use.
 	// Since: cosmos-sdk 0.43
 	Options []WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
+	// metadata is any arbitrary metadata attached to the vote, usually an URI to
+	// an off-chain document.
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
@@ -381,6 +394,9 @@ type DepositParams struct {
 	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty" yaml:"max_deposit_period"`
 	//  Minimum deposit for a expedited proposal to enter voting period.
 	MinExpeditedDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_expedited_deposit,json=minExpeditedDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_expedited_deposit,omitempty" yaml:"min_expedited_deposit"`
+	//  Fraction of the deposits burned when a proposal is cancelled by its
+	//  proposer, the remainder is refunded to the depositors.
+	ProposalCancelRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proposal_cancel_ratio,omitempty" yaml:"proposal_cancel_ratio"`
 }
 
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
@@ -520,108 +536,114 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcf, 0x6f, 0xdb, 0x46,
-	0x16, 0x16, 0x25, 0xf9, 0x87, 0x46, 0xb2, 0xcd, 0x8c, 0x1d, 0x5b, 0xd6, 0x3a, 0xa4, 0xc2, 0x5d,
-	0x04, 0x46, 0x90, 0xc8, 0x89, 0x77, 0xb1, 0x8b, 0x75, 0x80, 0xdd, 0x95, 0x2c, 0x7a, 0xa3, 0x45,
-	0x20, 0x69, 0x29, 0x45, 0x46, 0xb2, 0x07, 0x82, 0x96, 0x26, 0x32, 0x77, 0x45, 0x8e, 0x56, 0x1c,
-	0x39, 0xf6, 0xee, 0x25, 0x40, 0x2f, 0x81, 0x0e, 0x45, 0x8e, 0x29, 0x0a, 0x01, 0x41, 0x8b, 0x5e,
-	0x7a, 0xea, 0xa1, 0x7f, 0x41, 0x4f, 0x46, 0x51, 0xa0, 0x41, 0x2f, 0x0d, 0x7a, 0x50, 0x1a, 0x1b,
-	0x28, 0x02, 0x1f, 0xfd, 0x17, 0x14, 0xe4, 0x0c, 0x25, 0x52, 0x72, 0xaa, 0xa8, 0xa7, 0x90, 0x6f,
-	0xde, 0xf7, 0xbd, 0xf7, 0x3e, 0xbd, 0xf7, 0x86, 0x31, 0x58, 0xab, 0x62, 0xcb, 0xc0, 0xd6, 0x46,
-	0x1d, 0x1f, 0x6c, 0x1c, 0xdc, 0xde, 0x43, 0x44, 0xbb, 0x6d, 0x3f, 0xa7, 0x9a, 0x2d, 0x4c, 0x30,
-	0x84, 0xf4, 0x34, 0x65, 0x5b, 0xd8, 0x69, 0x42, 0x60, 0x88, 0x3d, 0xcd, 0x42, 0x7d, 0x48, 0x15,
-	0xeb, 0x26, 0xc5, 0x24, 0x96, 0xea, 0xb8, 0x8e, 0x9d, 0xc7, 0x0d, 0xfb, 0x89, 0x59, 0x57, 0x29,
-	0x4a, 0xa5, 0x07, 0x8c, 0x96, 0x1e, 0x89, 0x75, 0x8c, 0xeb, 0x0d, 0xb4, 0xe1, 0xbc, 0xed, 0xb5,
-	0x1f, 0x6d, 0x10, 0xdd, 0x40, 0x16, 0xd1, 0x8c, 0xa6, 0x8b, 0x1d, 0x76, 0xd0, 0xcc, 0x23, 0x76,
-	0x24, 0x0c, 0x1f, 0xd5, 0xda, 0x2d, 0x8d, 0xe8, 0x98, 0x25, 0x23, 0x7d, 0xc6, 0x01, 0xb8, 0x8b,
-	0xf4, 0xfa, 0x3e, 0x41, 0xb5, 0x0a, 0x26, 0xa8, 0xd0, 0xb4, 0x0f, 0xe1, 0x1f, 0xc1, 0x34, 0x76,
-	0x9e, 0xe2, 0x5c, 0x92, 0x5b, 0x9f, 0xdf, 0x14, 0x52, 0xa3, 0x85, 0xa6, 0x06, 0xfe, 0x0a, 0xf3,
-	0x86, 0xbb, 0x60, 0xfa, 0xb1, 0xc3, 0x16, 0x0f, 0x26, 0xb9, 0xf5, 0x48, 0xe6, 0xaf, 0xc7, 0x3d,
-	0x31, 0xf0, 0x43, 0x4f, 0xbc, 0x56, 0xd7, 0xc9, 0x7e, 0x7b, 0x2f, 0x55, 0xc5, 0x06, 0xab, 0x8d,
-	0xfd, 0x73, 0xd3, 0xaa, 0xfd, 0x67, 0x83, 0x1c, 0x35, 0x91, 0x95, 0xca, 0xa2, 0xea, 0x79, 0x4f,
-	0x9c, 0x3b, 0xd2, 0x8c, 0xc6, 0x96, 0x44, 0x59, 0x24, 0x85, 0xd1, 0x49, 0xff, 0x03, 0xb1, 0x32,
-	0x3a, 0x24, 0xc5, 0x16, 0x6e, 0x62, 0x4b, 0x6b, 0xc0, 0x25, 0x30, 0x45, 0x74, 0xd2, 0x40, 0x4e,
-	0x7e, 0x11, 0x85, 0xbe, 0xc0, 0x24, 0x88, 0xd6, 0x90, 0x55, 0x6d, 0xe9, 0x34, 0x77, 0x27, 0x07,
-	0xc5, 0x6b, 0x82, 0x57, 0x41, 0x4c, 0xb7, 0x54, 0x74, 0xd8, 0x44, 0x35, 0x9d, 0xa0, 0x5a, 0x3c,
-	0x94, 0xe4, 0xd6, 0x67, 0x95, 0xa8, 0x6e, 0xc9, 0xae, 0x69, 0x6b, 0xe1, 0xed, 0x0b, 0x91, 0xfb,
-	0xee, 0xcb, 0x9b, 0x33, 0xdb, 0xd8, 0x24, 0xc8, 0x24, 0xd2, 0xb7, 0x1c, 0x98, 0xc9, 0xa2, 0x26,
-	0xb6, 0x74, 0x02, 0xff, 0x04, 0xa2, 0x4d, 0x96, 0x83, 0xaa, 0xd7, 0x9c, 0xe8, 0xe1, 0xcc, 0xf2,
-	0x79, 0x4f, 0x84, 0x34, 0x6f, 0xcf, 0xa1, 0xa4, 0x00, 0xf7, 0x2d, 0x57, 0x83, 0x6b, 0x20, 0x52,
-	0xa3, 0x1c, 0xb8, 0xc5, 0x12, 0x1b, 0x18, 0x60, 0x15, 0x4c, 0x6b, 0x06, 0x6e, 0x9b, 0x24, 0x1e,
-	0x4a, 0x86, 0xd6, 0xa3, 0x9b, 0xab, 0xae, 0xde, 0x76, 0x13, 0xf5, 0x05, 0xdf, 0xc6, 0xba, 0x99,
-	0xb9, 0x65, 0x4b, 0xfa, 0xf9, 0x6b, 0x71, 0xfd, 0x3d, 0x24, 0xb5, 0x01, 0x96, 0xc2, 0xa8, 0xb7,
-	0x66, 0x9f, 0xbe, 0x10, 0x03, 0x6f, 0x5f, 0x88, 0x01, 0xe9, 0xa3, 0x19, 0x30, 0xdb, 0x97, 0xf2,
-	0x0f, 0x17, 0x95, 0xb4, 0x78, 0xd6, 0x13, 0x83, 0x7a, 0xed, 0xbc, 0x27, 0x46, 0x68, 0x61, 0xc3,
-	0xf5, 0xdc, 0x01, 0x33, 0x55, 0xaa, 0x8f, 0x53, 0x4d, 0x74, 0x73, 0x29, 0x45, 0x5b, 0x2d, 0xe5,
-	0xb6, 0x5a, 0x2a, 0x6d, 0x1e, 0x65, 0xa2, 0x5f, 0x0f, 0x84, 0x54, 0x5c, 0x04, 0xac, 0x80, 0x69,
-	0x8b, 0x68, 0xa4, 0x6d, 0x39, 0xfa, 0xcf, 0x6f, 0x4a, 0x17, 0xb5, 0x97, 0x9b, 0x60, 0xc9, 0xf1,
-	0xcc, 0x24, 0xce, 0x7b, 0xe2, 0xf2, 0x90, 0xc8, 0x94, 0x44, 0x52, 0x18, 0x1b, 0x6c, 0x02, 0xf8,
-	0x48, 0x37, 0xb5, 0x86, 0x4a, 0xb4, 0x46, 0xe3, 0x48, 0x6d, 0x21, 0xab, 0xdd, 0x20, 0xf1, 0xb0,
-	0x93, 0x9f, 0x78, 0x51, 0x8c, 0xb2, 0xed, 0xa7, 0x38, 0x6e, 0x99, 0xab, 0xb6, 0xb0, 0xe7, 0x3d,
-	0x71, 0x95, 0x06, 0x19, 0x25, 0x92, 0x14, 0xde, 0x31, 0x7a, 0x40, 0xf0, 0x5f, 0x20, 0x6a, 0xb5,
-	0xf7, 0x0c, 0x9d, 0xa8, 0xf6, 0x50, 0xc6, 0xa7, 0x9c, 0x50, 0x89, 0x11, 0x29, 0xca, 0xee, 0xc4,
-	0x66, 0x04, 0x16, 0x85, 0xf5, 0x8b, 0x07, 0x2c, 0x3d, 0x7b, 0x2d, 0x72, 0x0a, 0xa0, 0x16, 0x1b,
-	0x00, 0x75, 0xc0, 0xb3, 0x16, 0x51, 0x91, 0x59, 0xa3, 0x11, 0xa6, 0xc7, 0x46, 0xf8, 0x2d, 0x8b,
-	0xb0, 0x42, 0x23, 0x0c, 0x33, 0xd0, 0x30, 0xf3, 0xcc, 0x2c, 0x9b, 0x35, 0x27, 0xd4, 0x53, 0x0e,
-	0xcc, 0x11, 0x4c, 0xb4, 0x86, 0xca, 0x0e, 0xe2, 0x33, 0xe3, 0x1a, 0xf1, 0x2e, 0x8b, 0xb3, 0x44,
-	0xe3, 0xf8, 0xd0, 0xd2, 0x44, 0x0d, 0x1a, 0x73, 0xb0, 0xee, 0x88, 0x35, 0xc0, 0xa5, 0x03, 0x4c,
-	0x74, 0xb3, 0x6e, 0xff, 0xbc, 0x2d, 0x26, 0xec, 0xec, 0xd8, 0xb2, 0x7f, 0xc7, 0xd2, 0x89, 0xd3,
-	0x74, 0x46, 0x28, 0x68, 0xdd, 0x0b, 0xd4, 0x5e, 0xb2, 0xcd, 0x4e, 0xe1, 0x8f, 0x00, 0x33, 0x0d,
-	0x24, 0x8e, 0x8c, 0x8d, 0x25, 0xb1, 0x58, 0xcb, 0xbe, 0x58, 0x7e, 0x85, 0xe7, 0xa8, 0xd5, 0x15,
-	0x78, 0x78, 0xf1, 0x80, 0xd1, 0xc5, 0x13, 0xb6, 0x17, 0x8f, 0x74, 0x1c, 0x04, 0x51, 0x6f, 0x87,
-	0xfd, 0x0d, 0x84, 0x8e, 0x90, 0x45, 0xf7, 0x5c, 0x26, 0x35, 0xc1, 0x3e, 0xcd, 0x99, 0x44, 0xb1,
-	0xa1, 0xf0, 0x2e, 0x98, 0xd1, 0xf6, 0x2c, 0xa2, 0xe9, 0x6c, 0x23, 0x4e, 0xcc, 0xe2, 0xc2, 0xe1,
-	0x5f, 0x40, 0xd0, 0xc4, 0xce, 0xcc, 0x4e, 0x4e, 0x12, 0x34, 0x31, 0xac, 0x83, 0x98, 0x89, 0xd5,
-	0xc7, 0x3a, 0xd9, 0x57, 0x0f, 0x10, 0xc1, 0xce, 0x64, 0x46, 0x32, 0xf2, 0x64, 0x4c, 0xe7, 0x3d,
-	0x71, 0x91, 0xea, 0xee, 0xe5, 0x92, 0x14, 0x60, 0xe2, 0x5d, 0x9d, 0xec, 0x57, 0x10, 0xc1, 0x4c,
-	0xca, 0x53, 0x0e, 0x84, 0xed, 0x4b, 0xea, 0xd7, 0x6f, 0xed, 0x25, 0x30, 0x75, 0x80, 0x09, 0x72,
-	0x37, 0x36, 0x7d, 0x81, 0x5b, 0xfd, 0xdb, 0x31, 0xf4, 0x3e, 0xb7, 0x63, 0x26, 0x18, 0xe7, 0xfa,
-	0x37, 0xe4, 0x0e, 0x98, 0xa1, 0x4f, 0x56, 0x3c, 0xec, 0x4c, 0xd8, 0xb5, 0x8b, 0xc0, 0xa3, 0x57,
-	0x72, 0x26, 0x6c, 0xab, 0xa4, 0xb8, 0xe0, 0xad, 0xd9, 0xe7, 0xee, 0x32, 0xff, 0x20, 0x0c, 0xe6,
-	0xd8, 0xec, 0x14, 0xb5, 0x96, 0x66, 0x58, 0xf0, 0x63, 0x0e, 0x44, 0x0d, 0xdd, 0xec, 0x8f, 0x32,
-	0x37, 0x6e, 0x94, 0x55, 0x9b, 0xfb, 0xac, 0x27, 0x5e, 0xf6, 0xa0, 0x6e, 0x60, 0x43, 0x27, 0xc8,
-	0x68, 0x92, 0xa3, 0x81, 0x4e, 0x9e, 0xe3, 0xc9, 0x26, 0x1c, 0x18, 0xba, 0xe9, 0xce, 0xf7, 0x87,
-	0x1c, 0x80, 0x86, 0x76, 0xe8, 0x12, 0xa9, 0x4d, 0xd4, 0xd2, 0x71, 0x8d, 0xdd, 0x22, 0xab, 0x23,
-	0x53, 0x97, 0x65, 0x1f, 0x2c, 0xb4, 0x4d, 0xce, 0x7a, 0xe2, 0xda, 0x28, 0xd8, 0x97, 0x2b, 0xdb,
-	0xdf, 0xa3, 0x5e, 0xd2, 0x73, 0x7b, 0x2e, 0x79, 0x43, 0x3b, 0x74, 0xe5, 0x72, 0xcc, 0xf0, 0x2b,
-	0x0e, 0x38, 0x85, 0xf7, 0x87, 0xb3, 0x2f, 0xdc, 0xd8, 0xcb, 0xd8, 0x62, 0x39, 0x89, 0x17, 0xe2,
-	0x7d, 0x69, 0xad, 0x0d, 0x24, 0x1c, 0x71, 0x9c, 0x4c, 0xcc, 0x45, 0x43, 0x37, 0xfb, 0x5b, 0x83,
-	0x95, 0x22, 0x7d, 0x11, 0x04, 0xb1, 0x8a, 0xb3, 0x71, 0x58, 0x13, 0xfc, 0x1f, 0xb0, 0x0d, 0xe4,
-	0x0a, 0xcc, 0x8d, 0x13, 0xf8, 0x0e, 0x2b, 0x66, 0xc5, 0x87, 0xf3, 0x15, 0xb1, 0xe4, 0x5b, 0x78,
-	0x5e, 0x59, 0x63, 0xd4, 0xc6, 0x24, 0xfd, 0x84, 0x03, 0x2b, 0x83, 0x2a, 0xfd, 0x79, 0x8c, 0xfd,
-	0xa1, 0x0b, 0x2c, 0x8f, 0xab, 0xef, 0x60, 0xf0, 0x65, 0x24, 0xd0, 0x8c, 0xde, 0xe1, 0x4a, 0x73,
-	0xbb, 0xdc, 0x3f, 0xad, 0x78, 0x92, 0x94, 0xbe, 0x0f, 0xb3, 0x4d, 0xcb, 0x14, 0x7b, 0x08, 0xa6,
-	0xff, 0xdb, 0xc6, 0xad, 0xb6, 0xe1, 0x48, 0x15, 0xcb, 0x64, 0x26, 0xfb, 0x78, 0x3d, 0xeb, 0x89,
-	0x3c, 0xc5, 0x0f, 0x12, 0x54, 0x18, 0x23, 0xac, 0x82, 0x08, 0xd9, 0x6f, 0x21, 0x6b, 0x1f, 0x37,
-	0xa8, 0x02, 0xb1, 0x89, 0xd6, 0x1e, 0xa5, 0x5f, 0xec, 0x53, 0x78, 0x22, 0x0c, 0x78, 0x61, 0x87,
-	0x03, 0xf3, 0xf6, 0x2e, 0x54, 0x07, 0xa1, 0x42, 0x4e, 0xa8, 0xea, 0xc4, 0xa1, 0xe2, 0x7e, 0x1e,
-	0x9f, 0xe4, 0x97, 0x59, 0x13, 0xf8, 0x3c, 0x24, 0x65, 0xce, 0x36, 0x94, 0xfb, 0xc9, 0x3c, 0x06,
-	0xfc, 0xe0, 0x47, 0x61, 0xba, 0x86, 0x9d, 0x6c, 0xee, 0x4d, 0x9c, 0x4d, 0x62, 0x98, 0xc9, 0x53,
-	0xff, 0x42, 0xff, 0xec, 0x9f, 0x54, 0xea, 0x27, 0x1c, 0x58, 0x1c, 0xf8, 0x0f, 0xa4, 0x98, 0x72,
-	0x82, 0x17, 0x26, 0x0e, 0x7e, 0xe5, 0x02, 0x32, 0x4f, 0x7c, 0xd8, 0x3f, 0xee, 0xd7, 0x7e, 0xfd,
-	0x27, 0x0e, 0x00, 0xcf, 0xff, 0xa6, 0x6e, 0x80, 0x95, 0x4a, 0xa1, 0x2c, 0xab, 0x85, 0x62, 0x39,
-	0x57, 0xc8, 0xab, 0xf7, 0xf3, 0xa5, 0xa2, 0xbc, 0x9d, 0xdb, 0xc9, 0xc9, 0x59, 0x3e, 0x90, 0x58,
-	0xe8, 0x74, 0x93, 0x51, 0xea, 0x28, 0xdb, 0x84, 0x50, 0x02, 0x0b, 0x5e, 0xef, 0x07, 0x72, 0x89,
-	0xe7, 0x12, 0x73, 0x9d, 0x6e, 0x32, 0x42, 0xbd, 0x1e, 0x20, 0x0b, 0x5e, 0x07, 0x8b, 0x5e, 0x9f,
-	0x74, 0xa6, 0x54, 0x4e, 0xe7, 0xf2, 0x7c, 0x30, 0x71, 0xa9, 0xd3, 0x4d, 0xce, 0x51, 0xbf, 0x34,
-	0xbb, 0xb4, 0x93, 0x60, 0xde, 0xeb, 0x9b, 0x2f, 0xf0, 0xa1, 0x44, 0xac, 0xd3, 0x4d, 0xce, 0x52,
-	0xb7, 0x3c, 0x86, 0x9b, 0x20, 0xee, 0xf7, 0x50, 0x77, 0x73, 0xe5, 0xbb, 0x6a, 0x45, 0x2e, 0x17,
-	0xf8, 0x70, 0x62, 0xa9, 0xd3, 0x4d, 0xf2, 0xae, 0xaf, 0x7b, 0xc3, 0x26, 0xc2, 0x4f, 0x3f, 0x15,
-	0x02, 0xd7, 0xbf, 0x09, 0x82, 0x79, 0xff, 0x77, 0x3a, 0x4c, 0x81, 0xdf, 0x14, 0x95, 0x42, 0xb1,
-	0x50, 0x4a, 0xdf, 0x53, 0x4b, 0xe5, 0x74, 0xf9, 0x7e, 0x69, 0xa8, 0x60, 0xa7, 0x14, 0xea, 0x9c,
-	0xd7, 0x1b, 0xf0, 0x0e, 0x10, 0x86, 0xfd, 0xb3, 0x72, 0xb1, 0x50, 0xca, 0x95, 0xd5, 0xa2, 0xac,
-	0xe4, 0x0a, 0x59, 0x9e, 0x4b, 0xac, 0x74, 0xba, 0xc9, 0x45, 0x0a, 0xf1, 0xaf, 0xee, 0x3f, 0x83,
-	0x2b, 0xc3, 0xe0, 0x4a, 0xa1, 0x9c, 0xcb, 0xff, 0xdd, 0xc5, 0x06, 0x13, 0xcb, 0x9d, 0x6e, 0x12,
-	0x52, 0xac, 0x77, 0xfa, 0xe1, 0x0d, 0xb0, 0x3c, 0x0c, 0x2d, 0xa6, 0x4b, 0x25, 0x39, 0xcb, 0x87,
-	0x12, 0x7c, 0xa7, 0x9b, 0x8c, 0x51, 0x4c, 0x51, 0xb3, 0x2c, 0x54, 0x83, 0xb7, 0x40, 0x7c, 0xd8,
-	0x5b, 0x91, 0xff, 0x21, 0x6f, 0x97, 0xe5, 0x2c, 0x1f, 0x4e, 0xc0, 0x4e, 0x37, 0x39, 0x4f, 0xfd,
-	0x15, 0xf4, 0x6f, 0x54, 0x25, 0xe8, 0x42, 0xfe, 0x9d, 0x74, 0xee, 0x9e, 0x9c, 0xe5, 0xa7, 0xbc,
-	0xfc, 0x3b, 0x9a, 0xde, 0x40, 0x35, 0x2a, 0x67, 0x26, 0x7f, 0xfc, 0x46, 0x08, 0xbc, 0x7a, 0x23,
-	0x04, 0x9e, 0x9c, 0x08, 0x81, 0xe3, 0x13, 0x81, 0x7b, 0x79, 0x22, 0x70, 0x3f, 0x9e, 0x08, 0xdc,
-	0xb3, 0x53, 0x21, 0xf0, 0xf2, 0x54, 0x08, 0xbc, 0x3a, 0x15, 0x02, 0x0f, 0x7f, 0xf9, 0xa6, 0x38,
-	0x74, 0xfe, 0x54, 0xe1, 0x34, 0xf0, 0xde, 0xb4, 0xb3, 0x5c, 0x7f, 0xff, 0x73, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x81, 0xb1, 0xbe, 0x22, 0xc5, 0x10, 0x00, 0x00,
+	// 1700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcf, 0x6f, 0xe3, 0xc6,
+	0x15, 0x16, 0x25, 0xd9, 0x96, 0x47, 0xb2, 0xad, 0x8c, 0xbd, 0x36, 0x57, 0xdd, 0x90, 0x5c, 0xb6,
+	0x08, 0x8c, 0xc5, 0x46, 0x4e, 0xdc, 0xa2, 0x45, 0xbd, 0xe8, 0x0f, 0xd3, 0xe2, 0x76, 0x55, 0x6c,
+	0x2d, 0x95, 0x52, 0xbc, 0x48, 0x7a, 0x20, 0x68, 0x71, 0x56, 0x66, 0x23, 0x72, 0x14, 0x71, 0xe4,
+	0xb5, 0xdb, 0x4b, 0x8e, 0x0b, 0x1d, 0x8a, 0x3d, 0x06, 0x28, 0x04, 0x2c, 0x5a, 0xe4, 0xd2, 0x5e,
+	0x7a, 0xe8, 0x5f, 0xd0, 0xd3, 0xa2, 0x28, 0xd0, 0xa0, 0x97, 0x06, 0x3d, 0x28, 0xcd, 0x2e, 0x50,
+	0x04, 0x7b, 0xf4, 0x3f, 0xd0, 0x80, 0x33, 0x43, 0x8a, 0x94, 0x94, 0x28, 0xda, 0x93, 0xc9, 0x37,
+	0xef, 0xfb, 0xde, 0x7b, 0xdf, 0xbc, 0x79, 0x43, 0x19, 0xdc, 0x68, 0x61, 0xdf, 0xc5, 0xfe, 0x5e,
+	0x1b, 0x9f, 0xef, 0x9d, 0xbf, 0x7d, 0x8a, 0x88, 0xf5, 0x76, 0xf0, 0x5c, 0xee, 0xf6, 0x30, 0xc1,
+	0x10, 0xb2, 0xd5, 0x72, 0x60, 0xe1, 0xab, 0x25, 0x89, 0x23, 0x4e, 0x2d, 0x1f, 0x45, 0x90, 0x16,
+	0x76, 0x3c, 0x86, 0x29, 0x6d, 0xb5, 0x71, 0x1b, 0xd3, 0xc7, 0xbd, 0xe0, 0x89, 0x5b, 0xaf, 0x33,
+	0x94, 0xc9, 0x16, 0x38, 0x2d, 0x5b, 0x92, 0xdb, 0x18, 0xb7, 0x3b, 0x68, 0x8f, 0xbe, 0x9d, 0xf6,
+	0x1f, 0xee, 0x11, 0xc7, 0x45, 0x3e, 0xb1, 0xdc, 0x6e, 0x88, 0x9d, 0x74, 0xb0, 0xbc, 0x4b, 0xbe,
+	0x24, 0x4d, 0x2e, 0xd9, 0xfd, 0x9e, 0x45, 0x1c, 0xcc, 0x93, 0x51, 0x3f, 0x16, 0x00, 0x7c, 0x80,
+	0x9c, 0xf6, 0x19, 0x41, 0xf6, 0x09, 0x26, 0xa8, 0xd6, 0x0d, 0x16, 0xe1, 0xf7, 0xc1, 0x32, 0xa6,
+	0x4f, 0xa2, 0xa0, 0x08, 0xbb, 0xeb, 0xfb, 0x52, 0x79, 0xba, 0xd0, 0xf2, 0xd8, 0xdf, 0xe0, 0xde,
+	0xf0, 0x01, 0x58, 0x7e, 0x44, 0xd9, 0xc4, 0xb4, 0x22, 0xec, 0xae, 0x6a, 0x3f, 0x79, 0x36, 0x92,
+	0x53, 0xff, 0x19, 0xc9, 0x6f, 0xb4, 0x1d, 0x72, 0xd6, 0x3f, 0x2d, 0xb7, 0xb0, 0xcb, 0x6b, 0xe3,
+	0x7f, 0xde, 0xf4, 0xed, 0xf7, 0xf7, 0xc8, 0x65, 0x17, 0xf9, 0xe5, 0x0a, 0x6a, 0x5d, 0x8d, 0xe4,
+	0xb5, 0x4b, 0xcb, 0xed, 0x1c, 0xa8, 0x8c, 0x45, 0x35, 0x38, 0x9d, 0xfa, 0x1b, 0x50, 0x68, 0xa2,
+	0x0b, 0x52, 0xef, 0xe1, 0x2e, 0xf6, 0xad, 0x0e, 0xdc, 0x02, 0x4b, 0xc4, 0x21, 0x1d, 0x44, 0xf3,
+	0x5b, 0x35, 0xd8, 0x0b, 0x54, 0x40, 0xde, 0x46, 0x7e, 0xab, 0xe7, 0xb0, 0xdc, 0x69, 0x0e, 0x46,
+	0xdc, 0x04, 0x6f, 0x82, 0x82, 0xe3, 0x9b, 0xe8, 0xa2, 0x8b, 0x6c, 0x87, 0x20, 0x5b, 0xcc, 0x28,
+	0xc2, 0x6e, 0xce, 0xc8, 0x3b, 0xbe, 0x1e, 0x9a, 0x0e, 0x36, 0xbe, 0x78, 0x2a, 0x0b, 0xff, 0xfa,
+	0xeb, 0x9b, 0x2b, 0x47, 0xd8, 0x23, 0xc8, 0x23, 0xea, 0x3f, 0x05, 0xb0, 0x52, 0x41, 0x5d, 0xec,
+	0x3b, 0x04, 0xfe, 0x00, 0xe4, 0xbb, 0x3c, 0x07, 0xd3, 0xb1, 0x69, 0xf4, 0xac, 0xb6, 0x7d, 0x35,
+	0x92, 0x21, 0xcb, 0x3b, 0xb6, 0xa8, 0x1a, 0x20, 0x7c, 0xab, 0xda, 0xf0, 0x06, 0x58, 0xb5, 0x19,
+	0x07, 0xee, 0xf1, 0xc4, 0xc6, 0x06, 0xd8, 0x02, 0xcb, 0x96, 0x8b, 0xfb, 0x1e, 0x11, 0x33, 0x4a,
+	0x66, 0x37, 0xbf, 0x7f, 0x3d, 0xd4, 0x3b, 0x68, 0xa2, 0x48, 0xf0, 0x23, 0xec, 0x78, 0xda, 0x5b,
+	0x81, 0xa4, 0x7f, 0xfa, 0x4c, 0xde, 0xfd, 0x06, 0x92, 0x06, 0x00, 0xdf, 0xe0, 0xd4, 0x07, 0xb9,
+	0xc7, 0x4f, 0xe5, 0xd4, 0x17, 0x4f, 0xe5, 0x94, 0xfa, 0x24, 0x07, 0x72, 0x91, 0x94, 0xdf, 0x9b,
+	0x55, 0xd2, 0xe6, 0xcb, 0x91, 0x9c, 0x76, 0xec, 0xab, 0x91, 0xbc, 0xca, 0x0a, 0x9b, 0xac, 0xe7,
+	0x0e, 0x58, 0x69, 0x31, 0x7d, 0x68, 0x35, 0xf9, 0xfd, 0xad, 0x32, 0x6b, 0xb5, 0x72, 0xd8, 0x6a,
+	0xe5, 0x43, 0xef, 0x52, 0xcb, 0xff, 0x7d, 0x2c, 0xa4, 0x11, 0x22, 0xe0, 0x09, 0x58, 0xf6, 0x89,
+	0x45, 0xfa, 0x3e, 0xd5, 0x7f, 0x7d, 0x5f, 0x9d, 0xd5, 0x5e, 0x61, 0x82, 0x0d, 0xea, 0xa9, 0x95,
+	0xae, 0x46, 0xf2, 0xf6, 0x84, 0xc8, 0x8c, 0x44, 0x35, 0x38, 0x1b, 0xec, 0x02, 0xf8, 0xd0, 0xf1,
+	0xac, 0x8e, 0x49, 0xac, 0x4e, 0xe7, 0xd2, 0xec, 0x21, 0xbf, 0xdf, 0x21, 0x62, 0x96, 0xe6, 0x27,
+	0xcf, 0x8a, 0xd1, 0x0c, 0xfc, 0x0c, 0xea, 0xa6, 0xdd, 0x0c, 0x84, 0xbd, 0x1a, 0xc9, 0xd7, 0x59,
+	0x90, 0x69, 0x22, 0xd5, 0x28, 0x52, 0x63, 0x0c, 0x04, 0x7f, 0x05, 0xf2, 0x7e, 0xff, 0xd4, 0x75,
+	0x88, 0x19, 0x1c, 0x4a, 0x71, 0x89, 0x86, 0x2a, 0x4d, 0x49, 0xd1, 0x0c, 0x4f, 0xac, 0x26, 0xf1,
+	0x28, 0xbc, 0x5f, 0x62, 0x60, 0xf5, 0xc9, 0x67, 0xb2, 0x60, 0x00, 0x66, 0x09, 0x00, 0xd0, 0x01,
+	0x45, 0xde, 0x22, 0x26, 0xf2, 0x6c, 0x16, 0x61, 0x79, 0x6e, 0x84, 0x6f, 0xf3, 0x08, 0x3b, 0x2c,
+	0xc2, 0x24, 0x03, 0x0b, 0xb3, 0xce, 0xcd, 0xba, 0x67, 0xd3, 0x50, 0x8f, 0x05, 0xb0, 0x46, 0x30,
+	0xb1, 0x3a, 0x26, 0x5f, 0x10, 0x57, 0xe6, 0x35, 0xe2, 0x3d, 0x1e, 0x67, 0x8b, 0xc5, 0x49, 0xa0,
+	0xd5, 0x85, 0x1a, 0xb4, 0x40, 0xb1, 0xe1, 0x11, 0xeb, 0x80, 0xd7, 0xce, 0x31, 0x71, 0xbc, 0x76,
+	0xb0, 0xbd, 0x3d, 0x2e, 0x6c, 0x6e, 0x6e, 0xd9, 0xdf, 0xe1, 0xe9, 0x88, 0x2c, 0x9d, 0x29, 0x0a,
+	0x56, 0xf7, 0x06, 0xb3, 0x37, 0x02, 0x33, 0x2d, 0xfc, 0x21, 0xe0, 0xa6, 0xb1, 0xc4, 0xab, 0x73,
+	0x63, 0xa9, 0x3c, 0xd6, 0x76, 0x22, 0x56, 0x52, 0xe1, 0x35, 0x66, 0x0d, 0x05, 0x9e, 0x1c, 0x3c,
+	0x60, 0x6a, 0xf0, 0xc0, 0x1f, 0x81, 0x9c, 0x8b, 0x7c, 0xdf, 0x6a, 0x23, 0x5f, 0xcc, 0x2b, 0x99,
+	0xaf, 0x3f, 0x53, 0xbe, 0xfd, 0x7e, 0xf9, 0x17, 0x7e, 0xdb, 0x88, 0x20, 0xb0, 0x04, 0x72, 0xec,
+	0x60, 0xa0, 0x9e, 0x58, 0xa0, 0x03, 0x26, 0x7a, 0x0f, 0xd6, 0x5c, 0x44, 0x2c, 0xdb, 0x22, 0x96,
+	0xb8, 0xc6, 0xd6, 0xc2, 0xf7, 0x83, 0x6c, 0x30, 0xef, 0xd4, 0x67, 0x69, 0x90, 0x8f, 0x37, 0xf6,
+	0x4f, 0x41, 0xe6, 0x12, 0xf9, 0x6c, 0xbc, 0x6a, 0xe5, 0x05, 0xc6, 0x78, 0xd5, 0x23, 0x46, 0x00,
+	0x85, 0xf7, 0xc0, 0x8a, 0x75, 0xea, 0x13, 0xcb, 0xe1, 0x83, 0x78, 0x61, 0x96, 0x10, 0x0e, 0x7f,
+	0x0c, 0xd2, 0x1e, 0x16, 0x33, 0xaf, 0x44, 0x92, 0xf6, 0x30, 0x6c, 0x83, 0x82, 0x87, 0xcd, 0x47,
+	0x0e, 0x39, 0x33, 0xcf, 0x11, 0xc1, 0x74, 0x20, 0xac, 0x6a, 0xfa, 0x62, 0x4c, 0x57, 0x23, 0x79,
+	0x93, 0x6d, 0x77, 0x9c, 0x4b, 0x35, 0x80, 0x87, 0x1f, 0x38, 0xe4, 0xec, 0x04, 0x11, 0xcc, 0xa5,
+	0xfc, 0xbf, 0x00, 0xb2, 0xc1, 0xdd, 0xf8, 0xea, 0x97, 0xc5, 0x16, 0x58, 0x3a, 0xc7, 0x04, 0x85,
+	0x17, 0x05, 0x7b, 0x81, 0x07, 0xd1, 0xa5, 0x9c, 0xf9, 0x26, 0x97, 0xb2, 0x96, 0x16, 0x85, 0xe8,
+	0x62, 0xbe, 0x0b, 0x56, 0xd8, 0x93, 0x2f, 0x66, 0x69, 0x6b, 0xbd, 0x31, 0x0b, 0x3c, 0xfd, 0x25,
+	0xa0, 0x65, 0x03, 0x95, 0x8c, 0x10, 0x9c, 0x68, 0xa4, 0xa5, 0x89, 0x46, 0xca, 0x7d, 0x14, 0xde,
+	0x2f, 0x7f, 0x5e, 0x02, 0x6b, 0xfc, 0x38, 0xd7, 0xad, 0x9e, 0xe5, 0xfa, 0xf0, 0xf7, 0x02, 0xc8,
+	0xbb, 0x8e, 0x17, 0x4d, 0x17, 0x61, 0xde, 0x74, 0x31, 0x83, 0xb8, 0x2f, 0x47, 0xf2, 0xb5, 0x18,
+	0xea, 0x36, 0x76, 0x1d, 0x82, 0xdc, 0x2e, 0xb9, 0x1c, 0x6b, 0x18, 0x5b, 0x5e, 0x6c, 0xe8, 0x00,
+	0xd7, 0xf1, 0xc2, 0x91, 0xf3, 0x3b, 0x01, 0x40, 0xd7, 0xba, 0x08, 0x89, 0xcc, 0x2e, 0xea, 0x39,
+	0xd8, 0xe6, 0x17, 0xdb, 0xf5, 0xa9, 0x43, 0x58, 0xe1, 0xdf, 0x50, 0xac, 0x85, 0x5e, 0x8e, 0xe4,
+	0x1b, 0xd3, 0xe0, 0x44, 0xae, 0xfc, 0x4a, 0x99, 0xf6, 0x52, 0x3f, 0x0a, 0x46, 0x45, 0xd1, 0xb5,
+	0x2e, 0x42, 0xb9, 0xa8, 0x19, 0xfe, 0x4d, 0x00, 0xb4, 0xf0, 0x68, 0x5e, 0x44, 0xc2, 0xcd, 0xfd,
+	0x3e, 0xf0, 0x79, 0x4e, 0xf2, 0x4c, 0x7c, 0x22, 0xad, 0x1b, 0x63, 0x09, 0xa7, 0x1c, 0x17, 0x13,
+	0x73, 0xd3, 0x75, 0xbc, 0x68, 0x90, 0x85, 0xaa, 0x7e, 0x2c, 0x80, 0x6b, 0x51, 0x8b, 0xb7, 0x2c,
+	0xaf, 0x85, 0x3a, 0x26, 0x15, 0x8e, 0x1f, 0xc0, 0x0f, 0x16, 0xfb, 0x38, 0x0c, 0x6a, 0x9a, 0x49,
+	0x37, 0xab, 0xa6, 0x99, 0x8e, 0xaa, 0xb1, 0x19, 0xda, 0x8f, 0xa8, 0xd9, 0xa0, 0xd6, 0xbf, 0xa4,
+	0x41, 0xe1, 0x84, 0x0e, 0x6b, 0xde, 0xac, 0xbf, 0x05, 0x7c, 0x78, 0x87, 0x8d, 0x20, 0xcc, 0x6b,
+	0x84, 0x3b, 0x5c, 0xf4, 0x9d, 0x04, 0x2e, 0x91, 0xd8, 0x56, 0xe2, 0xae, 0x88, 0x6f, 0x7f, 0x81,
+	0xd9, 0xf8, 0xd6, 0xff, 0x41, 0x00, 0x3b, 0xe3, 0xdd, 0x48, 0xe6, 0x31, 0xb7, 0x21, 0x6b, 0x3c,
+	0x8f, 0x9b, 0x5f, 0xc1, 0x90, 0xc8, 0x48, 0x62, 0x19, 0x7d, 0x85, 0x2b, 0xcb, 0xed, 0x5a, 0xb4,
+	0x7a, 0x12, 0x4b, 0x52, 0xfd, 0x77, 0x96, 0xdf, 0x16, 0x5c, 0xb1, 0xf7, 0xc0, 0xf2, 0x07, 0x7d,
+	0xdc, 0xeb, 0xbb, 0x54, 0xaa, 0x82, 0xa6, 0x2d, 0xbc, 0xb5, 0x45, 0x86, 0x1f, 0x27, 0x68, 0x70,
+	0x46, 0xd8, 0x02, 0xab, 0xe4, 0xac, 0x87, 0xfc, 0x33, 0xdc, 0x61, 0x0a, 0x14, 0x34, 0x7d, 0x61,
+	0xfa, 0xcd, 0x88, 0x22, 0x16, 0x61, 0xcc, 0x0b, 0x07, 0x02, 0x58, 0x0f, 0xe6, 0xb9, 0x39, 0x0e,
+	0x95, 0xa1, 0xa1, 0x5a, 0x0b, 0x87, 0x12, 0x93, 0x3c, 0x09, 0xc9, 0xaf, 0xf1, 0x26, 0x48, 0x78,
+	0xa8, 0xc6, 0x5a, 0x60, 0x68, 0x46, 0xc9, 0x3c, 0x02, 0xc5, 0xf1, 0xa6, 0x70, 0x5d, 0xb3, 0x34,
+	0x9b, 0xfb, 0x0b, 0x67, 0x53, 0x9a, 0x64, 0x8a, 0xd5, 0xbf, 0x11, 0xad, 0xfd, 0x92, 0x49, 0xfd,
+	0xa1, 0x00, 0x36, 0xc7, 0xfe, 0x63, 0x29, 0x96, 0x68, 0xf0, 0xda, 0xc2, 0xc1, 0x5f, 0x9f, 0x41,
+	0x16, 0x8b, 0x0f, 0xa3, 0xe5, 0xa8, 0xf6, 0x5b, 0xff, 0x13, 0x00, 0x88, 0xfd, 0x10, 0xbd, 0x0d,
+	0x76, 0x4e, 0x6a, 0x4d, 0xdd, 0xac, 0xd5, 0x9b, 0xd5, 0xda, 0xb1, 0xf9, 0xce, 0x71, 0xa3, 0xae,
+	0x1f, 0x55, 0xef, 0x56, 0xf5, 0x4a, 0x31, 0x55, 0xda, 0x18, 0x0c, 0x95, 0x3c, 0x73, 0xd4, 0x03,
+	0x42, 0xa8, 0x82, 0x8d, 0xb8, 0xf7, 0xbb, 0x7a, 0xa3, 0x28, 0x94, 0xd6, 0x06, 0x43, 0x65, 0x95,
+	0x79, 0xbd, 0x8b, 0x7c, 0x78, 0x0b, 0x6c, 0xc6, 0x7d, 0x0e, 0xb5, 0x46, 0xf3, 0xb0, 0x7a, 0x5c,
+	0x4c, 0x97, 0x5e, 0x1b, 0x0c, 0x95, 0x35, 0xe6, 0x77, 0xc8, 0x3f, 0x3c, 0x14, 0xb0, 0x1e, 0xf7,
+	0x3d, 0xae, 0x15, 0x33, 0xa5, 0xc2, 0x60, 0xa8, 0xe4, 0x98, 0xdb, 0x31, 0x86, 0xfb, 0x40, 0x4c,
+	0x7a, 0x98, 0x0f, 0xaa, 0xcd, 0x7b, 0xe6, 0x89, 0xde, 0xac, 0x15, 0xb3, 0xa5, 0xad, 0xc1, 0x50,
+	0x29, 0x86, 0xbe, 0xe1, 0x57, 0x42, 0x29, 0xfb, 0xf8, 0x8f, 0x52, 0xea, 0xd6, 0x3f, 0xd2, 0x60,
+	0x3d, 0xf9, 0x13, 0x07, 0x96, 0xc1, 0xb7, 0xea, 0x46, 0xad, 0x5e, 0x6b, 0x1c, 0xde, 0x37, 0x1b,
+	0xcd, 0xc3, 0xe6, 0x3b, 0x8d, 0x89, 0x82, 0x69, 0x29, 0xcc, 0xf9, 0xd8, 0xe9, 0xc0, 0x3b, 0x40,
+	0x9a, 0xf4, 0xaf, 0xe8, 0xf5, 0x5a, 0xa3, 0xda, 0x34, 0xeb, 0xba, 0x51, 0xad, 0x55, 0x8a, 0x42,
+	0x69, 0x67, 0x30, 0x54, 0x36, 0x19, 0x24, 0x79, 0xc5, 0xfc, 0x10, 0xbc, 0x3e, 0x09, 0x3e, 0xa9,
+	0x35, 0xab, 0xc7, 0x3f, 0x0b, 0xb1, 0xe9, 0xd2, 0xf6, 0x60, 0xa8, 0x40, 0x86, 0x8d, 0x9f, 0x7e,
+	0x78, 0x1b, 0x6c, 0x4f, 0x42, 0xeb, 0x87, 0x8d, 0x86, 0x5e, 0x29, 0x66, 0x4a, 0xc5, 0xc1, 0x50,
+	0x29, 0x30, 0x4c, 0xdd, 0xf2, 0x7d, 0x64, 0xc3, 0xb7, 0x80, 0x38, 0xe9, 0x6d, 0xe8, 0x3f, 0xd7,
+	0x8f, 0x9a, 0x7a, 0xa5, 0x98, 0x2d, 0xc1, 0xc1, 0x50, 0x59, 0x67, 0xfe, 0x06, 0xfa, 0x35, 0x6a,
+	0x11, 0x34, 0x93, 0xff, 0xee, 0x61, 0xf5, 0xbe, 0x5e, 0x29, 0x2e, 0xc5, 0xf9, 0xef, 0x5a, 0x4e,
+	0x07, 0xd9, 0x4c, 0x4e, 0xed, 0xf8, 0xd9, 0xe7, 0x52, 0xea, 0xd3, 0xcf, 0xa5, 0xd4, 0x87, 0xcf,
+	0xa5, 0xd4, 0xb3, 0xe7, 0x92, 0xf0, 0xc9, 0x73, 0x49, 0xf8, 0xef, 0x73, 0x49, 0x78, 0xf2, 0x42,
+	0x4a, 0x7d, 0xf2, 0x42, 0x4a, 0x7d, 0xfa, 0x42, 0x4a, 0xbd, 0xf7, 0xf5, 0x37, 0xda, 0x05, 0xfd,
+	0x2f, 0x0f, 0x6d, 0xe0, 0xd3, 0x65, 0x3a, 0x5c, 0xbf, 0xfb, 0xe5, 0x00, 0xfe, 0xa1, 0x29, 0x20,
+	0x00, 0x12, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -708,6 +730,20 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if this.IsExpedited != that1.IsExpedited {
 		return false
 	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	if this.Proposer != that1.Proposer {
+		return false
+	}
+	if this.Metadata != that1.Metadata {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -897,6 +933,34 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.IsExpedited {
 		i--
 		if m.IsExpedited {
@@ -1071,6 +1135,13 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Options) > 0 {
 		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1125,6 +1196,16 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ProposalCancelRatio.Size()
+		i -= size
+		if _, err := m.ProposalCancelRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.MinExpeditedDeposit) > 0 {
 		for iNdEx := len(m.MinExpeditedDeposit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1378,6 +1459,20 @@ func (m *Proposal) Size() (n int) {
 	if m.IsExpedited {
 		n += 2
 	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1420,6 +1515,10 @@ func (m *Vote) Size() (n int) {
 			n += 1 + l + sovGov(uint64(l))
 		}
 	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1443,6 +1542,8 @@ func (m *DepositParams) Size() (n int) {
 			n += 1 + l + sovGov(uint64(l))
 		}
 	}
+	l = m.ProposalCancelRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -2178,6 +2279,104 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsExpedited = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2518,6 +2717,38 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2669,6 +2900,40 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposalCancelRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -186,7 +186,7 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 //
 //nolint:interfacer
 func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), option}
+	return &MsgVote{proposalID, voter.String(), option, ""}
 }
 
 // Route implements Msg
@@ -205,7 +205,7 @@ func (msg MsgVote) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidVote, msg.Option.String())
 	}
 
-	return nil
+	return ValidateMetadata(msg.Metadata)
 }
 
 // String implements the Stringer interface
@@ -230,7 +230,7 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 //
 //nolint:interfacer
 func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVoteWeighted {
-	return &MsgVoteWeighted{proposalID, voter.String(), options}
+	return &MsgVoteWeighted{proposalID, voter.String(), options, ""}
 }
 
 // Route implements Msg
@@ -270,7 +270,7 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidVote, "Total weight lower than 1.00")
 	}
 
-	return nil
+	return ValidateMetadata(msg.Metadata)
 }
 
 // String implements the Stringer interface
@@ -116,6 +116,12 @@ func TestMsgVote(t *testing.T) {
 			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
 		}
 	}
+
+	msg := NewMsgVote(addrs[0], 0, OptionYes)
+	msg.Metadata = "ipfs://vote"
+	require.NoError(t, msg.ValidateBasic())
+	msg.Metadata = strings.Repeat("#", MaxMetadataLength+1)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrMetadataTooLong)
 }
 
 // test ValidateBasic for MsgVoteWeighted
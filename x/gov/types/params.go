@@ -25,6 +25,7 @@ var (
 	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
 	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
 	DefaultVetoThreshold             = sdk.NewDecWithPrec(334, 3)
+	DefaultProposalCancelRatio       = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store key
@@ -44,11 +45,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, minExpeditedDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, minExpeditedDeposit sdk.Coins, maxDepositPeriod time.Duration, proposalCancelRatio sdk.Dec) DepositParams {
 	return DepositParams{
 		MinDeposit:          minDeposit,
 		MaxDepositPeriod:    maxDepositPeriod,
 		MinExpeditedDeposit: minExpeditedDeposit,
+		ProposalCancelRatio: proposalCancelRatio,
 	}
 }
 
@@ -58,6 +60,7 @@ func DefaultDepositParams() DepositParams {
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
+		DefaultProposalCancelRatio,
 	)
 }
 
@@ -79,7 +82,8 @@ func (dp DepositParams) GetMinimumDeposit(isExpedited bool) sdk.Coins {
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
 	return dp.MinDeposit.IsEqual(dp2.MinDeposit) &&
 		dp.MinExpeditedDeposit.IsEqual(dp2.MinExpeditedDeposit) &&
-		dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+		dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ProposalCancelRatio.Equal(dp2.ProposalCancelRatio)
 }
 
 func validateDepositParams(i interface{}) error {
@@ -100,6 +104,15 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if v.ProposalCancelRatio.IsNil() {
+		return fmt.Errorf("proposal cancel ratio cannot be nil")
+	}
+	if v.ProposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio cannot be negative: %s", v.ProposalCancelRatio)
+	}
+	if v.ProposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", v.ProposalCancelRatio)
+	}
 
 	return nil
 }
@@ -120,7 +120,8 @@ func TestDepositParamsGetCoins(t *testing.T) {
 			depositParams: types.NewDepositParams(
 				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1500000))),
 				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1600000))),
-				types.DefaultPeriod),
+				types.DefaultPeriod,
+				types.DefaultProposalCancelRatio),
 			expectedValue: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1600000))),
 			isExpedited:   true,
 		},
@@ -129,7 +130,8 @@ func TestDepositParamsGetCoins(t *testing.T) {
 			depositParams: types.NewDepositParams(
 				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1500000))),
 				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1600000))),
-				types.DefaultPeriod),
+				types.DefaultPeriod,
+				types.DefaultProposalCancelRatio),
 			expectedValue: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1500000))),
 			isExpedited:   false,
 		},
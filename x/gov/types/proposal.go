@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // DefaultStartingProposalID is 1
@@ -42,6 +43,28 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 	return p, nil
 }
 
+// NewProposalWithMessages creates a new Proposal instance executing the messages
+// with the gov module account as signer once passed
+func NewProposalWithMessages(messages []sdk.Msg, id uint64, submitTime, depositEndTime time.Time, isExpedited bool) (Proposal, error) {
+	msgs, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return Proposal{}, err
+	}
+
+	p := Proposal{
+		Messages:         msgs,
+		ProposalId:       id,
+		Status:           StatusDepositPeriod,
+		FinalTallyResult: EmptyTallyResult(),
+		TotalDeposit:     sdk.NewCoins(),
+		SubmitTime:       submitTime,
+		DepositEndTime:   depositEndTime,
+		IsExpedited:      isExpedited,
+	}
+
+	return p, nil
+}
+
 // String implements stringer interface
 func (p Proposal) String() string {
 	out, _ := yaml.Marshal(p)
@@ -57,6 +80,27 @@ func (p Proposal) GetContent() Content {
 	return content
 }
 
+// SetContent sets the legacy Content of the proposal
+func (p *Proposal) SetContent(content Content) error {
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%T does not implement proto.Message", content)
+	}
+
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+
+	p.Content = any
+	return nil
+}
+
+// GetMessages returns the messages executed by the proposal
+func (p Proposal) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
+}
+
 func (p Proposal) ProposalType() string {
 	content := p.GetContent()
 	if content == nil {
@@ -84,7 +128,11 @@ func (p Proposal) GetTitle() string {
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	var content Content
-	return unpacker.UnpackAny(p.Content, &content)
+	if err := unpacker.UnpackAny(p.Content, &content); err != nil {
+		return err
+	}
+
+	return sdktx.UnpackInterfaces(unpacker, p.Messages)
 }
 
 // Proposals is an array of proposal
@@ -123,6 +123,7 @@ type MsgVote struct {
 	ProposalId uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
 	Voter      string     `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
 	Option     VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	Metadata   string     `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *MsgVote) Reset()      { *m = MsgVote{} }
@@ -201,6 +202,7 @@ type MsgVoteWeighted struct {
 	ProposalId uint64               `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      string               `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
 	Options    []WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
+	Metadata   string               `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
@@ -364,50 +366,51 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/tx.proto", fileDescriptor_3c053992595e3dce) }
 
 var fileDescriptor_3c053992595e3dce = []byte{
-	// 685 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0xd3, 0x48,
-	0x14, 0xb6, 0x93, 0x6c, 0xd3, 0x4e, 0xaa, 0x76, 0x3b, 0x8a, 0xba, 0x89, 0x5b, 0xd9, 0x59, 0xaf,
-	0x5a, 0x45, 0x5a, 0xd5, 0xa6, 0x41, 0x02, 0xa9, 0x9c, 0x48, 0xa1, 0x02, 0xa4, 0x08, 0x30, 0x12,
-	0x48, 0x5c, 0x82, 0x13, 0x4f, 0xdd, 0x11, 0x89, 0xc7, 0xca, 0x4c, 0xa2, 0xe6, 0xc6, 0x91, 0x13,
-	0xe2, 0xc8, 0xb1, 0x67, 0x6e, 0x20, 0x4e, 0xfc, 0x82, 0x8a, 0x53, 0x8f, 0x1c, 0x50, 0x40, 0xed,
-	0x05, 0x10, 0xa7, 0xfe, 0x02, 0x64, 0xcf, 0x8c, 0x5b, 0x5a, 0x37, 0x2a, 0xa8, 0xa7, 0x64, 0xde,
-	0xf7, 0xbe, 0xe7, 0xf7, 0x7d, 0xf3, 0x9e, 0x0d, 0x16, 0xda, 0x84, 0x76, 0x09, 0xb5, 0x7d, 0x32,
-	0xb0, 0x07, 0xab, 0x2d, 0xc4, 0xdc, 0x55, 0x9b, 0x6d, 0x5b, 0x61, 0x8f, 0x30, 0x02, 0x21, 0x07,
-	0x2d, 0x9f, 0x0c, 0x2c, 0x01, 0x6a, 0xba, 0x20, 0xb4, 0x5c, 0x8a, 0x12, 0x46, 0x9b, 0xe0, 0x80,
-	0x73, 0xb4, 0xc5, 0x94, 0x82, 0x11, 0x9f, 0xa3, 0x65, 0x8e, 0x36, 0xe3, 0x93, 0x2d, 0xca, 0x73,
-	0xa8, 0xe8, 0x13, 0x9f, 0xf0, 0x78, 0xf4, 0x4f, 0x12, 0x7c, 0x42, 0xfc, 0x0e, 0xb2, 0xe3, 0x53,
-	0xab, 0xbf, 0x69, 0xbb, 0xc1, 0x90, 0x43, 0xe6, 0xdb, 0x0c, 0x98, 0x6b, 0x50, 0xff, 0x41, 0xbf,
-	0xd5, 0xc5, 0xec, 0x5e, 0x8f, 0x84, 0x84, 0xba, 0x1d, 0x78, 0x0d, 0xe4, 0xdb, 0x24, 0x60, 0x28,
-	0x60, 0x25, 0xb5, 0xa2, 0x56, 0x0b, 0xb5, 0xa2, 0xc5, 0x4b, 0x58, 0xb2, 0x84, 0x75, 0x3d, 0x18,
-	0xd6, 0x0b, 0x1f, 0xde, 0xad, 0xe4, 0xd7, 0x79, 0xa2, 0x23, 0x19, 0xf0, 0x85, 0x0a, 0x66, 0x71,
-	0x80, 0x19, 0x76, 0x3b, 0x4d, 0x0f, 0x85, 0x84, 0x62, 0x56, 0xca, 0x54, 0xb2, 0xd5, 0x42, 0xad,
-	0x6c, 0x89, 0x66, 0x23, 0xdd, 0xd2, 0x0c, 0x6b, 0x9d, 0xe0, 0xa0, 0x7e, 0x67, 0x77, 0x64, 0x28,
-	0x87, 0x23, 0x63, 0x7e, 0xe8, 0x76, 0x3b, 0x6b, 0xe6, 0x09, 0xbe, 0xf9, 0xfa, 0xb3, 0x51, 0xf5,
-	0x31, 0xdb, 0xea, 0xb7, 0xac, 0x36, 0xe9, 0x0a, 0xcd, 0xe2, 0x67, 0x85, 0x7a, 0x4f, 0x6d, 0x36,
-	0x0c, 0x11, 0x8d, 0x4b, 0x51, 0x67, 0x46, 0xb0, 0x6f, 0x70, 0x32, 0xd4, 0xc0, 0x64, 0x18, 0x2b,
-	0x43, 0xbd, 0x52, 0xb6, 0xa2, 0x56, 0xa7, 0x9c, 0xe4, 0x0c, 0xff, 0x05, 0xd3, 0x98, 0x36, 0xd1,
-	0x76, 0x88, 0x3c, 0xcc, 0x90, 0x57, 0xca, 0x55, 0xd4, 0xea, 0xa4, 0x53, 0xc0, 0xf4, 0xa6, 0x0c,
-	0xad, 0xfd, 0xfd, 0x7c, 0xc7, 0x50, 0x5e, 0xed, 0x18, 0xca, 0xd7, 0x1d, 0x43, 0x79, 0xf6, 0xa9,
-	0xa2, 0x98, 0x6d, 0x50, 0x3e, 0xe5, 0x99, 0x83, 0x68, 0x48, 0x02, 0x8a, 0xe0, 0x06, 0x28, 0x84,
-	0x22, 0xd6, 0xc4, 0x5e, 0xec, 0x5f, 0xae, 0xbe, 0xf4, 0x7d, 0x64, 0x1c, 0x0f, 0x1f, 0x8e, 0x0c,
-	0xc8, 0x95, 0x1e, 0x0b, 0x9a, 0x0e, 0x90, 0xa7, 0xdb, 0x9e, 0xf9, 0x46, 0x05, 0xf9, 0x06, 0xf5,
-	0x1f, 0x12, 0x76, 0x61, 0x35, 0x61, 0x11, 0xfc, 0x35, 0x20, 0x0c, 0xf5, 0x4a, 0x99, 0xd8, 0x06,
-	0x7e, 0x80, 0x57, 0xc0, 0x04, 0x09, 0x19, 0x26, 0x41, 0xec, 0xce, 0x4c, 0x4d, 0xb7, 0x4e, 0x8f,
-	0xac, 0x15, 0xf5, 0x71, 0x37, 0xce, 0x72, 0x44, 0x76, 0x8a, 0x31, 0x73, 0x60, 0x56, 0xb4, 0x2c,
-	0xed, 0x30, 0xdf, 0xab, 0x49, 0xec, 0x11, 0xc2, 0xfe, 0x16, 0x43, 0x1e, 0xbc, 0x9a, 0x26, 0x67,
-	0xfe, 0x8f, 0xfb, 0xdf, 0x00, 0x79, 0xde, 0x11, 0x2d, 0x65, 0xe3, 0x39, 0x5b, 0x4e, 0x13, 0x20,
-	0x9f, 0x7e, 0x24, 0xa4, 0x9e, 0x8b, 0x86, 0xce, 0x91, 0xe4, 0x14, 0x3d, 0x65, 0xf0, 0xcf, 0x89,
-	0xde, 0x13, 0x5d, 0xdf, 0x54, 0x00, 0x1a, 0xd4, 0x97, 0x33, 0x76, 0x51, 0x37, 0xb4, 0x08, 0xa6,
-	0xc4, 0xcc, 0x13, 0xa9, 0xf2, 0x28, 0x00, 0xdb, 0x60, 0xc2, 0xed, 0x92, 0x7e, 0xc0, 0x84, 0xd0,
-	0x31, 0x0b, 0x75, 0x29, 0xd2, 0xf6, 0x5b, 0x6b, 0x23, 0x4a, 0xa7, 0xd8, 0x50, 0x04, 0xf0, 0x48,
-	0xaa, 0x74, 0xa0, 0xf6, 0x23, 0x03, 0xb2, 0x0d, 0xea, 0xc3, 0x4d, 0x30, 0x73, 0xe2, 0xf5, 0xb1,
-	0x94, 0xe6, 0xff, 0xa9, 0x8d, 0xd1, 0x56, 0xce, 0x95, 0x96, 0x2c, 0xd6, 0x2d, 0x90, 0x8b, 0x97,
-	0x61, 0xe1, 0x0c, 0x5a, 0x04, 0x6a, 0xff, 0x8d, 0x01, 0x93, 0x4a, 0x4f, 0xc0, 0xf4, 0x2f, 0xf3,
-	0x38, 0x8e, 0x24, 0x93, 0xb4, 0xff, 0xcf, 0x91, 0x94, 0x3c, 0xe1, 0x3e, 0xc8, 0xcb, 0xc9, 0xd0,
-	0xcf, 0xe0, 0x09, 0x5c, 0x5b, 0x1e, 0x8f, 0xcb, 0x92, 0xf5, 0xfa, 0xee, 0xbe, 0xae, 0xee, 0xed,
-	0xeb, 0xea, 0x97, 0x7d, 0x5d, 0x7d, 0x79, 0xa0, 0x2b, 0x7b, 0x07, 0xba, 0xf2, 0xf1, 0x40, 0x57,
-	0x1e, 0x8f, 0xbf, 0xe2, 0xed, 0xf8, 0x2b, 0x12, 0x5f, 0x74, 0x6b, 0x22, 0x7e, 0x7d, 0x5f, 0xfe,
-	0x19, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x36, 0x6a, 0xce, 0xb1, 0x06, 0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x6b, 0x13, 0x4f,
+	0x14, 0xde, 0x4d, 0xf2, 0x6b, 0xda, 0x49, 0x69, 0x7f, 0x1d, 0x42, 0x4d, 0xb6, 0x65, 0x37, 0xae,
+	0xb4, 0x04, 0xa4, 0xbb, 0x36, 0x82, 0x42, 0x3d, 0x99, 0x6a, 0x51, 0x21, 0xa8, 0x2b, 0x28, 0x78,
+	0x89, 0x9b, 0xec, 0x74, 0x3b, 0x98, 0xec, 0x2c, 0x99, 0x49, 0x68, 0x6e, 0x1e, 0x3d, 0x89, 0x47,
+	0x8f, 0x3d, 0x7b, 0x14, 0xff, 0x88, 0x22, 0x08, 0x3d, 0x89, 0x07, 0x89, 0xd2, 0x5e, 0x54, 0x3c,
+	0xf5, 0x2f, 0x90, 0x9d, 0x9d, 0xd9, 0xd6, 0x36, 0x8d, 0xad, 0xf4, 0x94, 0xbc, 0xf7, 0xbd, 0xef,
+	0xed, 0x7c, 0xdf, 0xbc, 0xb7, 0x0b, 0xe6, 0x9a, 0x84, 0xb6, 0x09, 0xb5, 0x7d, 0xd2, 0xb3, 0x7b,
+	0xcb, 0x0d, 0xc4, 0xdc, 0x65, 0x9b, 0x6d, 0x5a, 0x61, 0x87, 0x30, 0x02, 0x61, 0x0c, 0x5a, 0x3e,
+	0xe9, 0x59, 0x02, 0xd4, 0x74, 0x41, 0x68, 0xb8, 0x14, 0x25, 0x8c, 0x26, 0xc1, 0x41, 0xcc, 0xd1,
+	0xe6, 0x87, 0x34, 0x8c, 0xf8, 0x31, 0x5a, 0x8c, 0xd1, 0x3a, 0x8f, 0x6c, 0xd1, 0x3e, 0x86, 0xf2,
+	0x3e, 0xf1, 0x49, 0x9c, 0x8f, 0xfe, 0x49, 0x82, 0x4f, 0x88, 0xdf, 0x42, 0x36, 0x8f, 0x1a, 0xdd,
+	0x75, 0xdb, 0x0d, 0xfa, 0x31, 0x64, 0xbe, 0x4b, 0x81, 0x99, 0x1a, 0xf5, 0x1f, 0x75, 0x1b, 0x6d,
+	0xcc, 0x1e, 0x74, 0x48, 0x48, 0xa8, 0xdb, 0x82, 0x37, 0x40, 0xb6, 0x49, 0x02, 0x86, 0x02, 0x56,
+	0x50, 0x4b, 0x6a, 0x39, 0x57, 0xc9, 0x5b, 0x71, 0x0b, 0x4b, 0xb6, 0xb0, 0x6e, 0x06, 0xfd, 0x6a,
+	0xee, 0xc3, 0xfb, 0xa5, 0xec, 0x6a, 0x5c, 0xe8, 0x48, 0x06, 0x7c, 0xa5, 0x82, 0x69, 0x1c, 0x60,
+	0x86, 0xdd, 0x56, 0xdd, 0x43, 0x21, 0xa1, 0x98, 0x15, 0x52, 0xa5, 0x74, 0x39, 0x57, 0x29, 0x5a,
+	0xe2, 0xb0, 0x91, 0x6e, 0x69, 0x86, 0xb5, 0x4a, 0x70, 0x50, 0xbd, 0xb7, 0x3d, 0x30, 0x94, 0xfd,
+	0x81, 0x31, 0xdb, 0x77, 0xdb, 0xad, 0x15, 0xf3, 0x08, 0xdf, 0x7c, 0xfb, 0xd5, 0x28, 0xfb, 0x98,
+	0x6d, 0x74, 0x1b, 0x56, 0x93, 0xb4, 0x85, 0x66, 0xf1, 0xb3, 0x44, 0xbd, 0xe7, 0x36, 0xeb, 0x87,
+	0x88, 0xf2, 0x56, 0xd4, 0x99, 0x12, 0xec, 0x5b, 0x31, 0x19, 0x6a, 0x60, 0x3c, 0xe4, 0xca, 0x50,
+	0xa7, 0x90, 0x2e, 0xa9, 0xe5, 0x09, 0x27, 0x89, 0xe1, 0x45, 0x30, 0x89, 0x69, 0x1d, 0x6d, 0x86,
+	0xc8, 0xc3, 0x0c, 0x79, 0x85, 0x4c, 0x49, 0x2d, 0x8f, 0x3b, 0x39, 0x4c, 0x6f, 0xcb, 0xd4, 0xca,
+	0xff, 0x2f, 0xb7, 0x0c, 0xe5, 0xcd, 0x96, 0xa1, 0x7c, 0xdf, 0x32, 0x94, 0x17, 0x5f, 0x4a, 0x8a,
+	0xd9, 0x04, 0xc5, 0x63, 0x9e, 0x39, 0x88, 0x86, 0x24, 0xa0, 0x08, 0xae, 0x81, 0x5c, 0x28, 0x72,
+	0x75, 0xec, 0x71, 0xff, 0x32, 0xd5, 0x85, 0x9f, 0x03, 0xe3, 0x70, 0x7a, 0x7f, 0x60, 0xc0, 0x58,
+	0xe9, 0xa1, 0xa4, 0xe9, 0x00, 0x19, 0xdd, 0xf5, 0xcc, 0x8f, 0x2a, 0xc8, 0xd6, 0xa8, 0xff, 0x98,
+	0xb0, 0x73, 0xeb, 0x09, 0xf3, 0xe0, 0xbf, 0x1e, 0x61, 0xa8, 0x53, 0x48, 0x71, 0x1b, 0xe2, 0x00,
+	0x5e, 0x03, 0x63, 0x24, 0x64, 0x98, 0x04, 0xdc, 0x9d, 0xa9, 0x8a, 0x6e, 0x1d, 0x1f, 0x59, 0x2b,
+	0x3a, 0xc7, 0x7d, 0x5e, 0xe5, 0x88, 0xea, 0xc8, 0xd7, 0x36, 0x62, 0xae, 0xe7, 0x32, 0x97, 0xfb,
+	0x36, 0xe1, 0x24, 0xf1, 0x10, 0xd3, 0x66, 0xc0, 0xb4, 0x90, 0x23, 0xad, 0x32, 0x3f, 0xa9, 0x49,
+	0xee, 0x09, 0xc2, 0xfe, 0x06, 0x43, 0x1e, 0xbc, 0x3e, 0x4c, 0xea, 0xec, 0x3f, 0x6b, 0x5b, 0x03,
+	0xd9, 0xf8, 0xb4, 0xb4, 0x90, 0xe6, 0x33, 0xb8, 0x38, 0x4c, 0x9c, 0x7c, 0xfa, 0x81, 0xc8, 0x6a,
+	0x26, 0x1a, 0x48, 0x47, 0x92, 0xcf, 0xa8, 0xb5, 0x08, 0x2e, 0x1c, 0xd1, 0x95, 0x68, 0xfe, 0xa1,
+	0x02, 0x50, 0xa3, 0xbe, 0x9c, 0xcd, 0xf3, 0xba, 0xd9, 0x79, 0x30, 0x21, 0x76, 0x85, 0x48, 0x07,
+	0x0e, 0x12, 0xb0, 0x09, 0xc6, 0xdc, 0x36, 0xe9, 0x06, 0xac, 0x90, 0xfe, 0xdb, 0x22, 0x5e, 0x89,
+	0x74, 0x9f, 0x69, 0xdd, 0x44, 0xeb, 0x21, 0x36, 0xe4, 0x01, 0x3c, 0x90, 0x2a, 0x1d, 0xa8, 0xfc,
+	0x4a, 0x81, 0x74, 0x8d, 0xfa, 0x70, 0x1d, 0x4c, 0x1d, 0x79, 0xed, 0x2c, 0x0c, 0xbb, 0x9b, 0x63,
+	0x9b, 0xa6, 0x2d, 0x9d, 0xaa, 0x2c, 0x59, 0xc8, 0x3b, 0x20, 0xc3, 0x97, 0x68, 0xee, 0x04, 0x5a,
+	0x04, 0x6a, 0x97, 0x46, 0x80, 0x49, 0xa7, 0x67, 0x60, 0xf2, 0x8f, 0x59, 0x1d, 0x45, 0x92, 0x45,
+	0xda, 0xe5, 0x53, 0x14, 0x25, 0x4f, 0x78, 0x08, 0xb2, 0x72, 0x32, 0xf4, 0x13, 0x78, 0x02, 0xd7,
+	0x16, 0x47, 0xe3, 0xb2, 0x65, 0xb5, 0xba, 0xbd, 0xab, 0xab, 0x3b, 0xbb, 0xba, 0xfa, 0x6d, 0x57,
+	0x57, 0x5f, 0xef, 0xe9, 0xca, 0xce, 0x9e, 0xae, 0x7c, 0xde, 0xd3, 0x95, 0xa7, 0xa3, 0xaf, 0x78,
+	0x93, 0x7f, 0x7d, 0xf8, 0x45, 0x37, 0xc6, 0xf8, 0x6b, 0xff, 0xea, 0xef, 0x01, 0x00, 0x8e, 0x49,
+	0xba, 0x9a, 0xe9, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -724,6 +727,13 @@ func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Option != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Option))
 		i--
@@ -787,6 +797,13 @@ func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Options) > 0 {
 		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -976,6 +993,10 @@ func (m *MsgVote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovTx(uint64(m.Option))
 	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1007,6 +1028,10 @@ func (m *MsgVoteWeighted) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1396,6 +1421,38 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1581,6 +1638,38 @@ func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,223 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Governance v1 message types
+const (
+	TypeMsgSubmitProposal    = "submit_proposal"
+	TypeMsgExecLegacyContent = "exec_legacy_content"
+	TypeMsgCancelProposal    = "cancel_proposal"
+)
+
+var (
+	_, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgExecLegacyContent{}, &MsgCancelProposal{}
+	_, _    types.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+)
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal.
+//
+//nolint:interfacer
+func NewMsgSubmitProposal(messages []sdk.Msg, initialDeposit sdk.Coins, proposer sdk.AccAddress, metadata string) (*MsgSubmitProposal, error) {
+	return NewMsgSubmitProposalWithExpedite(messages, initialDeposit, proposer, metadata, false)
+}
+
+// NewMsgSubmitProposalWithExpedite creates a new MsgSubmitProposal with expedited or not.
+//
+//nolint:interfacer
+func NewMsgSubmitProposalWithExpedite(messages []sdk.Msg, initialDeposit sdk.Coins, proposer sdk.AccAddress, metadata string, isExpedited bool) (*MsgSubmitProposal, error) {
+	m := &MsgSubmitProposal{
+		InitialDeposit: initialDeposit,
+		Proposer:       proposer.String(),
+		IsExpedited:    isExpedited,
+		Metadata:       metadata,
+	}
+
+	if err := m.SetMsgs(messages); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetMsgs unpacks the messages of the proposal.
+func (m *MsgSubmitProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "sdk.MsgProposal")
+}
+
+// SetMsgs packs the messages of the proposal.
+func (m *MsgSubmitProposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return err
+	}
+
+	m.Messages = anys
+	return nil
+}
+
+func (m *MsgSubmitProposal) GetInitialDeposit() sdk.Coins { return m.InitialDeposit }
+
+func (m *MsgSubmitProposal) GetProposer() sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(m.Proposer)
+	return proposer
+}
+
+// Route implements Msg
+func (m MsgSubmitProposal) Route() string { return govtypes.RouterKey }
+
+// Type implements Msg
+func (m MsgSubmitProposal) Type() string { return TypeMsgSubmitProposal }
+
+// ValidateBasic implements Msg
+func (m MsgSubmitProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Proposer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address: %s", err)
+	}
+	if !m.InitialDeposit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.InitialDeposit.String())
+	}
+	if m.InitialDeposit.IsAnyNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.InitialDeposit.String())
+	}
+	if err := govtypes.ValidateMetadata(m.Metadata); err != nil {
+		return err
+	}
+
+	msgs, err := m.GetMsgs()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return govtypes.ErrNoProposalMsgs
+	}
+
+	for idx, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(govtypes.ErrInvalidProposalMsg,
+				fmt.Sprintf("msg: %d, err: %s", idx, err.Error()))
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (m MsgSubmitProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (m MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(m.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
+// NewMsgExecLegacyContent creates a new MsgExecLegacyContent executing the legacy content
+// with the authority, which must be the gov module account.
+func NewMsgExecLegacyContent(content *types.Any, authority string) *MsgExecLegacyContent {
+	return &MsgExecLegacyContent{
+		Content:   content,
+		Authority: authority,
+	}
+}
+
+// GetContent returns the legacy Content of the message
+func (m *MsgExecLegacyContent) GetContent() govtypes.Content {
+	content, ok := m.Content.GetCachedValue().(govtypes.Content)
+	if !ok {
+		return nil
+	}
+	return content
+}
+
+// Route implements Msg
+func (m MsgExecLegacyContent) Route() string { return govtypes.RouterKey }
+
+// Type implements Msg
+func (m MsgExecLegacyContent) Type() string { return TypeMsgExecLegacyContent }
+
+// ValidateBasic implements Msg
+func (m MsgExecLegacyContent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	content := m.GetContent()
+	if content == nil {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, "missing content")
+	}
+	if !govtypes.IsValidProposalType(content.ProposalType()) {
+		return sdkerrors.Wrap(govtypes.ErrInvalidProposalType, content.ProposalType())
+	}
+
+	return content.ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (m MsgExecLegacyContent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (m MsgExecLegacyContent) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgExecLegacyContent) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var content govtypes.Content
+	return unpacker.UnpackAny(m.Content, &content)
+}
+
+// NewMsgCancelProposal creates a new MsgCancelProposal of the proposal by its proposer.
+//
+//nolint:interfacer
+func NewMsgCancelProposal(proposalID uint64, proposer sdk.AccAddress) *MsgCancelProposal {
+	return &MsgCancelProposal{
+		ProposalId: proposalID,
+		Proposer:   proposer.String(),
+	}
+}
+
+// Route implements Msg
+func (m MsgCancelProposal) Route() string { return govtypes.RouterKey }
+
+// Type implements Msg
+func (m MsgCancelProposal) Type() string { return TypeMsgCancelProposal }
+
+// ValidateBasic implements Msg
+func (m MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Proposer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (m MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (m MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(m.Proposer)
+	return []sdk.AccAddress{proposer}
+}
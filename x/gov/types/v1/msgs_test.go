@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	coinsPos  = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	coinsZero = sdk.NewCoins()
+	addrs     = []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+)
+
+// test ValidateBasic for MsgSubmitProposal
+func TestMsgSubmitProposal(t *testing.T) {
+	validMsg := banktypes.NewMsgSend(addrs[0], addrs[1], coinsPos)
+	invalidMsg := banktypes.NewMsgSend(addrs[0], addrs[1], coinsZero)
+
+	tests := []struct {
+		messages       []sdk.Msg
+		proposerAddr   sdk.AccAddress
+		initialDeposit sdk.Coins
+		metadata       string
+		expectPass     bool
+	}{
+		{[]sdk.Msg{validMsg}, addrs[0], coinsPos, "", true},
+		{[]sdk.Msg{validMsg, validMsg}, addrs[0], coinsZero, "ipfs://metadata", true},
+		{[]sdk.Msg{validMsg}, sdk.AccAddress{}, coinsPos, "", false},
+		{[]sdk.Msg{}, addrs[0], coinsPos, "", false},
+		{[]sdk.Msg{validMsg, invalidMsg}, addrs[0], coinsPos, "", false},
+		{[]sdk.Msg{validMsg}, addrs[0], coinsPos, strings.Repeat("#", govtypes.MaxMetadataLength+1), false},
+	}
+
+	for i, tc := range tests {
+		msg, err := NewMsgSubmitProposalWithExpedite(tc.messages, tc.initialDeposit, tc.proposerAddr, tc.metadata, i%2 == 0)
+		require.NoError(t, err)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSubmitProposalGetMsgs(t *testing.T) {
+	messages := []sdk.Msg{
+		banktypes.NewMsgSend(addrs[0], addrs[1], coinsPos),
+		banktypes.NewMsgSend(addrs[1], addrs[0], coinsPos),
+	}
+
+	msg, err := NewMsgSubmitProposalWithExpedite(messages, coinsPos, addrs[0], "ipfs://metadata", true)
+	require.NoError(t, err)
+	require.True(t, msg.IsExpedited)
+	require.Equal(t, "ipfs://metadata", msg.Metadata)
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, msg.GetSigners())
+
+	msgs, err := msg.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, messages, msgs)
+}
+
+// test ValidateBasic for MsgExecLegacyContent
+func TestMsgExecLegacyContent(t *testing.T) {
+	validContent, err := codectypes.NewAnyWithValue(govtypes.NewTextProposal("Test", "description", false))
+	require.NoError(t, err)
+	invalidContent, err := codectypes.NewAnyWithValue(govtypes.NewTextProposal("", "description", false))
+	require.NoError(t, err)
+
+	tests := []struct {
+		content    *codectypes.Any
+		authority  string
+		expectPass bool
+	}{
+		{validContent, addrs[0].String(), true},
+		{validContent, "", false},
+		{invalidContent, addrs[0].String(), false},
+		{nil, addrs[0].String(), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgExecLegacyContent(tc.content, tc.authority)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{addrs[0]}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{1, addrs[0], true},
+		{0, addrs[1], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelProposal(tc.proposalID, tc.proposerAddr)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.proposerAddr}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
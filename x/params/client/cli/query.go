@@ -24,6 +24,7 @@ func NewQueryCmd() *cobra.Command {
 	cmd.AddCommand(NewQuerySubspaceParamsCmd())
 	cmd.AddCommand(NewQueryFeeParamsCmd())
 	cmd.AddCommand(NewQueryCosmosGasParamsCmd())
+	cmd.AddCommand(NewQueryGasScheduleCmd())
 	cmd.AddCommand(NewQueryBlockParamsCmd())
 
 	return cmd
@@ -116,6 +117,40 @@ func NewQueryCosmosGasParamsCmd() *cobra.Command {
 	return cmd
 }
 
+// NewQueryGasScheduleCmd returns a CLI command handler for querying the gas schedule of the stores, along with
+// the effective gas configs of a store key.
+func NewQueryGasScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gasschedule [store-key]",
+		Short: "Query for the gas schedule of the stores",
+		Long: `Query for the gas schedule of the stores, along with the gas configs charged by the KVStore and
+the TransientStore of the given store key, or the default ones if no store key is given.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := proposal.NewQueryClient(clientCtx)
+
+			req := proposal.QueryGasScheduleRequest{}
+			if len(args) > 0 {
+				req.StoreKey = args[0]
+			}
+			res, err := queryClient.GasSchedule(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewQueryBlockParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "blockparams",
@@ -9,11 +9,13 @@ import (
 func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	k.SetFeesParams(ctx, data.FeesParams)
 	k.SetCosmosGasParams(ctx, data.CosmosGasParams)
+	k.SetGasSchedule(ctx, data.GasSchedule)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	feesParams := k.GetFeesParams(ctx)
 	cosmosGasParams := k.GetCosmosGasParams(ctx)
-	return types.NewGenesisState(feesParams, cosmosGasParams)
+	gasSchedule := k.GetGasSchedule(ctx)
+	return types.NewGenesisState(feesParams, cosmosGasParams, gasSchedule)
 }
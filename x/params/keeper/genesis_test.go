@@ -33,15 +33,24 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 		CosmosGasMultiplierNumerator:   1,
 		CosmosGasMultiplierDenominator: 2,
 	}
+	gasSchedule := types.DefaultGasSchedule()
+	gasSchedule.StoreGasConfigs = []types.StoreKeyGasConfig{
+		{StoreKey: "bank", GasConfig: types.StoreGasConfig{ReadCostFlat: 10, WriteCostFlat: 20}},
+	}
 
 	suite.keeper.SetFeesParams(suite.ctx, *feesParams)
 	suite.keeper.SetCosmosGasParams(suite.ctx, *cosmosGasParams)
+	suite.keeper.SetGasSchedule(suite.ctx, *gasSchedule)
+	suite.Require().Panics(func() {
+		suite.keeper.SetGasSchedule(suite.ctx, types.GasSchedule{StoreGasConfigs: []types.StoreKeyGasConfig{{StoreKey: ""}}})
+	})
 
 	genesis := suite.keeper.ExportGenesis(suite.ctx)
 	suite.Require().Equal(
 		&types.GenesisState{
 			FeesParams:      *feesParams,
 			CosmosGasParams: *cosmosGasParams,
+			GasSchedule:     *gasSchedule,
 		},
 		genesis,
 	)
@@ -56,6 +65,13 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 			CosmosGasMultiplierNumerator:   1,
 			CosmosGasMultiplierDenominator: 4,
 		},
+		GasSchedule: types.GasSchedule{
+			KvGasConfig:        types.StoreGasConfig{ReadCostFlat: 100},
+			TransientGasConfig: types.StoreGasConfig{ReadCostFlat: 10},
+			StoreGasConfigs: []types.StoreKeyGasConfig{
+				{StoreKey: "staking", GasConfig: types.StoreGasConfig{ReadCostFlat: 1000}},
+			},
+		},
 	}
 	suite.keeper.InitGenesis(suite.ctx, validGenesis)
 
@@ -72,6 +88,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 		},
 		suite.keeper.GetCosmosGasParams(suite.ctx),
 	)
+	suite.Require().Equal(validGenesis.GasSchedule, suite.keeper.GetGasSchedule(suite.ctx))
 }
 
 func TestGenesisTestSuite(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
@@ -34,3 +35,20 @@ func (k Keeper) Params(c context.Context, req *proposal.QueryParamsRequest) (*pr
 
 	return &proposal.QueryParamsResponse{Param: param}, nil
 }
+
+// GasSchedule returns the gas schedule and the effective gas configs of a store key
+func (k Keeper) GasSchedule(c context.Context, req *proposal.QueryGasScheduleRequest) (*proposal.QueryGasScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	gasSchedule := k.GetGasSchedule(ctx)
+	storeGasSchedule := gasSchedule.StoreGasSchedule()
+
+	return &proposal.QueryGasScheduleResponse{
+		GasSchedule:        gasSchedule,
+		KvGasConfig:        types.NewStoreGasConfig(storeGasSchedule.KVGasConfig(req.StoreKey)),
+		TransientGasConfig: types.NewStoreGasConfig(storeGasSchedule.TransientGasConfig(req.StoreKey)),
+	}, nil
+}
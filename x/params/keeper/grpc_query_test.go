@@ -84,3 +84,27 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryGasSchedule() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.queryClient.GasSchedule(ctx, nil)
+	suite.Require().Error(err)
+
+	bankGasConfig := types.StoreGasConfig{ReadCostFlat: 10, WriteCostFlat: 20}
+	gasSchedule := types.DefaultGasSchedule()
+	gasSchedule.StoreGasConfigs = []types.StoreKeyGasConfig{{StoreKey: "bank", GasConfig: bankGasConfig}}
+	suite.app.ParamsKeeper.SetGasSchedule(suite.ctx, *gasSchedule)
+
+	res, err := suite.queryClient.GasSchedule(ctx, &proposal.QueryGasScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(*gasSchedule, res.GasSchedule)
+	suite.Require().Equal(gasSchedule.KvGasConfig, res.KvGasConfig)
+	suite.Require().Equal(gasSchedule.TransientGasConfig, res.TransientGasConfig)
+
+	res, err = suite.queryClient.GasSchedule(ctx, &proposal.QueryGasScheduleRequest{StoreKey: "bank"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(bankGasConfig, res.KvGasConfig)
+	suite.Require().Equal(bankGasConfig, res.TransientGasConfig)
+}
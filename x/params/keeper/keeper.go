@@ -81,6 +81,31 @@ func (k Keeper) GetCosmosGasParams(ctx sdk.Context) types.CosmosGasParams {
 	return cosmosGasParams
 }
 
+func (k Keeper) SetGasSchedule(ctx sdk.Context, gasSchedule types.GasSchedule) {
+	if err := gasSchedule.Validate(); err != nil {
+		panic(err)
+	}
+	subspace, exist := k.GetSubspace(types.ModuleName)
+	if !exist {
+		panic("subspace params should exist")
+	}
+	subspace.Set(ctx, types.ParamStoreKeyGasSchedule, gasSchedule)
+}
+
+func (k Keeper) GetGasSchedule(ctx sdk.Context) types.GasSchedule {
+	subspace, _ := k.GetSubspace(types.ModuleName)
+
+	var gasSchedule types.GasSchedule
+	if !subspace.Has(ctx, types.ParamStoreKeyGasSchedule) {
+		defaultGasSchedule := *types.DefaultGasSchedule()
+		return defaultGasSchedule
+	}
+
+	// the costs are encoded as strings by amino JSON, so the schedule is decoded by the subspace
+	subspace.Get(ctx, types.ParamStoreKeyGasSchedule, &gasSchedule)
+	return gasSchedule
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+proposal.ModuleName)
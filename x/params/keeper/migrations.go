@@ -21,3 +21,9 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	m.keeper.SetCosmosGasParams(ctx, defaultGenesis.CosmosGasParams)
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.SetGasSchedule(ctx, *types.DefaultGasSchedule())
+	return nil
+}
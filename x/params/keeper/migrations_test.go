@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	pk "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -20,3 +21,18 @@ func TestMigrate1to2(t *testing.T) {
 	require.Equal(t, defaultParams.CosmosGasParams.CosmosGasMultiplierNumerator, cosmosGasParams.CosmosGasMultiplierNumerator)
 	require.Equal(t, defaultParams.CosmosGasParams.CosmosGasMultiplierDenominator, cosmosGasParams.CosmosGasMultiplierDenominator)
 }
+
+func TestMigrate2to3(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+	m := pk.NewMigrator(keeper)
+	err := m.Migrate2to3(ctx)
+	require.Nil(t, err)
+	// ensure set to defaults
+	gasSchedule := keeper.GetGasSchedule(ctx)
+	require.Equal(t, types.DefaultGasSchedule().KvGasConfig, gasSchedule.KvGasConfig)
+	require.Equal(t, types.DefaultGasSchedule().TransientGasConfig, gasSchedule.TransientGasConfig)
+	require.Empty(t, gasSchedule.StoreGasConfigs)
+	storeGasSchedule := gasSchedule.StoreGasSchedule()
+	require.Equal(t, storetypes.KVGasConfig(), storeGasSchedule.KVGasConfig("bank"))
+	require.Equal(t, storetypes.TransientGasConfig(), storeGasSchedule.TransientGasConfig("transient_bank"))
+}
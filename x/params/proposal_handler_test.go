@@ -73,6 +73,7 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 					MinDeposit:          sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
 					MinExpeditedDeposit: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000001))),
 					MaxDepositPeriod:    govtypes.DefaultPeriod,
+					ProposalCancelRatio: govtypes.DefaultProposalCancelRatio,
 				}, depositParams)
 			},
 			false,
@@ -1,6 +1,7 @@
 package types
 
 import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,18 +20,29 @@ func DefaultCosmosGasParams() *CosmosGasParams {
 	}
 }
 
+// DefaultGasSchedule returns a gas schedule charging the default gas configs of gaskv to every store.
+func DefaultGasSchedule() *GasSchedule {
+	return &GasSchedule{
+		KvGasConfig:        NewStoreGasConfig(storetypes.KVGasConfig()),
+		TransientGasConfig: NewStoreGasConfig(storetypes.TransientGasConfig()),
+		StoreGasConfigs:    []StoreKeyGasConfig{},
+	}
+}
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		FeesParams:      *DefaultFeesParams(),
 		CosmosGasParams: *DefaultCosmosGasParams(),
+		GasSchedule:     *DefaultGasSchedule(),
 	}
 }
 
-func NewGenesisState(feesParams FeesParams, cosmosGasParams CosmosGasParams) *GenesisState {
+func NewGenesisState(feesParams FeesParams, cosmosGasParams CosmosGasParams, gasSchedule GasSchedule) *GenesisState {
 	return &GenesisState{
 		FeesParams:      feesParams,
 		CosmosGasParams: cosmosGasParams,
+		GasSchedule:     gasSchedule,
 	}
 }
 
@@ -38,5 +50,8 @@ func (gs GenesisState) Validate() error {
 	if err := gs.CosmosGasParams.Validate(); err != nil {
 		return err
 	}
+	if err := gs.GasSchedule.Validate(); err != nil {
+		return err
+	}
 	return gs.FeesParams.Validate()
 }
@@ -9,6 +9,7 @@ import (
 
 var ParamStoreKeyFeesParams = []byte("FeesParams")
 var ParamStoreKeyCosmosGasParams = []byte("CosmosGasParams")
+var ParamStoreKeyGasSchedule = []byte("GasSchedule")
 
 func NewFeesParams(minGasPrices sdk.DecCoins) FeesParams {
 	return FeesParams{
@@ -21,6 +22,7 @@ func ParamKeyTable() KeyTable {
 	return NewKeyTable(
 		NewParamSetPair(ParamStoreKeyFeesParams, &FeesParams{}, validateFeesParams),
 		NewParamSetPair(ParamStoreKeyCosmosGasParams, &CosmosGasParams{}, validateCosmosGasParams),
+		NewParamSetPair(ParamStoreKeyGasSchedule, &GasSchedule{}, validateGasSchedule),
 	)
 }
 
@@ -75,3 +77,75 @@ func validateCosmosGasParams(i interface{}) error {
 	}
 	return nil
 }
+
+func NewStoreGasConfig(gasConfig sdk.GasConfig) StoreGasConfig {
+	return StoreGasConfig{
+		HasCost:          gasConfig.HasCost,
+		DeleteCost:       gasConfig.DeleteCost,
+		ReadCostFlat:     gasConfig.ReadCostFlat,
+		ReadCostPerByte:  gasConfig.ReadCostPerByte,
+		WriteCostFlat:    gasConfig.WriteCostFlat,
+		WriteCostPerByte: gasConfig.WriteCostPerByte,
+		IterNextCostFlat: gasConfig.IterNextCostFlat,
+	}
+}
+
+// GasConfig returns the gas config gaskv charges the operations of a KVStore with.
+func (gc StoreGasConfig) GasConfig() sdk.GasConfig {
+	return sdk.GasConfig{
+		HasCost:          gc.HasCost,
+		DeleteCost:       gc.DeleteCost,
+		ReadCostFlat:     gc.ReadCostFlat,
+		ReadCostPerByte:  gc.ReadCostPerByte,
+		WriteCostFlat:    gc.WriteCostFlat,
+		WriteCostPerByte: gc.WriteCostPerByte,
+		IterNextCostFlat: gc.IterNextCostFlat,
+	}
+}
+
+func NewGasSchedule(kvGasConfig StoreGasConfig, transientGasConfig StoreGasConfig, storeGasConfigs []StoreKeyGasConfig) GasSchedule {
+	return GasSchedule{
+		KvGasConfig:        kvGasConfig,
+		TransientGasConfig: transientGasConfig,
+		StoreGasConfigs:    storeGasConfigs,
+	}
+}
+
+func (gs *GasSchedule) Validate() error {
+	storeKeys := map[string]bool{}
+	for _, storeGasConfig := range gs.StoreGasConfigs {
+		if storeGasConfig.StoreKey == "" {
+			return errors.New("gas schedule store key can not be empty")
+		}
+		if storeKeys[storeGasConfig.StoreKey] {
+			return fmt.Errorf("duplicate gas config for store key %s", storeGasConfig.StoreKey)
+		}
+		storeKeys[storeGasConfig.StoreKey] = true
+	}
+	return nil
+}
+
+// StoreGasSchedule returns the gas schedule the stores of a Context are charged with.
+func (gs *GasSchedule) StoreGasSchedule() *sdk.GasSchedule {
+	stores := make(map[string]sdk.GasConfig, len(gs.StoreGasConfigs))
+	for _, storeGasConfig := range gs.StoreGasConfigs {
+		stores[storeGasConfig.StoreKey] = storeGasConfig.GasConfig.GasConfig()
+	}
+	return &sdk.GasSchedule{
+		KV:        gs.KvGasConfig.GasConfig(),
+		Transient: gs.TransientGasConfig.GasConfig(),
+		Stores:    stores,
+	}
+}
+
+func validateGasSchedule(i interface{}) error {
+	v, ok := i.(GasSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
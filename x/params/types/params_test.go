@@ -16,3 +16,23 @@ func TestCosmosGasParams(t *testing.T) {
 	err = invalidCosmosGasParam.Validate()
 	require.NotNil(t, err)
 }
+
+func TestGasSchedule(t *testing.T) {
+	gasSchedule := DefaultGasSchedule()
+	require.Nil(t, gasSchedule.Validate())
+
+	bankGasConfig := StoreGasConfig{ReadCostFlat: 10, WriteCostFlat: 20}
+	gasSchedule.StoreGasConfigs = []StoreKeyGasConfig{{StoreKey: "bank", GasConfig: bankGasConfig}}
+	require.Nil(t, gasSchedule.Validate())
+	storeGasSchedule := gasSchedule.StoreGasSchedule()
+	require.Equal(t, bankGasConfig.GasConfig(), storeGasSchedule.KVGasConfig("bank"))
+	require.Equal(t, gasSchedule.KvGasConfig.GasConfig(), storeGasSchedule.KVGasConfig("staking"))
+	require.Equal(t, gasSchedule.TransientGasConfig.GasConfig(), storeGasSchedule.TransientGasConfig("transient_staking"))
+
+	// Verify validation: store keys are non empty and unique
+	gasSchedule.StoreGasConfigs = append(gasSchedule.StoreGasConfigs, StoreKeyGasConfig{StoreKey: "bank"})
+	require.NotNil(t, gasSchedule.Validate())
+
+	gasSchedule.StoreGasConfigs = []StoreKeyGasConfig{{GasConfig: bankGasConfig}}
+	require.NotNil(t, gasSchedule.Validate())
+}
@@ -6,6 +6,7 @@ package proposal
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/x/params/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -130,36 +131,162 @@ func (m *QueryParamsResponse) GetParam() ParamChange {
 	return ParamChange{}
 }
 
+// QueryGasScheduleRequest is request type for the Query/GasSchedule RPC method.
+type QueryGasScheduleRequest struct {
+	// store_key optionally defines the name of the store key to query the
+	// effective gas configs for.
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+}
+
+func (m *QueryGasScheduleRequest) Reset()         { *m = QueryGasScheduleRequest{} }
+func (m *QueryGasScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasScheduleRequest) ProtoMessage()    {}
+func (*QueryGasScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{2}
+}
+func (m *QueryGasScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasScheduleRequest.Merge(m, src)
+}
+func (m *QueryGasScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryGasScheduleRequest) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+// QueryGasScheduleResponse is response type for the Query/GasSchedule RPC
+// method.
+type QueryGasScheduleResponse struct {
+	// gas_schedule defines the gas schedule the stores are charged with.
+	GasSchedule types.GasSchedule `protobuf:"bytes,1,opt,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule"`
+	// kv_gas_config defines the gas config charged by the KVStore of the
+	// queried store key, or the default one if no store key is queried.
+	KvGasConfig types.StoreGasConfig `protobuf:"bytes,2,opt,name=kv_gas_config,json=kvGasConfig,proto3" json:"kv_gas_config"`
+	// transient_gas_config defines the gas config charged by the TransientStore
+	// of the queried store key, or the default one if no store key is queried.
+	TransientGasConfig types.StoreGasConfig `protobuf:"bytes,3,opt,name=transient_gas_config,json=transientGasConfig,proto3" json:"transient_gas_config"`
+}
+
+func (m *QueryGasScheduleResponse) Reset()         { *m = QueryGasScheduleResponse{} }
+func (m *QueryGasScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasScheduleResponse) ProtoMessage()    {}
+func (*QueryGasScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{3}
+}
+func (m *QueryGasScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasScheduleResponse.Merge(m, src)
+}
+func (m *QueryGasScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryGasScheduleResponse) GetGasSchedule() types.GasSchedule {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return types.GasSchedule{}
+}
+
+func (m *QueryGasScheduleResponse) GetKvGasConfig() types.StoreGasConfig {
+	if m != nil {
+		return m.KvGasConfig
+	}
+	return types.StoreGasConfig{}
+}
+
+func (m *QueryGasScheduleResponse) GetTransientGasConfig() types.StoreGasConfig {
+	if m != nil {
+		return m.TransientGasConfig
+	}
+	return types.StoreGasConfig{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.params.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.params.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryGasScheduleRequest)(nil), "cosmos.params.v1beta1.QueryGasScheduleRequest")
+	proto.RegisterType((*QueryGasScheduleResponse)(nil), "cosmos.params.v1beta1.QueryGasScheduleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/params/v1beta1/query.proto", fileDescriptor_2b32979c1792ccc4) }
 
 var fileDescriptor_2b32979c1792ccc4 = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x4b, 0x3b, 0x31,
-	0x14, 0xc0, 0x2f, 0xfd, 0xff, 0x5b, 0x34, 0x2e, 0x12, 0x15, 0xca, 0xa1, 0x57, 0x3d, 0x10, 0x54,
-	0x30, 0xa1, 0xd5, 0xd9, 0xa1, 0xee, 0xa2, 0x05, 0x17, 0xb7, 0x5c, 0x0d, 0x69, 0x69, 0x7b, 0x2f,
-	0xbd, 0x97, 0x13, 0xbb, 0x3a, 0x38, 0x17, 0xfc, 0x52, 0x1d, 0x0b, 0x2e, 0x4e, 0x22, 0xad, 0x1f,
-	0x44, 0x9a, 0xb4, 0x82, 0x58, 0xc5, 0x29, 0x2f, 0x2f, 0xbf, 0xf7, 0x7b, 0x79, 0x09, 0xdd, 0x6b,
-	0x02, 0xf6, 0x00, 0x85, 0x91, 0x99, 0xec, 0xa1, 0xb8, 0xab, 0x26, 0xca, 0xca, 0xaa, 0xe8, 0xe7,
-	0x2a, 0x1b, 0x70, 0x93, 0x81, 0x05, 0xb6, 0xe5, 0x11, 0xee, 0x11, 0x3e, 0x47, 0xc2, 0x4d, 0x0d,
-	0x1a, 0x1c, 0x21, 0x66, 0x91, 0x87, 0xc3, 0x6d, 0x0d, 0xa0, 0xbb, 0x4a, 0x48, 0xd3, 0x16, 0x32,
-	0x4d, 0xc1, 0x4a, 0xdb, 0x86, 0x14, 0xe7, 0xa7, 0xf1, 0xf2, 0x6e, 0x73, 0xb3, 0x63, 0xe2, 0x3a,
-	0x65, 0x57, 0xb3, 0xee, 0x97, 0x2e, 0xd9, 0x50, 0xfd, 0x5c, 0xa1, 0x65, 0x21, 0x5d, 0xc1, 0x3c,
-	0x41, 0x23, 0x9b, 0xaa, 0x4c, 0x76, 0xc9, 0xc1, 0x6a, 0xe3, 0x73, 0xcf, 0xd6, 0xe9, 0xbf, 0x8e,
-	0x1a, 0x94, 0x0b, 0x2e, 0x3d, 0x0b, 0xe3, 0x6b, 0xba, 0xf1, 0xc5, 0x81, 0x06, 0x52, 0x54, 0xec,
-	0x8c, 0x16, 0x5d, 0x2b, 0x67, 0x58, 0xab, 0xc5, 0x7c, 0xe9, 0x64, 0xdc, 0x55, 0x9d, 0xb7, 0x64,
-	0xaa, 0x55, 0xfd, 0xff, 0xe8, 0xb5, 0x12, 0x34, 0x7c, 0x59, 0x6d, 0x48, 0x68, 0xd1, 0x79, 0xd9,
-	0x23, 0xa1, 0x25, 0x2f, 0x67, 0x87, 0x3f, 0x58, 0xbe, 0x0f, 0x11, 0x1e, 0xfd, 0x05, 0xf5, 0x77,
-	0x8d, 0xf7, 0x1f, 0x9e, 0xdf, 0x9f, 0x0a, 0x15, 0xb6, 0x23, 0x7e, 0x7b, 0xb3, 0xfa, 0xc5, 0x68,
-	0x12, 0x91, 0xf1, 0x24, 0x22, 0x6f, 0x93, 0x88, 0x0c, 0xa7, 0x51, 0x30, 0x9e, 0x46, 0xc1, 0xcb,
-	0x34, 0x0a, 0x6e, 0x4e, 0x75, 0xdb, 0xb6, 0xf2, 0x84, 0x37, 0xa1, 0xb7, 0x50, 0xf8, 0xe5, 0x18,
-	0x6f, 0x3b, 0xe2, 0x7e, 0xe1, 0xb3, 0x03, 0xa3, 0x50, 0x98, 0x0c, 0x0c, 0xa0, 0xec, 0x26, 0x25,
-	0xf7, 0x09, 0x27, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xd5, 0x67, 0xc7, 0x18, 0x02, 0x00,
-	0x00,
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x8e, 0x4d, 0xcc, 0x05, 0x09, 0x99, 0x21, 0xaa, 0x00, 0x29, 0x04, 0x55, 0xe2,
+	0x87, 0x88, 0xb5, 0x82, 0x38, 0x72, 0xe8, 0x0e, 0x3b, 0x4c, 0xe2, 0x47, 0x27, 0x2e, 0x48, 0xa8,
+	0x72, 0x33, 0xe3, 0x46, 0x6d, 0xf3, 0xbc, 0x3c, 0xa7, 0xa2, 0x57, 0x0e, 0x9c, 0x91, 0x38, 0x70,
+	0xe0, 0x7f, 0xe0, 0xef, 0xd8, 0x71, 0x12, 0x17, 0x4e, 0x08, 0xb5, 0xfc, 0x21, 0x28, 0xb6, 0x5b,
+	0x5a, 0x75, 0x1d, 0xe5, 0x92, 0x38, 0xcf, 0xdf, 0xf7, 0x79, 0xdf, 0x3c, 0x3f, 0x93, 0x3b, 0x31,
+	0xe0, 0x00, 0x90, 0x29, 0x9e, 0xf1, 0x01, 0xb2, 0xe1, 0x6e, 0x47, 0x68, 0xbe, 0xcb, 0x8e, 0x73,
+	0x91, 0x8d, 0x22, 0x95, 0x81, 0x06, 0x7a, 0xcd, 0x4a, 0x22, 0x2b, 0x89, 0x9c, 0xc4, 0xdf, 0x91,
+	0x20, 0xc1, 0x28, 0x58, 0xb1, 0xb2, 0x62, 0xff, 0xa6, 0x04, 0x90, 0x7d, 0xc1, 0xb8, 0x4a, 0x18,
+	0x4f, 0x53, 0xd0, 0x5c, 0x27, 0x90, 0xa2, 0xdb, 0x0d, 0xcf, 0xae, 0xe6, 0xc8, 0x56, 0x53, 0x5b,
+	0xd4, 0xe8, 0x91, 0x12, 0xee, 0x69, 0x05, 0x61, 0x93, 0xd0, 0x57, 0x85, 0xbd, 0x97, 0x46, 0xd0,
+	0x12, 0xc7, 0xb9, 0x40, 0x4d, 0x7d, 0x72, 0x11, 0xf3, 0x0e, 0x2a, 0x1e, 0x8b, 0xaa, 0x77, 0xdb,
+	0xbb, 0xb7, 0xdd, 0x9a, 0x7d, 0xd3, 0x2b, 0x64, 0xa3, 0x27, 0x46, 0xd5, 0xb2, 0x09, 0x17, 0xcb,
+	0xf0, 0x35, 0xb9, 0xba, 0xc0, 0x40, 0x05, 0x29, 0x0a, 0xfa, 0x8c, 0x6c, 0x9a, 0xb2, 0x86, 0x50,
+	0x69, 0x84, 0xd1, 0x99, 0xbf, 0x1e, 0x99, 0xac, 0xbd, 0x2e, 0x4f, 0xa5, 0x68, 0x5e, 0x38, 0xf9,
+	0x59, 0x2b, 0xb5, 0x6c, 0x5a, 0xf8, 0x94, 0x5c, 0x37, 0xd8, 0x7d, 0x8e, 0x87, 0x71, 0x57, 0x1c,
+	0xe5, 0x7d, 0x31, 0xf5, 0x77, 0x83, 0x6c, 0xa3, 0x86, 0x4c, 0xb4, 0x0b, 0x27, 0x53, 0x83, 0x45,
+	0xe0, 0x40, 0x8c, 0xc2, 0x2f, 0x65, 0x52, 0x5d, 0x4e, 0x74, 0xa6, 0x0e, 0xc8, 0x25, 0xc9, 0xb1,
+	0x8d, 0x2e, 0xfe, 0x0f, 0x6f, 0x73, 0x04, 0xe7, 0xad, 0x22, 0xff, 0x86, 0xe8, 0x0b, 0x72, 0xb9,
+	0x37, 0x6c, 0x17, 0xbc, 0x18, 0xd2, 0x77, 0x89, 0x34, 0x4d, 0xa9, 0x34, 0xea, 0x2b, 0x68, 0x87,
+	0x85, 0xc3, 0x7d, 0x8e, 0x7b, 0x46, 0x3c, 0x05, 0xf6, 0x86, 0xb3, 0x10, 0x7d, 0x4b, 0x76, 0x74,
+	0xc6, 0x53, 0x4c, 0x44, 0xaa, 0xe7, 0xb9, 0x1b, 0xff, 0xcf, 0xa5, 0x33, 0xd0, 0x6c, 0xa7, 0xf1,
+	0xad, 0x4c, 0x36, 0x4d, 0x67, 0xe8, 0x47, 0x8f, 0x6c, 0xd9, 0xe3, 0xa2, 0xf7, 0x57, 0x50, 0x97,
+	0xc7, 0xc2, 0x7f, 0xb0, 0x8e, 0xd4, 0x36, 0x3a, 0xac, 0x7f, 0xf8, 0xfe, 0xfb, 0x73, 0xb9, 0x46,
+	0x6f, 0xb1, 0xf3, 0xc6, 0x94, 0x7e, 0xf5, 0x48, 0x65, 0xae, 0xcb, 0x34, 0x3a, 0xaf, 0xc4, 0xf2,
+	0x24, 0xf8, 0x6c, 0x6d, 0xbd, 0xf3, 0xf5, 0xd0, 0xf8, 0xaa, 0xd3, 0xbb, 0x2b, 0x7c, 0xcd, 0x4f,
+	0x47, 0xf3, 0xf9, 0xc9, 0x38, 0xf0, 0x4e, 0xc7, 0x81, 0xf7, 0x6b, 0x1c, 0x78, 0x9f, 0x26, 0x41,
+	0xe9, 0x74, 0x12, 0x94, 0x7e, 0x4c, 0x82, 0xd2, 0x9b, 0x27, 0x32, 0xd1, 0xdd, 0xbc, 0x13, 0xc5,
+	0x30, 0x98, 0x82, 0xec, 0xeb, 0x11, 0x1e, 0xf5, 0xd8, 0xfb, 0xc5, 0x0b, 0xa7, 0x32, 0x50, 0x80,
+	0xbc, 0xdf, 0xd9, 0x32, 0x97, 0xee, 0xf1, 0x9f, 0x01, 0x00, 0x6f, 0x41, 0x58, 0xdc, 0x29, 0x04,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -177,6 +304,9 @@ type QueryClient interface {
 	// Params queries a specific parameter of a module, given its subspace and
 	// key.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GasSchedule queries the gas schedule the stores are charged with, along
+	// with the effective gas configs of a store key.
+	GasSchedule(ctx context.Context, in *QueryGasScheduleRequest, opts ...grpc.CallOption) (*QueryGasScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -196,11 +326,23 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) GasSchedule(ctx context.Context, in *QueryGasScheduleRequest, opts ...grpc.CallOption) (*QueryGasScheduleResponse, error) {
+	out := new(QueryGasScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.params.v1beta1.Query/GasSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries a specific parameter of a module, given its subspace and
 	// key.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GasSchedule queries the gas schedule the stores are charged with, along
+	// with the effective gas configs of a store key.
+	GasSchedule(context.Context, *QueryGasScheduleRequest) (*QueryGasScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -210,6 +352,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) GasSchedule(ctx context.Context, req *QueryGasScheduleRequest) (*QueryGasScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasSchedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -233,6 +378,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.params.v1beta1.Query/GasSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasSchedule(ctx, req.(*QueryGasScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.params.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -241,6 +404,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "GasSchedule",
+			Handler:    _Query_GasSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/params/v1beta1/query.proto",
@@ -316,6 +483,89 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGasScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TransientGasConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.KvGasConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.GasSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -355,6 +605,34 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGasScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasSchedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.KvGasConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TransientGasConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -558,6 +836,237 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGasScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KvGasConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KvGasConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransientGasConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransientGasConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -71,8 +71,8 @@ func (m *FeesParams) GetGlobalMinimumGasPrices() github_com_cosmos_cosmos_sdk_ty
 }
 
 type CosmosGasParams struct {
-	CosmosGasMultiplierNumerator   uint64 `protobuf:"varint,1,opt,name=cosmos_gas_multiplier_numerator,json=cosmosGasMultiplierNumerator,proto3" json:"cosmos_gas_multiplier_numerator,string,omitempty"`
-	CosmosGasMultiplierDenominator uint64 `protobuf:"varint,2,opt,name=cosmos_gas_multiplier_denominator,json=cosmosGasMultiplierDenominator,proto3" json:"cosmos_gas_multiplier_denominator,string,omitempty"`
+	CosmosGasMultiplierNumerator   uint64 `protobuf:"varint,1,opt,name=cosmos_gas_multiplier_numerator,json=cosmosGasMultiplierNumerator,proto3" json:"cosmos_gas_multiplier_numerator,omitempty"`
+	CosmosGasMultiplierDenominator uint64 `protobuf:"varint,2,opt,name=cosmos_gas_multiplier_denominator,json=cosmosGasMultiplierDenominator,proto3" json:"cosmos_gas_multiplier_denominator,omitempty"`
 }
 
 func (m *CosmosGasParams) Reset()         { *m = CosmosGasParams{} }
@@ -122,16 +122,226 @@ func (m *CosmosGasParams) GetCosmosGasMultiplierDenominator() uint64 {
 	return 0
 }
 
+// Defines the gas costs of the operations on a KVStore
+type StoreGasConfig struct {
+	HasCost          uint64 `protobuf:"varint,1,opt,name=has_cost,json=hasCost,proto3" json:"has_cost,omitempty"`
+	DeleteCost       uint64 `protobuf:"varint,2,opt,name=delete_cost,json=deleteCost,proto3" json:"delete_cost,omitempty"`
+	ReadCostFlat     uint64 `protobuf:"varint,3,opt,name=read_cost_flat,json=readCostFlat,proto3" json:"read_cost_flat,omitempty"`
+	ReadCostPerByte  uint64 `protobuf:"varint,4,opt,name=read_cost_per_byte,json=readCostPerByte,proto3" json:"read_cost_per_byte,omitempty"`
+	WriteCostFlat    uint64 `protobuf:"varint,5,opt,name=write_cost_flat,json=writeCostFlat,proto3" json:"write_cost_flat,omitempty"`
+	WriteCostPerByte uint64 `protobuf:"varint,6,opt,name=write_cost_per_byte,json=writeCostPerByte,proto3" json:"write_cost_per_byte,omitempty"`
+	IterNextCostFlat uint64 `protobuf:"varint,7,opt,name=iter_next_cost_flat,json=iterNextCostFlat,proto3" json:"iter_next_cost_flat,omitempty"`
+}
+
+func (m *StoreGasConfig) Reset()         { *m = StoreGasConfig{} }
+func (m *StoreGasConfig) String() string { return proto.CompactTextString(m) }
+func (*StoreGasConfig) ProtoMessage()    {}
+func (*StoreGasConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d782f42fecdb16, []int{2}
+}
+func (m *StoreGasConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreGasConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreGasConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreGasConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreGasConfig.Merge(m, src)
+}
+func (m *StoreGasConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreGasConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreGasConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreGasConfig proto.InternalMessageInfo
+
+func (m *StoreGasConfig) GetHasCost() uint64 {
+	if m != nil {
+		return m.HasCost
+	}
+	return 0
+}
+
+func (m *StoreGasConfig) GetDeleteCost() uint64 {
+	if m != nil {
+		return m.DeleteCost
+	}
+	return 0
+}
+
+func (m *StoreGasConfig) GetReadCostFlat() uint64 {
+	if m != nil {
+		return m.ReadCostFlat
+	}
+	return 0
+}
+
+func (m *StoreGasConfig) GetReadCostPerByte() uint64 {
+	if m != nil {
+		return m.ReadCostPerByte
+	}
+	return 0
+}
+
+func (m *StoreGasConfig) GetWriteCostFlat() uint64 {
+	if m != nil {
+		return m.WriteCostFlat
+	}
+	return 0
+}
+
+func (m *StoreGasConfig) GetWriteCostPerByte() uint64 {
+	if m != nil {
+		return m.WriteCostPerByte
+	}
+	return 0
+}
+
+func (m *StoreGasConfig) GetIterNextCostFlat() uint64 {
+	if m != nil {
+		return m.IterNextCostFlat
+	}
+	return 0
+}
+
+// Defines the gas config of the KVStore of a store key
+type StoreKeyGasConfig struct {
+	StoreKey  string         `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	GasConfig StoreGasConfig `protobuf:"bytes,2,opt,name=gas_config,json=gasConfig,proto3" json:"gas_config"`
+}
+
+func (m *StoreKeyGasConfig) Reset()         { *m = StoreKeyGasConfig{} }
+func (m *StoreKeyGasConfig) String() string { return proto.CompactTextString(m) }
+func (*StoreKeyGasConfig) ProtoMessage()    {}
+func (*StoreKeyGasConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d782f42fecdb16, []int{3}
+}
+func (m *StoreKeyGasConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreKeyGasConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreKeyGasConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreKeyGasConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreKeyGasConfig.Merge(m, src)
+}
+func (m *StoreKeyGasConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreKeyGasConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreKeyGasConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreKeyGasConfig proto.InternalMessageInfo
+
+func (m *StoreKeyGasConfig) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StoreKeyGasConfig) GetGasConfig() StoreGasConfig {
+	if m != nil {
+		return m.GasConfig
+	}
+	return StoreGasConfig{}
+}
+
+// Defines the gas costs of the KVStore operations that are controlled through governance.
+// The stores of the store keys without a gas config of their own are charged the default
+// gas config of their kind.
+type GasSchedule struct {
+	KvGasConfig        StoreGasConfig      `protobuf:"bytes,1,opt,name=kv_gas_config,json=kvGasConfig,proto3" json:"kv_gas_config"`
+	TransientGasConfig StoreGasConfig      `protobuf:"bytes,2,opt,name=transient_gas_config,json=transientGasConfig,proto3" json:"transient_gas_config"`
+	StoreGasConfigs    []StoreKeyGasConfig `protobuf:"bytes,3,rep,name=store_gas_configs,json=storeGasConfigs,proto3" json:"store_gas_configs"`
+}
+
+func (m *GasSchedule) Reset()         { *m = GasSchedule{} }
+func (m *GasSchedule) String() string { return proto.CompactTextString(m) }
+func (*GasSchedule) ProtoMessage()    {}
+func (*GasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d782f42fecdb16, []int{4}
+}
+func (m *GasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasSchedule.Merge(m, src)
+}
+func (m *GasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasSchedule proto.InternalMessageInfo
+
+func (m *GasSchedule) GetKvGasConfig() StoreGasConfig {
+	if m != nil {
+		return m.KvGasConfig
+	}
+	return StoreGasConfig{}
+}
+
+func (m *GasSchedule) GetTransientGasConfig() StoreGasConfig {
+	if m != nil {
+		return m.TransientGasConfig
+	}
+	return StoreGasConfig{}
+}
+
+func (m *GasSchedule) GetStoreGasConfigs() []StoreKeyGasConfig {
+	if m != nil {
+		return m.StoreGasConfigs
+	}
+	return nil
+}
+
 type GenesisState struct {
 	FeesParams      FeesParams      `protobuf:"bytes,1,opt,name=fees_params,json=feesParams,proto3" json:"fees_params"`
 	CosmosGasParams CosmosGasParams `protobuf:"bytes,2,opt,name=cosmos_gas_params,json=cosmosGasParams,proto3" json:"cosmos_gas_params"`
+	GasSchedule     GasSchedule     `protobuf:"bytes,3,opt,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d782f42fecdb16, []int{2}
+	return fileDescriptor_56d782f42fecdb16, []int{5}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,42 +384,69 @@ func (m *GenesisState) GetCosmosGasParams() CosmosGasParams {
 	return CosmosGasParams{}
 }
 
+func (m *GenesisState) GetGasSchedule() GasSchedule {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return GasSchedule{}
+}
+
 func init() {
 	proto.RegisterType((*FeesParams)(nil), "cosmos.params.v1beta1.FeesParams")
 	proto.RegisterType((*CosmosGasParams)(nil), "cosmos.params.v1beta1.CosmosGasParams")
+	proto.RegisterType((*StoreGasConfig)(nil), "cosmos.params.v1beta1.StoreGasConfig")
+	proto.RegisterType((*StoreKeyGasConfig)(nil), "cosmos.params.v1beta1.StoreKeyGasConfig")
+	proto.RegisterType((*GasSchedule)(nil), "cosmos.params.v1beta1.GasSchedule")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.params.v1beta1.GenesisState")
 }
 
 func init() { proto.RegisterFile("cosmos/params/types/types.proto", fileDescriptor_56d782f42fecdb16) }
 
 var fileDescriptor_56d782f42fecdb16 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbf, 0xae, 0xd3, 0x30,
-	0x14, 0xc6, 0xe3, 0x52, 0x31, 0xb8, 0x48, 0x15, 0x11, 0xa0, 0x52, 0x55, 0xee, 0x9f, 0x01, 0x55,
-	0x42, 0x38, 0x6a, 0xfb, 0x06, 0x6d, 0xa1, 0x74, 0x28, 0xaa, 0xca, 0x82, 0x58, 0x22, 0x27, 0x75,
-	0x83, 0x45, 0x1c, 0x47, 0xb1, 0x83, 0xe0, 0x1d, 0x18, 0xd8, 0x19, 0x59, 0x10, 0x23, 0x4f, 0xd1,
-	0xb1, 0x23, 0x13, 0xa0, 0xf4, 0x45, 0x50, 0x6c, 0x87, 0xde, 0x5b, 0xf5, 0xde, 0x25, 0x89, 0x9c,
-	0xef, 0xfc, 0xce, 0xe7, 0xf3, 0x1d, 0xd8, 0x0d, 0x85, 0xe4, 0x42, 0x7a, 0x29, 0xc9, 0x08, 0x97,
-	0x9e, 0xfa, 0x94, 0x52, 0xfb, 0xc4, 0x69, 0x26, 0x94, 0x70, 0x1f, 0x1a, 0x01, 0x36, 0x02, 0xfc,
-	0x61, 0x14, 0x50, 0x45, 0x46, 0xed, 0x07, 0x91, 0x88, 0x84, 0x56, 0x78, 0xe5, 0x97, 0x11, 0xb7,
-	0x91, 0xa5, 0x05, 0x44, 0x52, 0xcf, 0x4a, 0xbd, 0x50, 0xb0, 0xc4, 0xfc, 0x1f, 0x7c, 0x05, 0x10,
-	0xbe, 0xa0, 0x54, 0xae, 0x35, 0xcc, 0xfd, 0x0c, 0xe0, 0xe3, 0x28, 0x16, 0x01, 0x89, 0x7d, 0xce,
-	0x12, 0xc6, 0x73, 0xee, 0x47, 0x44, 0xfa, 0x69, 0xc6, 0x42, 0x2a, 0x5b, 0xa0, 0x77, 0x67, 0xd8,
-	0x18, 0x77, 0xb0, 0x35, 0x50, 0x32, 0xab, 0xf6, 0x78, 0x4e, 0xc3, 0x99, 0x60, 0xc9, 0x74, 0xb2,
-	0xff, 0xdd, 0x75, 0x7e, 0xfc, 0xe9, 0x3e, 0x8d, 0x98, 0x7a, 0x97, 0x07, 0x38, 0x14, 0xdc, 0xb3,
-	0x1e, 0xcc, 0xeb, 0x99, 0xdc, 0xbe, 0xb7, 0xf7, 0xb1, 0x35, 0x72, 0xf3, 0xc8, 0xf4, 0x5c, 0x99,
-	0x96, 0x0b, 0x22, 0xd7, 0xba, 0xe1, 0xe0, 0x1b, 0x80, 0xcd, 0x99, 0xae, 0x2a, 0xcf, 0x8c, 0xc5,
-	0xe7, 0xd5, 0x84, 0xb4, 0x33, 0x9e, 0xc7, 0x8a, 0xa5, 0x31, 0xa3, 0x99, 0x9f, 0xe4, 0x9c, 0x66,
-	0x44, 0x89, 0xac, 0x05, 0x7a, 0x60, 0x58, 0xdf, 0x74, 0xc2, 0xaa, 0x72, 0xf5, 0x5f, 0xf4, 0xaa,
-	0xd2, 0xb8, 0x4b, 0xd8, 0xbf, 0x8c, 0xd9, 0xd2, 0x44, 0x70, 0x96, 0x68, 0x50, 0x4d, 0x83, 0xd0,
-	0x05, 0xd0, 0xfc, 0xa4, 0x1a, 0xfc, 0x04, 0xf0, 0xde, 0x82, 0x26, 0x54, 0x32, 0xf9, 0x5a, 0x11,
-	0x45, 0xdd, 0x97, 0xb0, 0xb1, 0xa3, 0x54, 0xfa, 0x26, 0x21, 0x6d, 0xa7, 0x31, 0xee, 0xe3, 0x8b,
-	0xb9, 0xe1, 0xd3, 0xf4, 0xa7, 0xf5, 0x72, 0x76, 0x1b, 0xb8, 0x3b, 0xe5, 0xf1, 0x06, 0xde, 0xbf,
-	0xe2, 0xd2, 0xf2, 0x6a, 0x9a, 0xf7, 0xe4, 0x06, 0xde, 0xd9, 0xbc, 0x2c, 0xb4, 0x19, 0x9e, 0x1d,
-	0x2f, 0xbf, 0x17, 0x08, 0xec, 0x0b, 0x04, 0x0e, 0x05, 0x02, 0x7f, 0x0b, 0x04, 0xbe, 0x1c, 0x91,
-	0x73, 0x38, 0x22, 0xe7, 0xd7, 0x11, 0x39, 0x6f, 0x6f, 0x4f, 0xef, 0xe3, 0xb5, 0xe5, 0x0c, 0xee,
-	0xea, 0x55, 0x9a, 0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x26, 0x95, 0xab, 0x38, 0xba, 0x02, 0x00,
-	0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xdf, 0x4e, 0x13, 0x41,
+	0x14, 0x87, 0xbb, 0x80, 0xfc, 0x39, 0x05, 0x2a, 0x0b, 0x9a, 0x82, 0x64, 0x0b, 0x8d, 0x12, 0x12,
+	0xc2, 0x36, 0xc0, 0x1b, 0x00, 0x52, 0x91, 0x80, 0xa4, 0xdc, 0x18, 0x12, 0xb3, 0x99, 0x6e, 0x4f,
+	0xb7, 0x93, 0xee, 0xee, 0x34, 0x3b, 0x53, 0xa4, 0x89, 0x8f, 0xe0, 0x85, 0xb7, 0xc6, 0x4b, 0x63,
+	0x62, 0x7c, 0x12, 0x2e, 0xb9, 0xf4, 0x4a, 0x4d, 0x79, 0x10, 0xcd, 0xce, 0xcc, 0x76, 0xb7, 0x06,
+	0x48, 0x8c, 0x37, 0xd0, 0x9c, 0xf9, 0xf6, 0x3b, 0x67, 0x7f, 0xb3, 0x33, 0x50, 0x72, 0x19, 0x0f,
+	0x18, 0xaf, 0x74, 0x48, 0x44, 0x02, 0x5e, 0x11, 0xbd, 0x0e, 0xea, 0xbf, 0x76, 0x27, 0x62, 0x82,
+	0x99, 0x8f, 0x14, 0x60, 0x2b, 0xc0, 0xbe, 0xd8, 0xaa, 0xa3, 0x20, 0x5b, 0x4b, 0x0b, 0x1e, 0xf3,
+	0x98, 0x24, 0x2a, 0xf1, 0x2f, 0x05, 0x2f, 0x59, 0xda, 0x56, 0x27, 0x1c, 0x2b, 0x1a, 0xad, 0xb8,
+	0x8c, 0x86, 0x6a, 0xbd, 0xfc, 0xc9, 0x00, 0x38, 0x40, 0xe4, 0xa7, 0x52, 0x66, 0xbe, 0x37, 0x60,
+	0xd1, 0xf3, 0x59, 0x9d, 0xf8, 0x4e, 0x40, 0x43, 0x1a, 0x74, 0x03, 0xc7, 0x23, 0xdc, 0xe9, 0x44,
+	0xd4, 0x45, 0x5e, 0x34, 0x56, 0x46, 0xd7, 0xf3, 0xdb, 0xcb, 0xb6, 0x1e, 0x20, 0x76, 0x26, 0xed,
+	0xed, 0x7d, 0x74, 0xf7, 0x18, 0x0d, 0x77, 0x77, 0xae, 0x7e, 0x94, 0x72, 0xdf, 0x7e, 0x96, 0x36,
+	0x3c, 0x2a, 0x5a, 0xdd, 0xba, 0xed, 0xb2, 0xa0, 0xa2, 0x67, 0x50, 0xff, 0x36, 0x79, 0xa3, 0xad,
+	0xdf, 0x47, 0x3f, 0xc3, 0x6b, 0x8f, 0x55, 0xcf, 0x63, 0xd5, 0xb2, 0x4a, 0xf8, 0xa9, 0x6c, 0x58,
+	0xfe, 0x6c, 0x40, 0x61, 0x4f, 0x3e, 0x55, 0x25, 0xc9, 0x88, 0xcf, 0x93, 0x84, 0xe4, 0x64, 0x41,
+	0xd7, 0x17, 0xb4, 0xe3, 0x53, 0x8c, 0x9c, 0xb0, 0x1b, 0x60, 0x44, 0x04, 0x8b, 0x8a, 0xc6, 0x8a,
+	0xb1, 0x3e, 0x56, 0x5b, 0x76, 0x93, 0x27, 0x8f, 0x07, 0xd0, 0x49, 0xc2, 0x98, 0x87, 0xb0, 0x7a,
+	0xbb, 0xa6, 0x81, 0x21, 0x0b, 0x68, 0x28, 0x45, 0x23, 0x52, 0x64, 0xdd, 0x22, 0xda, 0x4f, 0xa9,
+	0xf2, 0x97, 0x11, 0x98, 0x3d, 0x13, 0x2c, 0xc2, 0x2a, 0xe1, 0x7b, 0x2c, 0x6c, 0x52, 0xcf, 0x5c,
+	0x84, 0xc9, 0x16, 0xe1, 0x8e, 0xcb, 0xb8, 0xd0, 0xd3, 0x4c, 0xb4, 0xe2, 0x45, 0x2e, 0xcc, 0x12,
+	0xe4, 0x1b, 0xe8, 0xa3, 0x40, 0xb5, 0xaa, 0x5a, 0x80, 0x2a, 0x49, 0xe0, 0x29, 0xcc, 0x46, 0x48,
+	0x1a, 0x72, 0xd9, 0x69, 0xfa, 0x44, 0x14, 0x47, 0x25, 0x33, 0x1d, 0x57, 0x63, 0xe2, 0xc0, 0x27,
+	0xc2, 0xdc, 0x00, 0x33, 0xa5, 0x3a, 0x18, 0x39, 0xf5, 0x9e, 0xc0, 0xe2, 0x98, 0x24, 0x0b, 0x09,
+	0x79, 0x8a, 0xd1, 0x6e, 0x4f, 0xa0, 0xb9, 0x06, 0x85, 0xb7, 0x11, 0x15, 0x98, 0x71, 0x3e, 0x90,
+	0xe4, 0x8c, 0x2c, 0x0f, 0xa4, 0x9b, 0x30, 0x9f, 0xe1, 0x06, 0xd6, 0x71, 0xc9, 0x3e, 0x1c, 0xb0,
+	0x89, 0x76, 0x13, 0xe6, 0xa9, 0x88, 0x93, 0xc7, 0x4b, 0x91, 0x51, 0x4f, 0x28, 0x3c, 0x5e, 0x3a,
+	0xc1, 0x4b, 0x91, 0xd8, 0xcb, 0xef, 0x60, 0x4e, 0xc6, 0x74, 0x84, 0xbd, 0x34, 0xa9, 0x27, 0x30,
+	0xc5, 0xe3, 0xa2, 0xd3, 0xc6, 0x9e, 0x8c, 0x6a, 0xaa, 0x36, 0xc9, 0x35, 0x65, 0xbe, 0x04, 0xf0,
+	0x64, 0x8c, 0x31, 0x2a, 0xa3, 0xca, 0x6f, 0x3f, 0xb3, 0x6f, 0xfd, 0xfe, 0xed, 0xe1, 0x1d, 0xd8,
+	0x1d, 0x8b, 0xbf, 0xc3, 0xda, 0x94, 0x97, 0x14, 0xca, 0x1f, 0x47, 0x20, 0x5f, 0x25, 0xfc, 0xcc,
+	0x6d, 0x61, 0xa3, 0xeb, 0xa3, 0xf9, 0x0a, 0x66, 0xda, 0x17, 0x4e, 0x46, 0x6f, 0xfc, 0xbb, 0x3e,
+	0xdf, 0xbe, 0x48, 0xdf, 0xe4, 0x0d, 0x2c, 0x88, 0x88, 0x84, 0x9c, 0x62, 0x28, 0x9c, 0xff, 0x1b,
+	0xdb, 0x1c, 0x88, 0x52, 0xfd, 0x39, 0xcc, 0xa9, 0xa0, 0x52, 0x35, 0x2f, 0x8e, 0xca, 0x13, 0xb9,
+	0x7e, 0x9f, 0x3b, 0x9b, 0xb6, 0xd6, 0x17, 0xf8, 0x50, 0x53, 0x5e, 0xfe, 0x6d, 0xc0, 0x74, 0x15,
+	0x43, 0xe4, 0x94, 0x9f, 0x09, 0x22, 0xd0, 0x7c, 0x01, 0xf9, 0x26, 0x22, 0x77, 0x94, 0x50, 0x47,
+	0xb3, 0x7a, 0x47, 0x9b, 0xf4, 0xfe, 0xd0, 0x7e, 0x68, 0x0e, 0x2a, 0xe6, 0x6b, 0x98, 0xcb, 0x9c,
+	0x33, 0xed, 0x53, 0x91, 0xac, 0xdd, 0xe1, 0xfb, 0xeb, 0xc4, 0x27, 0x43, 0xbb, 0xc3, 0x65, 0xf3,
+	0x08, 0xa6, 0x63, 0x25, 0xd7, 0x1b, 0x2a, 0x4f, 0x49, 0x7e, 0xbb, 0x7c, 0x87, 0x34, 0xb3, 0xf5,
+	0xc9, 0xe6, 0x79, 0x99, 0xd2, 0xe1, 0xd7, 0xbe, 0x65, 0x5c, 0xf5, 0x2d, 0xe3, 0xba, 0x6f, 0x19,
+	0xbf, 0xfa, 0x96, 0xf1, 0xe1, 0xc6, 0xca, 0x5d, 0xdf, 0x58, 0xb9, 0xef, 0x37, 0x56, 0xee, 0xfc,
+	0xfe, 0xcb, 0xec, 0x72, 0xe8, 0xae, 0xae, 0x8f, 0xcb, 0x9b, 0x75, 0xe7, 0xcf, 0x00, 0xce, 0x48,
+	0xca, 0xf7, 0xc9, 0x05, 0x00, 0x00,
 }
 
 func (this *FeesParams) Equal(that interface{}) bool {
@@ -268,6 +505,110 @@ func (this *CosmosGasParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *StoreGasConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StoreGasConfig)
+	if !ok {
+		that2, ok := that.(StoreGasConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HasCost != that1.HasCost {
+		return false
+	}
+	if this.DeleteCost != that1.DeleteCost {
+		return false
+	}
+	if this.ReadCostFlat != that1.ReadCostFlat {
+		return false
+	}
+	if this.ReadCostPerByte != that1.ReadCostPerByte {
+		return false
+	}
+	if this.WriteCostFlat != that1.WriteCostFlat {
+		return false
+	}
+	if this.WriteCostPerByte != that1.WriteCostPerByte {
+		return false
+	}
+	if this.IterNextCostFlat != that1.IterNextCostFlat {
+		return false
+	}
+	return true
+}
+func (this *StoreKeyGasConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StoreKeyGasConfig)
+	if !ok {
+		that2, ok := that.(StoreKeyGasConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StoreKey != that1.StoreKey {
+		return false
+	}
+	if !this.GasConfig.Equal(&that1.GasConfig) {
+		return false
+	}
+	return true
+}
+func (this *GasSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasSchedule)
+	if !ok {
+		that2, ok := that.(GasSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.KvGasConfig.Equal(&that1.KvGasConfig) {
+		return false
+	}
+	if !this.TransientGasConfig.Equal(&that1.TransientGasConfig) {
+		return false
+	}
+	if len(this.StoreGasConfigs) != len(that1.StoreGasConfigs) {
+		return false
+	}
+	for i := range this.StoreGasConfigs {
+		if !this.StoreGasConfigs[i].Equal(&that1.StoreGasConfigs[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *GenesisState) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -293,6 +634,9 @@ func (this *GenesisState) Equal(that interface{}) bool {
 	if !this.CosmosGasParams.Equal(&that1.CosmosGasParams) {
 		return false
 	}
+	if !this.GasSchedule.Equal(&that1.GasSchedule) {
+		return false
+	}
 	return true
 }
 func (m *FeesParams) Marshal() (dAtA []byte, err error) {
@@ -365,7 +709,7 @@ func (m *CosmosGasParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *StoreGasConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -375,18 +719,76 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *StoreGasConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StoreGasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IterNextCostFlat != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IterNextCostFlat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WriteCostPerByte != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WriteCostPerByte))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WriteCostFlat != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WriteCostFlat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReadCostPerByte != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReadCostPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadCostFlat != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ReadCostFlat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeleteCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DeleteCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HasCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreKeyGasConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreKeyGasConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreKeyGasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.CosmosGasParams.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.GasConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -395,13 +797,120 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.FeesParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StoreGasConfigs) > 0 {
+		for iNdEx := len(m.StoreGasConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoreGasConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.TransientGasConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.KvGasConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CosmosGasParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FeesParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0xa
@@ -431,44 +940,465 @@ func (m *FeesParams) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *CosmosGasParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CosmosGasMultiplierNumerator != 0 {
-		n += 1 + sovTypes(uint64(m.CosmosGasMultiplierNumerator))
-	}
-	if m.CosmosGasMultiplierDenominator != 0 {
-		n += 1 + sovTypes(uint64(m.CosmosGasMultiplierDenominator))
-	}
-	return n
-}
+	return n
+}
+
+func (m *CosmosGasParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosGasMultiplierNumerator != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosGasMultiplierNumerator))
+	}
+	if m.CosmosGasMultiplierDenominator != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosGasMultiplierDenominator))
+	}
+	return n
+}
+
+func (m *StoreGasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasCost != 0 {
+		n += 1 + sovTypes(uint64(m.HasCost))
+	}
+	if m.DeleteCost != 0 {
+		n += 1 + sovTypes(uint64(m.DeleteCost))
+	}
+	if m.ReadCostFlat != 0 {
+		n += 1 + sovTypes(uint64(m.ReadCostFlat))
+	}
+	if m.ReadCostPerByte != 0 {
+		n += 1 + sovTypes(uint64(m.ReadCostPerByte))
+	}
+	if m.WriteCostFlat != 0 {
+		n += 1 + sovTypes(uint64(m.WriteCostFlat))
+	}
+	if m.WriteCostPerByte != 0 {
+		n += 1 + sovTypes(uint64(m.WriteCostPerByte))
+	}
+	if m.IterNextCostFlat != 0 {
+		n += 1 + sovTypes(uint64(m.IterNextCostFlat))
+	}
+	return n
+}
+
+func (m *StoreKeyGasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.GasConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *GasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.KvGasConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TransientGasConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.StoreGasConfigs) > 0 {
+		for _, e := range m.StoreGasConfigs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeesParams.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CosmosGasParams.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.GasSchedule.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeesParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeesParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeesParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalMinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalMinimumGasPrices = append(m.GlobalMinimumGasPrices, types.DecCoin{})
+			if err := m.GlobalMinimumGasPrices[len(m.GlobalMinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosGasParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosGasParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosGasMultiplierNumerator", wireType)
+			}
+			m.CosmosGasMultiplierNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosGasMultiplierNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosGasMultiplierDenominator", wireType)
+			}
+			m.CosmosGasMultiplierDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosGasMultiplierDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreGasConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreGasConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCost", wireType)
+			}
+			m.HasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteCost", wireType)
+			}
+			m.DeleteCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostFlat", wireType)
+			}
+			m.ReadCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostPerByte", wireType)
+			}
+			m.ReadCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostFlat", wireType)
+			}
+			m.WriteCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostPerByte", wireType)
+			}
+			m.WriteCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterNextCostFlat", wireType)
+			}
+			m.IterNextCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterNextCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.FeesParams.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.CosmosGasParams.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *FeesParams) Unmarshal(dAtA []byte) error {
+func (m *StoreKeyGasConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -491,15 +1421,47 @@ func (m *FeesParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeesParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreKeyGasConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeesParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreKeyGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalMinimumGasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -526,8 +1488,7 @@ func (m *FeesParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalMinimumGasPrices = append(m.GlobalMinimumGasPrices, types.DecCoin{})
-			if err := m.GlobalMinimumGasPrices[len(m.GlobalMinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GasConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -552,7 +1513,7 @@ func (m *FeesParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CosmosGasParams) Unmarshal(dAtA []byte) error {
+func (m *GasSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -575,17 +1536,17 @@ func (m *CosmosGasParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CosmosGasParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CosmosGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosGasMultiplierNumerator", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KvGasConfig", wireType)
 			}
-			m.CosmosGasMultiplierNumerator = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -595,16 +1556,30 @@ func (m *CosmosGasParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosGasMultiplierNumerator |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KvGasConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosGasMultiplierDenominator", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransientGasConfig", wireType)
 			}
-			m.CosmosGasMultiplierDenominator = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -614,11 +1589,59 @@ func (m *CosmosGasParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosGasMultiplierDenominator |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransientGasConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreGasConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreGasConfigs = append(m.StoreGasConfigs, StoreKeyGasConfig{})
+			if err := m.StoreGasConfigs[len(m.StoreGasConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -735,6 +1758,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,67 @@
+package aclmapping
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkacltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+	aclkeeper "github.com/cosmos/cosmos-sdk/x/accesscontrol/keeper"
+	acltypes "github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetStakingDependencyGenerators returns the dependency generators of the x/staking messages.
+func GetStakingDependencyGenerators() aclkeeper.DependencyGeneratorMap {
+	return aclkeeper.DependencyGeneratorMap{
+		acltypes.GenerateMessageKey(&stakingtypes.MsgCancelUnbondingDelegation{}): MsgCancelUnbondingDelegationDependencyGenerator,
+	}
+}
+
+// MsgCancelUnbondingDelegationDependencyGenerator returns the access operations of a MsgCancelUnbondingDelegation.
+// The staking keys of the delegator and the validator are accessed individually, while the distribution hooks
+// and the transfers between the staking pools are covered by their whole stores.
+func MsgCancelUnbondingDelegationDependencyGenerator(
+	keeper aclkeeper.Keeper, ctx sdk.Context, msg sdk.Msg,
+) ([]sdkacltypes.AccessOperation, error) {
+	cancelMsg, ok := msg.(*stakingtypes.MsgCancelUnbondingDelegation)
+	if !ok {
+		return []sdkacltypes.AccessOperation{}, fmt.Errorf("invalid message received for MsgCancelUnbondingDelegation")
+	}
+	delAddr, err := sdk.AccAddressFromBech32(cancelMsg.DelegatorAddress)
+	if err != nil {
+		return []sdkacltypes.AccessOperation{}, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(cancelMsg.ValidatorAddress)
+	if err != nil {
+		return []sdkacltypes.AccessOperation{}, err
+	}
+
+	readWrite := func(resourceType sdkacltypes.ResourceType, identifier []byte) []sdkacltypes.AccessOperation {
+		identifierTemplate := "*"
+		if identifier != nil {
+			identifierTemplate = hex.EncodeToString(identifier)
+		}
+		return []sdkacltypes.AccessOperation{
+			{ResourceType: resourceType, AccessType: sdkacltypes.AccessType_READ, IdentifierTemplate: identifierTemplate},
+			{ResourceType: resourceType, AccessType: sdkacltypes.AccessType_WRITE, IdentifierTemplate: identifierTemplate},
+		}
+	}
+
+	accessOps := []sdkacltypes.AccessOperation{
+		{ResourceType: sdkacltypes.ResourceType_KV_STAKING_TOTAL_POWER, AccessType: sdkacltypes.AccessType_READ, IdentifierTemplate: hex.EncodeToString(stakingtypes.LastTotalPowerKey)},
+		{ResourceType: sdkacltypes.ResourceType_KV_AUTH, AccessType: sdkacltypes.AccessType_READ, IdentifierTemplate: "*"},
+	}
+	accessOps = append(accessOps, readWrite(sdkacltypes.ResourceType_KV_STAKING_VALIDATOR, stakingtypes.GetValidatorKey(valAddr))...)
+	accessOps = append(accessOps, readWrite(sdkacltypes.ResourceType_KV_STAKING_VALIDATORS_BY_POWER, stakingtypes.ValidatorsByPowerIndexKey)...)
+	accessOps = append(accessOps, readWrite(sdkacltypes.ResourceType_KV_STAKING_DELEGATION, stakingtypes.GetDelegationKey(delAddr, valAddr))...)
+	accessOps = append(accessOps, readWrite(sdkacltypes.ResourceType_KV_STAKING_UNBONDING_DELEGATION, stakingtypes.GetUBDKey(delAddr, valAddr))...)
+	accessOps = append(accessOps, readWrite(sdkacltypes.ResourceType_KV_STAKING_UNBONDING_DELEGATION_VAL, stakingtypes.GetUBDByValIndexKey(delAddr, valAddr))...)
+	// the completion time of the entry, which keys its unbonding queue timeslice, is only known from the store
+	accessOps = append(accessOps, readWrite(sdkacltypes.ResourceType_KV_STAKING_UNBONDING, stakingtypes.UnbondingQueueKey)...)
+	accessOps = append(accessOps, readWrite(sdkacltypes.ResourceType_KV_BANK_BALANCES, nil)...)
+	accessOps = append(accessOps, readWrite(sdkacltypes.ResourceType_KV_DISTRIBUTION, nil)...)
+	accessOps = append(accessOps, *acltypes.CommitAccessOp())
+
+	return accessOps, nil
+}
@@ -0,0 +1,39 @@
+package aclmapping_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkacltypes "github.com/cosmos/cosmos-sdk/types/accesscontrol"
+	acltypes "github.com/cosmos/cosmos-sdk/x/accesscontrol/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/aclmapping"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMsgCancelUnbondingDelegationDependencyGenerator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.ZeroInt())
+	valAddr := sdk.ValAddress(addrs[0])
+	msg := stakingtypes.NewMsgCancelUnbondingDelegation(addrs[0], valAddr, 1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	generator, ok := aclmapping.GetStakingDependencyGenerators()[acltypes.GenerateMessageKey(msg)]
+	require.True(t, ok)
+	accessOps, err := generator(app.AccessControlKeeper, ctx, msg)
+	require.NoError(t, err)
+	require.NoError(t, acltypes.ValidateAccessOps(accessOps))
+	require.Contains(t, accessOps, sdkacltypes.AccessOperation{
+		ResourceType:       sdkacltypes.ResourceType_KV_STAKING_UNBONDING_DELEGATION,
+		AccessType:         sdkacltypes.AccessType_WRITE,
+		IdentifierTemplate: hex.EncodeToString(stakingtypes.GetUBDKey(addrs[0], valAddr)),
+	})
+
+	_, err = generator(app.AccessControlKeeper, ctx, &stakingtypes.MsgUndelegate{})
+	require.Error(t, err)
+}
@@ -105,6 +105,58 @@ func TestInitGenesis(t *testing.T) {
 	require.Equal(t, abcivals, vals)
 }
 
+func TestInitGenesisWithoutLiquidShares(t *testing.T) {
+	app, ctx, addrs := bootstrapGenesisTest(2)
+
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	params := app.StakingKeeper.GetParams(ctx)
+
+	pk0, err := codectypes.NewAnyWithValue(PKs[0])
+	require.NoError(t, err)
+
+	// a validator exported before the tokenization of delegations has no validator bond and liquid shares
+	validator := types.Validator{
+		OperatorAddress: sdk.ValAddress(addrs[0]).String(),
+		ConsensusPubkey: pk0,
+		Status:          types.Bonded,
+		Tokens:          valTokens,
+		DelegatorShares: valTokens.ToDec(),
+		Description:     types.NewDescription("hoop", "", "", "", ""),
+	}
+	require.True(t, validator.ValidatorBondShares.IsNil())
+	require.True(t, validator.LiquidShares.IsNil())
+	validators := append(app.StakingKeeper.GetAllValidators(ctx), validator)
+	require.NoError(t,
+		simapp.FundModuleAccount(
+			app.BankKeeper,
+			ctx,
+			types.BondedPoolName,
+			sdk.NewCoins(
+				sdk.NewCoin(params.BondDenom, valTokens.MulRaw((int64)(len(validators)))),
+			),
+		),
+	)
+	genesisState := types.NewGenesisState(params, validators, nil)
+	staking.InitGenesis(ctx, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, genesisState)
+
+	resVal, found := app.StakingKeeper.GetValidator(ctx, sdk.ValAddress(addrs[0]))
+	require.True(t, found)
+	require.Equal(t, sdk.ZeroDec(), resVal.ValidatorBondShares)
+	require.Equal(t, sdk.ZeroDec(), resVal.LiquidShares)
+
+	// the validator can be delegated to
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[1], valTokens, types.Unbonded, resVal, true)
+	require.NoError(t, err)
+
+	// the exported genesis holds the shares
+	exported := staking.ExportGenesis(ctx, app.StakingKeeper)
+	require.NoError(t, staking.ValidateGenesis(exported))
+	for _, val := range exported.Validators {
+		require.False(t, val.ValidatorBondShares.IsNil())
+		require.False(t, val.LiquidShares.IsNil())
+	}
+}
+
 func TestInitGenesis_PoolsBalanceMismatch(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.NewContext(false, tmproto.Header{})
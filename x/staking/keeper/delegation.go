@@ -362,6 +362,29 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 	}
 }
 
+// RemoveUBDQueuePair removes a pair of an unbonding delegation from the
+// timeslice of the unbonding queue at completionTime, deleting the timeslice
+// once it is empty. Every entry of an unbonding delegation has a pair of its
+// own, so the pairs of the other entries completing at the same time are kept.
+func (k Keeper) RemoveUBDQueuePair(ctx sdk.Context, ubd types.UnbondingDelegation,
+	completionTime time.Time,
+) {
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress == ubd.DelegatorAddress && dvPair.ValidatorAddress == ubd.ValidatorAddress {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // UBDQueueIterator returns all the unbonding queue timeslices from time 0 until endTime.
 func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -719,12 +742,18 @@ func (k Keeper) Delegate(
 		}
 	}
 
-	_, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
+	validator, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
 
 	// Update delegation
 	delegation.Shares = delegation.Shares.Add(newShares)
 	k.SetDelegation(ctx, delegation)
 
+	// the shares of a validator bond count towards the liquid shares the validator can issue
+	if delegation.ValidatorBond {
+		validator.ValidatorBondShares = validator.ValidatorBondShares.Add(newShares)
+		k.SetValidator(ctx, validator)
+	}
+
 	// Call the after-modification hook
 	k.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr())
 
@@ -786,6 +815,13 @@ func (k Keeper) Unbond(
 	// NOTE that the amount is later (in keeper.Delegation) moved between staking module pools
 	validator, amount = k.RemoveValidatorTokensAndShares(ctx, validator, shares)
 
+	// NOTE the liquid shares are not checked against the remaining validator bond, so that
+	// slashing a redelegation can always unbond the shares of a validator bond
+	if delegation.ValidatorBond {
+		validator.ValidatorBondShares = validator.ValidatorBondShares.Sub(shares)
+		k.SetValidator(ctx, validator)
+	}
+
 	if validator.DelegatorShares.IsZero() && validator.IsUnbonded() {
 		// if not unbonded, we must instead remove validator in EndBlocker once it finishes its unbonding period
 		k.RemoveValidator(ctx, validator.GetOperator())
@@ -839,6 +875,10 @@ func (k Keeper) Undelegate(
 		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
 	}
 
+	if err := k.validateValidatorBondRemoval(ctx, delAddr, validator, sharesAmount); err != nil {
+		return time.Time{}, err
+	}
+
 	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
@@ -856,6 +896,73 @@ func (k Keeper) Undelegate(
 	return completionTime, nil
 }
 
+// CancelUnbondingDelegation delegates an amount of the unbonding delegation
+// entry created at creationHeight back to the validator. An entry whose whole
+// balance is delegated back is removed along with its pair in the unbonding
+// queue, and the unbonding delegation is removed once it has no entries left.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if validator.IsJailed() {
+		return types.ErrValidatorJailed
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "no entry created at height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if entry.IsMature(ctx.BlockHeader().Time) {
+		return types.ErrUnbondingDelegationEntryMature
+	}
+
+	if entry.Balance.LT(amount) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "amount %s exceeds the unbonding delegation entry balance %s", amount, entry.Balance,
+		)
+	}
+
+	// the balance of the entry is held by the not bonded pool until it completes
+	if _, err := k.Delegate(ctx, delAddr, amount, types.Unbonding, validator, false); err != nil {
+		return err
+	}
+
+	entry.Balance = entry.Balance.Sub(amount)
+	entry.InitialBalance = entry.InitialBalance.Sub(amount)
+	if entry.Balance.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+		k.RemoveUBDQueuePair(ctx, ubd, entry.CompletionTime)
+	} else {
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return nil
+}
+
 // CompleteUnbonding completes the unbonding of all mature entries in the
 // retrieved unbonding delegation object and returns the total unbonding balance
 // or an error upon failure.
@@ -933,6 +1040,10 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
+	if err := k.validateValidatorBondRemoval(ctx, delAddr, srcValidator, sharesAmount); err != nil {
+		return time.Time{}, err
+	}
+
 	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
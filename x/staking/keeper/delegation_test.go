@@ -397,6 +397,78 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	require.True(sdk.IntEq(t, newNotBonded, oldNotBonded.AddRaw(1)))
 }
 
+func TestCancelUnbondingDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+	ctx = ctx.WithBlockHeight(10)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	startTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, notBondedPool.GetName(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// create a bonded validator and a delegator to that validator
+	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	unbondTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, addrDels[0], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+	require.Len(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime), 1)
+	oldBonded := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount
+
+	// the entry has to exist and hold the amount
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 9, unbondTokens)
+	require.ErrorIs(t, err, types.ErrNoUnbondingDelegationEntry)
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 10, unbondTokens.AddRaw(1))
+	require.Error(t, err)
+
+	// cancel part of the entry
+	cancelTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	require.NoError(t, app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 10, cancelTokens))
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].Balance)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].InitialBalance)
+	require.Len(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime), 1)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, startTokens.Sub(unbondTokens).Add(cancelTokens), delegation.Shares.RoundInt())
+	newBonded := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount
+	require.True(sdk.IntEq(t, oldBonded.Add(cancelTokens), newBonded))
+
+	// cancel the rest of the entry, which removes it from the unbonding queue
+	require.NoError(t, app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 10, unbondTokens.Sub(cancelTokens)))
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime))
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, startTokens, delegation.Shares.RoundInt())
+
+	// a mature entry can't be canceled
+	completionTime, err = app.StakingKeeper.Undelegate(ctx, addrDels[0], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(completionTime)
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], 10, unbondTokens)
+	require.ErrorIs(t, err, types.ErrUnbondingDelegationEntryMature)
+}
+
 //// test undelegating self delegation from a validator pushing it below MinSelfDelegation
 //// shift it from the bonded to unbonding state and jailed
 func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
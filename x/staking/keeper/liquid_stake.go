@@ -0,0 +1,433 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTotalLiquidStakedTokens returns the amount of tokens held by the tokenized delegations
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// SetTotalLiquidStakedTokens sets the amount of tokens held by the tokenized delegations
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// DecreaseTotalLiquidStakedTokens decreases the amount of tokens held by the tokenized delegations. The total
+// is floored at zero, as the truncation of the token amounts can leave it slightly off the sum of the tokens of
+// the tokenized delegations.
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Int) {
+	k.SetTotalLiquidStakedTokens(ctx, sdk.MaxInt(k.GetTotalLiquidStakedTokens(ctx).Sub(tokens), sdk.ZeroInt()))
+}
+
+// ValidatorBondFactor - Maximal ratio of the liquid shares of a validator to its validator bond shares
+func (k Keeper) ValidatorBondFactor(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorBondFactor, &res)
+	return
+}
+
+// GlobalLiquidStakingCap - Maximal ratio of the liquid staked tokens to the total bonded tokens
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &res)
+	return
+}
+
+// ValidatorLiquidStakingCap - Maximal ratio of the liquid shares of a validator to its delegator shares
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
+	return
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the shares worth tokens of a delegation to the
+// validator exceeds the validator bond requirement or the liquid staking caps
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec, tokens sdk.Int) error {
+	liquidShares := validator.LiquidShares.Add(shares)
+
+	bondFactor := k.ValidatorBondFactor(ctx)
+	if !bondFactor.Equal(types.ValidatorBondCapDisabled) && liquidShares.GT(validator.ValidatorBondShares.Mul(bondFactor)) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+
+	validatorCap := k.ValidatorLiquidStakingCap(ctx)
+	if validatorCap.LT(sdk.OneDec()) && liquidShares.GT(validator.DelegatorShares.Mul(validatorCap)) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	globalCap := k.GlobalLiquidStakingCap(ctx)
+	liquidTokens := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+	if globalCap.LT(sdk.OneDec()) && liquidTokens.ToDec().GT(k.TotalBondedTokens(ctx).ToDec().Mul(globalCap)) {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	return nil
+}
+
+// validateValidatorBondRemoval returns an error if removing shares from the delegation of delAddr to the
+// validator leaves the validator with too few validator bond shares for its liquid shares
+func (k Keeper) validateValidatorBondRemoval(ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, shares sdk.Dec) error {
+	delegation, found := k.GetDelegation(ctx, delAddr, validator.GetOperator())
+	if !found || !delegation.ValidatorBond {
+		return nil
+	}
+
+	bondFactor := k.ValidatorBondFactor(ctx)
+	if bondFactor.Equal(types.ValidatorBondCapDisabled) {
+		return nil
+	}
+
+	if validator.LiquidShares.GT(validator.ValidatorBondShares.Sub(shares).Mul(bondFactor)) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+
+	return nil
+}
+
+// ValidatorBond flags the delegation of delAddr to a validator as a validator bond, whose shares allow the
+// validator to issue liquid shares
+func (k Keeper) ValidatorBond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegation
+	}
+
+	if delegation.ValidatorBond {
+		return nil
+	}
+
+	delegation.ValidatorBond = true
+	k.SetDelegation(ctx, delegation)
+
+	validator.ValidatorBondShares = validator.ValidatorBondShares.Add(delegation.Shares)
+	k.SetValidator(ctx, validator)
+
+	return nil
+}
+
+// TokenizeShares moves the shares worth amount of the delegation of delAddr to a validator to the module account
+// of a new tokenize share record owned by owner, and sends delAddr share tokens of the record for the moved shares.
+// The coins are moved through the account of the delegator, so that locked vesting coins can't be tokenized.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Int, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	if validator.InvalidExRate() {
+		return sdk.Coin{}, types.ErrDelegatorShareExRateInvalid
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	if delegation.ValidatorBond {
+		return sdk.Coin{}, types.ErrValidatorBondNotAllowedForTokenizeShare
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares, amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	record := types.NewTokenizeShareRecord(recordID, owner, valAddr)
+	moduleAddr := record.GetModuleAddress()
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, moduleAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the validator must be reloaded as unbonding changed its tokens and shares
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	newShares, err := k.Delegate(ctx, moduleAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the share tokens map 1:1 to the shares of the tokenized delegation
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator = k.mustGetValidator(ctx, valAddr)
+	validator.LiquidShares = validator.LiquidShares.Add(newShares)
+	k.SetValidator(ctx, validator)
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(returnAmount))
+
+	return shareToken, nil
+}
+
+// withdrawTokenizeShareRecordRewards withdraws the rewards of the tokenized delegation of a record to the module
+// account of the record through the modification hooks of the delegation, and returns the withdrawn rewards.
+func (k Keeper) withdrawTokenizeShareRecordRewards(ctx sdk.Context, record types.TokenizeShareRecord) (sdk.Coins, error) {
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleAddr := record.GetModuleAddress()
+	if _, found := k.GetDelegation(ctx, moduleAddr, valAddr); !found {
+		return sdk.Coins{}, nil
+	}
+
+	before := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+	k.BeforeDelegationSharesModified(ctx, moduleAddr, valAddr)
+	k.AfterDelegationModified(ctx, moduleAddr, valAddr)
+
+	return k.bankKeeper.GetAllBalances(ctx, moduleAddr).Sub(before), nil
+}
+
+// updateTokenizeShareRewardIndex withdraws the rewards of the tokenized delegation of a record and adds them per
+// share token to the reward index of the record, which it returns. The share token supply must not have changed
+// since the rewards were last withdrawn, which the settlement of every balance change of the share tokens ensures.
+func (k Keeper) updateTokenizeShareRewardIndex(ctx sdk.Context, record types.TokenizeShareRecord) (sdk.DecCoins, error) {
+	index := k.GetTokenizeShareRewardIndex(ctx, record.Id)
+	rewards, err := k.withdrawTokenizeShareRecordRewards(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	// without share tokens the rewards stay in the module account of the record
+	supply := k.bankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).Amount
+	if rewards.IsZero() || !supply.IsPositive() {
+		return index, nil
+	}
+
+	index = index.Add(sdk.NewDecCoinsFromCoins(rewards...).QuoDecTruncate(supply.ToDec())...)
+	k.setTokenizeShareRewardIndex(ctx, record.Id, index)
+	return index, nil
+}
+
+// SettleTokenizeShareRecordRewards pays holder the rewards of the tokenized delegation of a record its share
+// tokens accrued since they were last settled, i.e. the share tokens times the increase of the reward index of
+// the record, and returns the paid rewards. The share tokens held by the module account of the record aren't paid,
+// their rewards stay in the module account.
+func (k Keeper) SettleTokenizeShareRecordRewards(
+	ctx sdk.Context, record types.TokenizeShareRecord, holder sdk.AccAddress,
+) (sdk.Coins, error) {
+	index, err := k.updateTokenizeShareRewardIndex(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleAddr := record.GetModuleAddress()
+	if holder.Equals(moduleAddr) {
+		return sdk.Coins{}, nil
+	}
+
+	checkpoint := k.getTokenizeShareRewardCheckpoint(ctx, record.Id, holder)
+	k.setTokenizeShareRewardCheckpoint(ctx, record.Id, holder, index)
+
+	shareTokens := k.bankKeeper.GetBalance(ctx, holder, record.GetShareTokenDenom()).Amount
+	if !shareTokens.IsPositive() {
+		return sdk.Coins{}, nil
+	}
+
+	payout, _ := index.Sub(checkpoint).MulDecTruncate(shareTokens.ToDec()).TruncateDecimal()
+	if payout.IsZero() {
+		return payout, nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, moduleAddr, holder, payout); err != nil {
+		return nil, err
+	}
+
+	return payout, nil
+}
+
+// BeforeShareTokenBalanceChange settles the rewards of the share tokens of addr before its balance of a share token
+// denom changes, so that the share tokens are only paid the rewards accrued while they were held. It is meant to
+// be registered as a balance change hook of the bank keeper.
+func (k Keeper) BeforeShareTokenBalanceChange(ctx sdk.Context, addr sdk.AccAddress, denom string) error {
+	// the share token denoms start with the validator address, which saves the lookup of the other denoms
+	if !strings.HasPrefix(denom, sdk.GetConfig().GetBech32ValidatorAddrPrefix()) {
+		return nil
+	}
+
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	_, err := k.SettleTokenizeShareRecordRewards(ctx, record, addr)
+	return err
+}
+
+// RedeemTokensForShares burns the share tokens of delAddr and moves the shares they map to from the tokenized
+// delegation of their record to the delegation of delAddr. The rewards accrued by the share tokens of delAddr are
+// paid to delAddr. Redeeming the last share tokens of a record redeems the whole remaining tokenized delegation,
+// pays the rewards left in the module account of the record to delAddr and removes the record.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, amount.Denom)
+	if balance.Amount.LT(amount.Amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, amount)
+	}
+
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrTokenizeShareRecordNotExists
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	moduleAddr := record.GetModuleAddress()
+	delegation, found := k.GetDelegation(ctx, moduleAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	// the rewards accrued by the share tokens are paid before they are burnt, which changes the share token supply
+	if _, err := k.SettleTokenizeShareRecordRewards(ctx, record, delAddr); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareTokens := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, shareTokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shareTokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the share tokens are truncated from the shares of the tokenized delegation, so the last share tokens
+	// also redeem the remaining fraction of a share
+	shares := amount.Amount.ToDec()
+	if k.bankKeeper.GetSupply(ctx, amount.Denom).IsZero() || shares.GT(delegation.Shares) {
+		shares = delegation.Shares
+	}
+
+	returnAmount, err := k.Unbond(ctx, moduleAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	k.DecreaseTotalLiquidStakedTokens(ctx, returnAmount)
+	validator, validatorFound := k.GetValidator(ctx, valAddr)
+	if validatorFound {
+		validator.LiquidShares = sdk.MaxDec(validator.LiquidShares.Sub(shares), sdk.ZeroDec())
+		k.SetValidator(ctx, validator)
+	}
+
+	// the rewards left in the module account of the record, e.g. truncated from the payouts of earlier redeems,
+	// are paid to the holder of the last share tokens
+	if _, found := k.GetDelegation(ctx, moduleAddr, valAddr); !found {
+		if rewards := k.bankKeeper.GetAllBalances(ctx, moduleAddr); !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, moduleAddr, delAddr, rewards); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the tokens are left to delAddr if the validator was removed by the unbonding
+	if !validatorFound || !returnAmount.IsPositive() {
+		return returnCoin, nil
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return returnCoin, nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share record from sender to newOwner. The
+// rewards accrued by the share tokens of sender are paid to sender, as it can't withdraw them as the owner anymore.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, id uint64, sender, newOwner sdk.AccAddress) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	if record.Owner != sender.String() {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	if _, err := k.SettleTokenizeShareRecordRewards(ctx, record, sender); err != nil {
+		return err
+	}
+
+	k.deleteTokenizeShareRecordWithOwner(ctx, sender, id)
+	record.Owner = newOwner.String()
+	k.setTokenizeShareRecord(ctx, record)
+	k.setTokenizeShareRecordWithOwner(ctx, newOwner, id)
+
+	return nil
+}
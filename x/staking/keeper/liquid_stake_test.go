@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// bootstrapLiquidStakeTest creates a bonded validator of power 10 and delegations of the powers to it
+// from the first of the two returned delegator addresses.
+func bootstrapLiquidStakeTest(t *testing.T, powers ...int64) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, sdk.ValAddress) {
+	_, app, ctx := createTestInput()
+
+	addrDels, addrVals := generateAddresses(app, ctx, 3)
+	valAddr := addrVals[2]
+
+	startTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, notBondedPool.GetName(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), startTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := teststaking.NewValidator(t, valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+	require.True(t, validator.IsBonded())
+
+	for i, power := range powers {
+		shares := app.StakingKeeper.TokensFromConsensusPower(ctx, power).ToDec()
+		app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[i], valAddr, shares))
+	}
+
+	return app, ctx, addrDels[:2], valAddr
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app, ctx, addrDels, valAddr := bootstrapLiquidStakeTest(t, 10)
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	tokenizeTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], valAddr, delTokens.AddRaw(1), addrDels[0])
+	require.Error(t, err)
+
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], valAddr, tokenizeTokens, addrDels[0])
+	require.NoError(t, err)
+	require.Equal(t, tokenizeTokens, shareToken.Amount)
+	require.Equal(t, shareToken, app.BankKeeper.GetBalance(ctx, addrDels[0], shareToken.Denom))
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	require.True(t, found)
+	require.Equal(t, uint64(1), record.Id)
+	require.Equal(t, addrDels[0].String(), record.Owner)
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrDels[0]))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeTokens.ToDec(), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], valAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(tokenizeTokens).ToDec(), delegation.Shares)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeTokens.ToDec(), validator.LiquidShares)
+	require.Equal(t, delTokens, validator.Tokens)
+	require.Equal(t, tokenizeTokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the share tokens can be redeemed by any holder
+	redeemTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrDels[0], addrDels[1], sdk.NewCoins(sdk.NewCoin(shareToken.Denom, redeemTokens))))
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[1], sdk.NewCoin(shareToken.Denom, redeemTokens.AddRaw(1)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[1], sdk.NewCoin(shareToken.Denom, redeemTokens))
+	require.NoError(t, err)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddr)
+	require.True(t, found)
+	require.Equal(t, redeemTokens.ToDec(), delegation.Shares)
+	require.Equal(t, tokenizeTokens.Sub(redeemTokens), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.True(t, found)
+
+	// redeeming the last share tokens removes the record
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[0], app.BankKeeper.GetBalance(ctx, addrDels[0], shareToken.Denom))
+	require.NoError(t, err)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], valAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(redeemTokens).ToDec(), delegation.Shares)
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrDels[0]))
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.LiquidShares.IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx, shareToken.Denom).IsZero())
+}
+
+func TestTransferTokenizeShareRecord(t *testing.T) {
+	app, ctx, addrDels, valAddr := bootstrapLiquidStakeTest(t, 10)
+	tokenizeTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], valAddr, tokenizeTokens, addrDels[0])
+	require.NoError(t, err)
+
+	err = app.StakingKeeper.TransferTokenizeShareRecord(ctx, 2, addrDels[0], addrDels[1])
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+	err = app.StakingKeeper.TransferTokenizeShareRecord(ctx, 1, addrDels[1], addrDels[1])
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	require.NoError(t, app.StakingKeeper.TransferTokenizeShareRecord(ctx, 1, addrDels[0], addrDels[1]))
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, addrDels[1].String(), record.Owner)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrDels[0]))
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrDels[1]))
+}
+
+func TestValidatorBondAndLiquidStakingCaps(t *testing.T) {
+	app, ctx, addrDels, valAddr := bootstrapLiquidStakeTest(t, 6, 4)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// no liquid shares can be issued without validator bond
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 1), addrDels[0])
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	require.NoError(t, app.StakingKeeper.ValidatorBond(ctx, addrDels[1], valAddr))
+	require.NoError(t, app.StakingKeeper.ValidatorBond(ctx, addrDels[1], valAddr))
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 4).ToDec(), validator.ValidatorBondShares)
+
+	// the validator bond itself can't be tokenized
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[1], valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 1), addrDels[1])
+	require.ErrorIs(t, err, types.ErrValidatorBondNotAllowedForTokenizeShare)
+
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 5), addrDels[0])
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 2), addrDels[0])
+	require.NoError(t, err)
+
+	// the validator bond can't be removed below the liquid shares
+	_, err = app.StakingKeeper.Undelegate(ctx, addrDels[1], valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 3).ToDec())
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+	_, err = app.StakingKeeper.Undelegate(ctx, addrDels[1], valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 2).ToDec())
+	require.NoError(t, err)
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 2).ToDec(), validator.ValidatorBondShares)
+
+	// the liquid shares of the validator are capped
+	params.ValidatorBondFactor = types.ValidatorBondCapDisabled
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(3, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 1), addrDels[0])
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// the liquid staked tokens of all validators are capped
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	app.StakingKeeper.SetParams(ctx, params)
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrDels[0], valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 1), addrDels[0])
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+}
+
+func TestSlashTokenizedDelegation(t *testing.T) {
+	app, ctx, addrDels, valAddr := bootstrapLiquidStakeTest(t, 10)
+	tokenizeTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], valAddr, tokenizeTokens, addrDels[0])
+	require.NoError(t, err)
+
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, sdk.NewDecWithPrec(5, 1))
+
+	// the shares are left untouched while the liquid staked tokens are slashed
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeTokens.ToDec(), validator.LiquidShares)
+	require.Equal(t, tokenizeTokens.QuoRaw(2), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	returnCoin, err := app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[0], shareToken)
+	require.NoError(t, err)
+	require.Equal(t, tokenizeTokens.QuoRaw(2), returnCoin.Amount)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], valAddr)
+	require.True(t, found)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 10).ToDec(), delegation.Shares)
+}
+
+func TestRedeemTokensPaysRewards(t *testing.T) {
+	app, ctx, addrDels, valAddr := bootstrapLiquidStakeTest(t, 10)
+	tokenizeTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrDels[0], valAddr, tokenizeTokens, addrDels[0])
+	require.NoError(t, err)
+	record, found := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	require.True(t, found)
+
+	// the coins sent to the module account of the record aren't withdrawn rewards of its delegation, so they
+	// don't accrue to the share tokens
+	rewardDenom := "reward"
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, record.GetModuleAddress(), sdk.NewCoins(sdk.NewInt64Coin(rewardDenom, 100))))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrDels[0], addrDels[1], sdk.NewCoins(sdk.NewCoin(shareToken.Denom, tokenizeTokens.QuoRaw(4)))))
+	require.True(t, app.StakingKeeper.GetTokenizeShareRewardIndex(ctx, record.Id).IsZero())
+
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[1], sdk.NewCoin(shareToken.Denom, tokenizeTokens.QuoRaw(4)))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, addrDels[1], rewardDenom).IsZero())
+
+	require.NoError(t, app.StakingKeeper.TransferTokenizeShareRecord(ctx, record.Id, addrDels[0], addrDels[1]))
+	require.True(t, app.BankKeeper.GetBalance(ctx, addrDels[0], rewardDenom).IsZero())
+
+	// the holder of the last share tokens is paid the rewards left
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrDels[0], app.BankKeeper.GetBalance(ctx, addrDels[0], shareToken.Denom))
+	require.NoError(t, err)
+	require.Equal(t, int64(100), app.BankKeeper.GetBalance(ctx, addrDels[0], rewardDenom).Amount.Int64())
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/staking/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,21 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey)
 }
+
+// Migrate2to3 migrates from version 2 to 3. It sets the params of the tokenization of delegations to their
+// defaults, and initializes the validator bond shares and liquid shares of the validators to zero.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyValidatorBondFactor, types.DefaultValidatorBondFactor)
+	m.keeper.paramstore.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	m.keeper.paramstore.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+
+	for _, validator := range m.keeper.GetAllValidators(ctx) {
+		validator.ValidatorBondShares = sdk.ZeroDec()
+		validator.LiquidShares = sdk.ZeroDec()
+		m.keeper.SetValidator(ctx, validator)
+	}
+
+	m.keeper.SetTotalLiquidStakedTokens(ctx, sdk.ZeroInt())
+
+	return nil
+}
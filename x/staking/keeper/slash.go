@@ -119,8 +119,14 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
+	initialLiquidTokens := validator.TokensFromShares(validator.LiquidShares).TruncateInt()
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
 
+	// Deduct the slashed tokens of the tokenized delegations from the total liquid staked tokens.
+	// The unbonding delegations and redelegations slashed above are never tokenized.
+	slashedLiquidTokens := initialLiquidTokens.Sub(validator.TokensFromShares(validator.LiquidShares).TruncateInt())
+	k.DecreaseTotalLiquidStakedTokens(ctx, slashedLiquidTokens)
+
 	switch validator.GetStatus() {
 	case types.Bonded:
 		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the id
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of a share token denom
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return record, false
+	}
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an owner
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTokenizeShareRecordIDsByOwnerPrefix(owner))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, found := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Key()))
+		if !found {
+			panic("tokenize share record of owner index not found")
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// AddTokenizeShareRecord stores a new tokenize share record along with its owner and denom indexes
+func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	if _, found := k.GetTokenizeShareRecord(ctx, record.Id); found {
+		return types.ErrTokenizeShareRecordAlreadyExists
+	}
+
+	k.setTokenizeShareRecord(ctx, record)
+	k.setTokenizeShareRecordWithOwner(ctx, sdk.MustAccAddressFromBech32(record.Owner), record.Id)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.Id))
+
+	return nil
+}
+
+// DeleteTokenizeShareRecord removes the tokenize share record with the id along with its indexes
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+	k.deleteTokenizeShareRecordWithOwner(ctx, sdk.MustAccAddressFromBech32(record.Owner), id)
+	deletePrefix(store, types.GetTokenizeShareRewardIndexPrefix(id))
+	deletePrefix(store, types.GetTokenizeShareRewardCheckpointsPrefix(id))
+
+	return nil
+}
+
+func (k Keeper) setTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), k.cdc.MustMarshal(&record))
+}
+
+func (k Keeper) setTokenizeShareRecordWithOwner(ctx sdk.Context, owner sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id), []byte{})
+}
+
+func (k Keeper) deleteTokenizeShareRecordWithOwner(ctx sdk.Context, owner sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id))
+}
+
+// GetTokenizeShareRewardIndex returns the cumulative rewards per share token of a tokenize share record
+func (k Keeper) GetTokenizeShareRewardIndex(ctx sdk.Context, id uint64) sdk.DecCoins {
+	return k.getDecCoins(ctx, types.GetTokenizeShareRewardIndexPrefix(id))
+}
+
+func (k Keeper) setTokenizeShareRewardIndex(ctx sdk.Context, id uint64, index sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	for _, reward := range index {
+		store.Set(types.GetTokenizeShareRewardIndexKey(id, reward.Denom), k.cdc.MustMarshal(&sdk.DecProto{Dec: reward.Amount}))
+	}
+}
+
+// getTokenizeShareRewardCheckpoint returns the reward index of a tokenize share record up to which the rewards of
+// the share tokens of holder were settled
+func (k Keeper) getTokenizeShareRewardCheckpoint(ctx sdk.Context, id uint64, holder sdk.AccAddress) sdk.DecCoins {
+	return k.getDecCoins(ctx, types.GetTokenizeShareRewardCheckpointPrefix(id, holder))
+}
+
+func (k Keeper) setTokenizeShareRewardCheckpoint(ctx sdk.Context, id uint64, holder sdk.AccAddress, index sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	for _, reward := range index {
+		store.Set(types.GetTokenizeShareRewardCheckpointKey(id, holder, reward.Denom), k.cdc.MustMarshal(&sdk.DecProto{Dec: reward.Amount}))
+	}
+}
+
+// getDecCoins returns the coins stored as one amount per denom key under a prefix
+func (k Keeper) getDecCoins(ctx sdk.Context, keyPrefix []byte) sdk.DecCoins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	coins := sdk.DecCoins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+		coins = coins.Add(sdk.NewDecCoinFromDec(string(iterator.Key()), amount.Dec))
+	}
+	return coins
+}
+
+// deletePrefix deletes all the keys under a prefix
+func deletePrefix(store sdk.KVStore, keyPrefix []byte) {
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
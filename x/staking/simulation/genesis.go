@@ -73,6 +73,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		minCommissionRate,
 		sdk.MustNewDecFromStr(sdk.DefaultMaxVotingPowerRatio),
 		sdk.NewIntFromUint64(sdk.DefaultMaxVotingPowerEnforcementThreshold),
+		types.DefaultValidatorBondFactor,
+		types.DefaultGlobalLiquidStakingCap,
+		types.DefaultValidatorLiquidStakingCap,
 	)
 
 	// validators & delegations
@@ -51,4 +51,17 @@ var (
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 39, "empty validator public key")
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
 	ErrExceedMaxVotingPowerRatio       = sdkerrors.Register(ModuleName, 41, "exceed maximal voting power ratio")
+	ErrNoUnbondingDelegationEntry      = sdkerrors.Register(ModuleName, 42, "no unbonding delegation entry found")
+	ErrUnbondingDelegationEntryMature  = sdkerrors.Register(ModuleName, 43, "unbonding delegation entry already mature")
+)
+
+// x/staking module sentinel errors of the tokenization of delegations
+var (
+	ErrTokenizeShareRecordNotExists            = sdkerrors.Register(ModuleName, 44, "tokenize share record not exists")
+	ErrTokenizeShareRecordAlreadyExists        = sdkerrors.Register(ModuleName, 45, "tokenize share record already exists")
+	ErrNotTokenizeShareRecordOwner             = sdkerrors.Register(ModuleName, 46, "not tokenize share record owner")
+	ErrValidatorBondNotAllowedForTokenizeShare = sdkerrors.Register(ModuleName, 47, "validator bond delegation is not allowed to tokenize share")
+	ErrInsufficientValidatorBondShares         = sdkerrors.Register(ModuleName, 48, "insufficient validator bond shares")
+	ErrGlobalLiquidStakingCapExceeded          = sdkerrors.Register(ModuleName, 49, "tokenization exceeds the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded       = sdkerrors.Register(ModuleName, 50, "tokenization exceeds the validator liquid staking cap")
 )
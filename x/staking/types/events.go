@@ -9,6 +9,7 @@ const (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeCancelUnbonding      = "cancel_unbonding_delegation"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -18,5 +19,18 @@ const (
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyCreationHeight    = "creation_height"
 	AttributeValueCategory        = ModuleName
 )
+
+// staking module event types of the tokenization of delegations
+const (
+	EventTypeTokenizeShares              = "tokenize_shares"
+	EventTypeRedeemShares                = "redeem_shares"
+	EventTypeTransferTokenizeShareRecord = "transfer_tokenize_share_record"
+	EventTypeValidatorBond               = "validator_bond"
+
+	AttributeKeyShareOwner    = "share_owner"
+	AttributeKeyShareRecordID = "share_record_id"
+	AttributeKeyNewOwner      = "new_owner"
+)
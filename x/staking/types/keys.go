@@ -48,6 +48,14 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TokenizeShareRecordPrefix           = []byte{0x61} // key for the tokenize share records
+	TokenizeShareRecordIDByOwnerPrefix  = []byte{0x62} // key for the tokenize share record ids, by owner
+	TokenizeShareRecordIDByDenomPrefix  = []byte{0x63} // key for the tokenize share record ids, by share token denom
+	LastTokenizeShareRecordIDKey        = []byte{0x64} // key for the last tokenize share record id
+	TotalLiquidStakedTokensKey          = []byte{0x65} // key for the total liquid staked tokens
+	TokenizeShareRewardIndexPrefix      = []byte{0x66} // key for the rewards per share token of the tokenize share records
+	TokenizeShareRewardCheckpointPrefix = []byte{0x67} // key for the reward indexes last settled by the share token holders
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -347,3 +355,56 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of the tokenize share record with the id
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the key prefix of the tokenize share record ids of an owner
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key of the tokenize share record id of an owner
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key of the tokenize share record id of a share token denom
+// VALUE: tokenize share record id (big endian uint64)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetTokenizeShareRewardIndexPrefix returns the key prefix of the reward index of a tokenize share record
+func GetTokenizeShareRewardIndexPrefix(id uint64) []byte {
+	return append(TokenizeShareRewardIndexPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRewardIndexKey returns the key of the rewards of a denom per share token of a tokenize share record
+// VALUE: sdk.DecProto
+func GetTokenizeShareRewardIndexKey(id uint64, denom string) []byte {
+	return append(GetTokenizeShareRewardIndexPrefix(id), []byte(denom)...)
+}
+
+// GetTokenizeShareRewardCheckpointsPrefix returns the key prefix of the reward checkpoints of the holders of the
+// share tokens of a tokenize share record
+func GetTokenizeShareRewardCheckpointsPrefix(id uint64) []byte {
+	return append(TokenizeShareRewardCheckpointPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRewardCheckpointPrefix returns the key prefix of the reward checkpoint of a holder of the share
+// tokens of a tokenize share record
+func GetTokenizeShareRewardCheckpointPrefix(id uint64, holder sdk.AccAddress) []byte {
+	return append(GetTokenizeShareRewardCheckpointsPrefix(id), address.MustLengthPrefix(holder)...)
+}
+
+// GetTokenizeShareRewardCheckpointKey returns the key of the reward index of a denom last settled by a holder of
+// the share tokens of a tokenize share record
+// VALUE: sdk.DecProto
+func GetTokenizeShareRewardCheckpointKey(id uint64, holder sdk.AccAddress, denom string) []byte {
+	return append(GetTokenizeShareRewardCheckpointPrefix(id, holder), []byte(denom)...)
+}
@@ -35,6 +35,16 @@ const (
 var (
 	// DefaultMinCommissionRate is set to 0%
 	DefaultMinCommissionRate = sdk.NewDecWithPrec(5, 2)
+
+	// ValidatorBondCapDisabled is the validator bond factor disabling the validator bond requirement
+	ValidatorBondCapDisabled = sdk.NewDecFromInt(sdk.NewInt(-1))
+
+	// DefaultValidatorBondFactor disables the validator bond requirement
+	DefaultValidatorBondFactor = ValidatorBondCapDisabled
+	// DefaultGlobalLiquidStakingCap is set to 100%
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+	// DefaultValidatorLiquidStakingCap is set to 100%
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
 )
 
 var (
@@ -47,6 +57,9 @@ var (
 	KeyHistoricalEntries                  = []byte("HistoricalEntries")
 	KeyPowerReduction                     = []byte("PowerReduction")
 	KeyMinCommissionRate                  = []byte("MinCommissionRate")
+	KeyValidatorBondFactor                = []byte("ValidatorBondFactor")
+	KeyGlobalLiquidStakingCap             = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap          = []byte("ValidatorLiquidStakingCap")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -64,6 +77,9 @@ func NewParams(
 	minCommissionRate sdk.Dec,
 	maxVotingPowerRatio sdk.Dec,
 	maxVotingPowerEnforcementThreshold sdk.Int,
+	validatorBondFactor sdk.Dec,
+	globalLiquidStakingCap sdk.Dec,
+	validatorLiquidStakingCap sdk.Dec,
 ) Params {
 	return Params{
 		UnbondingTime:                      unbondingTime,
@@ -74,6 +90,9 @@ func NewParams(
 		MinCommissionRate:                  minCommissionRate,
 		MaxVotingPowerRatio:                maxVotingPowerRatio,
 		MaxVotingPowerEnforcementThreshold: maxVotingPowerEnforcementThreshold,
+		ValidatorBondFactor:                validatorBondFactor,
+		GlobalLiquidStakingCap:             globalLiquidStakingCap,
+		ValidatorLiquidStakingCap:          validatorLiquidStakingCap,
 	}
 }
 
@@ -88,6 +107,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
 		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		paramtypes.NewParamSetPair(KeyValidatorBondFactor, &p.ValidatorBondFactor, validateValidatorBondFactor),
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateLiquidStakingCap),
 	}
 }
 
@@ -102,6 +124,9 @@ func DefaultParams() Params {
 		DefaultMinCommissionRate,
 		sdk.MustNewDecFromStr(sdk.DefaultMaxVotingPowerRatio),
 		sdk.NewIntFromUint64(sdk.DefaultMaxVotingPowerEnforcementThreshold),
+		DefaultValidatorBondFactor,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
 	)
 }
 
@@ -161,6 +186,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -292,3 +329,38 @@ func validateMinCommissionRate(i interface{}) error {
 	}
 	return nil
 }
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor must be not nil")
+	}
+	if v.IsNegative() && !v.Equal(ValidatorBondCapDisabled) {
+		return fmt.Errorf("invalid validator bond factor: %s", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
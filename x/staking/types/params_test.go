@@ -35,4 +35,22 @@ func Test_validateParams(t *testing.T) {
 
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
+	params.MinCommissionRate = types.DefaultMinCommissionRate
+
+	// validate validator bond factor, -1 disables the validator bond requirement
+	params.ValidatorBondFactor = sdk.NewDec(250)
+	require.NoError(t, params.Validate())
+	params.ValidatorBondFactor = sdk.NewDec(-2)
+	require.Error(t, params.Validate())
+	params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+
+	// validate liquid staking caps
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(50, 2)
+	require.NoError(t, params.Validate())
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(101, 2)
+	require.Error(t, params.Validate())
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
 }
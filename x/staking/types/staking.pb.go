@@ -334,6 +334,10 @@ type Validator struct {
 	Commission Commission `protobuf:"bytes,10,opt,name=commission,proto3" json:"commission"`
 	// min_self_delegation is the validator's self declared minimum self delegation.
 	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation" yaml:"min_self_delegation"`
+	// validator_bond_shares is the number of shares of the delegations flagged as validator bond.
+	ValidatorBondShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=validator_bond_shares,json=validatorBondShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_shares" yaml:"validator_bond_shares"`
+	// liquid_shares is the number of shares of the delegations tokenized into share records.
+	LiquidShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=liquid_shares,json=liquidShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_shares" yaml:"liquid_shares"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -594,6 +598,9 @@ type Delegation struct {
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
 	// shares define the delegation shares received.
 	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// validator_bond defines whether the delegation is a validator bond, which allows the shares of the
+	// validator to be tokenized.
+	ValidatorBond bool `protobuf:"varint,4,opt,name=validator_bond,json=validatorBond,proto3" json:"validator_bond,omitempty" yaml:"validator_bond"`
 }
 
 func (m *Delegation) Reset()      { *m = Delegation{} }
@@ -850,6 +857,14 @@ type Params struct {
 	MaxVotingPowerRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_voting_power_ratio,json=maxVotingPowerRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_voting_power_ratio" yaml:"max_voting_power_ratio"`
 	// max_voting_power_enforcement_threshold defines the minimal bonded voting power of the max voting power ratio enforcement
 	MaxVotingPowerEnforcementThreshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=max_voting_power_enforcement_threshold,json=maxVotingPowerEnforcementThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_voting_power_enforcement_threshold" yaml:"max_voting_power_enforcement_threshold"`
+	// validator_bond_factor is the maximal ratio of the liquid shares of a validator to its validator bond
+	// shares, or -1 to disable the validator bond requirement.
+	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor" yaml:"validator_bond_factor"`
+	// global_liquid_staking_cap is the maximal ratio of the liquid staked tokens to the total bonded tokens.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximal ratio of the liquid shares of a validator to its delegator
+	// shares.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1115,6 +1130,80 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a delegation tokenized into share tokens. The delegation is held by the
+// module account of the record and its rewards are withdrawn to the owner of the record.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the bech32-encoded address of the owner of the record.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the tokenized delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty" yaml:"module_account"`
+	// validator is the bech32-encoded operator address of the validator of the tokenized delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
@@ -1137,6 +1226,7 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1144,128 +1234,142 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1923 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6c, 0x1b, 0x59,
-	0x19, 0xf7, 0x24, 0x59, 0x27, 0xfe, 0x9c, 0xc6, 0xc9, 0x6b, 0x9a, 0x75, 0x4c, 0xd7, 0xe3, 0x1d,
-	0x56, 0x25, 0xa0, 0xad, 0x43, 0xb3, 0x68, 0x11, 0xb9, 0x40, 0x1d, 0xa7, 0xc4, 0xda, 0x52, 0xc2,
-	0x24, 0x0d, 0x12, 0xac, 0x18, 0x8d, 0x67, 0x5e, 0xec, 0x21, 0x9e, 0x19, 0x33, 0xef, 0xb9, 0x1b,
-	0x4b, 0x7b, 0xe0, 0xb8, 0x5b, 0x84, 0x58, 0x6e, 0x7b, 0x29, 0xaa, 0xb4, 0xd7, 0x95, 0xb8, 0x20,
-	0xae, 0x5c, 0x17, 0xb8, 0x94, 0x1b, 0x42, 0x68, 0x40, 0xed, 0x05, 0xed, 0x09, 0xf9, 0x80, 0xb8,
-	0x81, 0xde, 0x9f, 0xf9, 0xe3, 0x71, 0xdc, 0xd6, 0xd5, 0x1e, 0x56, 0x82, 0x4b, 0xeb, 0xf7, 0xbd,
-	0xef, 0xfb, 0x7d, 0xef, 0xfb, 0xfb, 0xde, 0x37, 0x81, 0xd7, 0x2c, 0x9f, 0xb8, 0x3e, 0xd9, 0x26,
-	0xd4, 0x3c, 0x73, 0xbc, 0xce, 0xf6, 0xbd, 0x1b, 0x6d, 0x4c, 0xcd, 0x1b, 0xd1, 0xba, 0xde, 0x0f,
-	0x7c, 0xea, 0xa3, 0x0d, 0xc1, 0x55, 0x8f, 0xa8, 0x92, 0xab, 0xb2, 0xde, 0xf1, 0x3b, 0x3e, 0x67,
-	0xd9, 0x66, 0xbf, 0x04, 0x77, 0x65, 0xb3, 0xe3, 0xfb, 0x9d, 0x1e, 0xde, 0xe6, 0xab, 0xf6, 0xe0,
-	0x74, 0xdb, 0xf4, 0x86, 0x72, 0xab, 0x9a, 0xdd, 0xb2, 0x07, 0x81, 0x49, 0x1d, 0xdf, 0x93, 0xfb,
-	0x6a, 0x76, 0x9f, 0x3a, 0x2e, 0x26, 0xd4, 0x74, 0xfb, 0x11, 0xb6, 0x38, 0x89, 0x21, 0x94, 0xca,
-	0x63, 0x49, 0x6c, 0x69, 0x4a, 0xdb, 0x24, 0x38, 0xb6, 0xc3, 0xf2, 0x9d, 0x08, 0xfb, 0x2a, 0xc5,
-	0x9e, 0x8d, 0x03, 0xd7, 0xf1, 0xe8, 0x36, 0x1d, 0xf6, 0x31, 0x11, 0xff, 0x8a, 0x5d, 0xed, 0x7d,
-	0x05, 0x56, 0x0e, 0x1c, 0x42, 0xfd, 0xc0, 0xb1, 0xcc, 0x5e, 0xcb, 0x3b, 0xf5, 0xd1, 0x9b, 0x90,
-	0xef, 0x62, 0xd3, 0xc6, 0x41, 0x59, 0xa9, 0x29, 0x5b, 0xc5, 0x9d, 0x72, 0x3d, 0x41, 0xa8, 0x0b,
-	0xd9, 0x03, 0xbe, 0xdf, 0x58, 0xf8, 0x24, 0x54, 0x73, 0xba, 0xe4, 0x46, 0xdf, 0x84, 0xfc, 0x3d,
-	0xb3, 0x47, 0x30, 0x2d, 0xcf, 0xd5, 0xe6, 0xb7, 0x8a, 0x3b, 0xaf, 0xd6, 0x2f, 0x76, 0x5f, 0xfd,
-	0xc4, 0xec, 0x39, 0xb6, 0x49, 0xfd, 0x18, 0x40, 0x88, 0x69, 0xbf, 0x9e, 0x83, 0xd2, 0x9e, 0xef,
-	0xba, 0x0e, 0x21, 0x8e, 0xef, 0xe9, 0x26, 0xc5, 0x04, 0x35, 0x60, 0x21, 0x30, 0x29, 0xe6, 0x47,
-	0x29, 0x34, 0xea, 0x8c, 0xff, 0x2f, 0xa1, 0x7a, 0xad, 0xe3, 0xd0, 0xee, 0xa0, 0x5d, 0xb7, 0x7c,
-	0x57, 0x3a, 0x43, 0xfe, 0x77, 0x9d, 0xd8, 0x67, 0xd2, 0xbe, 0x26, 0xb6, 0x74, 0x2e, 0x8b, 0xde,
-	0x86, 0x25, 0xd7, 0x3c, 0x37, 0x38, 0xce, 0x1c, 0xc7, 0xb9, 0x39, 0x1b, 0xce, 0x28, 0x54, 0x4b,
-	0x43, 0xd3, 0xed, 0xed, 0x6a, 0x11, 0x8e, 0xa6, 0x2f, 0xba, 0xe6, 0x39, 0x3b, 0x22, 0xea, 0x43,
-	0x89, 0x51, 0xad, 0xae, 0xe9, 0x75, 0xb0, 0x50, 0x32, 0xcf, 0x95, 0x1c, 0xcc, 0xac, 0x64, 0x23,
-	0x51, 0x92, 0x82, 0xd3, 0xf4, 0x4b, 0xae, 0x79, 0xbe, 0xc7, 0x09, 0x4c, 0xe3, 0xee, 0xd2, 0x87,
-	0x0f, 0xd5, 0xdc, 0x3f, 0x1e, 0xaa, 0x8a, 0xf6, 0x27, 0x05, 0x20, 0xf1, 0x18, 0x7a, 0x1b, 0x56,
-	0xad, 0x78, 0xc5, 0x65, 0x89, 0x8c, 0xe1, 0x97, 0xa6, 0xc5, 0x22, 0xe3, 0xef, 0xc6, 0x12, 0x3b,
-	0xf4, 0xa3, 0x50, 0x55, 0xf4, 0x92, 0x95, 0x09, 0xc5, 0x0f, 0xa1, 0x38, 0xe8, 0xdb, 0x26, 0xc5,
-	0x06, 0xcb, 0x4e, 0xee, 0xc9, 0xe2, 0x4e, 0xa5, 0x2e, 0x52, 0xb7, 0x1e, 0xa5, 0x6e, 0xfd, 0x38,
-	0x4a, 0xdd, 0x46, 0x95, 0x61, 0x8d, 0x42, 0x15, 0x09, 0xb3, 0x52, 0xc2, 0xda, 0x07, 0x7f, 0x53,
-	0x15, 0x1d, 0x04, 0x85, 0x09, 0xa4, 0x6c, 0xfa, 0xbd, 0x02, 0xc5, 0x26, 0x26, 0x56, 0xe0, 0xf4,
-	0x59, 0x85, 0xa0, 0x32, 0x2c, 0xba, 0xbe, 0xe7, 0x9c, 0xc9, 0x7c, 0x2c, 0xe8, 0xd1, 0x12, 0x55,
-	0x60, 0xc9, 0xb1, 0xb1, 0x47, 0x1d, 0x3a, 0x14, 0x71, 0xd5, 0xe3, 0x35, 0x93, 0x7a, 0x07, 0xb7,
-	0x89, 0x13, 0x45, 0x43, 0x8f, 0x96, 0xe8, 0x16, 0xac, 0x12, 0x6c, 0x0d, 0x02, 0x87, 0x0e, 0x0d,
-	0xcb, 0xf7, 0xa8, 0x69, 0xd1, 0xf2, 0x02, 0x0f, 0xd8, 0x17, 0x46, 0xa1, 0xfa, 0xb2, 0x38, 0x6b,
-	0x96, 0x43, 0xd3, 0x4b, 0x11, 0x69, 0x4f, 0x50, 0x98, 0x06, 0x1b, 0x53, 0xd3, 0xe9, 0x91, 0xf2,
-	0x4b, 0x42, 0x83, 0x5c, 0xa6, 0x6c, 0xf9, 0x78, 0x11, 0x0a, 0x71, 0xb6, 0x33, 0xcd, 0x7e, 0x1f,
-	0x07, 0xec, 0xb7, 0x61, 0xda, 0x76, 0x80, 0x09, 0x91, 0x79, 0x9d, 0xd2, 0x9c, 0xe5, 0xd0, 0xf4,
-	0x52, 0x44, 0xba, 0x29, 0x28, 0x88, 0xb2, 0x30, 0x7b, 0x04, 0x7b, 0x64, 0x40, 0x8c, 0xfe, 0xa0,
-	0x7d, 0x86, 0x87, 0x32, 0x1a, 0xeb, 0x13, 0xd1, 0xb8, 0xe9, 0x0d, 0x1b, 0x6f, 0x24, 0xe8, 0x59,
-	0x39, 0xed, 0x0f, 0xbf, 0xb9, 0xbe, 0x2e, 0x53, 0xc3, 0x0a, 0x86, 0x7d, 0xea, 0xd7, 0x0f, 0x07,
-	0xed, 0xb7, 0xf0, 0x90, 0x85, 0x5f, 0xb2, 0x1e, 0x72, 0x4e, 0xb4, 0x01, 0xf9, 0x1f, 0x9b, 0x4e,
-	0x0f, 0xdb, 0xdc, 0xa1, 0x4b, 0xba, 0x5c, 0xa1, 0x5d, 0xc8, 0x13, 0x6a, 0xd2, 0x01, 0xe1, 0x5e,
-	0x5c, 0xd9, 0xd1, 0xa6, 0xa5, 0x5a, 0xc3, 0xf7, 0xec, 0x23, 0xce, 0xa9, 0x4b, 0x09, 0x74, 0x0b,
-	0xf2, 0xd4, 0x3f, 0xc3, 0x9e, 0x74, 0xe1, 0x4c, 0xf5, 0xdd, 0xf2, 0xa8, 0x2e, 0xa5, 0x99, 0x47,
-	0x6c, 0xdc, 0xc3, 0x1d, 0xee, 0x38, 0xd2, 0x35, 0x03, 0x4c, 0xca, 0x79, 0x8e, 0xd8, 0x9a, 0xb9,
-	0x08, 0xa5, 0xa7, 0xb2, 0x78, 0x9a, 0x5e, 0x8a, 0x49, 0x47, 0x9c, 0x82, 0xde, 0x82, 0xa2, 0x9d,
-	0x24, 0x6a, 0x79, 0x91, 0x87, 0xe0, 0x8b, 0xd3, 0xcc, 0x4f, 0xe5, 0xb4, 0xec, 0x7b, 0x69, 0x69,
-	0x96, 0x1c, 0x03, 0xaf, 0xed, 0x7b, 0xb6, 0xe3, 0x75, 0x8c, 0x2e, 0x76, 0x3a, 0x5d, 0x5a, 0x5e,
-	0xaa, 0x29, 0x5b, 0xf3, 0xe9, 0xe4, 0xc8, 0x72, 0x68, 0x7a, 0x29, 0x26, 0x1d, 0x70, 0x0a, 0xb2,
-	0x61, 0x25, 0xe1, 0xe2, 0x85, 0x5a, 0x78, 0x66, 0xa1, 0xbe, 0x2a, 0x0b, 0xf5, 0x4a, 0x56, 0x4b,
-	0x52, 0xab, 0x97, 0x62, 0x22, 0x13, 0x43, 0x07, 0x00, 0x49, 0x7b, 0x28, 0x03, 0xd7, 0xa0, 0x3d,
-	0xbb, 0xc7, 0x48, 0xc3, 0x53, 0xb2, 0xe8, 0x5d, 0xb8, 0xec, 0x3a, 0x9e, 0x41, 0x70, 0xef, 0xd4,
-	0x90, 0x0e, 0x66, 0x90, 0x45, 0x1e, 0xbd, 0xdb, 0xb3, 0xe5, 0xc3, 0x28, 0x54, 0x2b, 0xb2, 0x85,
-	0x4e, 0x42, 0x6a, 0xfa, 0x9a, 0xeb, 0x78, 0x47, 0xb8, 0x77, 0xda, 0x8c, 0x69, 0xbb, 0xcb, 0xef,
-	0x3d, 0x54, 0x73, 0xb2, 0x5c, 0x73, 0xda, 0x9b, 0xb0, 0x7c, 0x62, 0xf6, 0x64, 0x99, 0x61, 0x82,
-	0xae, 0x42, 0xc1, 0x8c, 0x16, 0x65, 0xa5, 0x36, 0xbf, 0x55, 0xd0, 0x13, 0x82, 0x28, 0xf3, 0x9f,
-	0xfe, 0xb5, 0xa6, 0x68, 0x1f, 0x2b, 0x90, 0x6f, 0x9e, 0x1c, 0x9a, 0x4e, 0x80, 0x5a, 0xb0, 0x96,
-	0x64, 0xce, 0x78, 0x91, 0x5f, 0x1d, 0x85, 0x6a, 0x39, 0x9b, 0x5c, 0x71, 0x95, 0x27, 0x09, 0x1c,
-	0x95, 0x79, 0x0b, 0xd6, 0xee, 0x45, 0xbd, 0x23, 0x86, 0x9a, 0xcb, 0x42, 0x4d, 0xb0, 0x68, 0xfa,
-	0x6a, 0x4c, 0x93, 0x50, 0x19, 0x33, 0xf7, 0x61, 0x51, 0x9c, 0x96, 0xa0, 0x5d, 0x78, 0xa9, 0xcf,
-	0x7e, 0x70, 0xeb, 0x8a, 0x3b, 0xd5, 0xa9, 0xc9, 0xcb, 0xf9, 0x65, 0xf8, 0x84, 0x88, 0xf6, 0xcb,
-	0x39, 0x80, 0xe6, 0xc9, 0xc9, 0x71, 0xe0, 0xf4, 0x7b, 0x98, 0x7e, 0x96, 0x96, 0x1f, 0xc3, 0x95,
-	0xc4, 0x2c, 0x12, 0x58, 0x19, 0xeb, 0x6b, 0xa3, 0x50, 0xbd, 0x9a, 0xb5, 0x3e, 0xc5, 0xa6, 0xe9,
-	0x97, 0x63, 0xfa, 0x51, 0x60, 0x5d, 0x88, 0x6a, 0x13, 0x1a, 0xa3, 0xce, 0x4f, 0x47, 0x4d, 0xb1,
-	0xa5, 0x51, 0x9b, 0x84, 0x5e, 0xec, 0xda, 0x23, 0x28, 0x26, 0x2e, 0x21, 0xa8, 0x09, 0x4b, 0x54,
-	0xfe, 0x96, 0x1e, 0xd6, 0xa6, 0x7b, 0x38, 0x12, 0x93, 0x5e, 0x8e, 0x25, 0xb5, 0x7f, 0x2b, 0x00,
-	0x49, 0xce, 0x7e, 0x3e, 0x53, 0x8c, 0xb5, 0x72, 0xd9, 0x78, 0xe7, 0x5f, 0xe8, 0xa9, 0x26, 0xa5,
-	0x33, 0xfe, 0xfc, 0xd9, 0x1c, 0x5c, 0xbe, 0x1b, 0x75, 0x9e, 0xcf, 0xbd, 0x0f, 0x0e, 0x61, 0x11,
-	0x7b, 0x34, 0x70, 0xb8, 0x13, 0x58, 0xb4, 0xbf, 0x3a, 0x2d, 0xda, 0x17, 0xd8, 0xb4, 0xef, 0xd1,
-	0x60, 0x28, 0x63, 0x1f, 0xc1, 0x64, 0xbc, 0xf1, 0x8b, 0x79, 0x28, 0x4f, 0x93, 0x44, 0x7b, 0x50,
-	0xb2, 0x02, 0xcc, 0x09, 0xd1, 0xfd, 0xa1, 0xf0, 0xfb, 0xa3, 0x92, 0xbc, 0x2c, 0x33, 0x0c, 0x9a,
-	0xbe, 0x12, 0x51, 0xe4, 0xed, 0xd1, 0x01, 0xf6, 0xec, 0x63, 0x69, 0xc7, 0xb8, 0x9e, 0xf3, 0x9d,
-	0xa7, 0xc9, 0xeb, 0x23, 0x52, 0x32, 0x0e, 0x20, 0xee, 0x8f, 0x95, 0x84, 0xca, 0x2f, 0x90, 0x9f,
-	0x40, 0xc9, 0xf1, 0x1c, 0xea, 0x98, 0x3d, 0xa3, 0x6d, 0xf6, 0x4c, 0xcf, 0x7a, 0x91, 0x57, 0xb3,
-	0x68, 0xf9, 0x52, 0x6d, 0x06, 0x4e, 0xd3, 0x57, 0x24, 0xa5, 0x21, 0x08, 0xe8, 0x00, 0x16, 0x23,
-	0x55, 0x0b, 0x2f, 0xf4, 0xda, 0x88, 0xc4, 0x53, 0x0f, 0xbc, 0x9f, 0xcf, 0xc3, 0x9a, 0x8e, 0xed,
-	0xff, 0x87, 0x62, 0xb6, 0x50, 0x7c, 0x07, 0x40, 0x94, 0x3b, 0x6b, 0xb0, 0x2f, 0x10, 0x0d, 0xd6,
-	0x30, 0x0a, 0x02, 0xa1, 0x49, 0x68, 0x2a, 0x1e, 0xe1, 0x1c, 0x2c, 0xa7, 0xe3, 0xf1, 0x3f, 0x7a,
-	0x2b, 0xa1, 0x56, 0xd2, 0x89, 0x16, 0x78, 0x27, 0xfa, 0xf2, 0xb4, 0x4e, 0x34, 0x91, 0xbd, 0x4f,
-	0x6f, 0x41, 0xff, 0xca, 0x43, 0xfe, 0xd0, 0x0c, 0x4c, 0x97, 0x20, 0x6b, 0xe2, 0xa5, 0x29, 0x66,
-	0xcd, 0xcd, 0x89, 0xfc, 0x6c, 0xca, 0xaf, 0x1d, 0xcf, 0x78, 0x68, 0x7e, 0x78, 0xc1, 0x43, 0xf3,
-	0x5b, 0xb0, 0xc2, 0xc6, 0xe1, 0xd8, 0x46, 0xe1, 0xed, 0x4b, 0x8d, 0xcd, 0x04, 0x65, 0x7c, 0x5f,
-	0x4c, 0xcb, 0xf1, 0xd0, 0x45, 0xd0, 0xd7, 0xa1, 0xc8, 0x38, 0x92, 0xc6, 0xcc, 0xc4, 0x37, 0x92,
-	0xb1, 0x34, 0xb5, 0xa9, 0xe9, 0xe0, 0x9a, 0xe7, 0xfb, 0x62, 0x81, 0x6e, 0x03, 0xea, 0xc6, 0x5f,
-	0x46, 0x8c, 0xc4, 0x9d, 0x4c, 0xfe, 0x95, 0x51, 0xa8, 0x6e, 0x0a, 0xf9, 0x49, 0x1e, 0x4d, 0x5f,
-	0x4b, 0x88, 0x11, 0xda, 0xd7, 0x00, 0x98, 0x5d, 0x86, 0x8d, 0x3d, 0xdf, 0x95, 0xe3, 0xce, 0x95,
-	0x51, 0xa8, 0xae, 0x09, 0x94, 0x64, 0x4f, 0xd3, 0x0b, 0x6c, 0xd1, 0x64, 0xbf, 0xa3, 0xd7, 0x71,
-	0x66, 0xaa, 0x97, 0xb3, 0xcd, 0xed, 0x99, 0x67, 0x9b, 0xd4, 0xeb, 0x38, 0x03, 0x29, 0x5e, 0xc7,
-	0xe3, 0x5f, 0x03, 0xd0, 0xaf, 0x14, 0xd8, 0xe0, 0xde, 0xf5, 0x29, 0x0b, 0x52, 0xdf, 0x7f, 0x07,
-	0x07, 0x06, 0x0f, 0x25, 0x1f, 0x76, 0x0a, 0x0d, 0x67, 0xb6, 0x13, 0x7c, 0x1a, 0xaa, 0x53, 0xf0,
-	0x46, 0xa1, 0xfa, 0x4a, 0x2a, 0x9a, 0x13, 0xfb, 0x9a, 0x7e, 0x99, 0x45, 0x95, 0xd3, 0x0f, 0x19,
-	0x59, 0x67, 0x54, 0x14, 0x2a, 0x70, 0x6d, 0x42, 0x00, 0x7b, 0xa7, 0x7e, 0x60, 0x61, 0x17, 0x7b,
-	0xd4, 0xa0, 0xdd, 0x00, 0x93, 0xae, 0xdf, 0xb3, 0xf9, 0x2c, 0x55, 0x68, 0xbc, 0xaf, 0xcc, 0xd6,
-	0xd3, 0x3e, 0x0d, 0xd5, 0xe7, 0x54, 0x30, 0x0a, 0xd5, 0xeb, 0x53, 0x2c, 0xb8, 0x90, 0x5f, 0xd3,
-	0xb5, 0x71, 0x8b, 0xf6, 0x13, 0xae, 0xe3, 0x88, 0x29, 0xd5, 0xd9, 0x3e, 0x52, 0x00, 0x25, 0x57,
-	0xbe, 0x8e, 0x49, 0x9f, 0xcd, 0xe7, 0x6c, 0x10, 0x4b, 0x4d, 0x4d, 0xca, 0xd3, 0x07, 0xb1, 0x44,
-	0x3e, 0x1a, 0xc4, 0x52, 0x9d, 0xf2, 0x1b, 0xc9, 0xf5, 0x38, 0x27, 0xeb, 0x58, 0xc2, 0xb4, 0x4d,
-	0x82, 0x53, 0xc3, 0x9c, 0x13, 0x49, 0x4f, 0xdc, 0x87, 0x39, 0xed, 0x8f, 0x0a, 0x6c, 0x4e, 0x74,
-	0x94, 0xf8, 0xb0, 0x3f, 0x02, 0x14, 0xa4, 0x36, 0x79, 0xbd, 0x0c, 0xe5, 0xa1, 0x67, 0x6e, 0x50,
-	0x6b, 0xc1, 0xc4, 0xbd, 0xfb, 0xd9, 0xdd, 0xf0, 0x0b, 0xdc, 0xe7, 0xbf, 0x53, 0x60, 0x3d, 0xad,
-	0x3e, 0x36, 0xe4, 0x0e, 0x2c, 0xa7, 0xb5, 0x4b, 0x13, 0x5e, 0x7b, 0x1e, 0x13, 0xe4, 0xe9, 0xc7,
-	0xe4, 0xd1, 0xf7, 0x92, 0x76, 0x2d, 0xbe, 0x9d, 0xde, 0x78, 0x6e, 0x6f, 0x44, 0x67, 0xca, 0xb6,
-	0xed, 0x05, 0x1e, 0x8f, 0xff, 0x28, 0xb0, 0x70, 0xe8, 0xfb, 0x3d, 0xe4, 0xc3, 0x9a, 0xe7, 0x53,
-	0x83, 0x75, 0x16, 0x6c, 0x1b, 0xf2, 0xa3, 0x8b, 0xb8, 0x07, 0xf7, 0x66, 0x2e, 0x89, 0x49, 0x28,
-	0xbd, 0xe4, 0xf9, 0xb4, 0xc1, 0x29, 0xc7, 0xe2, 0x93, 0xcc, 0xbb, 0x70, 0x69, 0x5c, 0x99, 0xb8,
-	0x25, 0xbf, 0x3f, 0xb3, 0xb2, 0x71, 0x98, 0x51, 0xa8, 0xae, 0x27, 0x1d, 0x33, 0x26, 0x6b, 0xfa,
-	0x72, 0x3b, 0xa5, 0x7d, 0x77, 0x89, 0xc5, 0xef, 0x9f, 0x0f, 0x55, 0xe5, 0x2b, 0xbf, 0x55, 0x00,
-	0x92, 0x2f, 0x4f, 0xe8, 0x75, 0x78, 0xb9, 0xf1, 0xdd, 0x3b, 0x4d, 0xe3, 0xe8, 0xf8, 0xe6, 0xf1,
-	0xdd, 0x23, 0xe3, 0xee, 0x9d, 0xa3, 0xc3, 0xfd, 0xbd, 0xd6, 0xad, 0xd6, 0x7e, 0x73, 0x35, 0x57,
-	0x29, 0xdd, 0x7f, 0x50, 0x2b, 0xde, 0xf5, 0x48, 0x1f, 0x5b, 0xce, 0xa9, 0x83, 0x6d, 0x74, 0x0d,
-	0xd6, 0xc7, 0xb9, 0xd9, 0x6a, 0xbf, 0xb9, 0xaa, 0x54, 0x96, 0xef, 0x3f, 0xa8, 0x2d, 0x89, 0xb7,
-	0x38, 0xb6, 0xd1, 0x16, 0x5c, 0x99, 0xe4, 0x6b, 0xdd, 0xf9, 0xf6, 0xea, 0x5c, 0xe5, 0xd2, 0xfd,
-	0x07, 0xb5, 0x42, 0xfc, 0x68, 0x47, 0x1a, 0xa0, 0x34, 0xa7, 0xc4, 0x9b, 0xaf, 0xc0, 0xfd, 0x07,
-	0xb5, 0xbc, 0x70, 0x60, 0x65, 0xe1, 0xbd, 0x8f, 0xaa, 0xb9, 0xc6, 0xad, 0x4f, 0x1e, 0x57, 0x95,
-	0x47, 0x8f, 0xab, 0xca, 0xdf, 0x1f, 0x57, 0x95, 0x0f, 0x9e, 0x54, 0x73, 0x8f, 0x9e, 0x54, 0x73,
-	0x7f, 0x7e, 0x52, 0xcd, 0xfd, 0xe0, 0xf5, 0xa7, 0xfa, 0xee, 0x3c, 0xfe, 0xa3, 0x06, 0xf7, 0x62,
-	0x3b, 0xcf, 0xaf, 0xe1, 0x37, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xba, 0x3e, 0x92, 0xf3,
-	0x18, 0x00, 0x00,
+	// 2152 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x19, 0xcf, 0x6f, 0x5b, 0x49,
+	0x39, 0xcf, 0xf1, 0x3a, 0xf1, 0xe7, 0x24, 0x4e, 0xa6, 0x69, 0xd6, 0x31, 0x5d, 0xdb, 0xfb, 0x76,
+	0x55, 0x0a, 0xda, 0x3a, 0xb4, 0x8b, 0x16, 0x91, 0x0b, 0x5b, 0xc7, 0x09, 0x89, 0xb6, 0x94, 0x30,
+	0x49, 0x8b, 0x04, 0x2b, 0xac, 0xe7, 0xf7, 0x26, 0xce, 0x23, 0xf6, 0x1b, 0xef, 0x9b, 0x71, 0x1b,
+	0xa3, 0x3d, 0x20, 0x4e, 0xbb, 0x45, 0x2b, 0x96, 0x0b, 0xda, 0x4b, 0x57, 0x45, 0xcb, 0x11, 0x89,
+	0x0b, 0xe2, 0xc0, 0x85, 0xeb, 0x02, 0x97, 0x72, 0x43, 0x08, 0x19, 0xd4, 0x5e, 0xd0, 0x9e, 0x50,
+	0xfe, 0x01, 0xd0, 0xfc, 0x78, 0x3f, 0xfc, 0x6c, 0xb7, 0x75, 0xd5, 0xc3, 0x4a, 0x70, 0x49, 0xde,
+	0x7c, 0xf3, 0xfd, 0xfe, 0xbe, 0xf9, 0xe6, 0xfb, 0xc6, 0xf0, 0xaa, 0x4d, 0x59, 0x87, 0xb2, 0x0d,
+	0xc6, 0xad, 0x13, 0xd7, 0x6b, 0x6d, 0xdc, 0xbe, 0xd2, 0x24, 0xdc, 0xba, 0x12, 0xac, 0xab, 0x5d,
+	0x9f, 0x72, 0x8a, 0xd6, 0x14, 0x56, 0x35, 0x80, 0x6a, 0xac, 0xe2, 0x6a, 0x8b, 0xb6, 0xa8, 0x44,
+	0xd9, 0x10, 0x5f, 0x0a, 0xbb, 0xb8, 0xde, 0xa2, 0xb4, 0xd5, 0x26, 0x1b, 0x72, 0xd5, 0xec, 0x1d,
+	0x6d, 0x58, 0x5e, 0x5f, 0x6f, 0x95, 0x92, 0x5b, 0x4e, 0xcf, 0xb7, 0xb8, 0x4b, 0x3d, 0xbd, 0x5f,
+	0x4e, 0xee, 0x73, 0xb7, 0x43, 0x18, 0xb7, 0x3a, 0xdd, 0x80, 0xb7, 0xd2, 0xa4, 0xa1, 0x84, 0x6a,
+	0xb5, 0x34, 0x6f, 0x6d, 0x4a, 0xd3, 0x62, 0x24, 0xb4, 0xc3, 0xa6, 0x6e, 0xc0, 0xfb, 0x02, 0x27,
+	0x9e, 0x43, 0xfc, 0x8e, 0xeb, 0xf1, 0x0d, 0xde, 0xef, 0x12, 0xa6, 0xfe, 0xaa, 0x5d, 0xf3, 0x7d,
+	0x03, 0x96, 0x76, 0x5d, 0xc6, 0xa9, 0xef, 0xda, 0x56, 0x7b, 0xcf, 0x3b, 0xa2, 0xe8, 0x0d, 0xc8,
+	0x1c, 0x13, 0xcb, 0x21, 0x7e, 0xc1, 0xa8, 0x18, 0x97, 0x72, 0x57, 0x0b, 0xd5, 0x88, 0x43, 0x55,
+	0xd1, 0xee, 0xca, 0xfd, 0x5a, 0xfa, 0xd3, 0x41, 0x79, 0x06, 0x6b, 0x6c, 0xf4, 0x0d, 0xc8, 0xdc,
+	0xb6, 0xda, 0x8c, 0xf0, 0x42, 0xaa, 0x32, 0x7b, 0x29, 0x77, 0xf5, 0xe5, 0xea, 0x78, 0xf7, 0x55,
+	0x6f, 0x59, 0x6d, 0xd7, 0xb1, 0x38, 0x0d, 0x19, 0x28, 0x32, 0xf3, 0x37, 0x29, 0xc8, 0x6f, 0xd1,
+	0x4e, 0xc7, 0x65, 0xcc, 0xa5, 0x1e, 0xb6, 0x38, 0x61, 0xa8, 0x06, 0x69, 0xdf, 0xe2, 0x44, 0xaa,
+	0x92, 0xad, 0x55, 0x05, 0xfe, 0xdf, 0x06, 0xe5, 0x8b, 0x2d, 0x97, 0x1f, 0xf7, 0x9a, 0x55, 0x9b,
+	0x76, 0xb4, 0x33, 0xf4, 0xbf, 0xcb, 0xcc, 0x39, 0xd1, 0xf6, 0xd5, 0x89, 0x8d, 0x25, 0x2d, 0x7a,
+	0x1b, 0xe6, 0x3b, 0xd6, 0x69, 0x43, 0xf2, 0x49, 0x49, 0x3e, 0xd7, 0xa6, 0xe3, 0x73, 0x36, 0x28,
+	0xe7, 0xfb, 0x56, 0xa7, 0xbd, 0x69, 0x06, 0x7c, 0x4c, 0x3c, 0xd7, 0xb1, 0x4e, 0x85, 0x8a, 0xa8,
+	0x0b, 0x79, 0x01, 0xb5, 0x8f, 0x2d, 0xaf, 0x45, 0x94, 0x90, 0x59, 0x29, 0x64, 0x77, 0x6a, 0x21,
+	0x6b, 0x91, 0x90, 0x18, 0x3b, 0x13, 0x2f, 0x76, 0xac, 0xd3, 0x2d, 0x09, 0x10, 0x12, 0x37, 0xe7,
+	0x3f, 0xba, 0x5f, 0x9e, 0xf9, 0xd7, 0xfd, 0xb2, 0x61, 0xfe, 0xc5, 0x00, 0x88, 0x3c, 0x86, 0xde,
+	0x86, 0x65, 0x3b, 0x5c, 0x49, 0x5a, 0xa6, 0x63, 0xf8, 0xc5, 0x49, 0xb1, 0x48, 0xf8, 0xbb, 0x36,
+	0x2f, 0x94, 0x7e, 0x30, 0x28, 0x1b, 0x38, 0x6f, 0x27, 0x42, 0xf1, 0x7d, 0xc8, 0xf5, 0xba, 0x8e,
+	0xc5, 0x49, 0x43, 0x64, 0xa7, 0xf4, 0x64, 0xee, 0x6a, 0xb1, 0xaa, 0x52, 0xb7, 0x1a, 0xa4, 0x6e,
+	0xf5, 0x30, 0x48, 0xdd, 0x5a, 0x49, 0xf0, 0x3a, 0x1b, 0x94, 0x91, 0x32, 0x2b, 0x46, 0x6c, 0x7e,
+	0xf8, 0x8f, 0xb2, 0x81, 0x41, 0x41, 0x04, 0x41, 0xcc, 0xa6, 0x3f, 0x1a, 0x90, 0xab, 0x13, 0x66,
+	0xfb, 0x6e, 0x57, 0x9c, 0x10, 0x54, 0x80, 0xb9, 0x0e, 0xf5, 0xdc, 0x13, 0x9d, 0x8f, 0x59, 0x1c,
+	0x2c, 0x51, 0x11, 0xe6, 0x5d, 0x87, 0x78, 0xdc, 0xe5, 0x7d, 0x15, 0x57, 0x1c, 0xae, 0x05, 0xd5,
+	0x1d, 0xd2, 0x64, 0x6e, 0x10, 0x0d, 0x1c, 0x2c, 0xd1, 0x0e, 0x2c, 0x33, 0x62, 0xf7, 0x7c, 0x97,
+	0xf7, 0x1b, 0x36, 0xf5, 0xb8, 0x65, 0xf3, 0x42, 0x5a, 0x06, 0xec, 0x0b, 0x67, 0x83, 0xf2, 0x8b,
+	0x4a, 0xd7, 0x24, 0x86, 0x89, 0xf3, 0x01, 0x68, 0x4b, 0x41, 0x84, 0x04, 0x87, 0x70, 0xcb, 0x6d,
+	0xb3, 0xc2, 0x0b, 0x4a, 0x82, 0x5e, 0xc6, 0x6c, 0xf9, 0x38, 0x0b, 0xd9, 0x30, 0xdb, 0x85, 0x64,
+	0xda, 0x25, 0xbe, 0xf8, 0x6e, 0x58, 0x8e, 0xe3, 0x13, 0xc6, 0x0a, 0x46, 0x52, 0x72, 0x12, 0xc3,
+	0xc4, 0xf9, 0x00, 0x74, 0x4d, 0x41, 0x10, 0x17, 0x61, 0xf6, 0x18, 0xf1, 0x58, 0x8f, 0x35, 0xba,
+	0xbd, 0xe6, 0x09, 0xe9, 0xeb, 0x68, 0xac, 0x8e, 0x44, 0xe3, 0x9a, 0xd7, 0xaf, 0xbd, 0x1e, 0x71,
+	0x4f, 0xd2, 0x99, 0x7f, 0xfa, 0xed, 0xe5, 0x55, 0x9d, 0x1a, 0xb6, 0xdf, 0xef, 0x72, 0x5a, 0xdd,
+	0xef, 0x35, 0xdf, 0x22, 0x7d, 0x9c, 0x0f, 0x51, 0xf7, 0x25, 0x26, 0x5a, 0x83, 0xcc, 0x0f, 0x2d,
+	0xb7, 0x4d, 0x1c, 0xe9, 0xd0, 0x79, 0xac, 0x57, 0x68, 0x13, 0x32, 0x8c, 0x5b, 0xbc, 0xc7, 0xa4,
+	0x17, 0x97, 0xae, 0x9a, 0x93, 0x52, 0xad, 0x46, 0x3d, 0xe7, 0x40, 0x62, 0x62, 0x4d, 0x81, 0x76,
+	0x20, 0xc3, 0xe9, 0x09, 0xf1, 0xb4, 0x0b, 0xa7, 0x3a, 0xdf, 0x7b, 0x1e, 0xc7, 0x9a, 0x5a, 0x78,
+	0xc4, 0x21, 0x6d, 0xd2, 0x92, 0x8e, 0x63, 0xc7, 0x96, 0x4f, 0x58, 0x21, 0x23, 0x39, 0xee, 0x4d,
+	0x7d, 0x08, 0xb5, 0xa7, 0x92, 0xfc, 0x4c, 0x9c, 0x0f, 0x41, 0x07, 0x12, 0x82, 0xde, 0x82, 0x9c,
+	0x13, 0x25, 0x6a, 0x61, 0x4e, 0x86, 0xe0, 0x95, 0x49, 0xe6, 0xc7, 0x72, 0x5a, 0xd7, 0xbd, 0x38,
+	0xb5, 0x48, 0x8e, 0x9e, 0xd7, 0xa4, 0x9e, 0xe3, 0x7a, 0xad, 0xc6, 0x31, 0x71, 0x5b, 0xc7, 0xbc,
+	0x30, 0x5f, 0x31, 0x2e, 0xcd, 0xc6, 0x93, 0x23, 0x89, 0x61, 0xe2, 0x7c, 0x08, 0xda, 0x95, 0x10,
+	0xe4, 0xc0, 0x52, 0x84, 0x25, 0x0f, 0x6a, 0xf6, 0x89, 0x07, 0xf5, 0x65, 0x7d, 0x50, 0xcf, 0x27,
+	0xa5, 0x44, 0x67, 0x75, 0x31, 0x04, 0x0a, 0x32, 0xb4, 0x0b, 0x10, 0x95, 0x87, 0x02, 0x48, 0x09,
+	0xe6, 0x93, 0x6b, 0x8c, 0x36, 0x3c, 0x46, 0x8b, 0xde, 0x85, 0x73, 0x1d, 0xd7, 0x6b, 0x30, 0xd2,
+	0x3e, 0x6a, 0x68, 0x07, 0x0b, 0x96, 0x39, 0x19, 0xbd, 0xeb, 0xd3, 0xe5, 0xc3, 0xd9, 0xa0, 0x5c,
+	0xd4, 0x25, 0x74, 0x94, 0xa5, 0x89, 0x57, 0x3a, 0xae, 0x77, 0x40, 0xda, 0x47, 0xf5, 0x10, 0x86,
+	0x7e, 0x62, 0xc0, 0xf9, 0xdb, 0xc1, 0x01, 0x6d, 0x08, 0x0b, 0x83, 0xf4, 0x59, 0x90, 0x0a, 0xdc,
+	0x98, 0x3a, 0x7d, 0x2e, 0x28, 0x05, 0xc6, 0x32, 0x35, 0xf1, 0xb9, 0x10, 0x2e, 0x4f, 0x83, 0xca,
+	0xa3, 0x13, 0x58, 0x6c, 0xbb, 0xef, 0xf4, 0xdc, 0x50, 0xf6, 0xa2, 0x94, 0xbd, 0x33, 0xb5, 0xec,
+	0x55, 0x25, 0x7b, 0x88, 0x99, 0x89, 0x17, 0xd4, 0x5a, 0x09, 0xdb, 0x5c, 0x78, 0xef, 0x7e, 0x79,
+	0x46, 0x17, 0xa8, 0x19, 0xf3, 0x0d, 0x58, 0xb8, 0x65, 0xb5, 0x75, 0x61, 0x21, 0x0c, 0x5d, 0x80,
+	0xac, 0x15, 0x2c, 0x0a, 0x46, 0x65, 0xf6, 0x52, 0x16, 0x47, 0x00, 0x55, 0xd8, 0x7e, 0xfc, 0xf7,
+	0x8a, 0x61, 0xfe, 0xda, 0x80, 0x4c, 0xfd, 0xd6, 0xbe, 0xe5, 0xfa, 0x68, 0x0f, 0x56, 0xa2, 0xb3,
+	0x32, 0x5c, 0xd6, 0x2e, 0x9c, 0x0d, 0xca, 0x85, 0xe4, 0x71, 0x0a, 0xeb, 0x5a, 0x74, 0x64, 0x83,
+	0xc2, 0xb6, 0x07, 0x2b, 0x91, 0xdf, 0x02, 0x56, 0xa9, 0x24, 0xab, 0x11, 0x14, 0x13, 0x2f, 0x87,
+	0x30, 0xcd, 0x2a, 0x61, 0xe6, 0x36, 0xcc, 0x29, 0x6d, 0x19, 0xda, 0x84, 0x17, 0xba, 0xe2, 0x43,
+	0x5a, 0x97, 0xbb, 0x5a, 0x9a, 0x78, 0x5c, 0x25, 0xbe, 0x4e, 0x58, 0x45, 0x62, 0xfe, 0x3c, 0x05,
+	0x50, 0xbf, 0x75, 0xeb, 0xd0, 0x77, 0xbb, 0x6d, 0xc2, 0x9f, 0xa7, 0xe5, 0x87, 0xf1, 0x34, 0x64,
+	0xbe, 0x9d, 0xb0, 0xbe, 0x32, 0x2e, 0xb1, 0x62, 0x68, 0xf1, 0xc4, 0x3a, 0xf0, 0xed, 0xb1, 0x5c,
+	0x1d, 0xc6, 0x43, 0xae, 0xb3, 0x93, 0xb9, 0xc6, 0xd0, 0xe2, 0x5c, 0xeb, 0x8c, 0x8f, 0x77, 0xed,
+	0x01, 0xe4, 0x22, 0x97, 0x30, 0x54, 0x87, 0x79, 0xae, 0xbf, 0xb5, 0x87, 0xcd, 0xc9, 0x1e, 0x0e,
+	0xc8, 0xb4, 0x97, 0x43, 0x4a, 0xf3, 0xf7, 0xc2, 0xd1, 0xd1, 0x29, 0xfd, 0x5c, 0xa6, 0x98, 0xb8,
+	0xbc, 0xf4, 0x79, 0x9d, 0x7d, 0xa6, 0xe6, 0x54, 0x53, 0xa3, 0x37, 0x61, 0x69, 0xb8, 0x5a, 0xc8,
+	0x8b, 0x74, 0xbe, 0xb6, 0x1e, 0x55, 0xe4, 0xe1, 0x7d, 0x13, 0x2f, 0x0e, 0x95, 0x91, 0x44, 0x44,
+	0x7e, 0x9a, 0x82, 0x73, 0x37, 0x83, 0x6a, 0xfd, 0xb9, 0xf7, 0xe2, 0x3e, 0xcc, 0x11, 0x8f, 0xfb,
+	0xae, 0x74, 0xa3, 0xc8, 0x97, 0xaf, 0x4c, 0xca, 0x97, 0x31, 0x36, 0x6d, 0x7b, 0xdc, 0xef, 0xeb,
+	0xec, 0x09, 0xd8, 0x24, 0xbc, 0xf1, 0xb3, 0x59, 0x28, 0x4c, 0xa2, 0x44, 0x5b, 0x90, 0xb7, 0x7d,
+	0x22, 0x01, 0xc1, 0x9d, 0x6b, 0xc8, 0x3b, 0xb7, 0x18, 0x75, 0xe3, 0x09, 0x04, 0x13, 0x2f, 0x05,
+	0x10, 0x7d, 0xe3, 0xb6, 0x40, 0xb4, 0xca, 0x22, 0x71, 0x05, 0xd6, 0x53, 0xf6, 0xc6, 0xa6, 0xbe,
+	0x72, 0x03, 0x21, 0xc3, 0x0c, 0xd4, 0x9d, 0xbb, 0x14, 0x41, 0xe5, 0xa5, 0xfb, 0x0e, 0xe4, 0x5d,
+	0xcf, 0xe5, 0xae, 0xd5, 0x6e, 0x34, 0xad, 0xb6, 0xe5, 0xd9, 0xcf, 0x32, 0x69, 0xa8, 0x6b, 0x52,
+	0x8b, 0x4d, 0xb0, 0x33, 0xf1, 0x92, 0x86, 0xd4, 0x14, 0x00, 0xed, 0xc2, 0x5c, 0x20, 0x2a, 0xfd,
+	0x4c, 0x1d, 0x5a, 0x40, 0x1e, 0x6b, 0x8a, 0x3f, 0x98, 0x85, 0x15, 0x4c, 0x9c, 0xff, 0x87, 0x62,
+	0xba, 0x50, 0x7c, 0x0b, 0x40, 0x15, 0x0c, 0x51, 0xa2, 0x0b, 0xe9, 0x67, 0x2a, 0x39, 0x59, 0xc5,
+	0xa1, 0xce, 0x78, 0x2c, 0x1e, 0x83, 0x14, 0x2c, 0xc4, 0xe3, 0xf1, 0x3f, 0x7a, 0xaf, 0xa1, 0xbd,
+	0xa8, 0x12, 0xa5, 0x65, 0x25, 0xfa, 0xd2, 0xa4, 0x4a, 0x34, 0x92, 0xbd, 0x8f, 0x2f, 0x41, 0xbf,
+	0x02, 0xc8, 0xec, 0x5b, 0xbe, 0xd5, 0x61, 0xc8, 0x1e, 0xe9, 0xce, 0xd5, 0x7c, 0xbe, 0x3e, 0x92,
+	0x9f, 0x75, 0xfd, 0x42, 0xf4, 0x84, 0xe6, 0xfc, 0xa3, 0x31, 0xcd, 0xf9, 0x9b, 0xb0, 0x24, 0x9e,
+	0x10, 0x42, 0x1b, 0x95, 0xb7, 0x17, 0xe3, 0x17, 0xca, 0xf0, 0xbe, 0x7a, 0x61, 0x08, 0x07, 0x55,
+	0x86, 0xbe, 0x06, 0x39, 0x81, 0x11, 0x15, 0x66, 0x41, 0xbe, 0x16, 0x8d, 0xf2, 0xb1, 0x4d, 0x13,
+	0x43, 0xc7, 0x3a, 0xdd, 0x56, 0x0b, 0x74, 0x1d, 0xd0, 0x71, 0xf8, 0x9a, 0xd4, 0x88, 0xdc, 0x29,
+	0xe8, 0x5f, 0x3a, 0x1b, 0x94, 0xd7, 0x15, 0xfd, 0x28, 0x8e, 0x89, 0x57, 0x22, 0x60, 0xc0, 0xed,
+	0xab, 0x00, 0xb2, 0x7b, 0x76, 0x88, 0x47, 0x3b, 0x7a, 0x44, 0x3c, 0x7f, 0x36, 0x28, 0xaf, 0x28,
+	0x2e, 0xd1, 0x9e, 0x89, 0xb3, 0x62, 0x51, 0x17, 0xdf, 0xc1, 0x44, 0x91, 0x78, 0x09, 0x29, 0x64,
+	0xa6, 0x9e, 0x28, 0x54, 0x53, 0x1d, 0x9b, 0x28, 0x12, 0x2c, 0xd5, 0x44, 0x31, 0xfc, 0x82, 0x82,
+	0x3e, 0x36, 0x60, 0x4d, 0x7a, 0x97, 0x72, 0x11, 0xa4, 0x2e, 0xbd, 0x43, 0xfc, 0x86, 0x0c, 0xa5,
+	0x1c, 0x10, 0xb3, 0x35, 0x77, 0x3a, 0x0d, 0x3e, 0x1b, 0x94, 0x27, 0xf0, 0x3b, 0x1b, 0x94, 0x5f,
+	0x8a, 0x45, 0x73, 0x64, 0xdf, 0xc4, 0xe7, 0x44, 0x54, 0x25, 0x7c, 0x5f, 0x80, 0xb1, 0x80, 0xa2,
+	0x81, 0x01, 0x17, 0x47, 0x08, 0x88, 0x77, 0x44, 0x7d, 0x9b, 0x74, 0x88, 0xc7, 0x1b, 0xfc, 0xd8,
+	0x27, 0xec, 0x98, 0xb6, 0x1d, 0x39, 0x7f, 0x66, 0x6b, 0xef, 0x1b, 0xd3, 0xd5, 0xb4, 0xcf, 0x06,
+	0xe5, 0xa7, 0x14, 0x70, 0x36, 0x28, 0x5f, 0x9e, 0x60, 0xc1, 0x58, 0x7c, 0x13, 0x9b, 0xc3, 0x16,
+	0x6d, 0x47, 0x58, 0x87, 0x01, 0xd2, 0xb8, 0x99, 0xee, 0xc8, 0xb2, 0x39, 0xf5, 0x0b, 0xd9, 0xe7,
+	0x3a, 0xd3, 0x29, 0xa6, 0xc9, 0x99, 0x6e, 0x47, 0x42, 0xd1, 0x07, 0x06, 0xac, 0xb7, 0xda, 0xb4,
+	0x69, 0xb5, 0x1b, 0xc1, 0x38, 0xa6, 0x8a, 0x48, 0xc3, 0xb6, 0xba, 0x72, 0x60, 0xce, 0xd6, 0xf0,
+	0xd4, 0x8a, 0x54, 0x94, 0x22, 0x13, 0x19, 0x9b, 0x78, 0x4d, 0xed, 0x5d, 0x57, 0x23, 0x9f, 0xda,
+	0xd9, 0xb2, 0xba, 0xe8, 0x17, 0x06, 0x5c, 0x88, 0xf4, 0x1f, 0xa3, 0x92, 0x1a, 0xb8, 0x6f, 0x4e,
+	0xad, 0xd2, 0x2b, 0x49, 0xdf, 0x8c, 0xd3, 0x6a, 0x3d, 0xdc, 0x4e, 0x2a, 0x16, 0xbb, 0x87, 0x3e,
+	0x31, 0x00, 0x45, 0x0d, 0x1a, 0x26, 0xac, 0x4b, 0x3d, 0x26, 0x9f, 0x1a, 0x62, 0xef, 0x02, 0xc6,
+	0xe3, 0x9f, 0x1a, 0x22, 0xfa, 0xe0, 0xa9, 0x21, 0xa2, 0x45, 0x5f, 0x8f, 0x9a, 0x99, 0x94, 0xae,
+	0xba, 0x9a, 0x4d, 0xd3, 0x62, 0x24, 0xf6, 0x5c, 0xe1, 0x06, 0xd4, 0x23, 0xdd, 0xcb, 0x8c, 0xf9,
+	0x67, 0x03, 0xd6, 0x47, 0xea, 0x7f, 0xa8, 0xec, 0x0f, 0x00, 0xf9, 0xb1, 0x4d, 0x59, 0xdd, 0xfa,
+	0x5a, 0xe9, 0xa9, 0xaf, 0x93, 0x15, 0x3f, 0xb9, 0xf1, 0x1c, 0xfb, 0xb1, 0xb4, 0xf4, 0xf9, 0x1f,
+	0x0c, 0x58, 0x8d, 0x8b, 0x0f, 0x0d, 0xb9, 0x01, 0x0b, 0x71, 0xe9, 0xda, 0x84, 0x57, 0x9f, 0xc6,
+	0x04, 0xad, 0xfd, 0x10, 0x3d, 0xfa, 0x4e, 0x74, 0xb9, 0xaa, 0x5f, 0x07, 0xae, 0x3c, 0xb5, 0x37,
+	0x02, 0x9d, 0x92, 0x97, 0x6c, 0x5a, 0xc6, 0xe3, 0x3f, 0x06, 0xa4, 0xf7, 0x29, 0x6d, 0x23, 0x0a,
+	0x2b, 0x1e, 0xe5, 0xf2, 0x68, 0x12, 0xa7, 0xa1, 0x9f, 0x15, 0x55, 0xd7, 0xb2, 0x35, 0x75, 0x01,
+	0x1b, 0x65, 0x85, 0xf3, 0x1e, 0xe5, 0x35, 0x09, 0x39, 0x94, 0x00, 0xf4, 0x2e, 0x2c, 0x0e, 0x0b,
+	0x53, 0x3d, 0xcd, 0x77, 0xa7, 0x16, 0x36, 0xcc, 0x26, 0x7a, 0xc7, 0x19, 0x02, 0x9b, 0x78, 0xa1,
+	0x19, 0x93, 0xbe, 0x39, 0x2f, 0xe2, 0xf7, 0x6f, 0x11, 0xc3, 0x5f, 0x1a, 0x70, 0x4e, 0x02, 0xdd,
+	0x1f, 0x11, 0xf9, 0xc8, 0x83, 0x89, 0x4d, 0x7d, 0x07, 0x2d, 0x41, 0xca, 0x75, 0xa4, 0x07, 0xd2,
+	0x38, 0xe5, 0x3a, 0x68, 0x15, 0x5e, 0xa0, 0x77, 0x3c, 0xe2, 0xeb, 0xb7, 0x72, 0xb5, 0x90, 0xcd,
+	0x02, 0x75, 0x7a, 0x6d, 0xd2, 0xb0, 0x6c, 0x9b, 0xf6, 0x3c, 0xae, 0x9b, 0xa8, 0x78, 0xb3, 0x30,
+	0xb4, 0x2f, 0x9a, 0x05, 0x09, 0xb8, 0xa6, 0xd6, 0xe2, 0xcd, 0x28, 0x3c, 0xde, 0x2a, 0x2b, 0x71,
+	0x04, 0x50, 0x79, 0xf6, 0xe5, 0xdf, 0x19, 0x00, 0xd1, 0xfb, 0x2f, 0x7a, 0x0d, 0x5e, 0xac, 0x7d,
+	0xfb, 0x46, 0xbd, 0x71, 0x70, 0x78, 0xed, 0xf0, 0xe6, 0x41, 0xe3, 0xe6, 0x8d, 0x83, 0xfd, 0xed,
+	0xad, 0xbd, 0x9d, 0xbd, 0xed, 0xfa, 0xf2, 0x4c, 0x31, 0x7f, 0xf7, 0x5e, 0x25, 0x77, 0xd3, 0x63,
+	0x5d, 0x62, 0xbb, 0x47, 0x2e, 0x71, 0xd0, 0x45, 0x58, 0x1d, 0xc6, 0x16, 0xab, 0xed, 0xfa, 0xb2,
+	0x51, 0x5c, 0xb8, 0x7b, 0xaf, 0x32, 0xaf, 0xa6, 0x3b, 0xe2, 0xa0, 0x4b, 0x70, 0x7e, 0x14, 0x6f,
+	0xef, 0xc6, 0x37, 0x97, 0x53, 0xc5, 0xc5, 0xbb, 0xf7, 0x2a, 0xd9, 0x70, 0x0c, 0x44, 0x26, 0xa0,
+	0x38, 0xa6, 0xe6, 0x37, 0x5b, 0x84, 0xbb, 0xf7, 0x2a, 0x19, 0x15, 0xe4, 0x62, 0xfa, 0xbd, 0x4f,
+	0x4a, 0x33, 0xb5, 0x9d, 0x4f, 0x1f, 0x96, 0x8c, 0x07, 0x0f, 0x4b, 0xc6, 0x3f, 0x1f, 0x96, 0x8c,
+	0x0f, 0x1f, 0x95, 0x66, 0x1e, 0x3c, 0x2a, 0xcd, 0xfc, 0xf5, 0x51, 0x69, 0xe6, 0x7b, 0xaf, 0x3d,
+	0x36, 0xbe, 0xa7, 0xe1, 0x4f, 0x8b, 0x32, 0xd2, 0xcd, 0x8c, 0x6c, 0xec, 0x5e, 0xff, 0xef, 0x00,
+	0x62, 0x88, 0xcf, 0xd5, 0x79, 0x1c, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1274,635 +1378,723 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 10046 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x63, 0xd7,
-		0x75, 0x18, 0x1f, 0x3e, 0x48, 0xe0, 0x10, 0x24, 0xc1, 0x4b, 0x2e, 0x85, 0xc5, 0xee, 0x12, 0xd4,
-		0x93, 0xb4, 0x5a, 0xad, 0x24, 0xae, 0xb4, 0xd2, 0xae, 0x24, 0xac, 0x6d, 0x99, 0x20, 0xb1, 0x5c,
-		0x6a, 0xf9, 0xa5, 0x47, 0x72, 0x25, 0xcb, 0x4e, 0x31, 0x8f, 0xc0, 0x25, 0xf8, 0x44, 0xe0, 0xbd,
-		0xa7, 0xf7, 0x1e, 0x76, 0xc9, 0xb5, 0xdd, 0x91, 0x3f, 0xea, 0xda, 0xca, 0xa4, 0xb1, 0xeb, 0x4e,
-		0x63, 0xcb, 0x5e, 0x57, 0x8e, 0xd3, 0x3a, 0x75, 0xdc, 0xc6, 0x8e, 0x5d, 0xa7, 0x69, 0x3b, 0x53,
-		0xbb, 0x33, 0x69, 0x6c, 0xb7, 0xe9, 0xd8, 0x6d, 0xa6, 0x4d, 0x32, 0x29, 0x9d, 0xc8, 0x9a, 0xd6,
-		0x75, 0xdd, 0xd6, 0xd9, 0xb8, 0x6d, 0x3a, 0x9e, 0x69, 0x3b, 0xf7, 0xeb, 0x7d, 0x01, 0x20, 0x00,
-		0x6a, 0xd7, 0x72, 0x3e, 0x7e, 0x11, 0xf7, 0xdc, 0x73, 0xce, 0x3d, 0xf7, 0x9c, 0x73, 0xcf, 0x3d,
-		0xf7, 0xeb, 0x11, 0xbe, 0x78, 0x01, 0xa6, 0xaa, 0x86, 0x51, 0xad, 0xe1, 0x33, 0xa6, 0x65, 0x38,
-		0xc6, 0x66, 0x63, 0xeb, 0x4c, 0x05, 0xdb, 0x65, 0x4b, 0x33, 0x1d, 0xc3, 0x9a, 0xa6, 0x30, 0x34,
-		0xc2, 0x30, 0xa6, 0x05, 0x86, 0xbc, 0x04, 0xa3, 0x17, 0xb5, 0x1a, 0x9e, 0x73, 0x11, 0xd7, 0xb0,
-		0x83, 0x1e, 0x87, 0xd8, 0x96, 0x56, 0xc3, 0x19, 0x69, 0x2a, 0x7a, 0x6a, 0xf0, 0xec, 0xdd, 0xd3,
-		0x21, 0xa2, 0xe9, 0x20, 0xc5, 0x2a, 0x01, 0x2b, 0x94, 0x42, 0x7e, 0x2d, 0x06, 0x63, 0x2d, 0x6a,
-		0x11, 0x82, 0x98, 0xae, 0xd6, 0x09, 0x47, 0xe9, 0x54, 0x52, 0xa1, 0xbf, 0x51, 0x06, 0x06, 0x4c,
-		0xb5, 0xbc, 0xa3, 0x56, 0x71, 0x26, 0x42, 0xc1, 0xa2, 0x88, 0x26, 0x01, 0x2a, 0xd8, 0xc4, 0x7a,
-		0x05, 0xeb, 0xe5, 0xbd, 0x4c, 0x74, 0x2a, 0x7a, 0x2a, 0xa9, 0xf8, 0x20, 0xe8, 0x7e, 0x18, 0x35,
-		0x1b, 0x9b, 0x35, 0xad, 0x5c, 0xf2, 0xa1, 0xc1, 0x54, 0xf4, 0x54, 0x5c, 0x49, 0xb3, 0x8a, 0x39,
-		0x0f, 0xf9, 0x5e, 0x18, 0xb9, 0x86, 0xd5, 0x1d, 0x3f, 0xea, 0x20, 0x45, 0x1d, 0x26, 0x60, 0x1f,
-		0xe2, 0x2c, 0xa4, 0xea, 0xd8, 0xb6, 0xd5, 0x2a, 0x2e, 0x39, 0x7b, 0x26, 0xce, 0xc4, 0x68, 0xef,
-		0xa7, 0x9a, 0x7a, 0x1f, 0xee, 0xf9, 0x20, 0xa7, 0x5a, 0xdf, 0x33, 0x31, 0x9a, 0x81, 0x24, 0xd6,
-		0x1b, 0x75, 0xc6, 0x21, 0xde, 0x46, 0x7f, 0x45, 0xbd, 0x51, 0x0f, 0x73, 0x49, 0x10, 0x32, 0xce,
-		0x62, 0xc0, 0xc6, 0xd6, 0x55, 0xad, 0x8c, 0x33, 0xfd, 0x94, 0xc1, 0xbd, 0x4d, 0x0c, 0xd6, 0x58,
-		0x7d, 0x98, 0x87, 0xa0, 0x43, 0xb3, 0x90, 0xc4, 0xbb, 0x0e, 0xd6, 0x6d, 0xcd, 0xd0, 0x33, 0x03,
-		0x94, 0xc9, 0x3d, 0x2d, 0xac, 0x88, 0x6b, 0x95, 0x30, 0x0b, 0x8f, 0x0e, 0x9d, 0x87, 0x01, 0xc3,
-		0x74, 0x34, 0x43, 0xb7, 0x33, 0x89, 0x29, 0xe9, 0xd4, 0xe0, 0xd9, 0xe3, 0x2d, 0x1d, 0x61, 0x85,
-		0xe1, 0x28, 0x02, 0x19, 0x2d, 0x40, 0xda, 0x36, 0x1a, 0x56, 0x19, 0x97, 0xca, 0x46, 0x05, 0x97,
-		0x34, 0x7d, 0xcb, 0xc8, 0x24, 0x29, 0x83, 0x5c, 0x73, 0x47, 0x28, 0xe2, 0xac, 0x51, 0xc1, 0x0b,
-		0xfa, 0x96, 0xa1, 0x0c, 0xdb, 0x81, 0x32, 0x9a, 0x80, 0x7e, 0x7b, 0x4f, 0x77, 0xd4, 0xdd, 0x4c,
-		0x8a, 0x7a, 0x08, 0x2f, 0xc9, 0xbf, 0xd1, 0x0f, 0x23, 0xdd, 0xb8, 0xd8, 0x05, 0x88, 0x6f, 0x91,
-		0x5e, 0x66, 0x22, 0xbd, 0xe8, 0x80, 0xd1, 0x04, 0x95, 0xd8, 0x7f, 0x48, 0x25, 0xce, 0xc0, 0xa0,
-		0x8e, 0x6d, 0x07, 0x57, 0x98, 0x47, 0x44, 0xbb, 0xf4, 0x29, 0x60, 0x44, 0xcd, 0x2e, 0x15, 0x3b,
-		0x94, 0x4b, 0x3d, 0x0b, 0x23, 0xae, 0x48, 0x25, 0x4b, 0xd5, 0xab, 0xc2, 0x37, 0xcf, 0x74, 0x92,
-		0x64, 0xba, 0x28, 0xe8, 0x14, 0x42, 0xa6, 0x0c, 0xe3, 0x40, 0x19, 0xcd, 0x01, 0x18, 0x3a, 0x36,
-		0xb6, 0x4a, 0x15, 0x5c, 0xae, 0x65, 0x12, 0x6d, 0xb4, 0xb4, 0x42, 0x50, 0x9a, 0xb4, 0x64, 0x30,
-		0x68, 0xb9, 0x86, 0x9e, 0xf0, 0x5c, 0x6d, 0xa0, 0x8d, 0xa7, 0x2c, 0xb1, 0x41, 0xd6, 0xe4, 0x6d,
-		0x1b, 0x30, 0x6c, 0x61, 0xe2, 0xf7, 0xb8, 0xc2, 0x7b, 0x96, 0xa4, 0x42, 0x4c, 0x77, 0xec, 0x99,
-		0xc2, 0xc9, 0x58, 0xc7, 0x86, 0x2c, 0x7f, 0x11, 0xdd, 0x05, 0x2e, 0xa0, 0x44, 0xdd, 0x0a, 0x68,
-		0x14, 0x4a, 0x09, 0xe0, 0xb2, 0x5a, 0xc7, 0xd9, 0xeb, 0x30, 0x1c, 0x54, 0x0f, 0x1a, 0x87, 0xb8,
-		0xed, 0xa8, 0x96, 0x43, 0xbd, 0x30, 0xae, 0xb0, 0x02, 0x4a, 0x43, 0x14, 0xeb, 0x15, 0x1a, 0xe5,
-		0xe2, 0x0a, 0xf9, 0x89, 0xde, 0xea, 0x75, 0x38, 0x4a, 0x3b, 0x7c, 0xb2, 0xd9, 0xa2, 0x01, 0xce,
-		0xe1, 0x7e, 0x67, 0x1f, 0x83, 0xa1, 0x40, 0x07, 0xba, 0x6d, 0x5a, 0x7e, 0x17, 0x1c, 0x69, 0xc9,
-		0x1a, 0x3d, 0x0b, 0xe3, 0x0d, 0x5d, 0xd3, 0x1d, 0x6c, 0x99, 0x16, 0x26, 0x1e, 0xcb, 0x9a, 0xca,
-		0xfc, 0xe7, 0x81, 0x36, 0x3e, 0xb7, 0xe1, 0xc7, 0x66, 0x5c, 0x94, 0xb1, 0x46, 0x33, 0xf0, 0x74,
-		0x32, 0xf1, 0xbd, 0x81, 0xf4, 0x8b, 0x2f, 0xbe, 0xf8, 0x62, 0x44, 0xfe, 0x5a, 0x3f, 0x8c, 0xb7,
-		0x1a, 0x33, 0x2d, 0x87, 0xef, 0x04, 0xf4, 0xeb, 0x8d, 0xfa, 0x26, 0xb6, 0xa8, 0x92, 0xe2, 0x0a,
-		0x2f, 0xa1, 0x19, 0x88, 0xd7, 0xd4, 0x4d, 0x5c, 0xcb, 0xc4, 0xa6, 0xa4, 0x53, 0xc3, 0x67, 0xef,
-		0xef, 0x6a, 0x54, 0x4e, 0x2f, 0x12, 0x12, 0x85, 0x51, 0xa2, 0xb7, 0x40, 0x8c, 0x87, 0x68, 0xc2,
-		0xe1, 0x74, 0x77, 0x1c, 0xc8, 0x58, 0x52, 0x28, 0x1d, 0x3a, 0x06, 0x49, 0xf2, 0x97, 0xf9, 0x46,
-		0x3f, 0x95, 0x39, 0x41, 0x00, 0xc4, 0x2f, 0x50, 0x16, 0x12, 0x74, 0x98, 0x54, 0xb0, 0x98, 0xda,
-		0xdc, 0x32, 0x71, 0xac, 0x0a, 0xde, 0x52, 0x1b, 0x35, 0xa7, 0x74, 0x55, 0xad, 0x35, 0x30, 0x75,
-		0xf8, 0xa4, 0x92, 0xe2, 0xc0, 0x2b, 0x04, 0x86, 0x72, 0x30, 0xc8, 0x46, 0x95, 0xa6, 0x57, 0xf0,
-		0x2e, 0x8d, 0x9e, 0x71, 0x85, 0x0d, 0xb4, 0x05, 0x02, 0x21, 0xcd, 0x3f, 0x6f, 0x1b, 0xba, 0x70,
-		0x4d, 0xda, 0x04, 0x01, 0xd0, 0xe6, 0x1f, 0x0b, 0x07, 0xee, 0x13, 0xad, 0xbb, 0xd7, 0x34, 0x96,
-		0xee, 0x85, 0x11, 0x8a, 0xf1, 0x08, 0x37, 0xbd, 0x5a, 0xcb, 0x8c, 0x4e, 0x49, 0xa7, 0x12, 0xca,
-		0x30, 0x03, 0xaf, 0x70, 0xa8, 0xfc, 0x95, 0x08, 0xc4, 0x68, 0x60, 0x19, 0x81, 0xc1, 0xf5, 0xb7,
-		0xad, 0x16, 0x4b, 0x73, 0x2b, 0x1b, 0x85, 0xc5, 0x62, 0x5a, 0x42, 0xc3, 0x00, 0x14, 0x70, 0x71,
-		0x71, 0x65, 0x66, 0x3d, 0x1d, 0x71, 0xcb, 0x0b, 0xcb, 0xeb, 0xe7, 0x1f, 0x4d, 0x47, 0x5d, 0x82,
-		0x0d, 0x06, 0x88, 0xf9, 0x11, 0x1e, 0x39, 0x9b, 0x8e, 0xa3, 0x34, 0xa4, 0x18, 0x83, 0x85, 0x67,
-		0x8b, 0x73, 0xe7, 0x1f, 0x4d, 0xf7, 0x07, 0x21, 0x8f, 0x9c, 0x4d, 0x0f, 0xa0, 0x21, 0x48, 0x52,
-		0x48, 0x61, 0x65, 0x65, 0x31, 0x9d, 0x70, 0x79, 0xae, 0xad, 0x2b, 0x0b, 0xcb, 0xf3, 0xe9, 0xa4,
-		0xcb, 0x73, 0x5e, 0x59, 0xd9, 0x58, 0x4d, 0x83, 0xcb, 0x61, 0xa9, 0xb8, 0xb6, 0x36, 0x33, 0x5f,
-		0x4c, 0x0f, 0xba, 0x18, 0x85, 0xb7, 0xad, 0x17, 0xd7, 0xd2, 0xa9, 0x80, 0x58, 0x8f, 0x9c, 0x4d,
-		0x0f, 0xb9, 0x4d, 0x14, 0x97, 0x37, 0x96, 0xd2, 0xc3, 0x68, 0x14, 0x86, 0x58, 0x13, 0x42, 0x88,
-		0x91, 0x10, 0xe8, 0xfc, 0xa3, 0xe9, 0xb4, 0x27, 0x08, 0xe3, 0x32, 0x1a, 0x00, 0x9c, 0x7f, 0x34,
-		0x8d, 0xe4, 0x59, 0x88, 0x53, 0x37, 0x44, 0x08, 0x86, 0x17, 0x67, 0x0a, 0xc5, 0xc5, 0xd2, 0xca,
-		0xea, 0xfa, 0xc2, 0xca, 0xf2, 0xcc, 0x62, 0x5a, 0xf2, 0x60, 0x4a, 0xf1, 0xe9, 0x8d, 0x05, 0xa5,
-		0x38, 0x97, 0x8e, 0xf8, 0x61, 0xab, 0xc5, 0x99, 0xf5, 0xe2, 0x5c, 0x3a, 0x2a, 0x97, 0x61, 0xbc,
-		0x55, 0x40, 0x6d, 0x39, 0x84, 0x7c, 0xbe, 0x10, 0x69, 0xe3, 0x0b, 0x94, 0x57, 0xd8, 0x17, 0xe4,
-		0xef, 0x46, 0x60, 0xac, 0xc5, 0xa4, 0xd2, 0xb2, 0x91, 0x27, 0x21, 0xce, 0x7c, 0x99, 0x4d, 0xb3,
-		0xf7, 0xb5, 0x9c, 0x9d, 0xa8, 0x67, 0x37, 0x4d, 0xb5, 0x94, 0xce, 0x9f, 0x6a, 0x44, 0xdb, 0xa4,
-		0x1a, 0x84, 0x45, 0x93, 0xc3, 0xfe, 0x4c, 0x53, 0xf0, 0x67, 0xf3, 0xe3, 0xf9, 0x6e, 0xe6, 0x47,
-		0x0a, 0xeb, 0x6d, 0x12, 0x88, 0xb7, 0x98, 0x04, 0x2e, 0xc0, 0x68, 0x13, 0xa3, 0xae, 0x83, 0xf1,
-		0xfb, 0x24, 0xc8, 0xb4, 0x53, 0x4e, 0x87, 0x90, 0x18, 0x09, 0x84, 0xc4, 0x0b, 0x61, 0x0d, 0xde,
-		0xd9, 0xde, 0x08, 0x4d, 0xb6, 0xfe, 0xac, 0x04, 0x13, 0xad, 0x53, 0xca, 0x96, 0x32, 0xbc, 0x05,
-		0xfa, 0xeb, 0xd8, 0xd9, 0x36, 0x44, 0x5a, 0x75, 0xb2, 0xc5, 0x64, 0x4d, 0xaa, 0xc3, 0xc6, 0xe6,
-		0x54, 0xfe, 0xd9, 0x3e, 0xda, 0x2e, 0x2f, 0x64, 0xd2, 0x34, 0x49, 0xfa, 0xa1, 0x08, 0x1c, 0x69,
-		0xc9, 0xbc, 0xa5, 0xa0, 0x27, 0x00, 0x34, 0xdd, 0x6c, 0x38, 0x2c, 0x75, 0x62, 0x91, 0x38, 0x49,
-		0x21, 0x34, 0x78, 0x91, 0x28, 0xdb, 0x70, 0xdc, 0xfa, 0x28, 0xad, 0x07, 0x06, 0xa2, 0x08, 0x8f,
-		0x7b, 0x82, 0xc6, 0xa8, 0xa0, 0x93, 0x6d, 0x7a, 0xda, 0xe4, 0x98, 0x0f, 0x41, 0xba, 0x5c, 0xd3,
-		0xb0, 0xee, 0x94, 0x6c, 0xc7, 0xc2, 0x6a, 0x5d, 0xd3, 0xab, 0x74, 0xaa, 0x49, 0xe4, 0xe3, 0x5b,
-		0x6a, 0xcd, 0xc6, 0xca, 0x08, 0xab, 0x5e, 0x13, 0xb5, 0x84, 0x82, 0x3a, 0x90, 0xe5, 0xa3, 0xe8,
-		0x0f, 0x50, 0xb0, 0x6a, 0x97, 0x42, 0xfe, 0x48, 0x12, 0x06, 0x7d, 0x09, 0x38, 0xba, 0x13, 0x52,
-		0xcf, 0xab, 0x57, 0xd5, 0x92, 0x58, 0x54, 0x31, 0x4d, 0x0c, 0x12, 0xd8, 0x2a, 0x5f, 0x58, 0x3d,
-		0x04, 0xe3, 0x14, 0xc5, 0x68, 0x38, 0xd8, 0x2a, 0x95, 0x6b, 0xaa, 0x6d, 0x53, 0xa5, 0x25, 0x28,
-		0x2a, 0x22, 0x75, 0x2b, 0xa4, 0x6a, 0x56, 0xd4, 0xa0, 0x73, 0x30, 0x46, 0x29, 0xea, 0x8d, 0x9a,
-		0xa3, 0x99, 0x35, 0x5c, 0x22, 0xcb, 0x3c, 0x9b, 0x4e, 0x39, 0xae, 0x64, 0xa3, 0x04, 0x63, 0x89,
-		0x23, 0x10, 0x89, 0x6c, 0x34, 0x07, 0x27, 0x28, 0x59, 0x15, 0xeb, 0xd8, 0x52, 0x1d, 0x5c, 0xc2,
-		0x2f, 0x34, 0xd4, 0x9a, 0x5d, 0x52, 0xf5, 0x4a, 0x69, 0x5b, 0xb5, 0xb7, 0x33, 0xe3, 0x84, 0x41,
-		0x21, 0x92, 0x91, 0x94, 0xa3, 0x04, 0x71, 0x9e, 0xe3, 0x15, 0x29, 0xda, 0x8c, 0x5e, 0xb9, 0xa4,
-		0xda, 0xdb, 0x28, 0x0f, 0x13, 0x94, 0x8b, 0xed, 0x58, 0x9a, 0x5e, 0x2d, 0x95, 0xb7, 0x71, 0x79,
-		0xa7, 0xd4, 0x70, 0xb6, 0x1e, 0xcf, 0x1c, 0xf3, 0xb7, 0x4f, 0x25, 0x5c, 0xa3, 0x38, 0xb3, 0x04,
-		0x65, 0xc3, 0xd9, 0x7a, 0x1c, 0xad, 0x41, 0x8a, 0x18, 0xa3, 0xae, 0x5d, 0xc7, 0xa5, 0x2d, 0xc3,
-		0xa2, 0x73, 0xe8, 0x70, 0x8b, 0xd0, 0xe4, 0xd3, 0xe0, 0xf4, 0x0a, 0x27, 0x58, 0x32, 0x2a, 0x38,
-		0x1f, 0x5f, 0x5b, 0x2d, 0x16, 0xe7, 0x94, 0x41, 0xc1, 0xe5, 0xa2, 0x61, 0x11, 0x87, 0xaa, 0x1a,
-		0xae, 0x82, 0x07, 0x99, 0x43, 0x55, 0x0d, 0xa1, 0xde, 0x73, 0x30, 0x56, 0x2e, 0xb3, 0x3e, 0x6b,
-		0xe5, 0x12, 0x5f, 0x8c, 0xd9, 0x99, 0x74, 0x40, 0x59, 0xe5, 0xf2, 0x3c, 0x43, 0xe0, 0x3e, 0x6e,
-		0xa3, 0x27, 0xe0, 0x88, 0xa7, 0x2c, 0x3f, 0xe1, 0x68, 0x53, 0x2f, 0xc3, 0xa4, 0xe7, 0x60, 0xcc,
-		0xdc, 0x6b, 0x26, 0x44, 0x81, 0x16, 0xcd, 0xbd, 0x30, 0xd9, 0x63, 0x30, 0x6e, 0x6e, 0x9b, 0xcd,
-		0x74, 0xa7, 0xfd, 0x74, 0xc8, 0xdc, 0x36, 0xc3, 0x84, 0xf7, 0xd0, 0x95, 0xb9, 0x85, 0xcb, 0xaa,
-		0x83, 0x2b, 0x99, 0x3b, 0xfc, 0xe8, 0xbe, 0x0a, 0x34, 0x0d, 0xe9, 0x72, 0xb9, 0x84, 0x75, 0x75,
-		0xb3, 0x86, 0x4b, 0xaa, 0x85, 0x75, 0xd5, 0xce, 0xe4, 0x28, 0x72, 0xcc, 0xb1, 0x1a, 0x58, 0x19,
-		0x2e, 0x97, 0x8b, 0xb4, 0x72, 0x86, 0xd6, 0xa1, 0xd3, 0x30, 0x6a, 0x6c, 0x3e, 0x5f, 0x66, 0x1e,
-		0x59, 0x32, 0x2d, 0xbc, 0xa5, 0xed, 0x66, 0xee, 0xa6, 0xea, 0x1d, 0x21, 0x15, 0xd4, 0x1f, 0x57,
-		0x29, 0x18, 0xdd, 0x07, 0xe9, 0xb2, 0xbd, 0xad, 0x5a, 0x26, 0x0d, 0xc9, 0xb6, 0xa9, 0x96, 0x71,
-		0xe6, 0x1e, 0x86, 0xca, 0xe0, 0xcb, 0x02, 0x4c, 0x46, 0x84, 0x7d, 0x4d, 0xdb, 0x72, 0x04, 0xc7,
-		0x7b, 0xd9, 0x88, 0xa0, 0x30, 0xce, 0xed, 0x14, 0xa4, 0x89, 0x26, 0x02, 0x0d, 0x9f, 0xa2, 0x68,
-		0xc3, 0xe6, 0xb6, 0xe9, 0x6f, 0xf7, 0x2e, 0x18, 0x22, 0x98, 0x5e, 0xa3, 0xf7, 0xb1, 0xc4, 0xcd,
-		0xdc, 0xf6, 0xb5, 0xf8, 0x28, 0x4c, 0x10, 0xa4, 0x3a, 0x76, 0xd4, 0x8a, 0xea, 0xa8, 0x3e, 0xec,
-		0x07, 0x28, 0x36, 0x51, 0xfb, 0x12, 0xaf, 0x0c, 0xc8, 0x69, 0x35, 0x36, 0xf7, 0x5c, 0xc7, 0x7a,
-		0x90, 0xc9, 0x49, 0x60, 0xc2, 0xb5, 0x6e, 0x5b, 0x72, 0x2e, 0xe7, 0x21, 0xe5, 0xf7, 0x7b, 0x94,
-		0x04, 0xe6, 0xf9, 0x69, 0x89, 0x24, 0x41, 0xb3, 0x2b, 0x73, 0x24, 0x7d, 0x79, 0xae, 0x98, 0x8e,
-		0x90, 0x34, 0x6a, 0x71, 0x61, 0xbd, 0x58, 0x52, 0x36, 0x96, 0xd7, 0x17, 0x96, 0x8a, 0xe9, 0xa8,
-		0x2f, 0xb1, 0x7f, 0x2a, 0x96, 0x38, 0x99, 0xbe, 0x57, 0xfe, 0x76, 0x04, 0x86, 0x83, 0x2b, 0x35,
-		0xf4, 0x26, 0xb8, 0x43, 0x6c, 0xab, 0xd8, 0xd8, 0x29, 0x5d, 0xd3, 0x2c, 0x3a, 0x20, 0xeb, 0x2a,
-		0x9b, 0x1c, 0x5d, 0xff, 0x19, 0xe7, 0x58, 0x6b, 0xd8, 0x79, 0x46, 0xb3, 0xc8, 0x70, 0xab, 0xab,
-		0x0e, 0x5a, 0x84, 0x9c, 0x6e, 0x94, 0x6c, 0x47, 0xd5, 0x2b, 0xaa, 0x55, 0x29, 0x79, 0x1b, 0x5a,
-		0x25, 0xb5, 0x5c, 0xc6, 0xb6, 0x6d, 0xb0, 0x89, 0xd0, 0xe5, 0x72, 0x5c, 0x37, 0xd6, 0x38, 0xb2,
-		0x37, 0x43, 0xcc, 0x70, 0xd4, 0x90, 0xfb, 0x46, 0xdb, 0xb9, 0xef, 0x31, 0x48, 0xd6, 0x55, 0xb3,
-		0x84, 0x75, 0xc7, 0xda, 0xa3, 0xf9, 0x79, 0x42, 0x49, 0xd4, 0x55, 0xb3, 0x48, 0xca, 0x3f, 0x91,
-		0x65, 0xd2, 0x53, 0xb1, 0x44, 0x22, 0x9d, 0x7c, 0x2a, 0x96, 0x48, 0xa6, 0x41, 0x7e, 0x35, 0x0a,
-		0x29, 0x7f, 0xbe, 0x4e, 0x96, 0x3f, 0x65, 0x3a, 0x63, 0x49, 0x34, 0xa6, 0xdd, 0x75, 0x60, 0x76,
-		0x3f, 0x3d, 0x4b, 0xa6, 0xb2, 0x7c, 0x3f, 0x4b, 0x8e, 0x15, 0x46, 0x49, 0xd2, 0x08, 0xe2, 0x6c,
-		0x98, 0x25, 0x23, 0x09, 0x85, 0x97, 0xd0, 0x3c, 0xf4, 0x3f, 0x6f, 0x53, 0xde, 0xfd, 0x94, 0xf7,
-		0xdd, 0x07, 0xf3, 0x7e, 0x6a, 0x8d, 0x32, 0x4f, 0x3e, 0xb5, 0x56, 0x5a, 0x5e, 0x51, 0x96, 0x66,
-		0x16, 0x15, 0x4e, 0x8e, 0x8e, 0x42, 0xac, 0xa6, 0x5e, 0xdf, 0x0b, 0x4e, 0x7a, 0x14, 0xd4, 0xad,
-		0x11, 0x8e, 0x42, 0xec, 0x1a, 0x56, 0x77, 0x82, 0x53, 0x0d, 0x05, 0xdd, 0xc6, 0xc1, 0x70, 0x06,
-		0xe2, 0x54, 0x5f, 0x08, 0x80, 0x6b, 0x2c, 0xdd, 0x87, 0x12, 0x10, 0x9b, 0x5d, 0x51, 0xc8, 0x80,
-		0x48, 0x43, 0x8a, 0x41, 0x4b, 0xab, 0x0b, 0xc5, 0xd9, 0x62, 0x3a, 0x22, 0x9f, 0x83, 0x7e, 0xa6,
-		0x04, 0x32, 0x58, 0x5c, 0x35, 0xa4, 0xfb, 0x78, 0x91, 0xf3, 0x90, 0x44, 0xed, 0xc6, 0x52, 0xa1,
-		0xa8, 0xa4, 0x23, 0x41, 0x53, 0xc7, 0xd2, 0x71, 0xd9, 0x86, 0x94, 0x3f, 0x0f, 0xff, 0xc9, 0x2c,
-		0xc6, 0xbf, 0x2a, 0xc1, 0xa0, 0x2f, 0xaf, 0x26, 0x09, 0x91, 0x5a, 0xab, 0x19, 0xd7, 0x4a, 0x6a,
-		0x4d, 0x53, 0x6d, 0xee, 0x1a, 0x40, 0x41, 0x33, 0x04, 0xd2, 0xad, 0xe9, 0x7e, 0x42, 0x43, 0x24,
-		0x9e, 0xee, 0x97, 0x3f, 0x25, 0x41, 0x3a, 0x9c, 0xd8, 0x86, 0xc4, 0x94, 0xde, 0x48, 0x31, 0xe5,
-		0x4f, 0x4a, 0x30, 0x1c, 0xcc, 0x66, 0x43, 0xe2, 0xdd, 0xf9, 0x86, 0x8a, 0xf7, 0x87, 0x11, 0x18,
-		0x0a, 0xe4, 0xb0, 0xdd, 0x4a, 0xf7, 0x02, 0x8c, 0x6a, 0x15, 0x5c, 0x37, 0x0d, 0x07, 0xeb, 0xe5,
-		0xbd, 0x52, 0x0d, 0x5f, 0xc5, 0xb5, 0x8c, 0x4c, 0x83, 0xc6, 0x99, 0x83, 0xb3, 0xe4, 0xe9, 0x05,
-		0x8f, 0x6e, 0x91, 0x90, 0xe5, 0xc7, 0x16, 0xe6, 0x8a, 0x4b, 0xab, 0x2b, 0xeb, 0xc5, 0xe5, 0xd9,
-		0xb7, 0x95, 0x36, 0x96, 0x2f, 0x2f, 0xaf, 0x3c, 0xb3, 0xac, 0xa4, 0xb5, 0x10, 0xda, 0x6d, 0x1c,
-		0xf6, 0xab, 0x90, 0x0e, 0x0b, 0x85, 0xee, 0x80, 0x56, 0x62, 0xa5, 0xfb, 0xd0, 0x18, 0x8c, 0x2c,
-		0xaf, 0x94, 0xd6, 0x16, 0xe6, 0x8a, 0xa5, 0xe2, 0xc5, 0x8b, 0xc5, 0xd9, 0xf5, 0x35, 0xb6, 0xef,
-		0xe1, 0x62, 0xaf, 0x07, 0x06, 0xb8, 0xfc, 0x72, 0x14, 0xc6, 0x5a, 0x48, 0x82, 0x66, 0xf8, 0x8a,
-		0x85, 0x2d, 0xa2, 0x1e, 0xec, 0x46, 0xfa, 0x69, 0x92, 0x33, 0xac, 0xaa, 0x96, 0xc3, 0x17, 0x38,
-		0xf7, 0x01, 0xd1, 0x92, 0xee, 0x68, 0x5b, 0x1a, 0xb6, 0xf8, 0x7e, 0x12, 0x5b, 0xc6, 0x8c, 0x78,
-		0x70, 0xb6, 0xa5, 0xf4, 0x00, 0x20, 0xd3, 0xb0, 0x35, 0x47, 0xbb, 0x8a, 0x4b, 0x9a, 0x2e, 0x36,
-		0x9f, 0xc8, 0xb2, 0x26, 0xa6, 0xa4, 0x45, 0xcd, 0x82, 0xee, 0xb8, 0xd8, 0x3a, 0xae, 0xaa, 0x21,
-		0x6c, 0x12, 0xcc, 0xa3, 0x4a, 0x5a, 0xd4, 0xb8, 0xd8, 0x77, 0x42, 0xaa, 0x62, 0x34, 0x48, 0xae,
-		0xc7, 0xf0, 0xc8, 0xdc, 0x21, 0x29, 0x83, 0x0c, 0xe6, 0xa2, 0xf0, 0x2c, 0xde, 0xdb, 0xf5, 0x4a,
-		0x29, 0x83, 0x0c, 0xc6, 0x50, 0xee, 0x85, 0x11, 0xb5, 0x5a, 0xb5, 0x08, 0x73, 0xc1, 0x88, 0xad,
-		0x4b, 0x86, 0x5d, 0x30, 0x45, 0xcc, 0x3e, 0x05, 0x09, 0xa1, 0x07, 0x32, 0x55, 0x13, 0x4d, 0x94,
-		0x4c, 0xb6, 0xd8, 0x8e, 0x9c, 0x4a, 0x2a, 0x09, 0x5d, 0x54, 0xde, 0x09, 0x29, 0xcd, 0x2e, 0x79,
-		0x9b, 0xf8, 0x91, 0xa9, 0xc8, 0xa9, 0x84, 0x32, 0xa8, 0xd9, 0xee, 0x06, 0xa8, 0xfc, 0xd9, 0x08,
-		0x0c, 0x07, 0x0f, 0x21, 0xd0, 0x1c, 0x24, 0x6a, 0x46, 0x59, 0xa5, 0xae, 0xc5, 0x4e, 0xc0, 0x4e,
-		0x75, 0x38, 0xb7, 0x98, 0x5e, 0xe4, 0xf8, 0x8a, 0x4b, 0x99, 0xfd, 0x37, 0x12, 0x24, 0x04, 0x18,
-		0x4d, 0x40, 0xcc, 0x54, 0x9d, 0x6d, 0xca, 0x2e, 0x5e, 0x88, 0xa4, 0x25, 0x85, 0x96, 0x09, 0xdc,
-		0x36, 0x55, 0x9d, 0xba, 0x00, 0x87, 0x93, 0x32, 0xb1, 0x6b, 0x0d, 0xab, 0x15, 0xba, 0xe8, 0x31,
-		0xea, 0x75, 0xac, 0x3b, 0xb6, 0xb0, 0x2b, 0x87, 0xcf, 0x72, 0x30, 0xba, 0x1f, 0x46, 0x1d, 0x4b,
-		0xd5, 0x6a, 0x01, 0xdc, 0x18, 0xc5, 0x4d, 0x8b, 0x0a, 0x17, 0x39, 0x0f, 0x47, 0x05, 0xdf, 0x0a,
-		0x76, 0xd4, 0xf2, 0x36, 0xae, 0x78, 0x44, 0xfd, 0x74, 0x73, 0xe3, 0x0e, 0x8e, 0x30, 0xc7, 0xeb,
-		0x05, 0xad, 0xfc, 0x6d, 0x09, 0x46, 0xc5, 0x32, 0xad, 0xe2, 0x2a, 0x6b, 0x09, 0x40, 0xd5, 0x75,
-		0xc3, 0xf1, 0xab, 0xab, 0xd9, 0x95, 0x9b, 0xe8, 0xa6, 0x67, 0x5c, 0x22, 0xc5, 0xc7, 0x20, 0x5b,
-		0x07, 0xf0, 0x6a, 0xda, 0xaa, 0x2d, 0x07, 0x83, 0xfc, 0x84, 0x89, 0x1e, 0x53, 0xb2, 0x85, 0x3d,
-		0x30, 0x10, 0x59, 0xcf, 0xa1, 0x71, 0x88, 0x6f, 0xe2, 0xaa, 0xa6, 0xf3, 0x7d, 0x63, 0x56, 0x10,
-		0xdb, 0x2f, 0x31, 0x77, 0xfb, 0xa5, 0xf0, 0x57, 0x61, 0xac, 0x6c, 0xd4, 0xc3, 0xe2, 0x16, 0xd2,
-		0xa1, 0xcd, 0x05, 0xfb, 0x92, 0xf4, 0xdc, 0x83, 0x1c, 0xa9, 0x6a, 0xd4, 0x54, 0xbd, 0x3a, 0x6d,
-		0x58, 0x55, 0xef, 0x98, 0x95, 0x64, 0x3c, 0xb6, 0xef, 0xb0, 0xd5, 0xdc, 0xfc, 0x53, 0x49, 0xfa,
-		0xc5, 0x48, 0x74, 0x7e, 0xb5, 0xf0, 0xb9, 0x48, 0x76, 0x9e, 0x11, 0xae, 0x0a, 0x65, 0x28, 0x78,
-		0xab, 0x86, 0xcb, 0xa4, 0x83, 0xf0, 0xfd, 0xfb, 0x61, 0xbc, 0x6a, 0x54, 0x0d, 0xca, 0xe9, 0x0c,
-		0xf9, 0xc5, 0xcf, 0x69, 0x93, 0x2e, 0x34, 0xdb, 0xf1, 0x50, 0x37, 0xbf, 0x0c, 0x63, 0x1c, 0xb9,
-		0x44, 0x0f, 0x8a, 0xd8, 0x32, 0x06, 0x1d, 0xb8, 0x87, 0x96, 0xf9, 0xe2, 0x6b, 0x74, 0xfa, 0x56,
-		0x46, 0x39, 0x29, 0xa9, 0x63, 0x2b, 0x9d, 0xbc, 0x02, 0x47, 0x02, 0xfc, 0xd8, 0x20, 0xc5, 0x56,
-		0x07, 0x8e, 0xbf, 0xc9, 0x39, 0x8e, 0xf9, 0x38, 0xae, 0x71, 0xd2, 0xfc, 0x2c, 0x0c, 0xf5, 0xc2,
-		0xeb, 0x5f, 0x72, 0x5e, 0x29, 0xec, 0x67, 0x32, 0x0f, 0x23, 0x94, 0x49, 0xb9, 0x61, 0x3b, 0x46,
-		0x9d, 0x46, 0xc0, 0x83, 0xd9, 0xfc, 0xd6, 0x6b, 0x6c, 0xd4, 0x0c, 0x13, 0xb2, 0x59, 0x97, 0x2a,
-		0x9f, 0x07, 0x7a, 0x36, 0x56, 0xc1, 0xe5, 0x5a, 0x07, 0x0e, 0x5f, 0xe7, 0x82, 0xb8, 0xf8, 0xf9,
-		0x2b, 0x30, 0x4e, 0x7e, 0xd3, 0x00, 0xe5, 0x97, 0xa4, 0xf3, 0x86, 0x5b, 0xe6, 0xdb, 0xef, 0x63,
-		0x03, 0x73, 0xcc, 0x65, 0xe0, 0x93, 0xc9, 0x67, 0xc5, 0x2a, 0x76, 0x1c, 0x6c, 0xd9, 0x25, 0xb5,
-		0xd6, 0x4a, 0x3c, 0xdf, 0x8e, 0x45, 0xe6, 0xe3, 0x3f, 0x08, 0x5a, 0x71, 0x9e, 0x51, 0xce, 0xd4,
-		0x6a, 0xf9, 0x0d, 0xb8, 0xa3, 0x85, 0x57, 0x74, 0xc1, 0xf3, 0x65, 0xce, 0x73, 0xbc, 0xc9, 0x33,
-		0x08, 0xdb, 0x55, 0x10, 0x70, 0xd7, 0x96, 0x5d, 0xf0, 0xfc, 0x04, 0xe7, 0x89, 0x38, 0xad, 0x30,
-		0x29, 0xe1, 0xf8, 0x14, 0x8c, 0x5e, 0xc5, 0xd6, 0xa6, 0x61, 0xf3, 0x5d, 0xa2, 0x2e, 0xd8, 0x7d,
-		0x92, 0xb3, 0x1b, 0xe1, 0x84, 0x74, 0xdb, 0x88, 0xf0, 0x7a, 0x02, 0x12, 0x5b, 0x6a, 0x19, 0x77,
-		0xc1, 0xe2, 0x06, 0x67, 0x31, 0x40, 0xf0, 0x09, 0xe9, 0x0c, 0xa4, 0xaa, 0x06, 0x9f, 0xa3, 0x3a,
-		0x93, 0x7f, 0x8a, 0x93, 0x0f, 0x0a, 0x1a, 0xce, 0xc2, 0x34, 0xcc, 0x46, 0x8d, 0x4c, 0x60, 0x9d,
-		0x59, 0xfc, 0x1d, 0xc1, 0x42, 0xd0, 0x70, 0x16, 0x3d, 0xa8, 0xf5, 0x15, 0xc1, 0xc2, 0xf6, 0xe9,
-		0xf3, 0x49, 0x18, 0x34, 0xf4, 0xda, 0x9e, 0xa1, 0x77, 0x23, 0xc4, 0xa7, 0x39, 0x07, 0xe0, 0x24,
-		0x84, 0xc1, 0x05, 0x48, 0x76, 0x6b, 0x88, 0xbf, 0xfb, 0x03, 0x31, 0x3c, 0x84, 0x05, 0xe6, 0x61,
-		0x44, 0x04, 0x28, 0xcd, 0xd0, 0xbb, 0x60, 0xf1, 0xf7, 0x38, 0x8b, 0x61, 0x1f, 0x19, 0xef, 0x86,
-		0x83, 0x6d, 0xa7, 0x8a, 0xbb, 0x61, 0xf2, 0x59, 0xd1, 0x0d, 0x4e, 0xc2, 0x55, 0xb9, 0x89, 0xf5,
-		0xf2, 0x76, 0x77, 0x1c, 0x7e, 0x59, 0xa8, 0x52, 0xd0, 0x10, 0x16, 0xb3, 0x30, 0x54, 0x57, 0x2d,
-		0x7b, 0x5b, 0xad, 0x75, 0x65, 0x8e, 0xbf, 0xcf, 0x79, 0xa4, 0x5c, 0x22, 0xae, 0x91, 0x86, 0xde,
-		0x0b, 0x9b, 0xcf, 0x09, 0x8d, 0xf8, 0xc8, 0xf8, 0xd0, 0xb3, 0x1d, 0xba, 0xa5, 0xd6, 0x0b, 0xb7,
-		0x5f, 0x11, 0x43, 0x8f, 0xd1, 0x2e, 0xf9, 0x39, 0x5e, 0x80, 0xa4, 0xad, 0x5d, 0xef, 0x8a, 0xcd,
-		0xe7, 0x85, 0xa5, 0x29, 0x01, 0x21, 0x7e, 0x1b, 0x1c, 0x6d, 0x39, 0x4d, 0x74, 0xc1, 0xec, 0x1f,
-		0x70, 0x66, 0x13, 0x2d, 0xa6, 0x0a, 0x1e, 0x12, 0x7a, 0x65, 0xf9, 0x0f, 0x45, 0x48, 0xc0, 0x21,
-		0x5e, 0xab, 0x64, 0xd5, 0x60, 0xab, 0x5b, 0xbd, 0x69, 0xed, 0x57, 0x85, 0xd6, 0x18, 0x6d, 0x40,
-		0x6b, 0xeb, 0x30, 0xc1, 0x39, 0xf6, 0x66, 0xd7, 0x2f, 0x88, 0xc0, 0xca, 0xa8, 0x37, 0x82, 0xd6,
-		0x7d, 0x3b, 0x64, 0x5d, 0x75, 0x8a, 0xf4, 0xd4, 0x2e, 0xd5, 0x55, 0xb3, 0x0b, 0xce, 0x5f, 0xe4,
-		0x9c, 0x45, 0xc4, 0x77, 0xf3, 0x5b, 0x7b, 0x49, 0x35, 0x09, 0xf3, 0x67, 0x21, 0x23, 0x98, 0x37,
-		0x74, 0x0b, 0x97, 0x8d, 0xaa, 0xae, 0x5d, 0xc7, 0x95, 0x2e, 0x58, 0xff, 0x5a, 0xc8, 0x54, 0x1b,
-		0x3e, 0x72, 0xc2, 0x79, 0x01, 0xd2, 0x6e, 0xae, 0x52, 0xd2, 0xea, 0xa6, 0x61, 0x39, 0x1d, 0x38,
-		0x7e, 0x49, 0x58, 0xca, 0xa5, 0x5b, 0xa0, 0x64, 0xf9, 0x22, 0xb0, 0x73, 0xe6, 0x6e, 0x5d, 0xf2,
-		0xcb, 0x9c, 0xd1, 0x90, 0x47, 0xc5, 0x03, 0x47, 0xd9, 0xa8, 0x9b, 0xaa, 0xd5, 0x4d, 0xfc, 0xfb,
-		0x47, 0x22, 0x70, 0x70, 0x12, 0x1e, 0x38, 0x48, 0x46, 0x47, 0x66, 0xfb, 0x2e, 0x38, 0x7c, 0x45,
-		0x04, 0x0e, 0x41, 0xc3, 0x59, 0x88, 0x84, 0xa1, 0x0b, 0x16, 0xbf, 0x2e, 0x58, 0x08, 0x1a, 0xc2,
-		0xe2, 0x69, 0x6f, 0xa2, 0xb5, 0x70, 0x55, 0xb3, 0x1d, 0x8b, 0x25, 0xc5, 0x07, 0xb3, 0xfa, 0xc7,
-		0x3f, 0x08, 0x26, 0x61, 0x8a, 0x8f, 0x94, 0x44, 0x22, 0xbe, 0xc9, 0x4a, 0xd7, 0x4c, 0x9d, 0x05,
-		0xfb, 0x0d, 0x11, 0x89, 0x7c, 0x64, 0x44, 0x36, 0x5f, 0x86, 0x48, 0xd4, 0x5e, 0x26, 0x2b, 0x85,
-		0x2e, 0xd8, 0xfd, 0x93, 0x90, 0x70, 0x6b, 0x82, 0x96, 0xf0, 0xf4, 0xe5, 0x3f, 0x0d, 0x7d, 0x07,
-		0xef, 0x75, 0xe5, 0x9d, 0xff, 0x34, 0x94, 0xff, 0x6c, 0x30, 0x4a, 0x16, 0x43, 0x46, 0x42, 0xf9,
-		0x14, 0xea, 0x74, 0xab, 0x28, 0xf3, 0x9e, 0x1f, 0xf1, 0xfe, 0x06, 0xd3, 0xa9, 0xfc, 0x22, 0x71,
-		0xf2, 0x60, 0xd2, 0xd3, 0x99, 0xd9, 0xfb, 0x7e, 0xe4, 0xfa, 0x79, 0x20, 0xe7, 0xc9, 0x5f, 0x84,
-		0xa1, 0x40, 0xc2, 0xd3, 0x99, 0xd5, 0xfb, 0x39, 0xab, 0x94, 0x3f, 0xdf, 0xc9, 0x9f, 0x83, 0x18,
-		0x49, 0x5e, 0x3a, 0x93, 0xff, 0x35, 0x4e, 0x4e, 0xd1, 0xf3, 0x6f, 0x86, 0x84, 0x48, 0x5a, 0x3a,
-		0x93, 0x7e, 0x80, 0x93, 0xba, 0x24, 0x84, 0x5c, 0x24, 0x2c, 0x9d, 0xc9, 0xff, 0xba, 0x20, 0x17,
-		0x24, 0x84, 0xbc, 0x7b, 0x15, 0x7e, 0xf5, 0x67, 0x63, 0x7c, 0xd2, 0x11, 0xba, 0xbb, 0x00, 0x03,
-		0x3c, 0x53, 0xe9, 0x4c, 0xfd, 0x21, 0xde, 0xb8, 0xa0, 0xc8, 0x3f, 0x06, 0xf1, 0x2e, 0x15, 0xfe,
-		0x73, 0x9c, 0x94, 0xe1, 0xe7, 0x67, 0x61, 0xd0, 0x97, 0x9d, 0x74, 0x26, 0xff, 0x1b, 0x9c, 0xdc,
-		0x4f, 0x45, 0x44, 0xe7, 0xd9, 0x49, 0x67, 0x06, 0x3f, 0x2f, 0x44, 0xe7, 0x14, 0x44, 0x6d, 0x22,
-		0x31, 0xe9, 0x4c, 0xfd, 0x61, 0xa1, 0x75, 0x41, 0x92, 0x7f, 0x12, 0x92, 0xee, 0x64, 0xd3, 0x99,
-		0xfe, 0x23, 0x9c, 0xde, 0xa3, 0x21, 0x1a, 0xf0, 0x4d, 0x76, 0x9d, 0x59, 0xfc, 0x4d, 0xa1, 0x01,
-		0x1f, 0x15, 0x19, 0x46, 0xe1, 0x04, 0xa6, 0x33, 0xa7, 0x8f, 0x8a, 0x61, 0x14, 0xca, 0x5f, 0x88,
-		0x35, 0x69, 0xcc, 0xef, 0xcc, 0xe2, 0x6f, 0x09, 0x6b, 0x52, 0x7c, 0x22, 0x46, 0x38, 0x23, 0xe8,
-		0xcc, 0xe3, 0x17, 0x84, 0x18, 0xa1, 0x84, 0x20, 0xbf, 0x0a, 0xa8, 0x39, 0x1b, 0xe8, 0xcc, 0xef,
-		0x63, 0x9c, 0xdf, 0x68, 0x53, 0x32, 0x90, 0x7f, 0x06, 0x26, 0x5a, 0x67, 0x02, 0x9d, 0xb9, 0x7e,
-		0xfc, 0x47, 0xa1, 0xb5, 0x9b, 0x3f, 0x11, 0xc8, 0xaf, 0x7b, 0x53, 0x8a, 0x3f, 0x0b, 0xe8, 0xcc,
-		0xf6, 0xe5, 0x1f, 0x05, 0x03, 0xb7, 0x3f, 0x09, 0xc8, 0xcf, 0x00, 0x78, 0x13, 0x70, 0x67, 0x5e,
-		0x9f, 0xe4, 0xbc, 0x7c, 0x44, 0x64, 0x68, 0xf0, 0xf9, 0xb7, 0x33, 0xfd, 0x0d, 0x31, 0x34, 0x38,
-		0x05, 0x19, 0x1a, 0x62, 0xea, 0xed, 0x4c, 0xfd, 0x29, 0x31, 0x34, 0x04, 0x09, 0xf1, 0x6c, 0xdf,
-		0xec, 0xd6, 0x99, 0xc3, 0xa7, 0x85, 0x67, 0xfb, 0xa8, 0xf2, 0xcb, 0x30, 0xda, 0x34, 0x21, 0x76,
-		0x66, 0xf5, 0x8b, 0x9c, 0x55, 0x3a, 0x3c, 0x1f, 0xfa, 0x27, 0x2f, 0x3e, 0x19, 0x76, 0xe6, 0xf6,
-		0x99, 0xd0, 0xe4, 0xc5, 0xe7, 0xc2, 0xfc, 0x05, 0x48, 0xe8, 0x8d, 0x5a, 0x8d, 0x0c, 0x1e, 0x74,
-		0xf0, 0x4d, 0xc0, 0xcc, 0x7f, 0xf9, 0x31, 0xd7, 0x8e, 0x20, 0xc8, 0x9f, 0x83, 0x38, 0xae, 0x6f,
-		0xe2, 0x4a, 0x27, 0xca, 0xef, 0xff, 0x58, 0x04, 0x4c, 0x82, 0x9d, 0x7f, 0x12, 0x80, 0x6d, 0x8d,
-		0xd0, 0xc3, 0xc0, 0x0e, 0xb4, 0xff, 0xf5, 0xc7, 0xfc, 0xea, 0x8d, 0x47, 0xe2, 0x31, 0x60, 0x17,
-		0x79, 0x0e, 0x66, 0xf0, 0x83, 0x20, 0x03, 0x6a, 0x91, 0x27, 0x60, 0xe0, 0x79, 0xdb, 0xd0, 0x1d,
-		0xb5, 0xda, 0x89, 0xfa, 0xbf, 0x71, 0x6a, 0x81, 0x4f, 0x14, 0x56, 0x37, 0x2c, 0xec, 0xa8, 0x55,
-		0xbb, 0x13, 0xed, 0x7f, 0xe7, 0xb4, 0x2e, 0x01, 0x21, 0x2e, 0xab, 0xb6, 0xd3, 0x4d, 0xbf, 0xff,
-		0x87, 0x20, 0x16, 0x04, 0x44, 0x68, 0xf2, 0x7b, 0x07, 0xef, 0x75, 0xa2, 0xfd, 0xa1, 0x10, 0x9a,
-		0xe3, 0xe7, 0xdf, 0x0c, 0x49, 0xf2, 0x93, 0xdd, 0xa7, 0xeb, 0x40, 0xfc, 0xc7, 0x9c, 0xd8, 0xa3,
-		0x20, 0x2d, 0xdb, 0x4e, 0xc5, 0xd1, 0x3a, 0x2b, 0xfb, 0x26, 0xb7, 0xb4, 0xc0, 0xcf, 0xcf, 0xc0,
-		0xa0, 0xed, 0x54, 0x2a, 0x0d, 0x9e, 0x9f, 0x76, 0x20, 0xff, 0x93, 0x1f, 0xbb, 0x5b, 0x16, 0x2e,
-		0x0d, 0xb1, 0xf6, 0xb5, 0x1d, 0xc7, 0x34, 0xe8, 0x81, 0x47, 0x27, 0x0e, 0x3f, 0xe2, 0x1c, 0x7c,
-		0x24, 0xf9, 0x59, 0x48, 0x91, 0xbe, 0x58, 0xd8, 0xc4, 0xf4, 0x74, 0xaa, 0x03, 0x8b, 0xff, 0xc9,
-		0x15, 0x10, 0x20, 0x2a, 0xfc, 0xcc, 0xd7, 0x5f, 0x9d, 0x94, 0xbe, 0xf5, 0xea, 0xa4, 0xf4, 0x87,
-		0xaf, 0x4e, 0x4a, 0x1f, 0xfe, 0xee, 0x64, 0xdf, 0xb7, 0xbe, 0x3b, 0xd9, 0xf7, 0xbb, 0xdf, 0x9d,
-		0xec, 0x6b, 0xbd, 0x4b, 0x0c, 0xf3, 0xc6, 0xbc, 0xc1, 0xf6, 0x87, 0x9f, 0x93, 0xab, 0x9a, 0xb3,
-		0xdd, 0xd8, 0x9c, 0x2e, 0x1b, 0x75, 0xba, 0x8d, 0xeb, 0xed, 0xd6, 0xba, 0x8b, 0x1c, 0x78, 0x6f,
-		0x14, 0x8e, 0x96, 0x0d, 0xbb, 0x6e, 0xd8, 0x25, 0xb6, 0xdf, 0xcb, 0x0a, 0x7c, 0xc7, 0x37, 0xe5,
-		0xaf, 0xea, 0x62, 0xd3, 0xf7, 0x12, 0x0c, 0xd3, 0xae, 0xd3, 0xed, 0x2e, 0xea, 0x6d, 0x1d, 0x03,
-		0xc4, 0x37, 0xfe, 0x7d, 0x9c, 0xf6, 0x7a, 0xc8, 0x25, 0xa4, 0xa7, 0xf7, 0xeb, 0x30, 0xae, 0xd5,
-		0xcd, 0x1a, 0xa6, 0xdb, 0xfc, 0x25, 0xb7, 0xae, 0x33, 0xbf, 0x6f, 0x72, 0x7e, 0x63, 0x1e, 0xf9,
-		0x82, 0xa0, 0xce, 0x2f, 0xc2, 0xa8, 0x5a, 0x2e, 0x63, 0x33, 0xc0, 0xb2, 0x83, 0x59, 0x84, 0x80,
-		0x69, 0x4e, 0xe9, 0x72, 0x2b, 0x3c, 0xd9, 0xce, 0x34, 0xcf, 0xdd, 0xe3, 0xd3, 0xbc, 0x85, 0xab,
-		0x58, 0x7f, 0x50, 0xc7, 0xce, 0x35, 0xc3, 0xda, 0xe1, 0xea, 0x7d, 0x90, 0x35, 0xd5, 0xcf, 0x6e,
-		0x30, 0xc3, 0xfb, 0xa3, 0x30, 0xc9, 0x2a, 0xce, 0x6c, 0xaa, 0x36, 0x3e, 0x73, 0xf5, 0xe1, 0x4d,
-		0xec, 0xa8, 0x0f, 0x9f, 0x29, 0x1b, 0x9a, 0xce, 0x2d, 0x31, 0xc6, 0xed, 0x42, 0xea, 0xa7, 0x79,
-		0x7d, 0xb6, 0xe5, 0x36, 0xbd, 0x3c, 0x0f, 0xb1, 0x59, 0x43, 0xd3, 0xd1, 0x38, 0xc4, 0x2b, 0x58,
-		0x37, 0xea, 0xfc, 0xce, 0x1d, 0x2b, 0xa0, 0xbb, 0xa0, 0x5f, 0xad, 0x1b, 0x0d, 0xdd, 0x61, 0x27,
-		0x14, 0x85, 0xc1, 0xaf, 0xef, 0xe7, 0xfa, 0x7e, 0x7f, 0x3f, 0x17, 0x5d, 0xd0, 0x1d, 0x85, 0x57,
-		0xe5, 0x63, 0xdf, 0x7b, 0x25, 0x27, 0xc9, 0x4f, 0xc1, 0xc0, 0x1c, 0x2e, 0x1f, 0x86, 0xd7, 0x1c,
-		0x2e, 0x87, 0x78, 0xdd, 0x07, 0x89, 0x05, 0xdd, 0x61, 0xb7, 0x22, 0x4f, 0x40, 0x54, 0xd3, 0xd9,
-		0x45, 0x9b, 0x50, 0xfb, 0x04, 0x4e, 0x50, 0xe7, 0x70, 0xd9, 0x45, 0xad, 0xe0, 0x72, 0x18, 0x95,
-		0xb0, 0x27, 0xf0, 0xc2, 0xdc, 0xef, 0xfe, 0xd1, 0x64, 0xdf, 0x8b, 0xaf, 0x4e, 0xf6, 0xb5, 0xb5,
-		0x84, 0x7f, 0x0c, 0x70, 0x15, 0x73, 0x13, 0xd8, 0x95, 0x1d, 0x76, 0x46, 0xe2, 0x9a, 0xe1, 0xb7,
-		0xfb, 0x41, 0xe6, 0x38, 0xb6, 0xa3, 0xee, 0x68, 0x7a, 0xd5, 0xb5, 0x84, 0xda, 0x70, 0xb6, 0xaf,
-		0x73, 0x53, 0x4c, 0x70, 0x53, 0x70, 0x9c, 0x83, 0xad, 0x91, 0x6d, 0x3f, 0xba, 0xb2, 0x1d, 0x6c,
-		0x2e, 0xff, 0xeb, 0x28, 0xa0, 0x35, 0x47, 0xdd, 0xc1, 0x33, 0x0d, 0x67, 0xdb, 0xb0, 0xb4, 0xeb,
-		0x2c, 0x96, 0x61, 0x80, 0xba, 0xba, 0x5b, 0x72, 0x8c, 0x1d, 0xac, 0xdb, 0x54, 0x35, 0x83, 0x67,
-		0x8f, 0x4e, 0xb7, 0xf0, 0x8f, 0x69, 0x62, 0xba, 0xc2, 0xfd, 0x9f, 0xfb, 0x4e, 0xee, 0xde, 0xce,
-		0x5a, 0xa0, 0xc8, 0x24, 0xb9, 0xde, 0x5d, 0xa7, 0x8c, 0xd1, 0x15, 0x60, 0x97, 0x2c, 0x4a, 0x35,
-		0xcd, 0x76, 0xf8, 0x3d, 0xed, 0x73, 0xd3, 0xad, 0xfb, 0x3e, 0xdd, 0x2c, 0xe6, 0xf4, 0x15, 0xb5,
-		0xa6, 0x55, 0x54, 0xc7, 0xb0, 0xec, 0x4b, 0x7d, 0x4a, 0x92, 0xb2, 0x5a, 0xd4, 0x6c, 0x07, 0xad,
-		0x43, 0xb2, 0x82, 0xf5, 0x3d, 0xc6, 0x36, 0xfa, 0xfa, 0xd8, 0x26, 0x08, 0x27, 0xca, 0xf5, 0x59,
-		0x40, 0xaa, 0x1f, 0x4f, 0x3c, 0x4c, 0x62, 0xf7, 0x2b, 0xdb, 0xb0, 0x0f, 0x70, 0xa6, 0xef, 0x28,
-		0x46, 0xd5, 0x30, 0x28, 0x7b, 0x12, 0xc0, 0x6b, 0x13, 0x65, 0x60, 0x40, 0xad, 0x54, 0x2c, 0x6c,
-		0xdb, 0xf4, 0x00, 0x30, 0xa9, 0x88, 0x62, 0x7e, 0xf4, 0xdf, 0x7e, 0xf9, 0xc1, 0xa1, 0x00, 0xc7,
-		0x42, 0x0a, 0xe0, 0xaa, 0x4b, 0x7a, 0xfa, 0x53, 0x12, 0x8c, 0x36, 0xb5, 0x88, 0x64, 0x98, 0x9c,
-		0xd9, 0x58, 0xbf, 0xb4, 0xa2, 0x2c, 0x3c, 0x37, 0xb3, 0xbe, 0xb0, 0xb2, 0x5c, 0x62, 0x57, 0xfe,
-		0x97, 0xd7, 0x56, 0x8b, 0xb3, 0x0b, 0x17, 0x17, 0x8a, 0x73, 0xe9, 0x3e, 0x94, 0x83, 0x63, 0x2d,
-		0x70, 0xe6, 0x8a, 0x8b, 0xc5, 0xf9, 0x99, 0xf5, 0x62, 0x5a, 0x42, 0x77, 0xc2, 0x89, 0x96, 0x4c,
-		0x5c, 0x94, 0x48, 0x1b, 0x14, 0xa5, 0xe8, 0xa2, 0x44, 0x0b, 0x17, 0xdb, 0x8e, 0xa2, 0x07, 0x0e,
-		0xf4, 0x9f, 0x5d, 0x77, 0xb8, 0x04, 0xc7, 0xd3, 0x7b, 0x22, 0x70, 0x34, 0x3c, 0x65, 0xa8, 0xfa,
-		0x5e, 0x9b, 0x57, 0x9f, 0x6d, 0xa2, 0xd9, 0x25, 0x88, 0xce, 0xe8, 0x7b, 0xe8, 0x28, 0xcb, 0xa7,
-		0x4b, 0x0d, 0xab, 0xc6, 0x63, 0xd0, 0x00, 0x29, 0x6f, 0x58, 0x35, 0x12, 0x9b, 0xc4, 0x45, 0x7f,
-		0xe9, 0x54, 0x8a, 0xdf, 0xde, 0xcf, 0xa7, 0x3f, 0xf6, 0x4a, 0xae, 0xef, 0x0b, 0xaf, 0xe4, 0xfa,
-		0x7e, 0xf8, 0xe9, 0x5c, 0xdf, 0x8b, 0x7f, 0x30, 0xd5, 0x57, 0xd8, 0x09, 0x77, 0xef, 0xab, 0x1d,
-		0x67, 0xd3, 0xc4, 0x8c, 0xbe, 0x47, 0x03, 0xd1, 0xaa, 0xf4, 0x5c, 0x9c, 0x76, 0x4e, 0x1c, 0xa0,
-		0x4e, 0x86, 0x0f, 0x50, 0x9f, 0xc1, 0xb5, 0xda, 0x65, 0xdd, 0xb8, 0x46, 0xad, 0xea, 0xe9, 0xe0,
-		0xa3, 0x11, 0x98, 0x6c, 0x9a, 0x36, 0x79, 0x86, 0xd1, 0xee, 0xf9, 0x6b, 0x1e, 0x12, 0x73, 0x22,
-		0x71, 0xc9, 0xc0, 0x80, 0x8d, 0xcb, 0x86, 0x5e, 0x61, 0x23, 0x3d, 0xaa, 0x88, 0x22, 0xe9, 0xb6,
-		0xae, 0xea, 0x86, 0xcd, 0xef, 0xdc, 0xb3, 0x42, 0xe1, 0x13, 0x52, 0x6f, 0xf9, 0xc2, 0x90, 0x68,
-		0x49, 0x74, 0xf3, 0xe1, 0x8e, 0x47, 0xca, 0x3b, 0xa4, 0x97, 0x6e, 0x27, 0x02, 0xc7, 0xca, 0xdd,
-		0x6a, 0xe5, 0x17, 0x22, 0x90, 0x0b, 0x6b, 0x85, 0xa4, 0x6d, 0xb6, 0xa3, 0xd6, 0xcd, 0x76, 0x6a,
-		0xb9, 0x00, 0xc9, 0x75, 0x81, 0xd3, 0xb3, 0x5e, 0x6e, 0xf4, 0xa8, 0x97, 0x61, 0xb7, 0x29, 0xa1,
-		0x98, 0xb3, 0x5d, 0x2a, 0xc6, 0xed, 0xc7, 0xa1, 0x34, 0xf3, 0xb9, 0x18, 0x9c, 0xa0, 0x8f, 0xb2,
-		0xac, 0xba, 0xa6, 0x3b, 0x67, 0xca, 0xd6, 0x9e, 0xe9, 0xd0, 0xc4, 0xcd, 0xd8, 0xe2, 0x7a, 0x19,
-		0xf5, 0xaa, 0xa7, 0x59, 0x75, 0x9b, 0x91, 0xb3, 0x05, 0xf1, 0x55, 0x42, 0x47, 0x34, 0xe2, 0x18,
-		0x8e, 0x5a, 0xe3, 0x9a, 0x62, 0x05, 0x02, 0x65, 0x0f, 0xb9, 0x22, 0x0c, 0xaa, 0x89, 0x37, 0x5c,
-		0x35, 0xac, 0x6e, 0xb1, 0xfb, 0xf0, 0x51, 0x3a, 0xa0, 0x12, 0x04, 0x40, 0xaf, 0xbe, 0x8f, 0x43,
-		0x5c, 0x6d, 0xb0, 0xab, 0x1c, 0x51, 0x32, 0xd2, 0x68, 0x41, 0xbe, 0x0c, 0x03, 0xfc, 0x40, 0x19,
-		0xa5, 0x21, 0xba, 0x83, 0xf7, 0x68, 0x3b, 0x29, 0x85, 0xfc, 0x44, 0xd3, 0x10, 0xa7, 0xc2, 0xf3,
-		0x09, 0x24, 0x33, 0xdd, 0x24, 0xfd, 0x34, 0x15, 0x52, 0x61, 0x68, 0xf2, 0x53, 0x90, 0x98, 0x33,
-		0xea, 0x9a, 0x6e, 0x04, 0xb9, 0x25, 0x19, 0x37, 0x2a, 0xb3, 0xd9, 0xe0, 0xf9, 0x86, 0xc2, 0x0a,
-		0x68, 0x02, 0xfa, 0xd9, 0xfb, 0x08, 0x7e, 0x1d, 0x85, 0x97, 0xe4, 0x59, 0x18, 0xa0, 0xbc, 0x57,
-		0x4c, 0x84, 0xf8, 0xcb, 0x3a, 0xfe, 0x10, 0x83, 0xa6, 0xa6, 0x9c, 0x7d, 0xc4, 0x13, 0x16, 0x41,
-		0xac, 0xa2, 0x3a, 0x2a, 0xef, 0x37, 0xfd, 0x2d, 0xbf, 0x05, 0x12, 0x9c, 0x89, 0x8d, 0xce, 0x42,
-		0xd4, 0x30, 0x6d, 0x7e, 0xa1, 0x24, 0xdb, 0xae, 0x2b, 0x2b, 0x66, 0x21, 0x46, 0x32, 0x15, 0x85,
-		0x20, 0x17, 0x94, 0xb6, 0x41, 0xf5, 0x71, 0x5f, 0x50, 0xf5, 0x99, 0xdc, 0xf7, 0x93, 0x99, 0xb4,
-		0xc9, 0x1d, 0x5c, 0x67, 0xf9, 0x74, 0x04, 0x26, 0x7d, 0xb5, 0x57, 0xb1, 0x65, 0x6b, 0x86, 0xce,
-		0xe7, 0x73, 0xe6, 0x2d, 0xc8, 0x27, 0x24, 0xaf, 0x6f, 0xe3, 0x2e, 0x6f, 0x86, 0xe8, 0x8c, 0x69,
-		0xa2, 0x2c, 0x24, 0x68, 0xb9, 0x6c, 0x30, 0x7f, 0x89, 0x29, 0x6e, 0x99, 0xd4, 0xd9, 0xc6, 0x96,
-		0x73, 0x4d, 0xb5, 0xdc, 0x27, 0x84, 0xa2, 0x2c, 0x3f, 0x01, 0xc9, 0x59, 0x43, 0xb7, 0xb1, 0x6e,
-		0x37, 0xe8, 0x18, 0xdc, 0xac, 0x19, 0xe5, 0x1d, 0xce, 0x81, 0x15, 0x88, 0xc2, 0x55, 0xd3, 0xa4,
-		0x94, 0x31, 0x85, 0xfc, 0x64, 0xb9, 0x61, 0x61, 0xad, 0xad, 0x8a, 0x9e, 0xe8, 0x5d, 0x45, 0xbc,
-		0x93, 0xae, 0x8e, 0x3e, 0x1e, 0x81, 0xe3, 0xcd, 0x03, 0x6a, 0x07, 0xef, 0xd9, 0xbd, 0x8e, 0x27,
-		0x07, 0x92, 0xab, 0xf4, 0x1d, 0xff, 0x65, 0xbc, 0x87, 0xb2, 0x30, 0x80, 0x2b, 0x67, 0xcf, 0x9d,
-		0x7b, 0xf8, 0x09, 0xe6, 0xed, 0x97, 0xfa, 0x14, 0x01, 0x40, 0x93, 0x90, 0xb4, 0x71, 0xd9, 0x3c,
-		0x7b, 0xee, 0xfc, 0xce, 0xc3, 0xcc, 0xbd, 0x48, 0x06, 0xe4, 0x82, 0x08, 0xad, 0x6d, 0x31, 0xda,
-		0xa8, 0xa0, 0xe5, 0x80, 0x7c, 0x82, 0x68, 0xe4, 0x7b, 0x9f, 0xce, 0x49, 0x85, 0x38, 0x44, 0xed,
-		0x46, 0xfd, 0xb6, 0xfa, 0xcf, 0xcb, 0x71, 0x98, 0xf2, 0x53, 0xd2, 0x28, 0xe6, 0x66, 0x2c, 0x5c,
-		0x3f, 0x69, 0x9f, 0x7e, 0x28, 0x46, 0x9b, 0x44, 0xf7, 0x40, 0x2d, 0xcb, 0xbf, 0x26, 0x41, 0xca,
-		0x4d, 0xa3, 0xd6, 0xb0, 0x83, 0x2e, 0xf8, 0x73, 0x23, 0x3e, 0xa4, 0x8e, 0x4d, 0x87, 0xdb, 0xf2,
-		0xd2, 0x3d, 0xc5, 0x87, 0x8e, 0x1e, 0xa3, 0x4e, 0x6a, 0x1a, 0x36, 0x7f, 0x72, 0xd6, 0x81, 0xd4,
-		0x45, 0x46, 0x0f, 0x00, 0xa2, 0xd1, 0xaf, 0x74, 0xd5, 0x70, 0x34, 0xbd, 0x5a, 0x32, 0x8d, 0x6b,
-		0xfc, 0x21, 0x6f, 0x54, 0x49, 0xd3, 0x9a, 0x2b, 0xb4, 0x62, 0x95, 0xc0, 0x89, 0xd0, 0x49, 0x97,
-		0x4b, 0x30, 0xf5, 0x23, 0x01, 0x42, 0x14, 0xd1, 0x05, 0x18, 0x30, 0x1b, 0x9b, 0x25, 0x11, 0x4d,
-		0x06, 0xcf, 0x1e, 0x6f, 0x15, 0x1b, 0x84, 0xef, 0xf0, 0xe8, 0xd0, 0x6f, 0x36, 0x36, 0x89, 0x27,
-		0xdd, 0x09, 0xa9, 0x16, 0xc2, 0x0c, 0x5e, 0xf5, 0xe4, 0xa0, 0x9f, 0x96, 0xe0, 0x3d, 0x28, 0x99,
-		0x96, 0x66, 0x58, 0x9a, 0xb3, 0x47, 0x73, 0xdb, 0xa8, 0x92, 0x16, 0x15, 0xab, 0x1c, 0x2e, 0xef,
-		0xc0, 0xc8, 0x1a, 0x5d, 0xfb, 0x7a, 0x92, 0x9f, 0xf3, 0xe4, 0x93, 0x3a, 0xcb, 0xd7, 0x56, 0xb2,
-		0x48, 0x93, 0x64, 0x85, 0xa7, 0xdb, 0x7a, 0xe7, 0x63, 0xbd, 0x7b, 0x67, 0x30, 0x7b, 0xfc, 0xbd,
-		0xc9, 0xc0, 0xc0, 0x65, 0xce, 0xe9, 0x0f, 0x6d, 0xdd, 0x3a, 0x66, 0xa7, 0x4c, 0x23, 0x7b, 0xf0,
-		0x84, 0x9b, 0xed, 0x10, 0x62, 0xb3, 0x1d, 0x87, 0x90, 0xfc, 0x04, 0x0c, 0xad, 0xaa, 0x96, 0xb3,
-		0x86, 0x9d, 0x4b, 0x58, 0xad, 0x60, 0x2b, 0x38, 0x23, 0x0f, 0x89, 0x19, 0x19, 0x41, 0x8c, 0x4e,
-		0xbb, 0x6c, 0x46, 0xa2, 0xbf, 0xe5, 0x6d, 0x88, 0xd1, 0x5b, 0xa3, 0xee, 0x6c, 0xcd, 0x29, 0xd8,
-		0x6c, 0x4d, 0xe2, 0xec, 0x9e, 0x83, 0x6d, 0x91, 0xfa, 0xd2, 0x02, 0x7a, 0x54, 0xcc, 0xb9, 0xd1,
-		0x83, 0xe7, 0x5c, 0xee, 0x88, 0x7c, 0xe6, 0xad, 0xc1, 0x40, 0x81, 0x84, 0xe9, 0x85, 0x39, 0x57,
-		0x10, 0xc9, 0x13, 0x04, 0x2d, 0xc1, 0x88, 0xa9, 0x5a, 0x0e, 0x7d, 0x2e, 0xb3, 0x4d, 0x7b, 0xc1,
-		0x7d, 0x3d, 0xd7, 0x3c, 0xf2, 0x02, 0x9d, 0xe5, 0xad, 0x0c, 0x99, 0x7e, 0xa0, 0xfc, 0x9f, 0x62,
-		0xd0, 0xcf, 0x95, 0xf1, 0x66, 0x18, 0xe0, 0x6a, 0xe5, 0xde, 0x79, 0x62, 0xba, 0x79, 0xd2, 0x9a,
-		0x76, 0x27, 0x17, 0xce, 0x4f, 0xd0, 0xa0, 0x93, 0x90, 0x28, 0x6f, 0xab, 0x9a, 0x5e, 0xd2, 0x2a,
-		0x62, 0x1b, 0xe2, 0xd5, 0xfd, 0xdc, 0xc0, 0x2c, 0x81, 0x2d, 0xcc, 0x29, 0x03, 0xb4, 0x72, 0xa1,
-		0x42, 0xb2, 0x84, 0x6d, 0xac, 0x55, 0xb7, 0x1d, 0x3e, 0xc2, 0x78, 0x09, 0x3d, 0x0e, 0x31, 0xe2,
-		0x10, 0xfc, 0x31, 0x65, 0xb6, 0x69, 0x33, 0xc8, 0x4d, 0x04, 0x0b, 0x09, 0xd2, 0xf0, 0x87, 0xbf,
-		0x93, 0x93, 0x14, 0x4a, 0x81, 0x66, 0x61, 0xa8, 0xa6, 0xda, 0x4e, 0x89, 0xce, 0x6e, 0xa4, 0xf9,
-		0x38, 0x5f, 0x8b, 0x37, 0x29, 0x84, 0x2b, 0x96, 0x8b, 0x3e, 0x48, 0xa8, 0x18, 0xa8, 0x82, 0x4e,
-		0x41, 0x9a, 0x32, 0x29, 0x1b, 0xf5, 0xba, 0xe6, 0xb0, 0xbc, 0xab, 0x9f, 0xea, 0x7d, 0x98, 0xc0,
-		0x67, 0x29, 0x98, 0x66, 0x5f, 0xc7, 0x20, 0x49, 0x9f, 0x6f, 0x51, 0x14, 0x76, 0x55, 0x39, 0x41,
-		0x00, 0xb4, 0xf2, 0x5e, 0x18, 0xf1, 0xe2, 0x23, 0x43, 0x49, 0x30, 0x2e, 0x1e, 0x98, 0x22, 0x3e,
-		0x04, 0xe3, 0x3a, 0xde, 0xa5, 0x97, 0xa7, 0x03, 0xd8, 0x49, 0x8a, 0x8d, 0x48, 0xdd, 0x95, 0x20,
-		0xc5, 0x3d, 0x30, 0x5c, 0x16, 0xca, 0x67, 0xb8, 0x40, 0x71, 0x87, 0x5c, 0x28, 0x45, 0x3b, 0x0a,
-		0x09, 0xd5, 0x34, 0x19, 0xc2, 0x20, 0x8f, 0x8f, 0xa6, 0x49, 0xab, 0x4e, 0xc3, 0x28, 0xed, 0xa3,
-		0x85, 0xed, 0x46, 0xcd, 0xe1, 0x4c, 0x52, 0x14, 0x67, 0x84, 0x54, 0x28, 0x0c, 0x4e, 0x71, 0xef,
-		0x82, 0x21, 0x7c, 0x55, 0xab, 0x60, 0xbd, 0x8c, 0x19, 0xde, 0x10, 0xc5, 0x4b, 0x09, 0x20, 0x45,
-		0xba, 0x0f, 0xdc, 0xb8, 0x57, 0x12, 0x31, 0x79, 0x98, 0xf1, 0x13, 0xf0, 0x19, 0x06, 0x96, 0x33,
-		0x10, 0x9b, 0x53, 0x1d, 0x95, 0x24, 0x1f, 0xce, 0x2e, 0x9b, 0x68, 0x52, 0x0a, 0xf9, 0x29, 0xff,
-		0x7a, 0x14, 0x62, 0x57, 0x0c, 0x07, 0xa3, 0x47, 0x7c, 0xc9, 0xe1, 0x70, 0x2b, 0x7f, 0x5e, 0xd3,
-		0xaa, 0x3a, 0xae, 0x2c, 0xd9, 0x55, 0xdf, 0xb7, 0x16, 0x3c, 0x77, 0x8a, 0x04, 0xdc, 0x69, 0x1c,
-		0xe2, 0x96, 0xd1, 0xd0, 0x2b, 0xe2, 0x96, 0x2f, 0x2d, 0xa0, 0x22, 0x24, 0x5c, 0x2f, 0x89, 0x75,
-		0xf2, 0x92, 0x11, 0xe2, 0x25, 0xc4, 0x87, 0x39, 0x40, 0x19, 0xd8, 0xe4, 0xce, 0x52, 0x80, 0xa4,
-		0x1b, 0xbc, 0xb8, 0xb7, 0x75, 0xe7, 0xb0, 0x1e, 0x19, 0x99, 0x4c, 0x5c, 0xdb, 0xbb, 0xca, 0x63,
-		0x1e, 0x97, 0x76, 0x2b, 0xb8, 0xf6, 0x02, 0x6e, 0xc5, 0xbf, 0xfb, 0x30, 0x40, 0xfb, 0xe5, 0xb9,
-		0x15, 0xfb, 0xf6, 0xc3, 0x71, 0x48, 0xda, 0x5a, 0x55, 0x57, 0x9d, 0x86, 0x85, 0xb9, 0xe7, 0x79,
-		0x00, 0x52, 0xeb, 0x5d, 0x78, 0x67, 0x9e, 0xe6, 0xfb, 0x1c, 0xcd, 0x19, 0x18, 0xf3, 0x3e, 0x04,
-		0xe3, 0x71, 0x61, 0x5e, 0x86, 0xdc, 0xaa, 0x35, 0x51, 0x23, 0x7f, 0x55, 0x82, 0x7e, 0x36, 0x30,
-		0x7c, 0x66, 0x90, 0x5a, 0x9b, 0x21, 0xd2, 0xce, 0x0c, 0xd1, 0xc3, 0x9b, 0x61, 0x06, 0xc0, 0x15,
-		0xd3, 0xe6, 0xaf, 0xfb, 0x5b, 0x24, 0x20, 0x4c, 0xc4, 0x35, 0xad, 0xca, 0xc7, 0xbd, 0x8f, 0x48,
-		0xfe, 0x8f, 0x12, 0xc9, 0x97, 0x79, 0x3d, 0x9a, 0x81, 0x21, 0x21, 0x57, 0x69, 0xab, 0xa6, 0x56,
-		0xb9, 0x2b, 0x9e, 0x68, 0x2b, 0xdc, 0xc5, 0x9a, 0x5a, 0x55, 0x06, 0xb9, 0x3c, 0xa4, 0xd0, 0xda,
-		0xac, 0x91, 0x36, 0x66, 0x0d, 0xf8, 0x51, 0xf4, 0x70, 0x7e, 0x14, 0xb0, 0x78, 0x2c, 0x64, 0x71,
-		0xf9, 0x8f, 0x24, 0xfe, 0x19, 0x9a, 0x0a, 0xbb, 0xad, 0xff, 0x46, 0x99, 0xea, 0x39, 0xee, 0x5b,
-		0x15, 0x5c, 0x29, 0x35, 0xd9, 0xec, 0xae, 0x66, 0x8e, 0x41, 0x99, 0x3d, 0xdb, 0x21, 0xc1, 0x65,
-		0xcd, 0xb3, 0xe1, 0x97, 0x23, 0x30, 0xda, 0x84, 0xff, 0xe7, 0xcf, 0x96, 0xc1, 0xd1, 0x1b, 0xef,
-		0x72, 0xf4, 0xf6, 0xb7, 0x1d, 0xbd, 0x5f, 0x8a, 0xd0, 0x25, 0xb5, 0x69, 0xd8, 0x6a, 0xed, 0x27,
-		0x11, 0x7b, 0x8f, 0x41, 0xd2, 0x34, 0x6a, 0x25, 0x56, 0xc3, 0xde, 0x59, 0x24, 0x4c, 0xa3, 0xa6,
-		0x34, 0xb9, 0x59, 0xfc, 0x16, 0x05, 0xe6, 0xfe, 0x5b, 0x60, 0x84, 0x81, 0xf0, 0x80, 0xb2, 0x20,
-		0xc5, 0x54, 0xc1, 0xb3, 0xa6, 0x87, 0x88, 0x0e, 0x68, 0x1a, 0x26, 0x35, 0x67, 0x79, 0x4c, 0x6c,
-		0x86, 0xa9, 0x70, 0x3c, 0x42, 0xc1, 0x92, 0x8c, 0x56, 0x7b, 0x31, 0xfe, 0x88, 0xa5, 0x70, 0x3c,
-		0xf9, 0x6f, 0x4b, 0x00, 0x8b, 0x44, 0xb3, 0xb4, 0xbf, 0x24, 0xdf, 0xb1, 0xa9, 0x08, 0xa5, 0x40,
-		0xcb, 0x93, 0xed, 0x8c, 0xc6, 0xdb, 0x4f, 0xd9, 0x7e, 0xb9, 0x67, 0x61, 0xc8, 0xf3, 0x6d, 0x1b,
-		0x0b, 0x61, 0x26, 0x0f, 0x58, 0xbf, 0xad, 0x61, 0x47, 0x49, 0x5d, 0xf5, 0x95, 0xe4, 0x7f, 0x21,
-		0x41, 0x92, 0xca, 0xb4, 0x84, 0x1d, 0x35, 0x60, 0x43, 0xe9, 0xf0, 0x36, 0x3c, 0x01, 0xc0, 0xd8,
-		0xd8, 0xda, 0x75, 0xcc, 0x3d, 0x2b, 0x49, 0x21, 0x6b, 0xda, 0x75, 0x8c, 0xce, 0xbb, 0x0a, 0x8f,
-		0x1e, 0xac, 0x70, 0xb1, 0xbe, 0xe3, 0x6a, 0xbf, 0x03, 0x06, 0xe8, 0x87, 0xd2, 0x76, 0x6d, 0xbe,
-		0x64, 0xeb, 0xd7, 0x1b, 0xf5, 0xf5, 0x5d, 0x5b, 0x7e, 0x1e, 0x06, 0xd6, 0x77, 0xd9, 0x0e, 0xdd,
-		0x31, 0x48, 0x5a, 0x86, 0xc1, 0xb3, 0x3f, 0x96, 0x75, 0x27, 0x08, 0x80, 0x26, 0x3b, 0x62, 0x57,
-		0x2a, 0xe2, 0xed, 0x4a, 0x79, 0xdb, 0x6a, 0xd1, 0xae, 0xb6, 0xd5, 0x4e, 0xff, 0x07, 0x09, 0x06,
-		0x7d, 0xe1, 0x06, 0x3d, 0x0c, 0x47, 0x0a, 0x8b, 0x2b, 0xb3, 0x97, 0x4b, 0x0b, 0x73, 0xa5, 0x8b,
-		0x8b, 0x33, 0xf3, 0xde, 0x4b, 0xc2, 0xec, 0xc4, 0x4b, 0x37, 0xa6, 0x90, 0x0f, 0x77, 0x43, 0xa7,
-		0xfb, 0x9a, 0xe8, 0x0c, 0x8c, 0x07, 0x49, 0x66, 0x0a, 0x6b, 0xc5, 0xe5, 0xf5, 0xb4, 0x94, 0x3d,
-		0xf2, 0xd2, 0x8d, 0xa9, 0x51, 0x1f, 0xc5, 0xcc, 0xa6, 0x8d, 0x75, 0xa7, 0x99, 0x60, 0x76, 0x65,
-		0x69, 0x69, 0x61, 0x3d, 0x1d, 0x69, 0x22, 0xe0, 0x13, 0xc4, 0x7d, 0x30, 0x1a, 0x24, 0x58, 0x5e,
-		0x58, 0x4c, 0x47, 0xb3, 0xe8, 0xa5, 0x1b, 0x53, 0xc3, 0x3e, 0xec, 0x65, 0xad, 0x96, 0x4d, 0x7c,
-		0xf0, 0x33, 0x93, 0x7d, 0xbf, 0xfc, 0x4b, 0x93, 0x12, 0xe9, 0xd9, 0x50, 0x20, 0x46, 0xa0, 0x07,
-		0xe0, 0x8e, 0xb5, 0x85, 0xf9, 0xe5, 0xe2, 0x5c, 0x69, 0x69, 0x6d, 0x5e, 0x9c, 0x84, 0x88, 0xde,
-		0x8d, 0xbc, 0x74, 0x63, 0x6a, 0x90, 0x77, 0xa9, 0x1d, 0xf6, 0xaa, 0x52, 0xbc, 0xb2, 0xb2, 0x5e,
-		0x4c, 0x4b, 0x0c, 0x7b, 0xd5, 0xc2, 0x57, 0x0d, 0x87, 0x7d, 0x49, 0xf1, 0x21, 0x38, 0xda, 0x02,
-		0xdb, 0xed, 0xd8, 0xe8, 0x4b, 0x37, 0xa6, 0x86, 0x56, 0x2d, 0xcc, 0xc6, 0x0f, 0xa5, 0x98, 0x86,
-		0x4c, 0x33, 0xc5, 0xca, 0xea, 0xca, 0xda, 0xcc, 0x62, 0x7a, 0x2a, 0x9b, 0x7e, 0xe9, 0xc6, 0x54,
-		0x4a, 0x04, 0x43, 0x7a, 0xdc, 0xe4, 0xf6, 0xec, 0x76, 0xae, 0xad, 0xff, 0xe4, 0x61, 0xb8, 0xbb,
-		0xcd, 0x49, 0xa7, 0x38, 0x23, 0x3b, 0xd4, 0x59, 0x67, 0xdb, 0xd3, 0x9e, 0x6c, 0x87, 0x43, 0x90,
-		0xce, 0x8b, 0xf4, 0xc3, 0x9f, 0xa3, 0x66, 0x0f, 0xdc, 0x46, 0x90, 0x3f, 0x24, 0xc1, 0xf0, 0x25,
-		0xcd, 0x76, 0x0c, 0x4b, 0x2b, 0xab, 0x35, 0xfa, 0x7e, 0xf0, 0x7c, 0xb7, 0xb1, 0x35, 0x34, 0xd4,
-		0x9f, 0x84, 0xfe, 0xab, 0x6a, 0x8d, 0x05, 0xb5, 0x28, 0xfd, 0xdc, 0x51, 0x9b, 0x83, 0x47, 0x37,
-		0xb4, 0x09, 0x06, 0x8c, 0x4c, 0xfe, 0xd5, 0x08, 0x8c, 0xd0, 0xc1, 0x60, 0xb3, 0x0f, 0xe1, 0x91,
-		0xd5, 0x7c, 0x01, 0x62, 0x96, 0xea, 0xf0, 0xad, 0xeb, 0xc2, 0x34, 0x3f, 0x03, 0x3f, 0xd9, 0xc5,
-		0x89, 0xee, 0x1c, 0x2e, 0x2b, 0x94, 0x16, 0xbd, 0x03, 0x12, 0x75, 0x75, 0xb7, 0x44, 0xf9, 0xb0,
-		0x35, 0xf2, 0x4c, 0x6f, 0x7c, 0x6e, 0xee, 0xe7, 0x46, 0xf6, 0xd4, 0x7a, 0x2d, 0x2f, 0x0b, 0x3e,
-		0xb2, 0x32, 0x50, 0x57, 0x77, 0x89, 0x88, 0xc8, 0x84, 0x11, 0x02, 0x2d, 0x6f, 0xab, 0x7a, 0x15,
-		0xb3, 0x46, 0xe8, 0x46, 0x7c, 0xe1, 0x52, 0xcf, 0x8d, 0x4c, 0x78, 0x8d, 0xf8, 0xd8, 0xc9, 0xca,
-		0x50, 0x5d, 0xdd, 0x9d, 0xa5, 0x00, 0xd2, 0x62, 0x3e, 0xf1, 0xb1, 0x57, 0x72, 0x7d, 0xf4, 0x5e,
-		0xc1, 0xb7, 0x25, 0x00, 0x4f, 0x63, 0xe8, 0x1d, 0x90, 0x2e, 0xbb, 0x25, 0x4a, 0x2b, 0x4e, 0xc8,
-		0xef, 0x6d, 0x67, 0x8b, 0x90, 0xbe, 0xd9, 0xdc, 0xfc, 0xad, 0xfd, 0x9c, 0xa4, 0x8c, 0x94, 0x43,
-		0xa6, 0x78, 0x3b, 0x0c, 0x36, 0xcc, 0x8a, 0xea, 0xe0, 0x12, 0xdd, 0x31, 0x88, 0x74, 0x9c, 0xe7,
-		0x27, 0x09, 0xaf, 0x9b, 0xfb, 0x39, 0xc4, 0xba, 0xe5, 0x23, 0x96, 0xe9, 0xec, 0x0f, 0x0c, 0x42,
-		0x08, 0x7c, 0x7d, 0xfa, 0x86, 0x04, 0x83, 0x73, 0xbe, 0x9b, 0xbd, 0x19, 0x18, 0xa8, 0x1b, 0xba,
-		0xb6, 0xc3, 0xfd, 0x31, 0xa9, 0x88, 0x22, 0xca, 0x42, 0x82, 0x3d, 0xa9, 0x76, 0xf6, 0xc4, 0x86,
-		0xbc, 0x28, 0x13, 0xaa, 0x6b, 0x78, 0xd3, 0xd6, 0x84, 0x35, 0x14, 0x51, 0x44, 0x17, 0x21, 0x6d,
-		0xe3, 0x72, 0xc3, 0xd2, 0x9c, 0xbd, 0x52, 0xd9, 0xd0, 0x1d, 0xb5, 0xec, 0xb0, 0xc7, 0xb9, 0x85,
-		0x63, 0x37, 0xf7, 0x73, 0x77, 0x30, 0x59, 0xc3, 0x18, 0xb2, 0x32, 0x22, 0x40, 0xb3, 0x0c, 0x42,
-		0x5a, 0xa8, 0x60, 0x47, 0xd5, 0x6a, 0x76, 0x86, 0x5d, 0x91, 0x11, 0x45, 0x5f, 0x5f, 0x3e, 0x3f,
-		0xe0, 0xdf, 0x42, 0xbd, 0x08, 0x69, 0xc3, 0xc4, 0x56, 0x20, 0xaf, 0x95, 0xc2, 0x2d, 0x87, 0x31,
-		0x64, 0x65, 0x44, 0x80, 0x44, 0xce, 0xeb, 0x10, 0x33, 0x8b, 0x2d, 0x09, 0xb3, 0xb1, 0xe9, 0xed,
-		0xbc, 0x8e, 0x37, 0x59, 0x63, 0x46, 0xdf, 0x2b, 0x3c, 0xe2, 0x71, 0x0f, 0xd3, 0xc9, 0xdf, 0xfc,
-		0xf2, 0x83, 0xe3, 0xdc, 0x35, 0xbc, 0x9d, 0xd0, 0xcb, 0x78, 0x8f, 0x98, 0x9f, 0xa3, 0xae, 0x52,
-		0x4c, 0x92, 0x76, 0x3e, 0xaf, 0x6a, 0x35, 0xf1, 0x91, 0x09, 0x85, 0x97, 0x50, 0x1e, 0xfa, 0x6d,
-		0x47, 0x75, 0x1a, 0x36, 0xbf, 0x6f, 0x20, 0xb7, 0x73, 0xb5, 0x82, 0xa1, 0x57, 0xd6, 0x28, 0xa6,
-		0xc2, 0x29, 0xd0, 0x45, 0xe8, 0xe7, 0x17, 0x39, 0xe2, 0x3d, 0x8f, 0x6f, 0x7a, 0x63, 0x87, 0x51,
-		0x13, 0x8d, 0x54, 0x70, 0x0d, 0x57, 0x59, 0x5a, 0xb5, 0xad, 0x92, 0x45, 0x0e, 0xfd, 0x02, 0x64,
-		0x61, 0xa1, 0xe7, 0x41, 0xc8, 0x35, 0x15, 0xe6, 0x27, 0x2b, 0x23, 0x2e, 0x68, 0x8d, 0x42, 0xd0,
-		0xe5, 0xc0, 0x15, 0x74, 0xfe, 0x99, 0xd4, 0xbb, 0xda, 0x75, 0xdf, 0xe7, 0xd3, 0x62, 0x27, 0xcc,
-		0x7f, 0x81, 0xfd, 0x22, 0xa4, 0x1b, 0xfa, 0xa6, 0xa1, 0xd3, 0x97, 0xe0, 0x3c, 0xbf, 0x4f, 0x90,
-		0x8c, 0xc9, 0xef, 0x1c, 0x61, 0x0c, 0x59, 0x19, 0x71, 0x41, 0x97, 0xd8, 0x2a, 0xa0, 0x02, 0xc3,
-		0x1e, 0x16, 0x1d, 0xa8, 0xc9, 0x8e, 0x03, 0xf5, 0x4e, 0x3e, 0x50, 0x8f, 0x84, 0x5b, 0xf1, 0xc6,
-		0xea, 0x90, 0x0b, 0x24, 0x64, 0xe8, 0x12, 0x80, 0x17, 0x1e, 0xe8, 0x5e, 0xc5, 0x60, 0x7b, 0xc3,
-		0x7b, 0x31, 0x46, 0x6c, 0x05, 0x78, 0xb4, 0xe8, 0x5d, 0x30, 0x56, 0xd7, 0xf4, 0x92, 0x8d, 0x6b,
-		0x5b, 0x25, 0xae, 0x60, 0xc2, 0x92, 0x7e, 0xc8, 0xab, 0xb0, 0xd8, 0x9b, 0x3f, 0xdc, 0xdc, 0xcf,
-		0x65, 0x79, 0x08, 0x6d, 0x66, 0x29, 0x2b, 0xa3, 0x75, 0x4d, 0x5f, 0xc3, 0xb5, 0xad, 0x39, 0x17,
-		0x96, 0x4f, 0x7d, 0xf0, 0x95, 0x5c, 0x1f, 0x1f, 0xae, 0x7d, 0xf2, 0x79, 0x7a, 0x4a, 0xc3, 0x87,
-		0x19, 0xb6, 0xc9, 0x9a, 0x44, 0x15, 0x05, 0x7e, 0xe1, 0xc5, 0x03, 0xb0, 0x61, 0xfe, 0xe2, 0x1f,
-		0x4c, 0x49, 0xf2, 0xe7, 0x25, 0xe8, 0x9f, 0xbb, 0xb2, 0xaa, 0x6a, 0x16, 0x5a, 0x80, 0x51, 0xcf,
-		0x73, 0x82, 0x83, 0xfc, 0xf8, 0xcd, 0xfd, 0x5c, 0x26, 0xec, 0x5c, 0xee, 0x28, 0xf7, 0x1c, 0x58,
-		0x0c, 0xf3, 0x85, 0x76, 0xeb, 0xe0, 0x00, 0xab, 0x26, 0x14, 0xb9, 0x79, 0x95, 0x1c, 0xea, 0x66,
-		0x11, 0x06, 0x98, 0xb4, 0x36, 0xca, 0x43, 0xdc, 0x24, 0x3f, 0xf8, 0x11, 0xd4, 0x64, 0x5b, 0xe7,
-		0xa5, 0xf8, 0xee, 0x96, 0x39, 0x21, 0x91, 0x3f, 0x12, 0x01, 0x98, 0xbb, 0x72, 0x65, 0xdd, 0xd2,
-		0xcc, 0x1a, 0x76, 0x6e, 0x65, 0xcf, 0xd7, 0xe1, 0x88, 0x6f, 0x95, 0x64, 0x95, 0x43, 0xbd, 0x9f,
-		0xba, 0xb9, 0x9f, 0x3b, 0x1e, 0xee, 0xbd, 0x0f, 0x4d, 0x56, 0xc6, 0xbc, 0xf5, 0x92, 0x55, 0x6e,
-		0xc9, 0xb5, 0x62, 0x3b, 0x2e, 0xd7, 0x68, 0x7b, 0xae, 0x3e, 0x34, 0x3f, 0xd7, 0x39, 0xdb, 0x69,
-		0xad, 0xda, 0x35, 0x18, 0xf4, 0x54, 0x62, 0xa3, 0x39, 0x48, 0x38, 0xfc, 0x37, 0xd7, 0xb0, 0xdc,
-		0x5e, 0xc3, 0x82, 0x8c, 0x6b, 0xd9, 0xa5, 0x94, 0xff, 0x54, 0x02, 0xf0, 0x7c, 0xf6, 0xa7, 0xd3,
-		0xc5, 0x48, 0x28, 0xe7, 0x81, 0x37, 0x7a, 0xa8, 0x54, 0x8d, 0x53, 0x87, 0xf4, 0xf9, 0xb3, 0x11,
-		0x18, 0xdb, 0x10, 0x91, 0xe7, 0xa7, 0x5e, 0x07, 0xab, 0x30, 0x80, 0x75, 0xc7, 0xd2, 0xa8, 0x12,
-		0x88, 0xb5, 0x1f, 0x6a, 0x67, 0xed, 0x16, 0x7d, 0xa2, 0x9f, 0x32, 0x13, 0xc7, 0x3b, 0x9c, 0x4d,
-		0x48, 0x1b, 0x3f, 0x1f, 0x85, 0x4c, 0x3b, 0x4a, 0x34, 0x0b, 0x23, 0x65, 0x0b, 0xb3, 0xeb, 0x7f,
-		0xfe, 0x9d, 0xc6, 0x42, 0xd6, 0xcb, 0x2c, 0x43, 0x08, 0xb2, 0x32, 0x2c, 0x20, 0x7c, 0xf6, 0xa8,
-		0x02, 0x49, 0xfb, 0x88, 0xdb, 0xd1, 0x5b, 0x84, 0xdd, 0xe5, 0x79, 0x32, 0x9f, 0x3e, 0x44, 0x23,
-		0x41, 0x06, 0x6c, 0xfe, 0x18, 0xf6, 0xa0, 0x74, 0x02, 0x79, 0x01, 0x46, 0x34, 0x5d, 0x73, 0x34,
-		0xb5, 0x56, 0xda, 0x54, 0x6b, 0xaa, 0x5e, 0x3e, 0x4c, 0xd6, 0xcc, 0x42, 0x3e, 0x6f, 0x36, 0xc4,
-		0x4e, 0x56, 0x86, 0x39, 0xa4, 0xc0, 0x00, 0xe8, 0x12, 0x0c, 0x88, 0xa6, 0x62, 0x87, 0xca, 0x36,
-		0x04, 0xb9, 0x2f, 0xc1, 0xfb, 0xb9, 0x28, 0x8c, 0x2a, 0xb8, 0xf2, 0x97, 0xa6, 0xe8, 0xcd, 0x14,
-		0x4b, 0x00, 0x6c, 0xb8, 0x93, 0x00, 0x7b, 0x08, 0x6b, 0x90, 0x80, 0x91, 0x64, 0x1c, 0xe6, 0x6c,
-		0xc7, 0x67, 0x8f, 0xfd, 0x08, 0xa4, 0xfc, 0xf6, 0xf8, 0x0b, 0x3a, 0x2b, 0xa1, 0x05, 0x2f, 0x12,
-		0xc5, 0xf8, 0x07, 0xa0, 0xdb, 0x44, 0xa2, 0x26, 0xef, 0x3d, 0x38, 0x04, 0xfd, 0xaf, 0x7e, 0xe8,
-		0x5f, 0x55, 0x2d, 0xb5, 0x6e, 0xa3, 0x72, 0x53, 0xa6, 0x29, 0xb6, 0x1f, 0x9b, 0x3e, 0xf3, 0xcf,
-		0x77, 0x3b, 0x3a, 0x24, 0x9a, 0x1f, 0x6b, 0x91, 0x68, 0xbe, 0x15, 0x86, 0xc9, 0x72, 0xd8, 0x77,
-		0x59, 0x86, 0x68, 0x7b, 0xa8, 0x70, 0xd4, 0xe3, 0x12, 0xac, 0x67, 0xab, 0xe5, 0x2b, 0xfe, 0xdb,
-		0x32, 0x83, 0x04, 0xc3, 0x0b, 0xcc, 0x84, 0x7c, 0xc2, 0x5b, 0x96, 0xfa, 0x2a, 0x65, 0x05, 0xea,
-		0xea, 0x6e, 0x91, 0x15, 0xd0, 0x22, 0xa0, 0x6d, 0x77, 0x67, 0xa4, 0xe4, 0xa9, 0x93, 0xd0, 0x9f,
-		0xb8, 0xb9, 0x9f, 0x3b, 0xca, 0xe8, 0x9b, 0x71, 0x64, 0x65, 0xd4, 0x03, 0x0a, 0x6e, 0x8f, 0x02,
-		0x90, 0x7e, 0x95, 0xd8, 0x43, 0x02, 0xb6, 0xdc, 0x39, 0x72, 0x73, 0x3f, 0x37, 0xca, 0xb8, 0x78,
-		0x75, 0xb2, 0x92, 0x24, 0x85, 0x39, 0xfa, 0xc6, 0x80, 0x67, 0xc7, 0xa1, 0x55, 0x3d, 0x5f, 0xdb,
-		0x2c, 0xf6, 0xbc, 0xb6, 0xf1, 0x65, 0xc7, 0x21, 0x96, 0x2c, 0x3b, 0x0e, 0xee, 0x06, 0xa0, 0x4f,
-		0x49, 0x30, 0x41, 0xb5, 0xeb, 0xbb, 0x07, 0x53, 0xa2, 0xa6, 0x64, 0x9f, 0xc8, 0x2f, 0x68, 0xbd,
-		0x49, 0xf0, 0xfd, 0xfd, 0x5c, 0x1b, 0x7e, 0x37, 0xf7, 0x73, 0x27, 0x7c, 0xd6, 0x6c, 0xaa, 0x97,
-		0x95, 0x31, 0x62, 0x55, 0xef, 0xee, 0x8d, 0x42, 0xa0, 0x68, 0x5f, 0x82, 0x93, 0x4d, 0x04, 0x58,
-		0xdf, 0x32, 0xac, 0x32, 0x7d, 0xfc, 0x52, 0x72, 0xb6, 0x2d, 0x6c, 0x6f, 0x1b, 0xb5, 0x0a, 0xfb,
-		0x6e, 0x59, 0xe1, 0x43, 0x52, 0x6f, 0x31, 0xed, 0xfb, 0xfb, 0xb9, 0x2e, 0x1b, 0xb8, 0xb9, 0x9f,
-		0x7b, 0xb0, 0x4d, 0x0f, 0x5a, 0xe2, 0xcb, 0x8a, 0x1c, 0xec, 0x51, 0xd1, 0xc3, 0x5a, 0x17, 0x48,
-		0xbe, 0xc8, 0xf6, 0x19, 0x09, 0x90, 0x37, 0xe5, 0x2b, 0xd8, 0x36, 0xc9, 0xfa, 0x9c, 0x2c, 0xc4,
-		0x7c, 0xab, 0x26, 0xe9, 0xe0, 0x85, 0x98, 0x47, 0x2f, 0x16, 0x62, 0xbe, 0x48, 0xf9, 0x84, 0x37,
-		0x3d, 0x46, 0x3a, 0xbd, 0xaa, 0xe0, 0x21, 0x22, 0x3c, 0x1f, 0xf6, 0xc9, 0xff, 0x4a, 0x82, 0xa3,
-		0x4d, 0x11, 0xc5, 0x15, 0xf6, 0xaf, 0x00, 0xb2, 0x7c, 0x95, 0xfc, 0x6b, 0xae, 0x4c, 0xe8, 0x9e,
-		0x03, 0xd4, 0xa8, 0xd5, 0x34, 0xef, 0xde, 0xba, 0x19, 0x9e, 0x3d, 0xdb, 0xf9, 0xe7, 0x12, 0x8c,
-		0xfb, 0x9b, 0x77, 0x3b, 0xb2, 0x0c, 0x29, 0x7f, 0xeb, 0xbc, 0x0b, 0x77, 0x77, 0xd3, 0x05, 0x2e,
-		0x7d, 0x80, 0x1e, 0x3d, 0xed, 0x85, 0x6b, 0xb6, 0x77, 0xfa, 0x70, 0xd7, 0xda, 0x10, 0x32, 0x85,
-		0xc3, 0x76, 0x8c, 0xda, 0xe3, 0xff, 0x49, 0x10, 0x5b, 0x35, 0x8c, 0x1a, 0x32, 0x60, 0x54, 0x37,
-		0x9c, 0x12, 0x89, 0x2c, 0xb8, 0xe2, 0x7f, 0x3d, 0x93, 0x2c, 0xcc, 0xf6, 0x3c, 0x24, 0x9a, 0x59,
-		0x29, 0x23, 0xba, 0xe1, 0x14, 0x28, 0x84, 0x3f, 0xa0, 0x79, 0x17, 0x0c, 0x05, 0x1b, 0x63, 0xb3,
-		0xe4, 0x33, 0x3d, 0x37, 0x16, 0x64, 0x73, 0x73, 0x3f, 0x37, 0xee, 0x45, 0x4c, 0x17, 0x2c, 0x2b,
-		0xa9, 0x4d, 0x5f, 0xeb, 0xec, 0x22, 0xe9, 0x0f, 0x5f, 0xc9, 0x49, 0xa7, 0xbf, 0x22, 0x01, 0x78,
-		0x3b, 0x4f, 0xe8, 0x01, 0xb8, 0xa3, 0xb0, 0xb2, 0x3c, 0x57, 0x5a, 0x5b, 0x9f, 0x59, 0xdf, 0x58,
-		0x0b, 0xbe, 0x34, 0x11, 0xc7, 0x23, 0xb6, 0x89, 0xcb, 0xda, 0x96, 0x86, 0x2b, 0xe8, 0x24, 0x8c,
-		0x07, 0xb1, 0x49, 0xa9, 0x38, 0x97, 0x96, 0xb2, 0xa9, 0x97, 0x6e, 0x4c, 0x25, 0x58, 0x2e, 0x8e,
-		0x2b, 0xe8, 0x14, 0x1c, 0x69, 0xc6, 0x5b, 0x58, 0x9e, 0x4f, 0x47, 0xb2, 0x43, 0x2f, 0xdd, 0x98,
-		0x4a, 0xba, 0x49, 0x3b, 0x92, 0x01, 0xf9, 0x31, 0x39, 0xbf, 0x68, 0x16, 0x5e, 0xba, 0x31, 0xd5,
-		0xcf, 0x14, 0x98, 0x8d, 0x7d, 0xf0, 0x33, 0x93, 0x7d, 0xb7, 0xfc, 0x3d, 0xca, 0x1f, 0x0f, 0xb4,
-		0x3d, 0xf5, 0xa8, 0x62, 0x1d, 0xdb, 0x9a, 0x7d, 0xa8, 0x53, 0x8f, 0xae, 0x4e, 0x52, 0xe4, 0xdf,
-		0x89, 0x43, 0x6a, 0x9e, 0xb5, 0x42, 0x0c, 0x81, 0xd1, 0x9b, 0xa0, 0xdf, 0xa4, 0x69, 0x84, 0x7b,
-		0x8c, 0xda, 0xc6, 0xe1, 0x59, 0xb2, 0xe1, 0xde, 0x1a, 0x65, 0xa9, 0x87, 0xcd, 0xaf, 0x8d, 0xb1,
-		0xdb, 0xac, 0xde, 0xfd, 0xcc, 0x54, 0x4f, 0xfb, 0x7d, 0x2c, 0x67, 0xe5, 0x5b, 0x6b, 0x61, 0x7e,
-		0x32, 0xbb, 0x81, 0xb6, 0x4e, 0x20, 0xec, 0x1e, 0xea, 0xfb, 0x25, 0x38, 0x42, 0xb1, 0xbc, 0x44,
-		0x8c, 0x62, 0x8a, 0xc5, 0xde, 0xe9, 0x76, 0x5d, 0x58, 0x54, 0x6d, 0xef, 0x56, 0x19, 0xbb, 0x39,
-		0x7a, 0x37, 0x4f, 0x84, 0x8e, 0xfb, 0x1a, 0x0f, 0xb3, 0x95, 0x95, 0xb1, 0x5a, 0x13, 0xa5, 0x8d,
-		0xe6, 0x03, 0x57, 0x87, 0x63, 0xbd, 0x1d, 0xb5, 0xf8, 0xaf, 0x11, 0x3f, 0x05, 0x83, 0x5e, 0x2c,
-		0xb1, 0xf9, 0x7f, 0x9f, 0xea, 0x7e, 0xee, 0xf0, 0x13, 0xa3, 0x0f, 0x48, 0x70, 0xc4, 0xcb, 0xe6,
-		0xfc, 0x6c, 0xd9, 0x7f, 0xe9, 0xba, 0xbf, 0x87, 0x85, 0x70, 0x58, 0x39, 0x2d, 0xf9, 0xca, 0xca,
-		0x78, 0xa3, 0x99, 0x94, 0x2c, 0xc1, 0x87, 0xfc, 0x91, 0xd5, 0xce, 0x88, 0x0f, 0xd1, 0x76, 0x1f,
-		0x9a, 0x83, 0x0c, 0xd8, 0x7f, 0x0e, 0x32, 0x0d, 0xcb, 0xc1, 0x2c, 0x89, 0x48, 0x28, 0x6e, 0x59,
-		0x5e, 0x06, 0xd4, 0x6c, 0xdc, 0xf0, 0x55, 0x69, 0xef, 0x95, 0x1c, 0x1a, 0x87, 0xb8, 0xff, 0x32,
-		0x31, 0x2b, 0xe4, 0x13, 0x1f, 0xe4, 0xd3, 0xe7, 0x2d, 0x1f, 0xf3, 0xdf, 0x89, 0xc0, 0x69, 0xff,
-		0xf1, 0xe0, 0x0b, 0x0d, 0x6c, 0xed, 0xb9, 0x43, 0xd4, 0x54, 0xab, 0x9a, 0xee, 0x7f, 0x8b, 0x75,
-		0xd4, 0x3f, 0xe1, 0x53, 0x5c, 0xa1, 0x27, 0xf9, 0x83, 0x12, 0x0c, 0xae, 0xaa, 0x55, 0xac, 0xe0,
-		0x17, 0x1a, 0xd8, 0x76, 0x5a, 0xbc, 0x75, 0x99, 0x80, 0x7e, 0x63, 0x6b, 0x4b, 0xdc, 0x69, 0x88,
-		0x29, 0xbc, 0x44, 0xfa, 0x5c, 0xd3, 0xea, 0x1a, 0xbb, 0x78, 0x1a, 0x53, 0x58, 0x01, 0xe5, 0x60,
-		0xb0, 0x6c, 0x34, 0x74, 0x3e, 0xe4, 0x32, 0x31, 0xf1, 0xc5, 0xa7, 0x86, 0xce, 0x86, 0x1c, 0x51,
-		0xa2, 0x85, 0xaf, 0x62, 0xcb, 0x66, 0xdf, 0xb8, 0x4d, 0x28, 0xa2, 0x28, 0x3f, 0x09, 0x29, 0x26,
-		0x09, 0x9f, 0x8c, 0x8f, 0x42, 0x82, 0xde, 0xe9, 0xf4, 0xe4, 0x19, 0x20, 0xe5, 0xcb, 0xec, 0xc5,
-		0x0c, 0xe3, 0xcf, 0x44, 0x62, 0x85, 0x42, 0xa1, 0xad, 0x96, 0x4f, 0x75, 0x8e, 0x1a, 0x4c, 0x87,
-		0xae, 0x86, 0x7f, 0x33, 0x0e, 0x47, 0xf8, 0xe1, 0xad, 0x6a, 0x6a, 0x67, 0xb6, 0x1d, 0x47, 0xbc,
-		0xe0, 0x02, 0xbe, 0x0a, 0x52, 0x4d, 0x4d, 0xde, 0x83, 0xd8, 0x25, 0xc7, 0x31, 0xd1, 0x69, 0x88,
-		0x5b, 0x8d, 0x1a, 0x16, 0x9b, 0x81, 0xee, 0x71, 0x8d, 0x6a, 0x6a, 0xd3, 0x04, 0x41, 0x69, 0xd4,
-		0xb0, 0xc2, 0x50, 0x50, 0x11, 0x72, 0x5b, 0x8d, 0x5a, 0x6d, 0xaf, 0x54, 0xc1, 0xf4, 0x9f, 0xf6,
-		0xb9, 0xff, 0xf6, 0x06, 0xef, 0x9a, 0xaa, 0xf8, 0x78, 0x2e, 0x51, 0xcc, 0x71, 0x8a, 0x36, 0x47,
-		0xb1, 0xc4, 0xbf, 0xbc, 0x29, 0x0a, 0x1c, 0xf9, 0xf7, 0x23, 0x90, 0x10, 0xac, 0xe9, 0x13, 0x16,
-		0x5c, 0xc3, 0x65, 0xc7, 0x10, 0x87, 0x69, 0x6e, 0x19, 0x21, 0x88, 0x56, 0xb9, 0xf1, 0x92, 0x97,
-		0xfa, 0x14, 0x52, 0x20, 0x30, 0xf7, 0x61, 0x11, 0x81, 0x99, 0x0d, 0x62, 0xcf, 0x98, 0x69, 0x88,
-		0x55, 0xfb, 0xa5, 0x3e, 0x85, 0x96, 0x50, 0x06, 0xfa, 0xc9, 0xa0, 0x71, 0x98, 0xb5, 0x08, 0x9c,
-		0x97, 0xd1, 0x04, 0xc4, 0x4d, 0xd5, 0x29, 0xb3, 0x7b, 0xbd, 0xa4, 0x82, 0x15, 0xd1, 0x63, 0xd0,
-		0xcf, 0xbe, 0x0d, 0x11, 0xfe, 0x8f, 0x58, 0x44, 0x19, 0xec, 0x23, 0x9c, 0x44, 0xee, 0x55, 0xd5,
-		0x71, 0xb0, 0xa5, 0x13, 0x86, 0x0c, 0x1d, 0x21, 0x88, 0x6d, 0x1a, 0x95, 0x3d, 0xfe, 0x5f, 0xba,
-		0xe8, 0x6f, 0xfe, 0x6f, 0x81, 0xa8, 0x3f, 0x94, 0x68, 0x25, 0xfb, 0xe7, 0x84, 0x29, 0x01, 0x2c,
-		0x10, 0xa4, 0x22, 0x8c, 0xa9, 0x95, 0x8a, 0xc6, 0xfe, 0x61, 0x56, 0x69, 0x53, 0xa3, 0xc1, 0xc3,
-		0xa6, 0xff, 0x7a, 0xb2, 0x9d, 0x2d, 0x90, 0x47, 0x50, 0xe0, 0xf8, 0x85, 0x24, 0x0c, 0x98, 0x4c,
-		0x28, 0xf9, 0x02, 0x8c, 0x36, 0x49, 0x4a, 0xe4, 0xdb, 0xd1, 0xf4, 0x8a, 0x78, 0x6d, 0x45, 0x7e,
-		0x13, 0x18, 0xfd, 0x6c, 0x2e, 0x3b, 0xa6, 0xa4, 0xbf, 0x0b, 0xef, 0x6d, 0xff, 0x28, 0x6f, 0xd8,
-		0xf7, 0x28, 0x4f, 0x35, 0xb5, 0x42, 0x92, 0xf2, 0xe7, 0x4f, 0xf1, 0x66, 0x9a, 0x9f, 0xe2, 0x55,
-		0xb1, 0x2e, 0x26, 0x66, 0x52, 0xa5, 0x9a, 0x9a, 0x4d, 0xdd, 0xd1, 0xfb, 0x8c, 0xaf, 0x7d, 0xc1,
-		0xf7, 0x9b, 0xbe, 0xcc, 0x8b, 0xcd, 0xcf, 0xac, 0x2e, 0xb8, 0x7e, 0xfc, 0xb5, 0x08, 0x1c, 0xf7,
-		0xf9, 0xb1, 0x0f, 0xb9, 0xd9, 0x9d, 0xb3, 0xad, 0x3d, 0xbe, 0x8b, 0x2f, 0x24, 0x5c, 0x86, 0x18,
-		0xc1, 0x47, 0x1d, 0xfe, 0x69, 0x4f, 0xe6, 0x0b, 0xdf, 0xfc, 0x67, 0x72, 0xf0, 0x40, 0x33, 0x60,
-		0x15, 0xca, 0xa4, 0xf0, 0x81, 0xee, 0xf5, 0x97, 0xf6, 0xbe, 0x60, 0x6c, 0xdf, 0x3a, 0x35, 0x86,
-		0x75, 0xf8, 0xda, 0xb9, 0xb6, 0x2f, 0xe8, 0x59, 0x30, 0x3d, 0x38, 0xbf, 0xea, 0x21, 0x52, 0xb7,
-		0x7b, 0x84, 0x74, 0x90, 0x05, 0xbb, 0xcc, 0xd4, 0x76, 0x61, 0xe2, 0x69, 0xd2, 0xb6, 0xb7, 0x83,
-		0x22, 0x42, 0xfe, 0x84, 0x7b, 0xd0, 0x2b, 0xf1, 0xff, 0xfc, 0x29, 0x0e, 0x71, 0xc1, 0x93, 0x8f,
-		0xaf, 0x1d, 0x4f, 0x4e, 0xb7, 0x9d, 0x4a, 0xa6, 0x7d, 0xd3, 0x88, 0xe2, 0xa3, 0x94, 0x7f, 0x45,
-		0x82, 0x3b, 0x9a, 0x9a, 0xe6, 0x31, 0x7e, 0xbe, 0xc5, 0x7b, 0xa9, 0x43, 0x25, 0x3d, 0xf3, 0x2d,
-		0x84, 0xbd, 0xb7, 0xa3, 0xb0, 0x4c, 0x8a, 0x80, 0xb4, 0x6f, 0x81, 0x23, 0x41, 0x61, 0x85, 0x9a,
-		0xee, 0x81, 0xe1, 0xe0, 0x61, 0x01, 0x57, 0xd7, 0x50, 0xe0, 0xb8, 0x40, 0x2e, 0x85, 0xf5, 0xec,
-		0xf6, 0xb5, 0x08, 0x49, 0x17, 0x95, 0x67, 0xc7, 0x5d, 0x77, 0xd5, 0xa3, 0x94, 0x3f, 0x22, 0xc1,
-		0x54, 0xb0, 0x05, 0x5f, 0x9e, 0xd4, 0x9b, 0xb0, 0xb7, 0xcc, 0xc4, 0xdf, 0x93, 0xe0, 0xce, 0x03,
-		0x64, 0xe2, 0x0a, 0xb8, 0x0e, 0xe3, 0xbe, 0x4d, 0x02, 0x11, 0xc2, 0x85, 0xd9, 0x4f, 0x77, 0xce,
-		0x50, 0xdd, 0x35, 0xf1, 0x31, 0xa2, 0x94, 0xcf, 0x7d, 0x27, 0x37, 0xd6, 0x5c, 0x67, 0x2b, 0x63,
-		0xcd, 0x0b, 0xfb, 0x5b, 0xe8, 0x1f, 0x2f, 0x4b, 0x70, 0x5f, 0xb0, 0xab, 0x2d, 0x52, 0xdd, 0x37,
-		0xca, 0x0e, 0xbf, 0x27, 0xc1, 0xe9, 0x6e, 0x84, 0xe3, 0x06, 0xd9, 0x84, 0x31, 0x2f, 0x09, 0x0f,
-		0xdb, 0xa3, 0xa7, 0xd4, 0x9e, 0x5f, 0x27, 0x77, 0xb9, 0xdd, 0x06, 0xc5, 0x9b, 0x7c, 0x60, 0xf9,
-		0x4d, 0xee, 0x2a, 0x39, 0xb8, 0xd1, 0x2f, 0x94, 0x1c, 0xd8, 0xea, 0x6f, 0x61, 0x8b, 0x48, 0x0b,
-		0x5b, 0x78, 0x59, 0xbb, 0x7c, 0x95, 0xc7, 0xad, 0x16, 0xdb, 0x73, 0x6f, 0x87, 0xb1, 0x16, 0xae,
-		0xcc, 0x47, 0x75, 0x0f, 0x9e, 0xac, 0xa0, 0x66, 0x67, 0x95, 0xf7, 0x20, 0x47, 0xdb, 0x6d, 0xa1,
-		0xe8, 0xdb, 0xdd, 0xe5, 0x3a, 0x8f, 0x2d, 0x2d, 0x9b, 0xe6, 0x7d, 0x5f, 0x80, 0x7e, 0x66, 0x67,
-		0xde, 0xdd, 0x43, 0x38, 0x0a, 0x67, 0x20, 0x7f, 0x42, 0xc4, 0xb2, 0x39, 0x21, 0x76, 0xeb, 0x31,
-		0xd4, 0x4d, 0x5f, 0x6f, 0xd1, 0x18, 0xf2, 0x29, 0xe3, 0xdb, 0x22, 0xaa, 0xb5, 0x96, 0x8e, 0xab,
-		0xa3, 0x7c, 0xcb, 0xa2, 0x1a, 0xd3, 0xcd, 0xed, 0x0d, 0x5f, 0xbf, 0x24, 0xc2, 0x97, 0xdb, 0xa7,
-		0x0e, 0xe1, 0xeb, 0x8d, 0x51, 0xbd, 0x1b, 0xc8, 0x3a, 0x88, 0xf9, 0x67, 0x31, 0x90, 0xfd, 0x50,
-		0x82, 0xa3, 0xb4, 0x6f, 0xfe, 0x3d, 0x8a, 0x5e, 0x55, 0xfe, 0x00, 0x20, 0xdb, 0x2a, 0x97, 0x5a,
-		0x8e, 0xee, 0xb4, 0x6d, 0x95, 0xaf, 0x04, 0xe6, 0x97, 0x07, 0x00, 0x55, 0x02, 0x3b, 0x51, 0x14,
-		0x9b, 0x5d, 0xa0, 0x4c, 0x57, 0x7c, 0x1b, 0x1d, 0x2d, 0xcc, 0x19, 0xbb, 0x05, 0xe6, 0xfc, 0x96,
-		0x04, 0xd9, 0x56, 0x5d, 0xe6, 0xe6, 0xd3, 0x60, 0x22, 0x70, 0x7e, 0x10, 0xb6, 0xe0, 0x03, 0xdd,
-		0xec, 0xf2, 0x84, 0x86, 0xd1, 0x11, 0x0b, 0xdf, 0xee, 0x3c, 0x20, 0x17, 0xf4, 0xd0, 0xe6, 0xcc,
-		0xfa, 0x0d, 0x1b, 0x3e, 0x5f, 0x6e, 0x8a, 0xab, 0x7f, 0x26, 0x72, 0xef, 0x5d, 0x98, 0x6c, 0x23,
-		0xf5, 0xed, 0x9e, 0xf7, 0xb6, 0xdb, 0x1a, 0xf3, 0x56, 0xa7, 0xef, 0x8f, 0xf2, 0x91, 0x10, 0xbc,
-		0x9c, 0xef, 0x5b, 0x8b, 0xb5, 0x7a, 0x4d, 0x28, 0xbf, 0x0d, 0x8e, 0xb5, 0xa4, 0xe2, 0xb2, 0xe5,
-		0x21, 0xb6, 0xad, 0xd9, 0x0e, 0x17, 0xeb, 0x64, 0x3b, 0xb1, 0x42, 0xd4, 0x94, 0x46, 0x46, 0x90,
-		0xa6, 0xac, 0x57, 0x0d, 0xa3, 0xc6, 0xc5, 0x90, 0x2f, 0xc3, 0xa8, 0x0f, 0xc6, 0x1b, 0x39, 0x0f,
-		0x31, 0xd3, 0xe0, 0xdf, 0x4f, 0x19, 0x3c, 0x7b, 0xbc, 0xed, 0xc6, 0xbe, 0x61, 0xd4, 0x78, 0xb7,
-		0x29, 0xbe, 0x3c, 0x0e, 0x88, 0x31, 0xa3, 0x7b, 0xfc, 0xa2, 0x89, 0x35, 0x18, 0x0b, 0x40, 0x79,
-		0x23, 0xaf, 0xeb, 0xfc, 0xe0, 0xec, 0xf7, 0x8f, 0x40, 0x9c, 0x72, 0x45, 0x1f, 0x97, 0x02, 0x1f,
-		0x38, 0x9b, 0x6e, 0xc7, 0xa6, 0xf5, 0x9a, 0x38, 0x7b, 0xa6, 0x6b, 0x7c, 0x9e, 0xb3, 0x9d, 0x7e,
-		0xef, 0xbf, 0x7b, 0xed, 0xa3, 0x91, 0xbb, 0x91, 0x7c, 0xa6, 0xcd, 0x6a, 0xdc, 0x37, 0x5e, 0x3e,
-		0x1b, 0xf8, 0x00, 0xc7, 0x83, 0xdd, 0x35, 0x25, 0x24, 0x9b, 0xee, 0x16, 0x9d, 0x0b, 0x76, 0x81,
-		0x0a, 0x76, 0x0e, 0x3d, 0xd2, 0x59, 0xb0, 0x33, 0xef, 0x0c, 0x0e, 0x9a, 0x77, 0xa3, 0xdf, 0x91,
-		0x60, 0xbc, 0xd5, 0x92, 0x0e, 0x3d, 0xde, 0x9d, 0x14, 0xcd, 0x29, 0x45, 0xf6, 0x89, 0x43, 0x50,
-		0xf2, 0xae, 0xcc, 0xd3, 0xae, 0xcc, 0xa0, 0x27, 0x0f, 0xd1, 0x95, 0x33, 0xfe, 0xad, 0xff, 0xff,
-		0x23, 0xc1, 0x89, 0x03, 0x57, 0x48, 0x68, 0xa6, 0x3b, 0x29, 0x0f, 0xc8, 0x9d, 0xb2, 0x85, 0xd7,
-		0xc3, 0x82, 0xf7, 0xf8, 0x69, 0xda, 0xe3, 0xcb, 0x68, 0xe1, 0x30, 0x3d, 0x6e, 0x79, 0xbe, 0x82,
-		0x7e, 0x2b, 0x78, 0xe9, 0xf4, 0x60, 0x77, 0x6a, 0x5a, 0x78, 0x74, 0x18, 0x18, 0xcd, 0x49, 0xad,
-		0xfc, 0x2c, 0xed, 0x82, 0x82, 0x56, 0x5f, 0xa7, 0xd1, 0xce, 0xbc, 0x33, 0x18, 0xf8, 0xdf, 0x8d,
-		0xfe, 0xb7, 0xd4, 0xfa, 0x0e, 0xe9, 0x63, 0x07, 0x8a, 0xd8, 0x7e, 0x51, 0x95, 0x7d, 0xbc, 0x77,
-		0x42, 0xde, 0xc9, 0x3a, 0xed, 0x64, 0x15, 0xe1, 0x5b, 0xdd, 0xc9, 0x96, 0x46, 0x44, 0xdf, 0x90,
-		0x60, 0xbc, 0xd5, 0x9a, 0xa4, 0xc3, 0xb0, 0x3c, 0x60, 0x91, 0xd5, 0x61, 0x58, 0x1e, 0xb4, 0x00,
-		0x92, 0xdf, 0x44, 0x3b, 0x7f, 0x1e, 0x3d, 0xda, 0xae, 0xf3, 0x07, 0x5a, 0x91, 0x8c, 0xc5, 0x03,
-		0x93, 0xfc, 0x0e, 0x63, 0xb1, 0x9b, 0x75, 0x4c, 0x87, 0xb1, 0xd8, 0xd5, 0x1a, 0xa3, 0xf3, 0x58,
-		0x74, 0x7b, 0xd6, 0xa5, 0x19, 0x6d, 0xf4, 0x35, 0x09, 0x86, 0x02, 0x19, 0x31, 0x7a, 0xf8, 0x40,
-		0x41, 0x5b, 0x2d, 0x18, 0xb2, 0x67, 0x7b, 0x21, 0xe1, 0x7d, 0x59, 0xa0, 0x7d, 0x99, 0x45, 0x33,
-		0x87, 0xe9, 0x4b, 0xf0, 0x18, 0xf5, 0x5b, 0x12, 0x8c, 0xb5, 0xc8, 0x32, 0x3b, 0x8c, 0xc2, 0xf6,
-		0x49, 0x73, 0xf6, 0xf1, 0xde, 0x09, 0x79, 0xaf, 0x2e, 0xd2, 0x5e, 0xbd, 0x15, 0xbd, 0xe5, 0x30,
-		0xbd, 0xf2, 0xcd, 0xcf, 0xfb, 0xde, 0x95, 0x2c, 0x5f, 0x3b, 0xe8, 0x7c, 0x8f, 0x82, 0x89, 0x0e,
-		0x3d, 0xd6, 0x33, 0x1d, 0xef, 0xcf, 0x33, 0xb4, 0x3f, 0x4f, 0xa3, 0x95, 0xd7, 0xd7, 0x9f, 0xe6,
-		0x69, 0xfd, 0x4b, 0xcd, 0x8f, 0x43, 0x0f, 0xf6, 0xa2, 0x96, 0xc9, 0x6a, 0xf6, 0x91, 0x9e, 0x68,
-		0x78, 0xa7, 0x1e, 0xa7, 0x9d, 0x3a, 0x8b, 0x1e, 0x6a, 0xd7, 0x29, 0xdf, 0xbd, 0x4b, 0x4d, 0xdf,
-		0x32, 0xce, 0xbc, 0x93, 0xa5, 0xc0, 0xef, 0x46, 0xef, 0x11, 0x77, 0x9e, 0x4e, 0x1d, 0xd8, 0xae,
-		0x2f, 0x8f, 0xcd, 0xde, 0xd7, 0x05, 0x26, 0x97, 0xeb, 0x6e, 0x2a, 0xd7, 0x24, 0x3a, 0xde, 0x4e,
-		0x2e, 0x92, 0xcb, 0xa2, 0x0f, 0x49, 0xee, 0x35, 0xd9, 0xd3, 0x07, 0xf3, 0xf6, 0x27, 0xbb, 0xd9,
-		0xfb, 0xbb, 0xc2, 0xe5, 0x92, 0x9c, 0xa4, 0x92, 0x4c, 0xa1, 0xc9, 0xb6, 0x92, 0xb0, 0xd4, 0xf7,
-		0x56, 0x5f, 0x2a, 0xf8, 0xbf, 0x13, 0x90, 0x6b, 0xd3, 0xa2, 0xb3, 0xdb, 0xe1, 0x8c, 0xeb, 0x80,
-		0x37, 0xd2, 0x1d, 0xdf, 0x40, 0xdf, 0xea, 0x2f, 0x4c, 0x77, 0x79, 0x20, 0xf6, 0xdb, 0x31, 0x40,
-		0x4b, 0x76, 0x75, 0xd6, 0xc2, 0xec, 0xbf, 0xdd, 0xf2, 0x51, 0x1e, 0x7a, 0xfc, 0x27, 0xbd, 0xae,
-		0xc7, 0x7f, 0x4b, 0x81, 0xe7, 0x74, 0x91, 0xde, 0x9e, 0xec, 0x76, 0xfd, 0xa6, 0x2e, 0xfa, 0x13,
-		0x79, 0x53, 0xd7, 0xfa, 0xca, 0x7d, 0xec, 0xd6, 0xbd, 0xcd, 0x89, 0x1f, 0xf6, 0x7d, 0x12, 0x7f,
-		0x2a, 0xdb, 0x7f, 0xc0, 0x53, 0xd9, 0x4c, 0xdb, 0xf7, 0xb0, 0x9c, 0x1a, 0x9d, 0x13, 0xdf, 0x5b,
-		0x1e, 0xe8, 0xee, 0x92, 0x2c, 0xff, 0x20, 0xb3, 0xb7, 0x85, 0x70, 0x1c, 0xb2, 0xcd, 0xee, 0xe4,
-		0x0e, 0xea, 0x8f, 0x46, 0x21, 0xbd, 0x64, 0x57, 0x8b, 0x15, 0xcd, 0xb9, 0x4d, 0xbe, 0xf6, 0x64,
-		0xfb, 0xf7, 0x4e, 0xe8, 0xe6, 0x7e, 0x6e, 0x98, 0xe9, 0xf4, 0x00, 0x4d, 0xd6, 0x61, 0x24, 0x7c,
-		0x1f, 0x9d, 0x79, 0xd6, 0xdc, 0x61, 0x1e, 0xbb, 0x37, 0xdd, 0x43, 0x1f, 0x0e, 0xbe, 0x3b, 0x47,
-		0xbb, 0xad, 0x9d, 0x99, 0x39, 0xd4, 0xa5, 0xdb, 0xf9, 0x38, 0xd4, 0xb3, 0x59, 0x16, 0x32, 0x61,
-		0xa3, 0xb8, 0x16, 0x7b, 0x55, 0x82, 0xc1, 0x25, 0x5b, 0xa4, 0x82, 0xf8, 0xa7, 0xf4, 0x69, 0xda,
-		0x63, 0xee, 0x3f, 0x2b, 0x88, 0x76, 0xe7, 0xb7, 0xfe, 0x7f, 0x60, 0xd0, 0x27, 0x1f, 0x81, 0x31,
-		0x5f, 0x1f, 0xdd, 0xbe, 0x7f, 0x33, 0x42, 0x63, 0x63, 0x01, 0x57, 0x35, 0xdd, 0xcd, 0x20, 0xf1,
-		0x5f, 0xd4, 0x47, 0x37, 0x9e, 0x8e, 0x63, 0x87, 0xd1, 0xf1, 0x0e, 0x0d, 0x0c, 0x21, 0x5d, 0xba,
-		0x1b, 0x5e, 0x4b, 0xcd, 0xcf, 0xc1, 0xa4, 0x1e, 0xbe, 0xb4, 0x14, 0x7a, 0xf4, 0x25, 0xbf, 0x26,
-		0xc1, 0xd0, 0x92, 0x5d, 0xdd, 0xd0, 0x2b, 0x7f, 0xae, 0xfd, 0x76, 0x0b, 0x8e, 0x04, 0x7a, 0x79,
-		0x9b, 0xd4, 0x79, 0xf6, 0xe5, 0x18, 0x44, 0x97, 0xec, 0x2a, 0x7a, 0x01, 0x46, 0xc2, 0x89, 0x42,
-		0xdb, 0xfc, 0xaf, 0x79, 0x16, 0x68, 0xbf, 0x46, 0x6b, 0x3f, 0x63, 0xa0, 0x1d, 0x18, 0x0a, 0xce,
-		0x16, 0xa7, 0x0e, 0x60, 0x12, 0xc0, 0xcc, 0x3e, 0xd4, 0x2d, 0xa6, 0xdb, 0xd8, 0x3b, 0x20, 0xe1,
-		0x06, 0xba, 0xbb, 0x0e, 0xa0, 0x16, 0x48, 0xed, 0x33, 0xda, 0x16, 0xe1, 0x84, 0x68, 0x2f, 0x1c,
-		0x4a, 0x0e, 0xd2, 0x5e, 0x08, 0xf7, 0x40, 0xed, 0xb5, 0x1b, 0x56, 0x9b, 0x00, 0xbe, 0x31, 0x70,
-		0xcf, 0x01, 0x1c, 0x3c, 0xb4, 0xec, 0x83, 0x5d, 0xa1, 0xb9, 0x07, 0x4d, 0xb7, 0x38, 0x01, 0xff,
-		0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x14, 0x07, 0xc8, 0xa5, 0x57, 0x9c, 0x00, 0x00,
+		// 11450 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x74, 0x5c, 0xc7,
+		0x75, 0x18, 0xde, 0xee, 0x02, 0xd8, 0xbd, 0xf8, 0x5a, 0x0c, 0x40, 0x72, 0xb9, 0x24, 0x01, 0xe8,
+		0x51, 0x22, 0x29, 0x4a, 0x04, 0x25, 0x4a, 0xa4, 0xa8, 0xa5, 0x25, 0x79, 0x17, 0x58, 0x82, 0x20,
+		0xf1, 0xa5, 0xb7, 0x00, 0xf5, 0x61, 0xa7, 0x7b, 0x1e, 0x76, 0x07, 0x8b, 0x27, 0xee, 0xbe, 0xb7,
+		0x7a, 0xef, 0x2d, 0x49, 0xc8, 0x51, 0x8f, 0x62, 0x27, 0xa9, 0xac, 0x34, 0x8d, 0x5d, 0xb7, 0x89,
+		0xed, 0x98, 0xaa, 0x15, 0xbb, 0x75, 0xea, 0x7c, 0x39, 0xb1, 0x6b, 0xe7, 0xa3, 0xa7, 0xb5, 0x7b,
+		0x4e, 0xea, 0x8f, 0x36, 0xad, 0x9c, 0xf6, 0xa4, 0x49, 0x4e, 0x4a, 0xc7, 0xb2, 0xdb, 0xba, 0x8e,
+		0xdb, 0xba, 0xac, 0x7b, 0xda, 0x1c, 0x9f, 0xd3, 0xf6, 0xcc, 0xd7, 0xfb, 0xda, 0x7d, 0xfb, 0x01,
+		0x91, 0xfe, 0x4a, 0x7f, 0x01, 0x73, 0xe7, 0xde, 0x3b, 0x77, 0xee, 0xdc, 0xb9, 0x73, 0xe7, 0xce,
+		0xbc, 0x59, 0xf8, 0xea, 0x79, 0x98, 0xa9, 0x18, 0x46, 0xa5, 0x8a, 0x4f, 0xd6, 0x4d, 0xc3, 0x36,
+		0x36, 0x1b, 0x5b, 0x27, 0xcb, 0xd8, 0x2a, 0x99, 0x5a, 0xdd, 0x36, 0xcc, 0x59, 0x0a, 0x43, 0x63,
+		0x0c, 0x63, 0x56, 0x60, 0xc8, 0xcb, 0x30, 0x7e, 0x5e, 0xab, 0xe2, 0x79, 0x07, 0xb1, 0x80, 0x6d,
+		0x74, 0x16, 0x62, 0x5b, 0x5a, 0x15, 0xa7, 0xa4, 0x99, 0xe8, 0xb1, 0xa1, 0x53, 0x77, 0xcf, 0x06,
+		0x88, 0x66, 0xfd, 0x14, 0x6b, 0x04, 0xac, 0x50, 0x0a, 0xf9, 0xff, 0xc4, 0x60, 0xa2, 0x45, 0x2d,
+		0x42, 0x10, 0xd3, 0xd5, 0x1a, 0xe1, 0x28, 0x1d, 0x4b, 0x28, 0xf4, 0x7f, 0x94, 0x82, 0xc1, 0xba,
+		0x5a, 0xba, 0xa2, 0x56, 0x70, 0x2a, 0x42, 0xc1, 0xa2, 0x88, 0xa6, 0x00, 0xca, 0xb8, 0x8e, 0xf5,
+		0x32, 0xd6, 0x4b, 0x3b, 0xa9, 0xe8, 0x4c, 0xf4, 0x58, 0x42, 0xf1, 0x40, 0xd0, 0x7d, 0x30, 0x5e,
+		0x6f, 0x6c, 0x56, 0xb5, 0x52, 0xd1, 0x83, 0x06, 0x33, 0xd1, 0x63, 0xfd, 0x4a, 0x92, 0x55, 0xcc,
+		0xbb, 0xc8, 0x47, 0x61, 0xec, 0x1a, 0x56, 0xaf, 0x78, 0x51, 0x87, 0x28, 0xea, 0x28, 0x01, 0x7b,
+		0x10, 0xe7, 0x60, 0xb8, 0x86, 0x2d, 0x4b, 0xad, 0xe0, 0xa2, 0xbd, 0x53, 0xc7, 0xa9, 0x18, 0xed,
+		0xfd, 0x4c, 0x53, 0xef, 0x83, 0x3d, 0x1f, 0xe2, 0x54, 0xeb, 0x3b, 0x75, 0x8c, 0xb2, 0x90, 0xc0,
+		0x7a, 0xa3, 0xc6, 0x38, 0xf4, 0x87, 0xe8, 0x2f, 0xaf, 0x37, 0x6a, 0x41, 0x2e, 0x71, 0x42, 0xc6,
+		0x59, 0x0c, 0x5a, 0xd8, 0xbc, 0xaa, 0x95, 0x70, 0x6a, 0x80, 0x32, 0x38, 0xda, 0xc4, 0xa0, 0xc0,
+		0xea, 0x83, 0x3c, 0x04, 0x1d, 0x9a, 0x83, 0x04, 0xbe, 0x6e, 0x63, 0xdd, 0xd2, 0x0c, 0x3d, 0x35,
+		0x48, 0x99, 0xdc, 0xd3, 0x62, 0x14, 0x71, 0xb5, 0x1c, 0x64, 0xe1, 0xd2, 0xa1, 0x33, 0x30, 0x68,
+		0xd4, 0x6d, 0xcd, 0xd0, 0xad, 0x54, 0x7c, 0x46, 0x3a, 0x36, 0x74, 0xea, 0x60, 0x4b, 0x43, 0x58,
+		0x65, 0x38, 0x8a, 0x40, 0x46, 0x8b, 0x90, 0xb4, 0x8c, 0x86, 0x59, 0xc2, 0xc5, 0x92, 0x51, 0xc6,
+		0x45, 0x4d, 0xdf, 0x32, 0x52, 0x09, 0xca, 0x60, 0xba, 0xb9, 0x23, 0x14, 0x71, 0xce, 0x28, 0xe3,
+		0x45, 0x7d, 0xcb, 0x50, 0x46, 0x2d, 0x5f, 0x19, 0xed, 0x85, 0x01, 0x6b, 0x47, 0xb7, 0xd5, 0xeb,
+		0xa9, 0x61, 0x6a, 0x21, 0xbc, 0x44, 0x4c, 0x07, 0x97, 0x35, 0xd2, 0x5c, 0x6a, 0x84, 0x99, 0x0e,
+		0x2f, 0xca, 0xbf, 0x33, 0x00, 0x63, 0xdd, 0x18, 0xdf, 0x39, 0xe8, 0xdf, 0x22, 0xfd, 0x4f, 0x45,
+		0x7a, 0xd1, 0x0e, 0xa3, 0xf1, 0xab, 0x77, 0x60, 0x97, 0xea, 0xcd, 0xc2, 0x90, 0x8e, 0x2d, 0x1b,
+		0x97, 0x99, 0xad, 0x44, 0xbb, 0xb4, 0x36, 0x60, 0x44, 0xcd, 0xc6, 0x16, 0xdb, 0x95, 0xb1, 0x3d,
+		0x0d, 0x63, 0x8e, 0x48, 0x45, 0x53, 0xd5, 0x2b, 0xc2, 0x6a, 0x4f, 0x76, 0x92, 0x64, 0x36, 0x2f,
+		0xe8, 0x14, 0x42, 0xa6, 0x8c, 0x62, 0x5f, 0x19, 0xcd, 0x03, 0x18, 0x3a, 0x36, 0xb6, 0x8a, 0x65,
+		0x5c, 0xaa, 0xa6, 0xe2, 0x21, 0x5a, 0x5a, 0x25, 0x28, 0x4d, 0x5a, 0x32, 0x18, 0xb4, 0x54, 0x45,
+		0x8f, 0xba, 0x46, 0x38, 0x18, 0x62, 0x43, 0xcb, 0x6c, 0xfa, 0x35, 0xd9, 0xe1, 0x06, 0x8c, 0x9a,
+		0x98, 0xcc, 0x08, 0x5c, 0xe6, 0x3d, 0x4b, 0x50, 0x21, 0x66, 0x3b, 0xf6, 0x4c, 0xe1, 0x64, 0xac,
+		0x63, 0x23, 0xa6, 0xb7, 0x88, 0x0e, 0x83, 0x03, 0x28, 0x52, 0xb3, 0x02, 0xea, 0x9f, 0x86, 0x05,
+		0x70, 0x45, 0xad, 0xe1, 0xf4, 0x0b, 0x30, 0xea, 0x57, 0x0f, 0x9a, 0x84, 0x7e, 0xcb, 0x56, 0x4d,
+		0x9b, 0x5a, 0x61, 0xbf, 0xc2, 0x0a, 0x28, 0x09, 0x51, 0xac, 0x97, 0xa9, 0xff, 0xeb, 0x57, 0xc8,
+		0xbf, 0xe8, 0xad, 0x6e, 0x87, 0xa3, 0xb4, 0xc3, 0x47, 0x9a, 0x47, 0xd4, 0xc7, 0x39, 0xd8, 0xef,
+		0xf4, 0x23, 0x30, 0xe2, 0xeb, 0x40, 0xb7, 0x4d, 0xcb, 0xbf, 0x16, 0x83, 0x3d, 0x2d, 0x79, 0xa3,
+		0xa7, 0x61, 0xb2, 0xa1, 0x6b, 0xba, 0x8d, 0xcd, 0xba, 0x89, 0x89, 0xc9, 0xb2, 0xb6, 0x52, 0xff,
+		0x69, 0x30, 0xc4, 0xe8, 0x36, 0xbc, 0xd8, 0x8c, 0x8b, 0x32, 0xd1, 0x68, 0x06, 0xa2, 0x67, 0x60,
+		0x88, 0xd8, 0x87, 0x6a, 0xaa, 0x94, 0x21, 0x9b, 0x8d, 0xa7, 0xba, 0xeb, 0xf2, 0xec, 0xbc, 0x4b,
+		0x99, 0x8b, 0xbe, 0x2c, 0x45, 0x14, 0x2f, 0x2f, 0xb4, 0x0d, 0xc3, 0x57, 0xb1, 0xa9, 0x6d, 0x69,
+		0x25, 0xc6, 0x9b, 0xa8, 0x73, 0xf4, 0xd4, 0xd9, 0x2e, 0x79, 0x5f, 0xf6, 0x90, 0x16, 0x6c, 0xd5,
+		0xc6, 0x19, 0xd8, 0x58, 0xb9, 0x9c, 0x57, 0x16, 0xcf, 0x2f, 0xe6, 0xe7, 0x15, 0x1f, 0xe7, 0xf4,
+		0x27, 0x25, 0x18, 0xf2, 0xc8, 0x42, 0xdc, 0x96, 0xde, 0xa8, 0x6d, 0x62, 0x93, 0x6b, 0x9c, 0x97,
+		0xd0, 0x01, 0x48, 0x6c, 0x35, 0xaa, 0x55, 0x66, 0x36, 0x6c, 0xcd, 0x8b, 0x13, 0x00, 0x31, 0x19,
+		0xe2, 0xa5, 0xb8, 0x23, 0xa0, 0x5e, 0x8a, 0xfc, 0x8f, 0x0e, 0xc3, 0x90, 0x66, 0x15, 0x4d, 0x5c,
+		0xc7, 0xaa, 0x8d, 0xcb, 0xa9, 0xd8, 0x8c, 0x74, 0x2c, 0x9e, 0x8b, 0xa4, 0x24, 0x05, 0x34, 0x4b,
+		0xe1, 0x50, 0x94, 0x86, 0xb8, 0xb0, 0xbd, 0x54, 0x3f, 0xc1, 0x50, 0x9c, 0x32, 0xab, 0xe3, 0xd4,
+		0x03, 0xa2, 0x8e, 0x95, 0xe5, 0x87, 0x61, 0xbc, 0xa9, 0x93, 0x68, 0x0c, 0x86, 0xe6, 0xf3, 0x73,
+		0x4b, 0x59, 0x25, 0xbb, 0xbe, 0xb8, 0xba, 0x92, 0xec, 0x43, 0xa3, 0xe0, 0xe9, 0x77, 0x52, 0x3a,
+		0x9e, 0x88, 0x7f, 0x63, 0x30, 0xf9, 0xd2, 0x4b, 0x2f, 0xbd, 0x14, 0x91, 0x3f, 0x37, 0x00, 0x93,
+		0xad, 0xbc, 0x5c, 0x4b, 0x87, 0xeb, 0xea, 0x24, 0xea, 0xd3, 0x49, 0x16, 0xfa, 0xab, 0xea, 0x26,
+		0xae, 0xd2, 0xce, 0x8d, 0x9e, 0xba, 0xaf, 0x2b, 0x3f, 0x3a, 0xbb, 0x44, 0x48, 0x14, 0x46, 0x89,
+		0x1e, 0xe7, 0x9a, 0xeb, 0xa7, 0x1c, 0x8e, 0x77, 0xc7, 0x81, 0x78, 0x3f, 0xae, 0xe5, 0x03, 0x90,
+		0x20, 0x7f, 0xd9, 0xb0, 0x0c, 0xb0, 0x61, 0x21, 0x00, 0x3a, 0x2c, 0x69, 0x88, 0x53, 0xc7, 0x56,
+		0xc6, 0xce, 0x90, 0x89, 0x32, 0x71, 0x05, 0x65, 0xbc, 0xa5, 0x36, 0xaa, 0x76, 0xf1, 0xaa, 0x5a,
+		0x6d, 0x60, 0xea, 0xa2, 0x12, 0xca, 0x30, 0x07, 0x5e, 0x26, 0x30, 0x34, 0x0d, 0x43, 0xcc, 0x0f,
+		0x6a, 0x7a, 0x19, 0x5f, 0xa7, 0x2b, 0x61, 0xbf, 0xc2, 0x5c, 0xe3, 0x22, 0x81, 0x90, 0xe6, 0x9f,
+		0xb3, 0x0c, 0x5d, 0x38, 0x13, 0xda, 0x04, 0x01, 0xd0, 0xe6, 0x1f, 0x09, 0x2e, 0xc2, 0x87, 0x5a,
+		0x77, 0xaf, 0xc9, 0xfb, 0x1d, 0x85, 0x31, 0x8a, 0xf1, 0x10, 0x9f, 0xab, 0x6a, 0x35, 0x35, 0x4e,
+		0x0d, 0x60, 0x94, 0x81, 0x57, 0x39, 0x54, 0xfe, 0x74, 0x04, 0x62, 0x74, 0x29, 0x18, 0x83, 0xa1,
+		0xf5, 0x67, 0xd6, 0xf2, 0xc5, 0xf9, 0xd5, 0x8d, 0xdc, 0x52, 0x3e, 0x29, 0x91, 0xa1, 0xa7, 0x80,
+		0xf3, 0x4b, 0xab, 0xd9, 0xf5, 0x64, 0xc4, 0x29, 0x2f, 0xae, 0xac, 0x9f, 0x79, 0x38, 0x19, 0x75,
+		0x08, 0x36, 0x18, 0x20, 0xe6, 0x45, 0x78, 0xe8, 0x54, 0xb2, 0x1f, 0x25, 0x61, 0x98, 0x31, 0x58,
+		0x7c, 0x3a, 0x3f, 0x7f, 0xe6, 0xe1, 0xe4, 0x80, 0x1f, 0xf2, 0xd0, 0xa9, 0xe4, 0x20, 0x1a, 0x81,
+		0x04, 0x85, 0xe4, 0x56, 0x57, 0x97, 0x92, 0x71, 0x87, 0x67, 0x61, 0x5d, 0x59, 0x5c, 0x59, 0x48,
+		0x26, 0x1c, 0x9e, 0x0b, 0xca, 0xea, 0xc6, 0x5a, 0x12, 0x1c, 0x0e, 0xcb, 0xf9, 0x42, 0x21, 0xbb,
+		0x90, 0x4f, 0x0e, 0x39, 0x18, 0xb9, 0x67, 0xd6, 0xf3, 0x85, 0xe4, 0xb0, 0x4f, 0xac, 0x87, 0x4e,
+		0x25, 0x47, 0x9c, 0x26, 0xf2, 0x2b, 0x1b, 0xcb, 0xc9, 0x51, 0x34, 0x0e, 0x23, 0xac, 0x09, 0x21,
+		0xc4, 0x58, 0x00, 0x74, 0xe6, 0xe1, 0x64, 0xd2, 0x15, 0x84, 0x71, 0x19, 0xf7, 0x01, 0xce, 0x3c,
+		0x9c, 0x44, 0xf2, 0x1c, 0xf4, 0x53, 0x33, 0x44, 0x08, 0x46, 0x97, 0xb2, 0xb9, 0xfc, 0x52, 0x71,
+		0x75, 0x8d, 0x4c, 0x9a, 0xec, 0x52, 0x52, 0x72, 0x61, 0x4a, 0xfe, 0xc9, 0x8d, 0x45, 0x25, 0x3f,
+		0x9f, 0x8c, 0x78, 0x61, 0x6b, 0xf9, 0xec, 0x7a, 0x7e, 0x3e, 0x19, 0x95, 0x4b, 0x30, 0xd9, 0x6a,
+		0x09, 0x6c, 0x39, 0x85, 0x3c, 0xb6, 0x10, 0x09, 0xb1, 0x05, 0xca, 0x2b, 0x68, 0x0b, 0xf2, 0xd7,
+		0x22, 0x30, 0xd1, 0x22, 0x0c, 0x68, 0xd9, 0xc8, 0x13, 0xd0, 0xcf, 0x6c, 0x99, 0xb9, 0xe2, 0x7b,
+		0x5b, 0xc6, 0x13, 0xd4, 0xb2, 0x9b, 0x82, 0x23, 0x4a, 0xe7, 0x0d, 0x1b, 0xa3, 0x21, 0x61, 0x23,
+		0x61, 0xd1, 0x64, 0xb0, 0x3f, 0xd6, 0xb4, 0x5c, 0xb3, 0x88, 0xe6, 0x4c, 0x37, 0x11, 0x0d, 0x85,
+		0xf5, 0xb6, 0x6c, 0xf7, 0xb7, 0x58, 0xb6, 0xcf, 0xc1, 0x78, 0x13, 0xa3, 0xae, 0x97, 0xcf, 0x77,
+		0x49, 0x90, 0x0a, 0x53, 0x4e, 0x07, 0x97, 0x18, 0xf1, 0xb9, 0xc4, 0x73, 0x41, 0x0d, 0xde, 0x15,
+		0x3e, 0x08, 0x4d, 0x63, 0xfd, 0x31, 0x09, 0xf6, 0xb6, 0xde, 0x1e, 0xb4, 0x94, 0xe1, 0x71, 0x18,
+		0xa8, 0x61, 0x7b, 0xdb, 0x10, 0x81, 0xf0, 0x91, 0x16, 0xe1, 0x15, 0xa9, 0x0e, 0x0e, 0x36, 0xa7,
+		0x42, 0x8f, 0x06, 0x65, 0x9d, 0x0e, 0xdb, 0xac, 0x34, 0x49, 0xfa, 0xee, 0x08, 0xec, 0x69, 0xc9,
+		0xbc, 0xa5, 0xa0, 0x87, 0x00, 0x34, 0xbd, 0xde, 0xb0, 0x59, 0xb0, 0xcb, 0x3c, 0x71, 0x82, 0x42,
+		0xa8, 0xf3, 0x22, 0x5e, 0xb6, 0x61, 0x3b, 0xf5, 0x6c, 0x11, 0x05, 0x06, 0xa2, 0x08, 0x67, 0x5d,
+		0x41, 0x63, 0x54, 0xd0, 0xa9, 0x90, 0x9e, 0x36, 0x19, 0xe6, 0x03, 0x90, 0x2c, 0x55, 0x35, 0xac,
+		0xdb, 0x45, 0xcb, 0x36, 0xb1, 0x5a, 0xd3, 0xf4, 0x0a, 0x5b, 0x67, 0x33, 0xfd, 0x5b, 0x6a, 0xd5,
+		0xc2, 0xca, 0x18, 0xab, 0x2e, 0x88, 0x5a, 0x42, 0x41, 0x0d, 0xc8, 0xf4, 0x50, 0x0c, 0xf8, 0x28,
+		0x58, 0xb5, 0x43, 0x21, 0xbf, 0x37, 0x01, 0x43, 0x9e, 0xcd, 0x14, 0xba, 0x0b, 0x86, 0x9f, 0x53,
+		0xaf, 0xaa, 0x45, 0xb1, 0x41, 0x66, 0x9a, 0x18, 0x22, 0xb0, 0x35, 0x06, 0x42, 0x0f, 0xc0, 0x24,
+		0x45, 0x31, 0x1a, 0x36, 0x36, 0x8b, 0xa5, 0xaa, 0x6a, 0x59, 0x54, 0x69, 0x71, 0x8a, 0x8a, 0x48,
+		0xdd, 0x2a, 0xa9, 0x9a, 0x13, 0x35, 0xe8, 0x34, 0x4c, 0x50, 0x8a, 0x5a, 0xa3, 0x6a, 0x6b, 0xf5,
+		0x2a, 0x2e, 0x92, 0x2d, 0xbb, 0x95, 0x02, 0xaf, 0x64, 0xe3, 0x04, 0x63, 0x99, 0x23, 0x10, 0x89,
+		0x2c, 0x34, 0x0f, 0x87, 0x28, 0x59, 0x05, 0xeb, 0xd8, 0x54, 0x6d, 0x5c, 0xc4, 0xcf, 0x37, 0xd4,
+		0xaa, 0x55, 0x54, 0xf5, 0x72, 0x71, 0x5b, 0xb5, 0xb6, 0x53, 0x93, 0x4e, 0x58, 0xb2, 0x9f, 0x20,
+		0x2e, 0x70, 0xbc, 0x3c, 0x45, 0xcb, 0xea, 0xe5, 0x0b, 0xaa, 0xb5, 0x8d, 0x32, 0xb0, 0x97, 0x72,
+		0xb1, 0x6c, 0x53, 0xd3, 0x2b, 0xc5, 0xd2, 0x36, 0x2e, 0x5d, 0x29, 0x36, 0xec, 0xad, 0xb3, 0xa9,
+		0x03, 0xde, 0xf6, 0xa9, 0x84, 0x05, 0x8a, 0x33, 0x47, 0x50, 0x36, 0xec, 0xad, 0xb3, 0xa8, 0x00,
+		0xc3, 0x64, 0x30, 0x6a, 0xda, 0x0b, 0xb8, 0xb8, 0x65, 0x98, 0x74, 0x0d, 0x1d, 0x6d, 0xe1, 0x9a,
+		0x3c, 0x1a, 0x9c, 0x5d, 0xe5, 0x04, 0xcb, 0x46, 0x19, 0x67, 0xfa, 0x0b, 0x6b, 0xf9, 0xfc, 0xbc,
+		0x32, 0x24, 0xb8, 0x9c, 0x37, 0x4c, 0x62, 0x50, 0x15, 0xc3, 0x51, 0xf0, 0x10, 0x33, 0xa8, 0x8a,
+		0x21, 0xd4, 0x7b, 0x1a, 0x26, 0x4a, 0x25, 0xd6, 0x67, 0xad, 0x54, 0xe4, 0x1b, 0x6b, 0x2b, 0x95,
+		0xf4, 0x29, 0xab, 0x54, 0x5a, 0x60, 0x08, 0xdc, 0xc6, 0x2d, 0xf4, 0x28, 0xec, 0x71, 0x95, 0xe5,
+		0x25, 0x1c, 0x6f, 0xea, 0x65, 0x90, 0xf4, 0x34, 0x4c, 0xd4, 0x77, 0x9a, 0x09, 0x91, 0xaf, 0xc5,
+		0xfa, 0x4e, 0x90, 0xec, 0x11, 0x98, 0xac, 0x6f, 0xd7, 0x9b, 0xe9, 0x8e, 0x7b, 0xe9, 0x50, 0x7d,
+		0xbb, 0x1e, 0x24, 0xbc, 0x87, 0x66, 0x59, 0x4c, 0x5c, 0xa2, 0xd1, 0xe1, 0x3e, 0x2f, 0xba, 0xa7,
+		0x02, 0xcd, 0x42, 0xb2, 0x54, 0x2a, 0x62, 0x5d, 0xdd, 0xac, 0xe2, 0xa2, 0x6a, 0x62, 0x5d, 0xb5,
+		0x52, 0xd3, 0x14, 0x39, 0x66, 0x9b, 0x0d, 0xac, 0x8c, 0x96, 0x4a, 0x79, 0x5a, 0x99, 0xa5, 0x75,
+		0xe8, 0x38, 0x8c, 0x1b, 0x9b, 0xcf, 0x95, 0x98, 0x45, 0x16, 0xeb, 0x26, 0xde, 0xd2, 0xae, 0xa7,
+		0xee, 0xa6, 0xea, 0x1d, 0x23, 0x15, 0xd4, 0x1e, 0xd7, 0x28, 0x18, 0xdd, 0x0b, 0xc9, 0x92, 0xb5,
+		0xad, 0x9a, 0x75, 0xea, 0x92, 0xad, 0xba, 0x5a, 0xc2, 0xa9, 0x7b, 0x18, 0x2a, 0x83, 0xaf, 0x08,
+		0x30, 0x99, 0x11, 0xd6, 0x35, 0x6d, 0xcb, 0x16, 0x1c, 0x8f, 0xb2, 0x19, 0x41, 0x61, 0x9c, 0xdb,
+		0x31, 0x48, 0x12, 0x4d, 0xf8, 0x1a, 0x3e, 0x46, 0xd1, 0x46, 0xeb, 0xdb, 0x75, 0x6f, 0xbb, 0x87,
+		0x61, 0xa4, 0xbe, 0xed, 0x6d, 0xf4, 0x5e, 0x16, 0xb8, 0xd5, 0xb7, 0x3d, 0x2d, 0x3e, 0x0c, 0x7b,
+		0x09, 0x52, 0x0d, 0xdb, 0x6a, 0x59, 0xb5, 0x55, 0x0f, 0xf6, 0xfd, 0x14, 0x9b, 0xa8, 0x7d, 0x99,
+		0x57, 0xfa, 0xe4, 0x34, 0x1b, 0x9b, 0x3b, 0x8e, 0x61, 0x9d, 0x60, 0x72, 0x12, 0x98, 0x30, 0xad,
+		0x3b, 0xb6, 0x9b, 0x92, 0x33, 0x30, 0xec, 0xb5, 0x7b, 0x94, 0x00, 0x66, 0xf9, 0x49, 0x89, 0x04,
+		0x41, 0x73, 0xab, 0xf3, 0x24, 0x7c, 0x79, 0x36, 0x9f, 0x8c, 0x90, 0x30, 0x6a, 0x69, 0x71, 0x3d,
+		0x5f, 0x54, 0x36, 0x56, 0xd6, 0x17, 0x97, 0xf3, 0xc9, 0xa8, 0x27, 0xb0, 0xbf, 0x18, 0x8b, 0x1f,
+		0x49, 0x1e, 0x95, 0x7f, 0x2f, 0x0a, 0xa3, 0xfe, 0xbd, 0x35, 0x7a, 0x0b, 0xec, 0x13, 0x29, 0x32,
+		0x0b, 0xdb, 0xc5, 0x6b, 0x9a, 0x49, 0x27, 0x64, 0x4d, 0x65, 0x8b, 0xa3, 0x63, 0x3f, 0x93, 0x1c,
+		0xab, 0x80, 0xed, 0xa7, 0x34, 0x93, 0x4c, 0xb7, 0x9a, 0x6a, 0xa3, 0x25, 0x98, 0xd6, 0x8d, 0xa2,
+		0x65, 0xab, 0x7a, 0x59, 0x35, 0xcb, 0x45, 0x37, 0x39, 0x59, 0x54, 0x4b, 0x25, 0x6c, 0x59, 0x06,
+		0x5b, 0x08, 0x1d, 0x2e, 0x07, 0x75, 0xa3, 0xc0, 0x91, 0xdd, 0x15, 0x22, 0xcb, 0x51, 0x03, 0xe6,
+		0x1b, 0x0d, 0x33, 0xdf, 0x03, 0x90, 0xa8, 0xa9, 0xf5, 0x22, 0xd6, 0x6d, 0x73, 0x87, 0xc6, 0xe7,
+		0x71, 0x25, 0x5e, 0x53, 0xeb, 0x79, 0x52, 0x46, 0x97, 0xe1, 0x88, 0x8b, 0x5a, 0xac, 0xe2, 0x8a,
+		0x5a, 0xda, 0x29, 0xd2, 0x60, 0x9c, 0x26, 0x7a, 0x8a, 0x25, 0x43, 0xdf, 0xaa, 0x6a, 0x25, 0xdb,
+		0x4a, 0x0d, 0x39, 0x3e, 0x4e, 0x76, 0x29, 0x96, 0x28, 0xc1, 0x45, 0xcb, 0xd0, 0x69, 0x0c, 0x3e,
+		0x27, 0xb0, 0xef, 0xdc, 0x08, 0xfb, 0x47, 0x29, 0x96, 0xec, 0xbf, 0x18, 0x8b, 0xf7, 0x27, 0x07,
+		0x2e, 0xc6, 0xe2, 0x03, 0xc9, 0xc1, 0x8b, 0xb1, 0x78, 0x3c, 0x99, 0xb8, 0x18, 0x8b, 0x27, 0x92,
+		0x20, 0xff, 0x54, 0x02, 0x86, 0xbd, 0x3b, 0x03, 0xb2, 0xd1, 0x2a, 0xd1, 0xb5, 0x51, 0xa2, 0xde,
+		0xf3, 0x70, 0xdb, 0x7d, 0xc4, 0xec, 0x1c, 0x59, 0x34, 0x33, 0x03, 0x2c, 0x0c, 0x57, 0x18, 0x25,
+		0x09, 0x58, 0x88, 0x59, 0x63, 0x16, 0xf6, 0xc4, 0x15, 0x5e, 0x42, 0x0b, 0x30, 0xf0, 0x9c, 0x45,
+		0x79, 0x0f, 0x50, 0xde, 0x77, 0xb7, 0xe7, 0x7d, 0xb1, 0x40, 0x99, 0x27, 0x2e, 0x16, 0x8a, 0x2b,
+		0xab, 0xca, 0x72, 0x76, 0x49, 0xe1, 0xe4, 0x68, 0x3f, 0xc4, 0xaa, 0xea, 0x0b, 0x3b, 0xfe, 0xe5,
+		0x95, 0x82, 0xd0, 0x2c, 0x8c, 0x35, 0x74, 0xb6, 0xeb, 0x26, 0x43, 0x45, 0xb0, 0xc6, 0xbc, 0x58,
+		0xa3, 0x6e, 0xed, 0x12, 0xc1, 0xef, 0xd2, 0x3c, 0xf6, 0x43, 0x8c, 0xa4, 0x81, 0xfd, 0x8b, 0x20,
+		0x05, 0xa1, 0x63, 0x30, 0x5c, 0xc6, 0x9b, 0x8d, 0x4a, 0xd1, 0xc4, 0x65, 0xb5, 0x64, 0xfb, 0x5d,
+		0xff, 0x10, 0xad, 0x52, 0x68, 0x0d, 0xba, 0x04, 0x09, 0x32, 0x46, 0x3a, 0x1d, 0xe3, 0x71, 0xaa,
+		0x82, 0x13, 0xed, 0x55, 0xc0, 0x87, 0x58, 0x10, 0x29, 0x2e, 0x3d, 0xba, 0x08, 0x03, 0xb6, 0x6a,
+		0x56, 0xb0, 0x4d, 0x3d, 0xff, 0xe8, 0xa9, 0xd9, 0x6e, 0x38, 0xad, 0x53, 0x0a, 0xa2, 0x56, 0x6a,
+		0xa3, 0x9c, 0x03, 0xba, 0x00, 0x83, 0xec, 0x3f, 0x2b, 0x35, 0x31, 0x13, 0xed, 0x9d, 0x99, 0x22,
+		0xc8, 0xef, 0xa0, 0xcf, 0x3a, 0x09, 0xfd, 0xd4, 0xd8, 0x10, 0x00, 0x37, 0xb7, 0x64, 0x1f, 0x8a,
+		0x43, 0x6c, 0x6e, 0x55, 0x21, 0x7e, 0x2b, 0x09, 0xc3, 0x0c, 0x5a, 0x5c, 0x5b, 0xcc, 0xcf, 0xe5,
+		0x93, 0x11, 0xf9, 0x34, 0x0c, 0x30, 0x0b, 0x22, 0x3e, 0xcd, 0xb1, 0xa1, 0x64, 0x1f, 0x2f, 0x72,
+		0x1e, 0x92, 0xa8, 0xdd, 0x58, 0xce, 0xe5, 0x95, 0x64, 0x44, 0xde, 0x80, 0xb1, 0x80, 0xd6, 0xd1,
+		0x1e, 0x18, 0x57, 0xf2, 0xeb, 0xf9, 0x15, 0xb2, 0x6b, 0x2b, 0x6e, 0xac, 0x5c, 0x5a, 0x59, 0x7d,
+		0x8a, 0xa4, 0x3c, 0x7c, 0x60, 0xe1, 0x20, 0x25, 0x34, 0x09, 0x49, 0x17, 0x5c, 0x58, 0xdd, 0x50,
+		0xa8, 0x34, 0x7f, 0x33, 0x02, 0xc9, 0xa0, 0xda, 0xd0, 0x3e, 0x98, 0x58, 0xcf, 0x2a, 0x0b, 0xf9,
+		0xf5, 0x22, 0xdb, 0x89, 0x3a, 0xac, 0x27, 0x21, 0xe9, 0xad, 0x38, 0xbf, 0x48, 0x37, 0xda, 0xd3,
+		0x70, 0xc0, 0x0b, 0xcd, 0x3f, 0xbd, 0x9e, 0x5f, 0x29, 0xd0, 0xc6, 0xb3, 0x2b, 0x0b, 0xc4, 0x5b,
+		0x07, 0xf8, 0x89, 0xbd, 0x6f, 0x94, 0x88, 0xea, 0xe7, 0x97, 0x5f, 0x9a, 0x4f, 0xc6, 0x82, 0xe0,
+		0xd5, 0x95, 0xfc, 0xea, 0xf9, 0x64, 0x7f, 0xb0, 0x75, 0xba, 0x1f, 0x1e, 0x40, 0x69, 0xd8, 0x1b,
+		0x84, 0x16, 0xf3, 0x2b, 0xeb, 0xca, 0x33, 0xc9, 0xc1, 0x60, 0xc3, 0x85, 0xbc, 0x72, 0x79, 0x71,
+		0x2e, 0x9f, 0x8c, 0xa3, 0xbd, 0x80, 0xfc, 0x12, 0xad, 0x5f, 0x58, 0x9d, 0x4f, 0x26, 0x9a, 0xfc,
+		0x93, 0x6c, 0xc1, 0xb0, 0x77, 0x53, 0xfa, 0x3d, 0x71, 0x8d, 0xf2, 0xfb, 0x23, 0x30, 0xe4, 0xd9,
+		0x64, 0x92, 0xdd, 0x81, 0x5a, 0xad, 0x1a, 0xd7, 0x8a, 0x6a, 0x55, 0x53, 0x2d, 0xee, 0xbd, 0x80,
+		0x82, 0xb2, 0x04, 0xd2, 0xad, 0xb7, 0xe8, 0x7e, 0xbd, 0x18, 0xf8, 0x41, 0x5c, 0x2f, 0xfa, 0x93,
+		0x03, 0xf2, 0xab, 0x12, 0x24, 0x83, 0xbb, 0xc7, 0x40, 0xf7, 0xa5, 0xb0, 0xee, 0x7f, 0x4f, 0xc6,
+		0xee, 0x43, 0x12, 0x8c, 0xfa, 0xb7, 0x8c, 0x01, 0xf1, 0xee, 0xfa, 0xbe, 0x8a, 0xf7, 0xe7, 0x11,
+		0x18, 0xf1, 0x6d, 0x14, 0xbb, 0x95, 0xee, 0x79, 0x18, 0xd7, 0xca, 0xb8, 0x56, 0x37, 0x6c, 0x72,
+		0xda, 0x58, 0xac, 0xe2, 0xab, 0xb8, 0x9a, 0x92, 0xa9, 0x8b, 0x3f, 0xd9, 0x7e, 0x2b, 0x3a, 0xbb,
+		0xe8, 0xd2, 0x2d, 0x11, 0xb2, 0xcc, 0xc4, 0xe2, 0x7c, 0x7e, 0x79, 0x6d, 0x75, 0x3d, 0xbf, 0x32,
+		0xf7, 0x8c, 0xf0, 0x2e, 0x4a, 0x52, 0x0b, 0xa0, 0xdd, 0x41, 0xa7, 0xbd, 0x06, 0xc9, 0xa0, 0x50,
+		0xc4, 0x57, 0xb4, 0x10, 0x2b, 0xd9, 0x87, 0x26, 0x60, 0x6c, 0x65, 0xb5, 0x58, 0x58, 0x9c, 0xcf,
+		0x17, 0xf3, 0xe7, 0xcf, 0xe7, 0xe7, 0xd6, 0x0b, 0x2c, 0xb9, 0xe8, 0x60, 0xaf, 0x27, 0x23, 0x5e,
+		0x15, 0x7f, 0x30, 0x0a, 0x13, 0x2d, 0x24, 0x41, 0x59, 0x9e, 0x16, 0x60, 0x99, 0x8a, 0x13, 0xdd,
+		0x48, 0x3f, 0x4b, 0x02, 0xf3, 0x35, 0xd5, 0xb4, 0x79, 0x16, 0xe1, 0x5e, 0x20, 0x5a, 0xd2, 0x6d,
+		0x12, 0x27, 0x98, 0x3c, 0x69, 0xcb, 0x72, 0x05, 0x63, 0x2e, 0x9c, 0xe5, 0x6d, 0xef, 0x07, 0x54,
+		0x37, 0x2c, 0xcd, 0xd6, 0xae, 0x92, 0x33, 0x4c, 0x91, 0xe1, 0x25, 0xb9, 0x83, 0x98, 0x92, 0x14,
+		0x35, 0x8b, 0xba, 0xed, 0x60, 0xeb, 0xb8, 0xa2, 0x06, 0xb0, 0x49, 0x1c, 0x13, 0x55, 0x92, 0xa2,
+		0xc6, 0xc1, 0xbe, 0x0b, 0x86, 0xcb, 0x46, 0x83, 0x6c, 0xa8, 0x18, 0x1e, 0xf1, 0x16, 0x92, 0x32,
+		0xc4, 0x60, 0x0e, 0x0a, 0xdf, 0x2a, 0xbb, 0xa9, 0xe5, 0x61, 0x65, 0x88, 0xc1, 0x18, 0xca, 0x51,
+		0x18, 0x53, 0x2b, 0x15, 0x93, 0x30, 0x17, 0x8c, 0xd8, 0xe6, 0x7f, 0xd4, 0x01, 0x53, 0xc4, 0xf4,
+		0x45, 0x88, 0x0b, 0x3d, 0x90, 0x78, 0x98, 0x68, 0xa2, 0x58, 0x67, 0x19, 0xad, 0x08, 0xc9, 0x36,
+		0xeb, 0xa2, 0xf2, 0x2e, 0x18, 0xd6, 0xac, 0xa2, 0x7b, 0xb6, 0x19, 0x99, 0x89, 0x1c, 0x8b, 0x2b,
+		0x43, 0x9a, 0xe5, 0x9c, 0x91, 0xc8, 0x1f, 0x8b, 0xc0, 0xa8, 0xff, 0xd4, 0x16, 0xcd, 0x43, 0xbc,
+		0x6a, 0xf0, 0x43, 0x16, 0x76, 0x65, 0xe0, 0x58, 0x87, 0x83, 0xde, 0xd9, 0x25, 0x8e, 0xaf, 0x38,
+		0x94, 0xe9, 0x7f, 0x25, 0x41, 0x5c, 0x80, 0xd1, 0x5e, 0x88, 0xd5, 0x55, 0x7b, 0x9b, 0xb2, 0xeb,
+		0xcf, 0x45, 0x92, 0x92, 0x42, 0xcb, 0x04, 0x6e, 0xd5, 0x55, 0x76, 0x4e, 0xc4, 0xe1, 0xa4, 0x4c,
+		0xc6, 0xb5, 0x8a, 0xd5, 0x32, 0xcd, 0x2c, 0x18, 0xb5, 0x1a, 0xd6, 0x6d, 0x4b, 0x8c, 0x2b, 0x87,
+		0xcf, 0x71, 0x30, 0xb9, 0x3c, 0x60, 0x9b, 0xaa, 0x56, 0xf5, 0xe1, 0xc6, 0x28, 0x6e, 0x52, 0x54,
+		0x38, 0xc8, 0x19, 0xd8, 0x2f, 0xf8, 0x96, 0xb1, 0xad, 0x96, 0xb6, 0x71, 0xd9, 0x25, 0x1a, 0xa0,
+		0x19, 0xc4, 0x7d, 0x1c, 0x61, 0x9e, 0xd7, 0x0b, 0x5a, 0xf9, 0xf5, 0x08, 0x8c, 0x8b, 0x5c, 0x48,
+		0xd9, 0x51, 0xd6, 0x32, 0x80, 0xaa, 0xeb, 0x86, 0xed, 0x55, 0x57, 0xb3, 0x29, 0x37, 0xd1, 0xcd,
+		0x66, 0x1d, 0x22, 0xc5, 0xc3, 0x20, 0xfd, 0x17, 0x12, 0x80, 0x5b, 0x15, 0xaa, 0xb7, 0x69, 0x18,
+		0xe2, 0x67, 0xf2, 0xf4, 0x62, 0x07, 0x4b, 0x9f, 0x01, 0x03, 0x91, 0xac, 0x09, 0x49, 0x72, 0x6e,
+		0xe2, 0x8a, 0xa6, 0xf3, 0xd3, 0x19, 0x56, 0x10, 0x49, 0xce, 0x98, 0x7b, 0x3c, 0xa9, 0x40, 0xdc,
+		0xc2, 0x35, 0x55, 0xb7, 0xb5, 0x12, 0x3f, 0x6f, 0x39, 0xd3, 0x93, 0xf0, 0xb3, 0x05, 0x4e, 0xad,
+		0x38, 0x7c, 0xe4, 0x63, 0x10, 0x17, 0x50, 0x12, 0xf8, 0xad, 0xac, 0xae, 0xe4, 0x93, 0x7d, 0x68,
+		0x10, 0xa2, 0x85, 0xfc, 0x7a, 0x52, 0x22, 0x9b, 0xd8, 0xec, 0xd2, 0x62, 0xb6, 0x90, 0x8c, 0xe4,
+		0xfe, 0x3a, 0x4c, 0x94, 0x8c, 0x5a, 0xb0, 0xc1, 0x5c, 0x32, 0x90, 0x40, 0xb4, 0x2e, 0x48, 0xcf,
+		0x9e, 0xe0, 0x48, 0x15, 0xa3, 0xaa, 0xea, 0x95, 0x59, 0xc3, 0xac, 0xb8, 0xd7, 0x62, 0xc8, 0x5e,
+		0xc3, 0xf2, 0x5c, 0x8e, 0xa9, 0x6f, 0xfe, 0x6f, 0x49, 0xfa, 0xa5, 0x48, 0x74, 0x61, 0x2d, 0xf7,
+		0xf1, 0x48, 0x7a, 0x81, 0x11, 0xae, 0x89, 0xee, 0x28, 0x78, 0xab, 0x8a, 0x4b, 0x44, 0x78, 0xf8,
+		0xe6, 0x7d, 0x30, 0x59, 0x31, 0x2a, 0x06, 0xe5, 0x74, 0x92, 0xfc, 0xc7, 0x84, 0x40, 0x09, 0x07,
+		0x9a, 0xee, 0x78, 0x09, 0x27, 0xb3, 0x02, 0x13, 0x1c, 0xb9, 0x48, 0x8f, 0xef, 0x59, 0xaa, 0x02,
+		0xb5, 0xcd, 0x93, 0xa7, 0x7e, 0xf3, 0xeb, 0x34, 0x2a, 0x51, 0xc6, 0x39, 0x29, 0xa9, 0x63, 0xd9,
+		0x8c, 0x8c, 0x02, 0x7b, 0x7c, 0xfc, 0x98, 0x8f, 0xc0, 0x66, 0x07, 0x8e, 0xbf, 0xcf, 0x39, 0x4e,
+		0x78, 0x38, 0x16, 0x38, 0x69, 0x66, 0x0e, 0x46, 0x7a, 0xe1, 0xf5, 0xcf, 0x39, 0xaf, 0x61, 0xec,
+		0x65, 0xb2, 0x00, 0x63, 0x94, 0x49, 0xa9, 0x61, 0xd9, 0x46, 0x8d, 0x3a, 0xe0, 0xf6, 0x6c, 0x3e,
+		0xff, 0x75, 0x36, 0x69, 0x47, 0x09, 0xd9, 0x9c, 0x43, 0x95, 0xc9, 0x00, 0xbd, 0xb1, 0x40, 0x4e,
+		0x77, 0x3b, 0x70, 0xf8, 0x02, 0x17, 0xc4, 0xc1, 0xcf, 0x5c, 0x86, 0x49, 0xf2, 0x3f, 0xf5, 0x8f,
+		0x5e, 0x49, 0x3a, 0x27, 0xd5, 0x53, 0x5f, 0x7e, 0x17, 0xf3, 0x0b, 0x13, 0x0e, 0x03, 0x8f, 0x4c,
+		0x9e, 0x51, 0xac, 0x60, 0xdb, 0xc6, 0xa6, 0x55, 0x54, 0xab, 0xad, 0xc4, 0xf3, 0x64, 0x25, 0x53,
+		0x1f, 0xf8, 0x96, 0x7f, 0x14, 0x17, 0x18, 0x65, 0xb6, 0x5a, 0xcd, 0x6c, 0xc0, 0xbe, 0x16, 0x56,
+		0xd1, 0x05, 0xcf, 0x0f, 0x72, 0x9e, 0x93, 0x4d, 0x96, 0x41, 0xd8, 0xae, 0x81, 0x80, 0x3b, 0x63,
+		0xd9, 0x05, 0xcf, 0x5f, 0xe4, 0x3c, 0x11, 0xa7, 0x15, 0x43, 0x4a, 0x38, 0x5e, 0x84, 0xf1, 0xab,
+		0xd8, 0xdc, 0x34, 0x2c, 0x9e, 0x09, 0xee, 0x82, 0xdd, 0x87, 0x38, 0xbb, 0x31, 0x4e, 0x48, 0x53,
+		0xc3, 0x84, 0xd7, 0xa3, 0x10, 0xdf, 0x52, 0x4b, 0xb8, 0x0b, 0x16, 0x37, 0x38, 0x8b, 0x41, 0x82,
+		0x4f, 0x48, 0xb3, 0x30, 0x5c, 0x31, 0xf8, 0x12, 0xd9, 0x99, 0xfc, 0x55, 0x4e, 0x3e, 0x24, 0x68,
+		0x38, 0x8b, 0xba, 0x51, 0x6f, 0x54, 0xc9, 0xfa, 0xd9, 0x99, 0xc5, 0xdf, 0x13, 0x2c, 0x04, 0x0d,
+		0x67, 0xd1, 0x83, 0x5a, 0x3f, 0x2c, 0x58, 0x58, 0x1e, 0x7d, 0x3e, 0x41, 0x0e, 0x88, 0xab, 0x3b,
+		0x86, 0xde, 0x8d, 0x10, 0xaf, 0x71, 0x0e, 0xc0, 0x49, 0x08, 0x83, 0x73, 0x90, 0xe8, 0x76, 0x20,
+		0xfe, 0xfe, 0xb7, 0xc4, 0xf4, 0x10, 0x23, 0xb0, 0x00, 0x63, 0xc2, 0x41, 0x91, 0x2b, 0x40, 0x9d,
+		0x59, 0xfc, 0x03, 0xce, 0x62, 0xd4, 0x43, 0xc6, 0xbb, 0x61, 0x63, 0xcb, 0xae, 0xe0, 0x6e, 0x98,
+		0x7c, 0x4c, 0x74, 0x83, 0x93, 0x70, 0x55, 0x6e, 0x62, 0xbd, 0xb4, 0xdd, 0x1d, 0x87, 0x5f, 0x16,
+		0xaa, 0x14, 0x34, 0x84, 0xc5, 0x1c, 0x8c, 0xd4, 0x54, 0xd3, 0xda, 0x56, 0xab, 0x5d, 0x0d, 0xc7,
+		0x3f, 0xe4, 0x3c, 0x86, 0x1d, 0x22, 0xae, 0x91, 0x86, 0xde, 0x0b, 0x9b, 0x8f, 0x0b, 0x8d, 0x34,
+		0x74, 0x1f, 0xa3, 0x35, 0x98, 0xb4, 0x6c, 0x9a, 0x36, 0xef, 0x85, 0xdb, 0xaf, 0x88, 0xa9, 0xc7,
+		0x68, 0x97, 0xbd, 0x1c, 0xcf, 0x41, 0xc2, 0xd2, 0x5e, 0xe8, 0x8a, 0xcd, 0xaf, 0x8a, 0x91, 0xa6,
+		0x04, 0x84, 0xf8, 0x19, 0xd8, 0xdf, 0x72, 0x99, 0xe8, 0x82, 0xd9, 0xaf, 0x71, 0x66, 0x7b, 0x5b,
+		0x2c, 0x15, 0xdc, 0x25, 0xf4, 0xca, 0xf2, 0xd7, 0x85, 0x4b, 0xc0, 0x01, 0x5e, 0x6b, 0x64, 0xd3,
+		0x62, 0xa9, 0x5b, 0xbd, 0x69, 0xed, 0x37, 0x84, 0xd6, 0x18, 0xad, 0x4f, 0x6b, 0xeb, 0xb0, 0x97,
+		0x73, 0xec, 0x6d, 0x5c, 0x3f, 0x21, 0x1c, 0x2b, 0xa3, 0xde, 0xf0, 0x8f, 0xee, 0xdb, 0x20, 0xed,
+		0xa8, 0x53, 0x44, 0xc7, 0x56, 0x91, 0xe4, 0x9a, 0x3b, 0x73, 0xfe, 0x4d, 0xce, 0x59, 0x78, 0x7c,
+		0x27, 0xbc, 0xb6, 0x96, 0xd5, 0x3a, 0x61, 0xfe, 0x34, 0xa4, 0x04, 0xf3, 0x86, 0x6e, 0xe2, 0x92,
+		0x51, 0xd1, 0xb5, 0x17, 0x70, 0xb9, 0x0b, 0xd6, 0xbf, 0x15, 0x18, 0xaa, 0x0d, 0x0f, 0x39, 0xe1,
+		0xbc, 0x08, 0x49, 0x27, 0x56, 0x29, 0x6a, 0xb5, 0xba, 0x61, 0xda, 0x1d, 0x38, 0x7e, 0x52, 0x8c,
+		0x94, 0x43, 0xb7, 0x48, 0xc9, 0x32, 0x79, 0x60, 0x77, 0x49, 0xba, 0x35, 0xc9, 0x4f, 0x71, 0x46,
+		0x23, 0x2e, 0x15, 0x77, 0x1c, 0x25, 0xa3, 0x56, 0x57, 0xcd, 0x6e, 0xfc, 0xdf, 0x3f, 0x12, 0x8e,
+		0x83, 0x93, 0x70, 0xc7, 0x41, 0x22, 0x3a, 0xb2, 0xda, 0x77, 0xc1, 0xe1, 0xd3, 0xc2, 0x71, 0x08,
+		0x1a, 0xce, 0x42, 0x04, 0x0c, 0x5d, 0xb0, 0xf8, 0x8c, 0x60, 0x21, 0x68, 0x08, 0x8b, 0x27, 0xdd,
+		0x85, 0xd6, 0xc4, 0x15, 0xcd, 0xb2, 0xf9, 0x65, 0xb0, 0xf6, 0xac, 0x7e, 0xfb, 0x5b, 0xfe, 0x20,
+		0x4c, 0xf1, 0x90, 0x12, 0x4f, 0xc4, 0x0f, 0x52, 0xe8, 0x96, 0xad, 0xb3, 0x60, 0xbf, 0x23, 0x3c,
+		0x91, 0x87, 0x8c, 0xc8, 0xe6, 0x89, 0x10, 0x89, 0xda, 0x4b, 0x64, 0xa3, 0xd2, 0x05, 0xbb, 0xdf,
+		0x0d, 0x08, 0x57, 0x10, 0xb4, 0x84, 0xa7, 0x27, 0xfe, 0x69, 0xe8, 0x57, 0xf0, 0x4e, 0x57, 0xd6,
+		0xf9, 0x7b, 0x81, 0xf8, 0x67, 0x83, 0x51, 0x32, 0x1f, 0x32, 0x16, 0x88, 0xa7, 0x50, 0xa7, 0xbb,
+		0x9e, 0xa9, 0x9f, 0xf8, 0x0e, 0xef, 0xaf, 0x3f, 0x9c, 0xca, 0x2c, 0x41, 0x92, 0x43, 0xdc, 0x00,
+		0xb6, 0x23, 0xb3, 0x77, 0x7d, 0xc7, 0xb1, 0x73, 0x5f, 0xcc, 0x93, 0x39, 0x0f, 0x23, 0xbe, 0x80,
+		0xa7, 0x33, 0xab, 0x9f, 0xe4, 0xac, 0x86, 0xbd, 0xf1, 0x4e, 0xe6, 0x34, 0xc4, 0x48, 0xf0, 0xd2,
+		0x99, 0xfc, 0xa7, 0x38, 0x39, 0x45, 0xcf, 0x3c, 0x06, 0x71, 0x11, 0xb4, 0x74, 0x26, 0xfd, 0x69,
+		0x4e, 0xea, 0x90, 0x10, 0x72, 0x11, 0xb0, 0x74, 0x26, 0xff, 0x1b, 0x82, 0x5c, 0x90, 0x10, 0xf2,
+		0xee, 0x55, 0xf8, 0xd9, 0x9f, 0x89, 0x31, 0x72, 0x41, 0x92, 0x21, 0x77, 0x59, 0x58, 0xa4, 0xd2,
+		0x99, 0xfa, 0xdd, 0xbc, 0x71, 0x41, 0x91, 0x79, 0x04, 0xfa, 0xbb, 0x54, 0xf8, 0xcf, 0x72, 0x52,
+		0x86, 0x9f, 0x99, 0x83, 0x21, 0x4f, 0x74, 0xd2, 0x99, 0xfc, 0x6f, 0x71, 0x72, 0x2f, 0x15, 0x11,
+		0x9d, 0x47, 0x27, 0x9d, 0x19, 0xfc, 0x9c, 0x10, 0x9d, 0x53, 0x10, 0xb5, 0x89, 0xc0, 0xa4, 0x33,
+		0xf5, 0x7b, 0x84, 0xd6, 0x05, 0x49, 0xe6, 0x09, 0x48, 0x38, 0x8b, 0x4d, 0x67, 0xfa, 0xf7, 0x72,
+		0x7a, 0x97, 0x86, 0x68, 0xa0, 0xa1, 0xf7, 0xc0, 0xe2, 0x6f, 0x0b, 0x0d, 0x78, 0xa8, 0xc8, 0x34,
+		0x0a, 0x06, 0x30, 0x9d, 0x39, 0xbd, 0x4f, 0x4c, 0xa3, 0x40, 0xfc, 0x42, 0x46, 0x93, 0xfa, 0xfc,
+		0xce, 0x2c, 0xfe, 0x8e, 0x18, 0x4d, 0x8a, 0x4f, 0xc4, 0x08, 0x46, 0x04, 0x9d, 0x79, 0xfc, 0x82,
+		0x10, 0x23, 0x10, 0x10, 0x64, 0xd6, 0x00, 0x35, 0x47, 0x03, 0x9d, 0xf9, 0xbd, 0x9f, 0xf3, 0x1b,
+		0x6f, 0x0a, 0x06, 0x32, 0x4f, 0xc1, 0xde, 0xd6, 0x91, 0x40, 0x67, 0xae, 0x1f, 0xf8, 0x4e, 0x60,
+		0xef, 0xe6, 0x0d, 0x04, 0x32, 0xeb, 0x30, 0xd9, 0x2a, 0x0a, 0xe8, 0xcc, 0xf6, 0x83, 0xdf, 0xf1,
+		0x3b, 0x6e, 0x6f, 0x10, 0x90, 0xc9, 0x02, 0xb8, 0x0b, 0x70, 0x67, 0x5e, 0x1f, 0xe2, 0xbc, 0x3c,
+		0x44, 0x64, 0x6a, 0xf0, 0xf5, 0xb7, 0x33, 0xfd, 0x0d, 0x31, 0x35, 0x38, 0x05, 0x99, 0x1a, 0x62,
+		0xe9, 0xed, 0x4c, 0xfd, 0xaa, 0x98, 0x1a, 0x82, 0x84, 0x58, 0xb6, 0x67, 0x75, 0xeb, 0xcc, 0xe1,
+		0x35, 0x61, 0xd9, 0x1e, 0xaa, 0xcc, 0x0a, 0x8c, 0x37, 0x2d, 0x88, 0x9d, 0x59, 0xfd, 0x12, 0x67,
+		0x95, 0x0c, 0xae, 0x87, 0xde, 0xc5, 0x8b, 0x2f, 0x86, 0x9d, 0xb9, 0x7d, 0x24, 0xb0, 0x78, 0xf1,
+		0xb5, 0x30, 0x73, 0x0e, 0xe2, 0x7a, 0xa3, 0x5a, 0x25, 0x93, 0x07, 0xb5, 0xbf, 0xed, 0x9b, 0xfa,
+		0xcf, 0xdf, 0xe5, 0xda, 0x11, 0x04, 0x99, 0xd3, 0xd0, 0x8f, 0x6b, 0x9b, 0xb8, 0xdc, 0x89, 0xf2,
+		0x9b, 0xdf, 0x15, 0x0e, 0x93, 0x60, 0x67, 0x9e, 0x00, 0x60, 0xa9, 0x11, 0x7a, 0x0c, 0xdf, 0x81,
+		0xf6, 0x2f, 0xbe, 0xcb, 0xaf, 0xd7, 0xb9, 0x24, 0x2e, 0x03, 0x76, 0x59, 0xaf, 0x3d, 0x83, 0x6f,
+		0xf9, 0x19, 0xd0, 0x11, 0x79, 0x14, 0x06, 0xc9, 0x41, 0x9a, 0xad, 0x56, 0x3a, 0x51, 0xff, 0x17,
+		0x4e, 0x2d, 0xf0, 0x89, 0xc2, 0x6a, 0x86, 0x89, 0x6d, 0xb5, 0x62, 0x75, 0xa2, 0xfd, 0xaf, 0x9c,
+		0xd6, 0x21, 0x20, 0xc4, 0x25, 0xd5, 0xb2, 0xbb, 0xe9, 0xf7, 0x7f, 0x13, 0xc4, 0x82, 0x80, 0x08,
+		0x4d, 0xfe, 0xbf, 0x82, 0x77, 0x3a, 0xd1, 0x7e, 0x5b, 0x08, 0xcd, 0xf1, 0x33, 0x8f, 0x41, 0x82,
+		0xfc, 0xcb, 0xee, 0xcc, 0x76, 0x20, 0xfe, 0xef, 0x9c, 0xd8, 0xa5, 0x20, 0x2d, 0x5b, 0x76, 0xd9,
+		0xd6, 0x3a, 0x2b, 0xfb, 0x16, 0x1f, 0x69, 0x81, 0x9f, 0xc9, 0xc2, 0x90, 0x65, 0x97, 0xcb, 0x0d,
+		0x1e, 0x9f, 0x76, 0x20, 0xff, 0x1f, 0xdf, 0x75, 0x52, 0x16, 0x0e, 0x0d, 0x19, 0xed, 0x6b, 0x57,
+		0xec, 0xba, 0x41, 0xcf, 0x5b, 0x3a, 0x71, 0xf8, 0x0e, 0xe7, 0xe0, 0x21, 0xc9, 0xcc, 0xc1, 0x30,
+		0xe9, 0x8b, 0xf8, 0x16, 0xa1, 0x13, 0x8b, 0xff, 0xc9, 0x15, 0xe0, 0x23, 0xca, 0xfd, 0xd8, 0x17,
+		0xde, 0x98, 0x92, 0x5e, 0x7f, 0x63, 0x4a, 0xfa, 0xf3, 0x37, 0xa6, 0xa4, 0xf7, 0x7c, 0x6d, 0xaa,
+		0xef, 0xf5, 0xaf, 0x4d, 0xf5, 0xfd, 0xf1, 0xd7, 0xa6, 0xfa, 0x5a, 0x67, 0x89, 0x61, 0xc1, 0x58,
+		0x30, 0x58, 0x7e, 0xf8, 0x59, 0xb9, 0xa2, 0xd9, 0xdb, 0x8d, 0xcd, 0xd9, 0x92, 0x51, 0xa3, 0x69,
+		0x5c, 0x37, 0x5b, 0xeb, 0x6c, 0x72, 0xe0, 0x27, 0x22, 0xb0, 0x9f, 0xf1, 0x70, 0x6b, 0x55, 0x7d,
+		0x27, 0xe4, 0x4b, 0xca, 0x74, 0xcb, 0xc4, 0xb0, 0x7c, 0x01, 0xa2, 0x59, 0x7d, 0x07, 0xed, 0x67,
+		0x3e, 0xaf, 0xd8, 0x30, 0xab, 0xfc, 0x2e, 0xe7, 0x20, 0x29, 0x6f, 0x98, 0x55, 0x92, 0x79, 0x17,
+		0x17, 0xae, 0xc9, 0x09, 0x0f, 0x2b, 0x64, 0x92, 0xef, 0xff, 0xf0, 0x74, 0xdf, 0x27, 0x3e, 0x3c,
+		0xdd, 0xf7, 0xed, 0xd7, 0xa6, 0xfb, 0x5e, 0xfa, 0xb3, 0x99, 0xbe, 0xdc, 0x95, 0x60, 0x6f, 0x3f,
+		0xdb, 0xb1, 0xc7, 0xf1, 0xac, 0xbe, 0x43, 0x3b, 0xbc, 0x26, 0x3d, 0xdb, 0x4f, 0xda, 0xb3, 0x44,
+		0x92, 0x7b, 0x2a, 0x98, 0xe4, 0x7e, 0x0a, 0x57, 0xab, 0x97, 0x74, 0xe3, 0x9a, 0x4e, 0xee, 0x2f,
+		0x58, 0x9b, 0x03, 0xec, 0x23, 0x01, 0x78, 0x5f, 0x04, 0xa6, 0x9a, 0xf2, 0xd9, 0xdc, 0x0a, 0xc2,
+		0x3e, 0x29, 0xcd, 0x40, 0x7c, 0x5e, 0x18, 0x57, 0x8a, 0x7c, 0xcb, 0x58, 0x32, 0xf4, 0xb2, 0x45,
+		0xbb, 0x1d, 0x55, 0x44, 0x91, 0x74, 0x5b, 0x57, 0x75, 0xc3, 0xe2, 0x77, 0x9f, 0x59, 0x21, 0xf7,
+		0x8b, 0x52, 0x6f, 0x63, 0x3a, 0x22, 0x5a, 0x12, 0xdd, 0x7c, 0xb0, 0x63, 0xda, 0xff, 0x0a, 0xe9,
+		0xa5, 0xd3, 0x09, 0x5f, 0xea, 0xbf, 0x5b, 0xad, 0xfc, 0x42, 0x04, 0xa6, 0x83, 0x5a, 0x21, 0x53,
+		0xcb, 0xb2, 0xd5, 0x5a, 0x3d, 0x4c, 0x2d, 0xe7, 0x20, 0xb1, 0x2e, 0x70, 0x7a, 0xd6, 0xcb, 0x8d,
+		0x1e, 0xf5, 0x32, 0xea, 0x34, 0x25, 0x14, 0x73, 0xaa, 0x4b, 0xc5, 0x38, 0xfd, 0xd8, 0x95, 0x66,
+		0xde, 0x19, 0x85, 0xfd, 0x25, 0xc3, 0xaa, 0x19, 0x56, 0x91, 0x4d, 0x05, 0x56, 0xe0, 0x3a, 0x19,
+		0xf6, 0x56, 0x75, 0x71, 0x50, 0x72, 0x01, 0x46, 0xa9, 0xbb, 0xa0, 0x29, 0x62, 0xea, 0xa1, 0x3b,
+		0x2e, 0xaa, 0x5f, 0xfc, 0xa3, 0x7e, 0x3a, 0xbd, 0x46, 0x1c, 0x42, 0x7a, 0xd7, 0x6c, 0x1d, 0x26,
+		0xb5, 0x5a, 0xbd, 0x8a, 0xe9, 0xc9, 0x5c, 0xd1, 0xa9, 0xeb, 0xcc, 0xef, 0x4b, 0x9c, 0xdf, 0x84,
+		0x4b, 0xbe, 0x28, 0xa8, 0x33, 0x4b, 0x30, 0x4e, 0x6e, 0x2e, 0xd6, 0x7d, 0x2c, 0x3b, 0xb8, 0x32,
+		0x21, 0x60, 0x92, 0x53, 0x3a, 0xdc, 0x72, 0x4f, 0x84, 0x0d, 0xf1, 0xb3, 0xf7, 0x78, 0xbc, 0x95,
+		0x89, 0x2b, 0x58, 0x3f, 0xa1, 0x63, 0xfb, 0x9a, 0x61, 0x5e, 0xe1, 0xea, 0x3d, 0xc1, 0x9a, 0x12,
+		0x83, 0xf0, 0x93, 0x51, 0x98, 0x62, 0x15, 0x27, 0x37, 0x55, 0x0b, 0x9f, 0xbc, 0xfa, 0xe0, 0x26,
+		0xb6, 0xd5, 0x07, 0x4f, 0x96, 0x0c, 0x4d, 0x4c, 0xda, 0x09, 0x3e, 0x2e, 0xa4, 0x7e, 0x96, 0xd7,
+		0x87, 0x78, 0xb0, 0x05, 0x88, 0xcd, 0x19, 0x9a, 0x4e, 0x0c, 0xb3, 0x8c, 0x75, 0xa3, 0xc6, 0xfd,
+		0x17, 0x2b, 0xa0, 0xc3, 0x30, 0xa0, 0xd6, 0x8c, 0x86, 0x6e, 0xb3, 0x33, 0xc5, 0xdc, 0xd0, 0x17,
+		0x6e, 0x4e, 0xf7, 0xfd, 0xe9, 0xcd, 0xe9, 0xe8, 0xa2, 0x6e, 0x2b, 0xbc, 0x2a, 0x13, 0xfb, 0xc6,
+		0x87, 0xa7, 0x25, 0xf9, 0x22, 0x0c, 0xce, 0xe3, 0xd2, 0x6e, 0x78, 0xcd, 0xe3, 0x52, 0x80, 0xd7,
+		0xbd, 0x10, 0x5f, 0xd4, 0x6d, 0xf6, 0xb5, 0xc0, 0x21, 0x88, 0x6a, 0x3a, 0xbb, 0x80, 0x1a, 0x68,
+		0x9f, 0xc0, 0x09, 0xea, 0x3c, 0x2e, 0x39, 0xa8, 0x65, 0x5c, 0x4a, 0x49, 0xcd, 0xec, 0x09, 0x3c,
+		0x37, 0xff, 0xc7, 0x5f, 0x9d, 0xea, 0x7b, 0xe9, 0x8d, 0xa9, 0xbe, 0xd0, 0x91, 0xf0, 0xae, 0x1b,
+		0x5c, 0xc5, 0x7c, 0x08, 0xac, 0xf2, 0x15, 0x36, 0x8f, 0x9c, 0x61, 0xf8, 0x78, 0x0c, 0x0e, 0xd1,
+		0x0f, 0xc5, 0xcc, 0x9a, 0xa6, 0xdb, 0x27, 0x4b, 0xe6, 0x4e, 0xdd, 0xa6, 0x0b, 0x8d, 0xb1, 0xc5,
+		0x47, 0x61, 0xdc, 0xad, 0x9e, 0x65, 0xd5, 0x21, 0x63, 0xb0, 0x05, 0xfd, 0x6b, 0x84, 0x8e, 0x28,
+		0xce, 0x36, 0x6c, 0xb5, 0xca, 0xbd, 0x06, 0x2b, 0x10, 0x28, 0xfb, 0xb8, 0x2c, 0xc2, 0xa0, 0x9a,
+		0xf8, 0xae, 0xac, 0x8a, 0xd5, 0x2d, 0x76, 0x47, 0x3f, 0x4a, 0x17, 0x97, 0x38, 0x01, 0xd0, 0xeb,
+		0xf8, 0x93, 0xd0, 0xaf, 0x36, 0xd8, 0xc9, 0x77, 0x94, 0xac, 0x3a, 0xb4, 0x20, 0x5f, 0x82, 0x41,
+		0x7e, 0x00, 0x46, 0x8e, 0x7e, 0xaf, 0xe0, 0x1d, 0xda, 0xce, 0xb0, 0x42, 0xfe, 0x45, 0xb3, 0xd0,
+		0x4f, 0x85, 0xe7, 0x1f, 0x1f, 0xa5, 0x66, 0x9b, 0xa4, 0x9f, 0xa5, 0x42, 0x2a, 0x0c, 0x4d, 0xbe,
+		0x08, 0xf1, 0x79, 0xa3, 0xa6, 0xe9, 0x86, 0x9f, 0x5b, 0x82, 0x71, 0xa3, 0x32, 0xd7, 0x1b, 0x7c,
+		0xac, 0x15, 0x56, 0x20, 0x37, 0x4c, 0xd9, 0x37, 0x1b, 0xfc, 0xf4, 0x9e, 0x97, 0xe4, 0x39, 0x18,
+		0xa4, 0xbc, 0x57, 0xeb, 0xce, 0x77, 0x92, 0x92, 0xe7, 0x3b, 0x49, 0xce, 0x3e, 0xe2, 0x0a, 0x8b,
+		0x20, 0x56, 0x56, 0x6d, 0x95, 0xf7, 0x9b, 0xfe, 0x2f, 0x3f, 0x0e, 0x71, 0xce, 0xc4, 0x42, 0xa7,
+		0x20, 0x6a, 0xd4, 0x2d, 0x7e, 0xfe, 0x9e, 0x0e, 0xeb, 0xca, 0x6a, 0x3d, 0x17, 0x23, 0x56, 0xa2,
+		0x10, 0xe4, 0x9c, 0x12, 0x6a, 0x16, 0x67, 0x3d, 0x66, 0xe1, 0x19, 0x72, 0xcf, 0xbf, 0x6c, 0x48,
+		0x9b, 0xcc, 0xc1, 0x31, 0x96, 0xd7, 0x22, 0x30, 0xe5, 0xa9, 0xbd, 0x8a, 0x4d, 0xb2, 0x0b, 0x64,
+		0x16, 0xc5, 0xad, 0x05, 0x79, 0x84, 0xe4, 0xf5, 0x21, 0xe6, 0xf2, 0x18, 0x44, 0xb3, 0xf5, 0x3a,
+		0xf9, 0x74, 0x91, 0x96, 0x4b, 0x06, 0xb3, 0x97, 0x98, 0xe2, 0x94, 0x49, 0x9d, 0x65, 0x6c, 0xd9,
+		0xd7, 0x54, 0xd3, 0xf9, 0xac, 0x51, 0x94, 0xe5, 0x47, 0x21, 0x31, 0x67, 0xe8, 0x16, 0xd6, 0xad,
+		0x06, 0x5d, 0x8f, 0x36, 0xab, 0x46, 0xe9, 0x0a, 0xe7, 0xc0, 0x0a, 0x44, 0xe1, 0x6a, 0xbd, 0x4e,
+		0x29, 0x63, 0x0a, 0xf9, 0x97, 0xcd, 0xcb, 0x5c, 0x21, 0x54, 0x45, 0x8f, 0xf6, 0xae, 0x22, 0xde,
+		0x49, 0x47, 0x47, 0x1f, 0x88, 0xc0, 0xc1, 0xe6, 0x09, 0x75, 0x05, 0xef, 0x58, 0xbd, 0xce, 0x27,
+		0x1b, 0x12, 0x6b, 0xf4, 0x9d, 0x88, 0x4b, 0x78, 0x07, 0xa5, 0xc9, 0x63, 0x02, 0xa7, 0x4e, 0x9f,
+		0x7e, 0xf0, 0x51, 0x66, 0xed, 0x17, 0xfa, 0x14, 0x01, 0x40, 0x53, 0x90, 0xb0, 0x70, 0xa9, 0x7e,
+		0xea, 0xf4, 0x99, 0x2b, 0x0f, 0x32, 0xf3, 0xba, 0xd0, 0xa7, 0xb8, 0x20, 0x42, 0x6b, 0x99, 0x8c,
+		0x36, 0x2a, 0x68, 0x39, 0x20, 0x13, 0x27, 0x1a, 0xf9, 0xc6, 0x6b, 0xd3, 0x52, 0xae, 0x1f, 0xa2,
+		0x56, 0xa3, 0x76, 0x47, 0xed, 0xe7, 0x83, 0xfd, 0x30, 0xe3, 0xa5, 0xa4, 0x2b, 0xfa, 0x55, 0xb5,
+		0xaa, 0x95, 0x55, 0xf7, 0xf5, 0x8f, 0xa4, 0x47, 0x3f, 0x14, 0xa3, 0xb5, 0x7a, 0xd2, 0x6d, 0xb5,
+		0x2c, 0xff, 0x96, 0x04, 0xc3, 0x97, 0x05, 0x67, 0xf2, 0x5c, 0xc8, 0x39, 0x00, 0xa7, 0x25, 0x31,
+		0xa5, 0x0e, 0xcc, 0x06, 0xdb, 0x9a, 0x75, 0x68, 0x14, 0x0f, 0x3a, 0x7a, 0x84, 0x1a, 0x69, 0xdd,
+		0xb0, 0xf8, 0x67, 0x70, 0x1d, 0x48, 0x1d, 0x64, 0x72, 0xe3, 0x8a, 0x7a, 0xbf, 0xe2, 0x55, 0xc3,
+		0x26, 0x67, 0xc0, 0x75, 0xe3, 0x1a, 0xff, 0xb8, 0x38, 0xaa, 0x24, 0x69, 0xcd, 0x65, 0x5a, 0xb1,
+		0x46, 0xe0, 0x44, 0xe8, 0x84, 0xc3, 0x85, 0x84, 0x5f, 0x6a, 0xb9, 0x6c, 0x62, 0xcb, 0xe2, 0x0e,
+		0x4e, 0x14, 0xc9, 0xb7, 0x77, 0xf5, 0xc6, 0x66, 0x51, 0x78, 0x13, 0xf2, 0xf5, 0x62, 0x0b, 0xdf,
+		0x20, 0x6c, 0x87, 0x7b, 0x87, 0x81, 0x7a, 0x63, 0x93, 0x58, 0xd2, 0x5d, 0x30, 0xdc, 0x42, 0x98,
+		0xa1, 0xab, 0xae, 0x1c, 0xf4, 0xe9, 0x12, 0xde, 0x83, 0x62, 0xdd, 0xd4, 0x0c, 0x53, 0xb3, 0x77,
+		0xe8, 0xfd, 0x9a, 0xa8, 0x92, 0x14, 0x15, 0x6b, 0x1c, 0x2e, 0x5f, 0x81, 0xb1, 0x02, 0x8d, 0x3b,
+		0x5c, 0xc9, 0x4f, 0xbb, 0xf2, 0x49, 0x9d, 0xe5, 0x0b, 0x95, 0x2c, 0xd2, 0x24, 0x59, 0xee, 0xc9,
+		0x50, 0xeb, 0x7c, 0xa4, 0x77, 0xeb, 0xf4, 0xaf, 0x84, 0x7f, 0x32, 0x05, 0x07, 0x83, 0x95, 0x3e,
+		0xd7, 0xd6, 0xad, 0x61, 0x76, 0x8a, 0xba, 0xd3, 0xed, 0x17, 0xdc, 0x74, 0x07, 0x17, 0x9b, 0xee,
+		0x38, 0x85, 0xe4, 0x47, 0x61, 0x84, 0xdc, 0x94, 0x2b, 0x60, 0xfb, 0x02, 0x56, 0xcb, 0xd8, 0xf4,
+		0xaf, 0xc8, 0x23, 0x62, 0x45, 0x46, 0x10, 0xa3, 0xcb, 0x2e, 0x5b, 0x91, 0xe8, 0xff, 0xf2, 0x36,
+		0xc4, 0x08, 0xa9, 0xbb, 0x5a, 0x73, 0x0a, 0x5a, 0x20, 0xd0, 0xcd, 0x1d, 0x1b, 0x5b, 0x62, 0x1b,
+		0x48, 0x0b, 0xe8, 0x61, 0xb1, 0xe6, 0x46, 0xdb, 0xaf, 0xb9, 0xdc, 0x10, 0xf9, 0xca, 0x5b, 0x85,
+		0xc1, 0x1c, 0x71, 0xd3, 0x8b, 0xf3, 0x8e, 0x20, 0x92, 0x2b, 0x08, 0x5a, 0x86, 0xb1, 0xba, 0x6a,
+		0xda, 0xf4, 0x13, 0x9e, 0x6d, 0xda, 0x0b, 0x6e, 0xeb, 0xd3, 0xcd, 0x33, 0xcf, 0xd7, 0x59, 0xde,
+		0xca, 0x48, 0xdd, 0x0b, 0x94, 0xff, 0x63, 0x0c, 0x06, 0xb8, 0x32, 0x1e, 0x83, 0x41, 0xae, 0x56,
+		0x6e, 0x9d, 0x87, 0x66, 0x9b, 0x17, 0xad, 0x59, 0x67, 0x71, 0xe1, 0xfc, 0x04, 0x0d, 0x3a, 0x02,
+		0xf1, 0xd2, 0xb6, 0xaa, 0xe9, 0x45, 0xad, 0x2c, 0x42, 0xc0, 0x37, 0x6e, 0x4e, 0x0f, 0xce, 0x11,
+		0xd8, 0xe2, 0xbc, 0x32, 0x48, 0x2b, 0x17, 0xcb, 0x24, 0x4a, 0xd8, 0xc6, 0x5a, 0x65, 0xdb, 0xe6,
+		0x33, 0x8c, 0x97, 0xc8, 0xbb, 0x45, 0xc4, 0x20, 0xf8, 0x07, 0x9e, 0xe9, 0xa6, 0x40, 0xdc, 0xd9,
+		0x14, 0xe5, 0xe2, 0xa4, 0xe1, 0xf7, 0x7c, 0x65, 0x5a, 0x52, 0x28, 0x05, 0x9a, 0x83, 0x91, 0xaa,
+		0x6a, 0xd9, 0x45, 0xba, 0xba, 0x91, 0xe6, 0xfb, 0x29, 0x8b, 0xfd, 0xcd, 0x0a, 0xe1, 0x8a, 0xe5,
+		0xa2, 0x0f, 0x11, 0x2a, 0x06, 0x2a, 0x93, 0xef, 0xcf, 0x28, 0x13, 0x72, 0x41, 0x50, 0xb3, 0x59,
+		0xdc, 0x35, 0x40, 0xf5, 0x3e, 0x4a, 0xe0, 0x73, 0x14, 0x4c, 0xa3, 0xaf, 0x03, 0x90, 0xa0, 0x9f,
+		0x94, 0x51, 0x14, 0x76, 0xb3, 0x33, 0x4e, 0x00, 0xb4, 0xf2, 0x28, 0x8c, 0xb9, 0xfe, 0x91, 0xa1,
+		0xc4, 0x19, 0x17, 0x17, 0x4c, 0x11, 0x1f, 0x80, 0x49, 0x1d, 0x5f, 0xb7, 0x8b, 0x2e, 0x98, 0x61,
+		0x27, 0x28, 0x36, 0x22, 0x75, 0x97, 0xfd, 0x14, 0xf7, 0xc0, 0x68, 0x49, 0x28, 0x9f, 0xe1, 0x02,
+		0xc5, 0x1d, 0x71, 0xa0, 0x14, 0x6d, 0x3f, 0xc4, 0xd5, 0x7a, 0x9d, 0x21, 0x0c, 0x71, 0xff, 0x58,
+		0xaf, 0xd3, 0xaa, 0xe3, 0x30, 0x4e, 0xfb, 0x68, 0x62, 0xab, 0x51, 0xb5, 0x39, 0x93, 0x61, 0x8a,
+		0x33, 0x46, 0x2a, 0x14, 0x06, 0xa7, 0xb8, 0x87, 0x61, 0x04, 0x5f, 0xd5, 0xca, 0x58, 0x2f, 0x61,
+		0x86, 0x37, 0x42, 0xf1, 0x86, 0x05, 0x90, 0x22, 0xdd, 0x0b, 0x8e, 0xdf, 0x2b, 0x0a, 0x9f, 0x3c,
+		0xca, 0xf8, 0x09, 0x78, 0x96, 0x81, 0xe5, 0x14, 0xc4, 0xe6, 0x55, 0x5b, 0x25, 0xc1, 0x87, 0x7d,
+		0x9d, 0x2d, 0x34, 0xc3, 0x0a, 0xf9, 0x57, 0xfe, 0x4c, 0x14, 0x62, 0x97, 0x0d, 0x1b, 0xa3, 0x87,
+		0x3c, 0xc1, 0xe1, 0x68, 0x2b, 0x7b, 0x2e, 0x68, 0x15, 0x1d, 0x97, 0x97, 0xad, 0x8a, 0xe7, 0xfd,
+		0x07, 0xd7, 0x9c, 0x22, 0x3e, 0x73, 0x9a, 0x84, 0x7e, 0xd3, 0x68, 0xe8, 0x65, 0x71, 0x27, 0x92,
+		0x16, 0x50, 0x1e, 0xe2, 0x8e, 0x95, 0xc4, 0x3a, 0x59, 0xc9, 0x18, 0xb1, 0x12, 0x62, 0xc3, 0x1c,
+		0xa0, 0x0c, 0x6e, 0x72, 0x63, 0xc9, 0x41, 0xc2, 0x71, 0x5e, 0xa9, 0xfe, 0x1e, 0x0c, 0xd6, 0x25,
+		0x23, 0x8b, 0x89, 0x33, 0xf6, 0x8e, 0xf2, 0x98, 0xc5, 0x25, 0x9d, 0x0a, 0xae, 0x3d, 0x9f, 0x59,
+		0xf1, 0xb7, 0x28, 0x06, 0x69, 0xbf, 0x5c, 0xb3, 0x62, 0xef, 0x51, 0x1c, 0x24, 0x97, 0x4c, 0x2a,
+		0xba, 0x6a, 0x37, 0x4c, 0xcc, 0x2d, 0xcf, 0x05, 0x90, 0x5a, 0xf7, 0x7e, 0x30, 0xb3, 0x34, 0x17,
+		0x80, 0x4e, 0xc2, 0x84, 0x53, 0x28, 0xba, 0x5c, 0x98, 0x95, 0x21, 0xa7, 0xaa, 0x20, 0x6a, 0xe4,
+		0xcf, 0x4a, 0x30, 0xc0, 0x26, 0x86, 0x67, 0x18, 0xa4, 0xd6, 0xc3, 0x10, 0x09, 0x1b, 0x86, 0xe8,
+		0xee, 0x87, 0x21, 0x0b, 0xe0, 0x88, 0x69, 0xf1, 0x17, 0x07, 0x5a, 0x04, 0x20, 0x4c, 0xc4, 0x82,
+		0x56, 0xe1, 0xf3, 0xde, 0x43, 0x24, 0xff, 0x7b, 0x09, 0x12, 0x4e, 0x3d, 0xca, 0xc2, 0x88, 0x90,
+		0xab, 0xb8, 0x55, 0x55, 0x2b, 0xdc, 0x14, 0x0f, 0x85, 0x0a, 0x77, 0xbe, 0xaa, 0x56, 0x94, 0x21,
+		0x2e, 0x0f, 0x29, 0xb4, 0x1e, 0xd6, 0x48, 0xc8, 0xb0, 0xfa, 0xec, 0x28, 0xba, 0x3b, 0x3b, 0xf2,
+		0x8d, 0x78, 0x2c, 0x30, 0xe2, 0xf2, 0x57, 0x25, 0xfe, 0x98, 0x51, 0x19, 0x97, 0xbf, 0x9f, 0x43,
+		0xf5, 0x2c, 0xb7, 0xad, 0x32, 0x2e, 0x17, 0x9b, 0xc6, 0xec, 0x70, 0x33, 0x47, 0xbf, 0xcc, 0xee,
+		0xd8, 0x21, 0xc1, 0xa5, 0xe0, 0x8e, 0xe1, 0xa7, 0x22, 0x30, 0xde, 0x84, 0xff, 0xa3, 0x37, 0x96,
+		0xfe, 0xd9, 0xdb, 0xdf, 0xe5, 0xec, 0x1d, 0x08, 0x9d, 0xbd, 0x9f, 0x8c, 0xd0, 0x2d, 0x75, 0xdd,
+		0xb0, 0xd4, 0xea, 0xf7, 0xc2, 0xf7, 0x1e, 0x80, 0x44, 0xdd, 0xa8, 0x16, 0x59, 0x0d, 0xbb, 0x95,
+		0x1e, 0xaf, 0x1b, 0x55, 0xa5, 0xc9, 0xcc, 0xfa, 0x6f, 0x93, 0x63, 0x1e, 0xb8, 0x0d, 0x83, 0x30,
+		0x18, 0x9c, 0x50, 0x26, 0x0c, 0x33, 0x55, 0xf0, 0xa8, 0xe9, 0x01, 0xa2, 0x03, 0xf2, 0x5f, 0x4a,
+		0x6a, 0x8e, 0xf2, 0x98, 0xd8, 0x0c, 0x53, 0x19, 0xd8, 0x76, 0x28, 0x58, 0x90, 0x91, 0x8a, 0x84,
+		0x51, 0x30, 0x2b, 0x56, 0x38, 0x9e, 0xfc, 0xf3, 0x12, 0xc0, 0x12, 0xd1, 0x2c, 0xed, 0x2f, 0x89,
+		0x77, 0x2c, 0x2a, 0x42, 0xd1, 0xd7, 0xf2, 0x54, 0xd8, 0xa0, 0xf1, 0xf6, 0x87, 0x2d, 0xaf, 0xdc,
+		0x73, 0x30, 0xe2, 0xda, 0xb6, 0x85, 0x85, 0x30, 0x53, 0x6d, 0xf6, 0x6f, 0x05, 0x6c, 0x2b, 0xc3,
+		0x57, 0x3d, 0x25, 0xf9, 0x9f, 0x49, 0x90, 0xa0, 0x32, 0x91, 0x4f, 0xe9, 0x7d, 0x63, 0x28, 0xed,
+		0x7e, 0x0c, 0x0f, 0x01, 0x30, 0x36, 0xe4, 0x70, 0x97, 0x5b, 0x56, 0x82, 0x42, 0xc8, 0x91, 0x2d,
+		0x3a, 0xe3, 0x28, 0x3c, 0xda, 0x5e, 0xe1, 0x62, 0x7f, 0xc7, 0xd5, 0xbe, 0x0f, 0x06, 0xe9, 0x73,
+		0x7b, 0xd7, 0x2d, 0xbe, 0x65, 0x23, 0x2f, 0xb6, 0xac, 0x5f, 0xb7, 0xe4, 0xe7, 0x60, 0x70, 0xfd,
+		0x3a, 0xcb, 0xd0, 0x1d, 0x80, 0x84, 0x69, 0x18, 0x3c, 0xfa, 0x63, 0x51, 0x77, 0x9c, 0x00, 0x68,
+		0xb0, 0x23, 0xb2, 0x52, 0x11, 0x37, 0x2b, 0xe5, 0xa6, 0xd5, 0xa2, 0x5d, 0xa5, 0xd5, 0x8e, 0xff,
+		0x3b, 0x09, 0x86, 0x3c, 0xee, 0x06, 0x3d, 0x08, 0x7b, 0x72, 0x4b, 0xab, 0x73, 0x97, 0x8a, 0x8b,
+		0xf3, 0xc5, 0xf3, 0x4b, 0xd9, 0x05, 0xf7, 0xc3, 0xab, 0xf4, 0xde, 0x57, 0x6e, 0xcc, 0x20, 0x0f,
+		0xee, 0x86, 0x4e, 0x73, 0xfc, 0xe8, 0x24, 0x4c, 0xfa, 0x49, 0xb2, 0xb9, 0x02, 0xf9, 0x0a, 0x4b,
+		0x4a, 0xef, 0x79, 0xe5, 0xc6, 0xcc, 0xb8, 0x87, 0x22, 0xbb, 0x69, 0x61, 0xdd, 0x6e, 0x26, 0x98,
+		0x5b, 0x5d, 0x5e, 0x5e, 0x5c, 0x4f, 0x46, 0x9a, 0x08, 0xf8, 0x02, 0x71, 0x2f, 0x8c, 0xfb, 0x09,
+		0x56, 0x16, 0x97, 0x92, 0xd1, 0x34, 0x7a, 0xe5, 0xc6, 0xcc, 0xa8, 0x07, 0x7b, 0x45, 0xab, 0xa6,
+		0xe3, 0x2f, 0x7f, 0x64, 0xaa, 0xef, 0x97, 0x3f, 0x3a, 0x25, 0x91, 0x9e, 0x8d, 0xf8, 0x7c, 0x04,
+		0xba, 0x1f, 0xf6, 0x15, 0x16, 0x17, 0x56, 0xf2, 0xf3, 0xc5, 0xe5, 0xc2, 0x42, 0xe0, 0x5b, 0xda,
+		0xf4, 0xd8, 0x2b, 0x37, 0x66, 0x86, 0x78, 0x97, 0xc2, 0xb0, 0xd7, 0x94, 0xfc, 0xe5, 0xd5, 0xf5,
+		0x7c, 0x52, 0x62, 0xd8, 0x6b, 0x26, 0xbe, 0x6a, 0xd8, 0xec, 0xa5, 0xce, 0x07, 0x60, 0x7f, 0x0b,
+		0x6c, 0xa7, 0x63, 0xe3, 0xaf, 0xdc, 0x98, 0x19, 0x59, 0x33, 0x31, 0x9b, 0x3f, 0x94, 0x62, 0x16,
+		0x52, 0xcd, 0x14, 0xab, 0x6b, 0xab, 0x85, 0xec, 0x52, 0x72, 0x26, 0x9d, 0x7c, 0xe5, 0xc6, 0xcc,
+		0xb0, 0x70, 0x86, 0x04, 0xdf, 0xed, 0xd9, 0x9d, 0xdc, 0x5b, 0xff, 0xe5, 0x59, 0xb8, 0x9b, 0x67,
+		0xa2, 0x2d, 0x5b, 0xbd, 0xa2, 0xe9, 0x15, 0x27, 0xdf, 0xcf, 0xcb, 0x7c, 0x8f, 0xbd, 0x97, 0x61,
+		0xcd, 0x0a, 0x68, 0xdb, 0xac, 0x7f, 0x3a, 0xfc, 0xe4, 0x33, 0xdd, 0xe1, 0x40, 0xb0, 0xf3, 0x26,
+		0x3d, 0xfc, 0x84, 0x28, 0xdd, 0xe1, 0xdc, 0x22, 0xdd, 0x36, 0x8d, 0x20, 0xbf, 0x5b, 0x82, 0xd1,
+		0x0b, 0x9a, 0x65, 0x1b, 0xa6, 0x56, 0x52, 0xab, 0xf4, 0x73, 0xab, 0x33, 0xdd, 0xfa, 0xd6, 0xc0,
+		0x54, 0x7f, 0x02, 0x06, 0xae, 0xaa, 0x55, 0xe6, 0xd4, 0xa2, 0xf4, 0x09, 0xa6, 0xd6, 0xea, 0x73,
+		0x5d, 0x9b, 0x60, 0xc0, 0xc8, 0xe4, 0xdf, 0x88, 0xc0, 0x18, 0x9d, 0x0c, 0x16, 0x7b, 0x5a, 0x90,
+		0xec, 0xe6, 0x73, 0x10, 0x33, 0x55, 0x9b, 0xa7, 0xae, 0x73, 0xb3, 0xfc, 0xfc, 0xe1, 0x48, 0xe7,
+		0x33, 0x05, 0xf2, 0xd2, 0xa1, 0x42, 0x69, 0xd1, 0xdb, 0x21, 0x5e, 0x53, 0xaf, 0x17, 0x29, 0x1f,
+		0xb6, 0x47, 0xce, 0xf6, 0xc6, 0xe7, 0xd6, 0xcd, 0xe9, 0xb1, 0x1d, 0xb5, 0x56, 0xcd, 0xc8, 0x82,
+		0x8f, 0xac, 0x0c, 0xd6, 0xd4, 0xeb, 0x44, 0x44, 0x54, 0x87, 0x31, 0x02, 0x2d, 0x6d, 0xab, 0x7a,
+		0x05, 0xb3, 0x46, 0x68, 0x22, 0x3e, 0x77, 0xa1, 0xe7, 0x46, 0xf6, 0xba, 0x8d, 0x78, 0xd8, 0xc9,
+		0xca, 0x48, 0x4d, 0xbd, 0x3e, 0x47, 0x01, 0xa4, 0xc5, 0x4c, 0x9c, 0x1c, 0x74, 0xd3, 0x33, 0x9d,
+		0x2f, 0x4b, 0x00, 0xae, 0xc6, 0xd0, 0xdb, 0x21, 0x59, 0x72, 0x4a, 0x94, 0xd6, 0xe2, 0x63, 0x78,
+		0x34, 0x6c, 0x2c, 0x02, 0xfa, 0x66, 0x6b, 0xf3, 0xeb, 0x37, 0xa7, 0x25, 0x65, 0xac, 0x14, 0x18,
+		0x8a, 0xb7, 0xc1, 0x50, 0xa3, 0x5e, 0x56, 0x6d, 0x5c, 0xa4, 0x19, 0x83, 0x48, 0xc7, 0x75, 0x7e,
+		0x8a, 0xf0, 0xba, 0x75, 0x73, 0x1a, 0xb1, 0x6e, 0x79, 0x88, 0x65, 0xba, 0xfa, 0x03, 0x83, 0x10,
+		0x02, 0x4f, 0x9f, 0xbe, 0x48, 0x5f, 0x86, 0x74, 0x6f, 0x22, 0xa6, 0x60, 0xb0, 0x66, 0xe8, 0xda,
+		0x15, 0x6e, 0x8f, 0x09, 0x45, 0x14, 0x49, 0x42, 0x9e, 0x7d, 0x81, 0x6a, 0xef, 0x88, 0x84, 0xbc,
+		0x28, 0x13, 0xaa, 0x6b, 0x78, 0xd3, 0xd2, 0xc4, 0x68, 0x28, 0xa2, 0x88, 0xce, 0x93, 0x97, 0xa6,
+		0x4a, 0x0d, 0x92, 0x2d, 0x24, 0x1f, 0x9f, 0xdb, 0xe4, 0x9d, 0x0a, 0xfa, 0xcd, 0x52, 0xee, 0xc0,
+		0xad, 0x9b, 0xd3, 0xfb, 0x98, 0xac, 0x41, 0x0c, 0x59, 0x19, 0x13, 0xa0, 0x39, 0x06, 0x21, 0x2d,
+		0x94, 0xb1, 0xad, 0x6a, 0x55, 0x2b, 0xc5, 0x8e, 0x27, 0x45, 0xd1, 0xd3, 0x97, 0x57, 0x13, 0xde,
+		0x14, 0xea, 0x79, 0x48, 0x1a, 0x75, 0x6c, 0xfa, 0xe2, 0x5a, 0x29, 0xd8, 0x72, 0x10, 0x43, 0x56,
+		0xc6, 0x04, 0x48, 0xc4, 0xbc, 0x36, 0x24, 0x9d, 0xe4, 0x43, 0xb1, 0xde, 0xd8, 0x74, 0x33, 0xaf,
+		0x93, 0x4d, 0xa3, 0x91, 0xd5, 0x77, 0x72, 0x0f, 0xb9, 0xdc, 0x83, 0x74, 0xf2, 0x97, 0x3e, 0x75,
+		0x62, 0x92, 0x9b, 0x86, 0x9b, 0x09, 0x25, 0x69, 0xd0, 0x31, 0x07, 0x75, 0x8d, 0x62, 0x92, 0xb0,
+		0xf3, 0x39, 0x55, 0xab, 0x8a, 0x6f, 0xfd, 0x15, 0x5e, 0x42, 0x19, 0x18, 0xb0, 0x6c, 0xd5, 0x6e,
+		0x58, 0xfc, 0x39, 0x4a, 0x39, 0xcc, 0xd4, 0x72, 0x86, 0x5e, 0x2e, 0x50, 0x4c, 0x85, 0x53, 0xa0,
+		0xf3, 0x30, 0x60, 0x1b, 0x57, 0xb0, 0xce, 0x55, 0xd8, 0xd3, 0xfc, 0xa6, 0xa7, 0xa5, 0x8c, 0x9a,
+		0x68, 0xa4, 0x8c, 0xab, 0xb8, 0xc2, 0xc2, 0xaa, 0x6d, 0x95, 0x6c, 0x72, 0xe8, 0xab, 0x94, 0xb9,
+		0xc5, 0x9e, 0x27, 0x21, 0xd7, 0x54, 0x90, 0x9f, 0xac, 0x8c, 0x39, 0xa0, 0x02, 0x85, 0xa0, 0x4b,
+		0xbe, 0x2b, 0xb3, 0xfc, 0xb1, 0xdd, 0xc3, 0x61, 0xdd, 0xf7, 0xd8, 0xb4, 0xc8, 0x84, 0x79, 0xa8,
+		0x89, 0x71, 0x34, 0xf4, 0x4d, 0x43, 0xa7, 0x1f, 0xce, 0xf2, 0xf8, 0x9e, 0x64, 0x12, 0xa2, 0x5e,
+		0xe3, 0x08, 0x62, 0xc8, 0xca, 0x98, 0x03, 0xba, 0x40, 0x21, 0xa8, 0x0c, 0xa3, 0x2e, 0x16, 0x9d,
+		0xa8, 0x89, 0x8e, 0x13, 0xf5, 0x2e, 0x3e, 0x51, 0xf7, 0x04, 0x5b, 0x71, 0xe7, 0xea, 0x88, 0x03,
+		0x24, 0x64, 0xe8, 0x02, 0x80, 0xeb, 0x1e, 0x68, 0xae, 0x62, 0xe8, 0x94, 0xdc, 0xd9, 0xc7, 0xf0,
+		0x8e, 0x7b, 0x68, 0xd1, 0x8f, 0xc3, 0x44, 0x4d, 0xd3, 0x8b, 0x16, 0xae, 0x6e, 0x15, 0xb9, 0x82,
+		0x09, 0x4b, 0xfa, 0xb8, 0x58, 0x6e, 0xa9, 0x37, 0x7b, 0xb8, 0x75, 0x73, 0x3a, 0xcd, 0x5d, 0x68,
+		0x33, 0x4b, 0x59, 0x19, 0xaf, 0x69, 0x7a, 0x01, 0x57, 0xb7, 0xe6, 0x1d, 0x18, 0x7a, 0xa7, 0x04,
+		0x7b, 0xdc, 0x80, 0x9c, 0xf4, 0x50, 0x98, 0x0f, 0x7d, 0x3d, 0x3b, 0xb7, 0xd2, 0xb3, 0xf9, 0x1c,
+		0x64, 0x02, 0xb4, 0x64, 0x2a, 0x2b, 0x13, 0x0e, 0x9c, 0xce, 0x06, 0x66, 0x47, 0x57, 0x60, 0xa4,
+		0xaa, 0x3d, 0xdf, 0xd0, 0x9c, 0xb6, 0xe9, 0x03, 0xdd, 0xb9, 0xf3, 0x3d, 0xb7, 0x3d, 0xc9, 0xda,
+		0xf6, 0x31, 0x93, 0x95, 0x61, 0x56, 0x66, 0x8d, 0x65, 0x86, 0x5f, 0xfe, 0xf0, 0x74, 0x1f, 0x77,
+		0x50, 0x7d, 0xf2, 0x19, 0x7a, 0x2e, 0xc5, 0x1d, 0x0b, 0xb6, 0xc8, 0x2e, 0x4c, 0x15, 0x05, 0x9a,
+		0x2d, 0x4c, 0x28, 0x2e, 0x80, 0x39, 0xb6, 0x97, 0xfe, 0x6c, 0x46, 0x92, 0x7f, 0x55, 0x82, 0x81,
+		0xf9, 0xcb, 0x6b, 0xaa, 0x66, 0xa2, 0x45, 0x18, 0x77, 0xe7, 0x8a, 0xdf, 0xad, 0x1d, 0xbc, 0x75,
+		0x73, 0x3a, 0x15, 0x9c, 0x4e, 0x8e, 0x5f, 0x73, 0xa7, 0xac, 0x70, 0x6c, 0x8b, 0x61, 0x3b, 0x7f,
+		0x1f, 0xab, 0x26, 0x14, 0xb9, 0x39, 0x2f, 0x10, 0xe8, 0x66, 0x1e, 0x06, 0x99, 0xb4, 0xe4, 0xf3,
+		0xf4, 0xfe, 0x3a, 0xf9, 0x87, 0x1f, 0xba, 0x4d, 0x85, 0x4e, 0x57, 0x8a, 0xef, 0x1c, 0x12, 0x10,
+		0x12, 0xf9, 0xbd, 0x11, 0x80, 0xf9, 0xcb, 0x97, 0xd7, 0x4d, 0xad, 0x5e, 0xc5, 0xf6, 0xed, 0xec,
+		0xf9, 0xba, 0xd7, 0x0c, 0x2d, 0xb3, 0x14, 0xe8, 0xfd, 0x4c, 0x2b, 0xc3, 0xf2, 0xa0, 0x79, 0x0d,
+		0xab, 0x60, 0x96, 0x5a, 0x72, 0x2d, 0x5b, 0xb6, 0xc3, 0x35, 0x1a, 0xce, 0xd5, 0x83, 0xe6, 0xe5,
+		0x3a, 0x6f, 0xd9, 0xad, 0x55, 0x5b, 0x80, 0x21, 0x57, 0x25, 0xe4, 0xe5, 0xc3, 0xb8, 0xcd, 0xff,
+		0xe7, 0x1a, 0x96, 0xc3, 0x35, 0x2c, 0xc8, 0xb8, 0x96, 0x1d, 0x4a, 0xf9, 0x77, 0x89, 0xa2, 0xdd,
+		0x59, 0xfa, 0x03, 0x69, 0x62, 0x64, 0xf1, 0xe2, 0xf3, 0x35, 0xba, 0xab, 0xe0, 0x94, 0x53, 0xa3,
+		0xb7, 0xc2, 0xa8, 0xdf, 0x5b, 0xf0, 0x47, 0xab, 0xf7, 0xbb, 0x1e, 0xd9, 0x5f, 0x2f, 0x2b, 0x23,
+		0x3e, 0x37, 0x12, 0x18, 0x91, 0x9f, 0x89, 0x90, 0xb7, 0x40, 0xb8, 0xb7, 0xfe, 0x81, 0xd7, 0xe2,
+		0x1a, 0x0c, 0x62, 0xdd, 0x36, 0x35, 0xaa, 0x46, 0x62, 0x2f, 0x0f, 0x84, 0xd9, 0x4b, 0x8b, 0x3e,
+		0xd1, 0x27, 0xe9, 0xc4, 0x91, 0x18, 0x67, 0x13, 0xd0, 0xc6, 0xcf, 0x45, 0x21, 0x15, 0x46, 0x89,
+		0xe6, 0x60, 0xac, 0x64, 0x62, 0x0a, 0x28, 0x7a, 0xb3, 0xb3, 0xb9, 0xb4, 0x1b, 0x8d, 0x07, 0x10,
+		0x64, 0x65, 0x54, 0x40, 0xf8, 0x8a, 0x5b, 0x01, 0x12, 0x2a, 0x13, 0xc3, 0x25, 0x58, 0x5d, 0xc6,
+		0xc6, 0x32, 0x5f, 0x72, 0x45, 0x23, 0x7e, 0x06, 0x6c, 0xcd, 0x1d, 0x75, 0xa1, 0x74, 0xd1, 0x7d,
+		0x1e, 0xc6, 0x34, 0x5d, 0xb3, 0x35, 0xb5, 0x5a, 0xdc, 0x54, 0xab, 0xaa, 0x5e, 0xda, 0xcd, 0x4e,
+		0x83, 0x2d, 0x93, 0xbc, 0xd9, 0x00, 0x3b, 0x59, 0x19, 0xe5, 0x90, 0x1c, 0x03, 0x90, 0xd7, 0xd0,
+		0x44, 0x53, 0xb1, 0x5d, 0x45, 0x68, 0x82, 0xdc, 0x13, 0x14, 0xff, 0x6c, 0x14, 0xc6, 0x15, 0x5c,
+		0xfe, 0xff, 0x43, 0xd1, 0xdb, 0x50, 0x2c, 0x03, 0x30, 0x87, 0x41, 0x5c, 0x74, 0x2a, 0xb6, 0x2b,
+		0x97, 0x93, 0x60, 0x1c, 0xe6, 0x2d, 0xdb, 0x33, 0x1e, 0x37, 0x23, 0x30, 0xec, 0x1d, 0x8f, 0xbf,
+		0xa2, 0xeb, 0x1a, 0x5a, 0x74, 0x3d, 0x51, 0x8c, 0x3f, 0xe4, 0x1d, 0xe2, 0x89, 0x9a, 0xac, 0xb7,
+		0xbd, 0x0b, 0xfa, 0x28, 0xc0, 0xc0, 0x9a, 0x6a, 0xaa, 0x35, 0x0b, 0x95, 0x9a, 0xa2, 0x73, 0x91,
+		0xb2, 0x6d, 0xfa, 0x81, 0x0d, 0x9e, 0x21, 0xea, 0x10, 0x9c, 0xbf, 0xbf, 0x45, 0x70, 0xfe, 0x56,
+		0x18, 0x25, 0x29, 0x04, 0xcf, 0x05, 0x23, 0xa2, 0xed, 0x11, 0xef, 0x82, 0xe2, 0xaf, 0x67, 0x19,
+		0x86, 0xcb, 0xde, 0x1b, 0x46, 0x43, 0x04, 0xc3, 0x75, 0xcc, 0x84, 0x7c, 0xaf, 0xbb, 0x95, 0xf7,
+		0x54, 0xca, 0x0a, 0xd4, 0xd4, 0xeb, 0x79, 0x56, 0x40, 0x4b, 0x80, 0xb6, 0x9d, 0x6c, 0x52, 0xd1,
+		0x55, 0x27, 0xa1, 0x3f, 0x74, 0xeb, 0xe6, 0xf4, 0x7e, 0x46, 0xdf, 0x8c, 0x23, 0x2b, 0xe3, 0x2e,
+		0x50, 0x70, 0x7b, 0x18, 0x80, 0x46, 0xcf, 0xec, 0xe2, 0x2b, 0xdb, 0x22, 0xee, 0xb9, 0x75, 0x73,
+		0x7a, 0x9c, 0x71, 0x71, 0xeb, 0x64, 0x25, 0x41, 0x0a, 0xf3, 0xe4, 0x7f, 0xb1, 0xa3, 0x08, 0x64,
+		0x42, 0x52, 0x03, 0x3d, 0xef, 0x28, 0x58, 0x50, 0xed, 0xd9, 0x51, 0x04, 0x58, 0xb2, 0x1d, 0x85,
+		0x3f, 0x83, 0x82, 0x5e, 0x95, 0x60, 0x2f, 0xd5, 0xae, 0xe7, 0xee, 0x50, 0x91, 0x0e, 0x25, 0xfb,
+		0xa9, 0x83, 0x9c, 0xd6, 0x9b, 0x04, 0xdf, 0xbc, 0x39, 0x1d, 0xc2, 0xef, 0xd6, 0xcd, 0xe9, 0x43,
+		0x9e, 0xd1, 0x6c, 0xaa, 0x97, 0x95, 0x09, 0x32, 0xaa, 0xee, 0x7d, 0x25, 0x85, 0x40, 0xd1, 0x4d,
+		0x09, 0x8e, 0x34, 0x11, 0x60, 0x7d, 0xcb, 0x30, 0x4b, 0xf4, 0xb2, 0x76, 0xd1, 0xde, 0x36, 0xb1,
+		0xb5, 0x6d, 0x54, 0xcb, 0xec, 0x69, 0xac, 0xdc, 0xbb, 0xa5, 0xde, 0x7c, 0xda, 0x37, 0x6f, 0x4e,
+		0x77, 0xd9, 0xc0, 0xad, 0x9b, 0xd3, 0x27, 0x42, 0x7a, 0xd0, 0x12, 0x5f, 0x56, 0x64, 0x7f, 0x8f,
+		0xf2, 0x2e, 0xd6, 0xba, 0x40, 0x6a, 0xb5, 0xa7, 0xdb, 0x52, 0x4b, 0x36, 0x7f, 0x04, 0xfb, 0xf6,
+		0xed, 0xe9, 0x18, 0xd3, 0xe0, 0x9e, 0xee, 0x3c, 0x85, 0xa2, 0x9f, 0x95, 0x60, 0x7f, 0xa5, 0x6a,
+		0x6c, 0xaa, 0xd5, 0xa2, 0xd8, 0x8e, 0x31, 0x27, 0x52, 0x2c, 0xa9, 0x75, 0xf6, 0x93, 0x15, 0x39,
+		0xa5, 0x67, 0x41, 0x66, 0x98, 0x20, 0xa1, 0x8c, 0x65, 0x65, 0x2f, 0xab, 0x5b, 0x62, 0x5b, 0x3e,
+		0x56, 0x33, 0xa7, 0xd6, 0xd1, 0xcf, 0x4b, 0x70, 0xd0, 0x95, 0xbf, 0x85, 0x48, 0x6c, 0xc3, 0xbd,
+		0xd1, 0xb3, 0x48, 0x87, 0x83, 0xba, 0x69, 0x25, 0xd5, 0x7e, 0xa7, 0x3a, 0x28, 0x98, 0x67, 0x1d,
+		0xfa, 0x88, 0x04, 0xc8, 0x0d, 0xd0, 0x14, 0x6c, 0xd5, 0x0d, 0xdd, 0xa2, 0xa9, 0x06, 0x4f, 0x5e,
+		0x40, 0x6a, 0x9f, 0x6a, 0x70, 0xe9, 0x45, 0xaa, 0xc1, 0xa5, 0x25, 0x0f, 0xef, 0x8b, 0xc5, 0x3a,
+		0xc2, 0xbd, 0x6e, 0x8b, 0x3b, 0xfd, 0xb3, 0xe4, 0xba, 0xbd, 0x70, 0xe8, 0xc1, 0xe8, 0xa5, 0x4f,
+		0xfe, 0x17, 0x12, 0xec, 0x6f, 0xf2, 0xff, 0x8e, 0xb0, 0x7f, 0x0d, 0x90, 0xe9, 0xa9, 0xe4, 0x6f,
+		0x28, 0x33, 0xa1, 0x7b, 0x5e, 0x4e, 0xc6, 0xcd, 0x60, 0xc5, 0x6d, 0x8c, 0xc7, 0xd8, 0x47, 0x01,
+		0xff, 0x54, 0x82, 0x49, 0x6f, 0xf3, 0x4e, 0x47, 0x56, 0x60, 0xd8, 0xdb, 0x3a, 0xef, 0xc2, 0xdd,
+		0xdd, 0x74, 0x81, 0x4b, 0xef, 0xa3, 0x47, 0x4f, 0xba, 0x8b, 0x2b, 0x3b, 0x1d, 0x78, 0xb0, 0x6b,
+		0x6d, 0x08, 0x99, 0x82, 0x8b, 0x6c, 0x8c, 0x8e, 0xc7, 0xff, 0x95, 0x20, 0xb6, 0x66, 0x18, 0x55,
+		0x64, 0xc0, 0xb8, 0x6e, 0xd8, 0x74, 0x6a, 0xe2, 0x72, 0x91, 0xa7, 0x15, 0x59, 0xd4, 0x32, 0xd7,
+		0xb3, 0x03, 0x6b, 0x66, 0xa5, 0x8c, 0xe9, 0x86, 0x9d, 0xa3, 0x90, 0x75, 0x0a, 0x40, 0x3f, 0x0e,
+		0x23, 0xfe, 0xc6, 0x58, 0x4c, 0xf3, 0x54, 0xcf, 0x8d, 0xf9, 0xd9, 0xb8, 0x79, 0x1c, 0x1f, 0x58,
+		0x56, 0x86, 0x37, 0x3d, 0xad, 0xb3, 0xab, 0xd2, 0xdf, 0x26, 0x63, 0xf8, 0x9a, 0x04, 0x13, 0x14,
+		0xa8, 0xbd, 0x80, 0x69, 0x92, 0x47, 0xc1, 0x25, 0xc3, 0x2c, 0xa3, 0x51, 0x88, 0xf0, 0x23, 0xe1,
+		0x98, 0x12, 0xd1, 0xca, 0xe4, 0x7e, 0x80, 0x71, 0x4d, 0xe7, 0x37, 0x17, 0x13, 0x0a, 0x2b, 0xd0,
+		0x60, 0xc1, 0x28, 0x37, 0xaa, 0x98, 0xbc, 0x28, 0x4e, 0xbf, 0x24, 0x61, 0x41, 0x94, 0x37, 0x58,
+		0xf0, 0xd5, 0x93, 0x60, 0x81, 0x02, 0xb2, 0xac, 0x4c, 0x72, 0x46, 0xce, 0xf4, 0xe6, 0xaf, 0x02,
+		0xba, 0x00, 0x66, 0x67, 0xc7, 0x3f, 0x2d, 0x01, 0xb8, 0xf9, 0x5f, 0x72, 0xec, 0x98, 0x5b, 0x5d,
+		0x99, 0x2f, 0x16, 0xd6, 0xb3, 0xeb, 0x1b, 0x85, 0xe2, 0xc6, 0x4a, 0x61, 0x2d, 0x3f, 0xc7, 0x7e,
+		0x2e, 0xc9, 0x39, 0xa4, 0xb4, 0xea, 0xb8, 0x44, 0xdf, 0xa6, 0x46, 0x47, 0x60, 0xd2, 0x8f, 0x4d,
+		0x4a, 0xe4, 0x85, 0xf6, 0xf4, 0xf0, 0x2b, 0x37, 0x66, 0xe2, 0x6c, 0x77, 0x87, 0xc9, 0x65, 0xc2,
+		0x3d, 0xcd, 0x78, 0xe4, 0x61, 0xe3, 0x48, 0x7a, 0xe4, 0x95, 0x1b, 0x33, 0x09, 0x67, 0x1b, 0x88,
+		0x64, 0x40, 0x5e, 0x4c, 0xce, 0x2f, 0x9a, 0x86, 0x57, 0x6e, 0xcc, 0x0c, 0xb0, 0x41, 0x4e, 0xc7,
+		0xc8, 0x51, 0x64, 0xee, 0x7c, 0xe8, 0x31, 0xe4, 0xfd, 0x6d, 0xc7, 0xf7, 0xba, 0x73, 0xb4, 0xe8,
+		0x3f, 0x7b, 0xfc, 0x2e, 0x84, 0x9e, 0x3d, 0x56, 0xb0, 0x8e, 0x2d, 0xcd, 0xda, 0xd5, 0xd9, 0x63,
+		0x57, 0xe7, 0x99, 0xf2, 0xcd, 0x38, 0x0c, 0x2f, 0xb0, 0x56, 0xd8, 0x4f, 0x57, 0xbd, 0x85, 0xbc,
+		0x4e, 0x4e, 0x02, 0x53, 0xe7, 0x32, 0x43, 0xc8, 0xa4, 0x64, 0xe1, 0xab, 0x73, 0x77, 0x9b, 0x96,
+		0x90, 0xc5, 0x2f, 0x6f, 0xb2, 0x3b, 0xe5, 0xee, 0x2d, 0xe9, 0xe1, 0xdc, 0x62, 0xcf, 0xbb, 0x20,
+		0x9e, 0xe0, 0x0e, 0xf2, 0x93, 0xd9, 0x3d, 0xd0, 0x75, 0x02, 0x61, 0xb7, 0xc1, 0x7f, 0x52, 0x82,
+		0x3d, 0x14, 0xcb, 0x5d, 0x71, 0x28, 0xa6, 0x48, 0x1f, 0x1c, 0x0f, 0xeb, 0xc2, 0x92, 0x6a, 0xb9,
+		0x77, 0x3b, 0x29, 0xaf, 0xdc, 0xdd, 0x3c, 0xb4, 0x3e, 0xe8, 0x69, 0x3c, 0xc8, 0x56, 0x56, 0x26,
+		0xaa, 0x4d, 0x94, 0x16, 0x5a, 0xf0, 0x5d, 0xe0, 0x8f, 0xf5, 0x76, 0xe0, 0xe9, 0x21, 0x45, 0x17,
+		0x61, 0xc8, 0xf5, 0x77, 0x16, 0xff, 0x25, 0xc1, 0xee, 0xd7, 0x37, 0x2f, 0x31, 0xfa, 0x69, 0x09,
+		0xf6, 0xb8, 0xfb, 0x03, 0x2f, 0x5b, 0xf6, 0x8b, 0x8b, 0xf7, 0xf5, 0x90, 0x5a, 0x09, 0x2a, 0xa7,
+		0x25, 0x5f, 0x59, 0x99, 0x74, 0xe0, 0xf3, 0x1e, 0x41, 0xd6, 0xc8, 0x2f, 0x07, 0x79, 0xdb, 0x17,
+		0xaf, 0xe7, 0x76, 0xbf, 0x7c, 0xf8, 0x19, 0xb0, 0xdf, 0x14, 0xab, 0x1b, 0xa6, 0x8d, 0x59, 0x58,
+		0x1a, 0x57, 0x9c, 0x32, 0x7a, 0x59, 0x82, 0xbd, 0x36, 0x77, 0x80, 0x2c, 0xeb, 0x5d, 0x34, 0xa9,
+		0x0b, 0xb4, 0x52, 0x89, 0xf6, 0xfd, 0x6e, 0xe1, 0x36, 0x73, 0xf7, 0xf0, 0x7e, 0xf3, 0xd8, 0xba,
+		0x35, 0x63, 0x59, 0x99, 0xb4, 0x9b, 0x69, 0x2d, 0xf4, 0x1c, 0x1c, 0xe2, 0x26, 0xdc, 0x82, 0x8a,
+		0xdc, 0xd0, 0x21, 0x91, 0x5f, 0x2c, 0x77, 0xec, 0xd6, 0xcd, 0xe9, 0xbb, 0x7d, 0x16, 0xdf, 0x1a,
+		0x5d, 0x56, 0xf6, 0x33, 0xf3, 0x6f, 0x6a, 0x6a, 0xb1, 0x8c, 0xde, 0x23, 0x41, 0x9a, 0x4d, 0x15,
+		0x4f, 0xc8, 0xe5, 0xae, 0x46, 0xf4, 0x16, 0x72, 0xae, 0xd0, 0xf3, 0x4c, 0xbc, 0x4b, 0xf4, 0x3b,
+		0x8c, 0xb3, 0xac, 0xec, 0xa3, 0x95, 0x6e, 0x28, 0x27, 0x16, 0x25, 0x79, 0x05, 0x50, 0xf3, 0x34,
+		0x0b, 0x7e, 0x3a, 0x92, 0x70, 0x3f, 0x1d, 0x99, 0x84, 0x7e, 0xef, 0xc7, 0x15, 0xac, 0x90, 0x89,
+		0xbf, 0xcc, 0x83, 0xad, 0xdb, 0xee, 0x7d, 0xff, 0xf0, 0x28, 0x4c, 0x87, 0x78, 0x4a, 0xfb, 0x7a,
+		0x07, 0xc7, 0xdb, 0xe6, 0x7a, 0x47, 0xc7, 0xeb, 0x1b, 0x21, 0x17, 0x46, 0x76, 0x7f, 0xa9, 0xa3,
+		0x3b, 0x7f, 0xff, 0x07, 0x31, 0x40, 0xcb, 0x56, 0x65, 0xce, 0xc4, 0xec, 0x5d, 0x63, 0x7e, 0x0e,
+		0x1d, 0x38, 0xb7, 0x94, 0xde, 0xd4, 0xb9, 0xe5, 0xb2, 0xef, 0x24, 0x30, 0xd2, 0xdb, 0x6d, 0x83,
+		0xae, 0x8f, 0x03, 0xa3, 0xdf, 0x9b, 0xe3, 0xc0, 0x96, 0x99, 0xaf, 0xd8, 0xed, 0x4b, 0x91, 0xf7,
+		0xef, 0xf6, 0xa0, 0x81, 0x9f, 0xf2, 0x0f, 0xb4, 0x39, 0xe5, 0x4f, 0x85, 0x1e, 0xe5, 0x73, 0x6a,
+		0x74, 0x5a, 0x3c, 0x9b, 0x30, 0xd8, 0xdd, 0xee, 0x87, 0x61, 0xbb, 0xd3, 0x51, 0x3e, 0x08, 0xe9,
+		0x66, 0x73, 0x12, 0xe1, 0xb9, 0xfc, 0xbe, 0x28, 0x24, 0x97, 0xad, 0x4a, 0xbe, 0xac, 0xd9, 0x77,
+		0xc8, 0xd6, 0x9e, 0x08, 0x3f, 0x76, 0x40, 0xb7, 0x6e, 0x4e, 0x8f, 0x32, 0x9d, 0xb6, 0xd1, 0x64,
+		0x0d, 0xc6, 0x82, 0x69, 0x21, 0x66, 0x59, 0xf3, 0xbb, 0xb9, 0xa7, 0xd3, 0x94, 0x0e, 0x1a, 0xf5,
+		0x5f, 0x99, 0x41, 0xd7, 0x5b, 0x1b, 0x33, 0x33, 0xa8, 0x0b, 0x77, 0xd0, 0x90, 0x3d, 0x63, 0x96,
+		0x86, 0x54, 0x70, 0x50, 0x9c, 0x11, 0x7b, 0x43, 0x82, 0xa1, 0x65, 0x4b, 0xac, 0xdc, 0xf8, 0x07,
+		0xf4, 0x84, 0xe8, 0x11, 0xe7, 0x1b, 0xf7, 0x68, 0x77, 0x76, 0xeb, 0xfd, 0xee, 0xbd, 0x4f, 0xde,
+		0x03, 0x13, 0x9e, 0x3e, 0x3a, 0x7d, 0xff, 0x52, 0x84, 0xfa, 0xc6, 0x1c, 0xae, 0x68, 0xba, 0x13,
+		0x7e, 0xe0, 0xbf, 0xaa, 0xb9, 0x6f, 0x57, 0xc7, 0xb1, 0xdd, 0xe8, 0xf8, 0x0a, 0xa4, 0x9b, 0x75,
+		0xe9, 0xe4, 0x12, 0x96, 0x9b, 0x4f, 0x65, 0xa4, 0x1e, 0x2e, 0x89, 0x07, 0xce, 0x5e, 0xe4, 0xaf,
+		0x4b, 0x30, 0xb2, 0x6c, 0x55, 0x36, 0xf4, 0xf2, 0x8f, 0xb4, 0xdd, 0x6e, 0xc1, 0x1e, 0x5f, 0x2f,
+		0xef, 0x94, 0x3a, 0x7f, 0x3b, 0x02, 0x07, 0x89, 0x57, 0x27, 0x59, 0xa1, 0xea, 0x0f, 0xcf, 0xb9,
+		0xf1, 0x6e, 0xb5, 0xdb, 0xea, 0xa0, 0x31, 0xd6, 0xeb, 0x41, 0x23, 0x1f, 0xa2, 0x23, 0x70, 0x77,
+		0x3b, 0xcd, 0x39, 0xbe, 0xe6, 0x9f, 0x44, 0x60, 0x9c, 0xdc, 0xcf, 0xf6, 0x86, 0xf1, 0xd6, 0x8f,
+		0x9a, 0x5e, 0xd7, 0x61, 0x8f, 0xd8, 0xc6, 0xf0, 0xbb, 0x47, 0x45, 0x96, 0x6e, 0x8a, 0x05, 0x1d,
+		0x53, 0x4b, 0x34, 0x59, 0x99, 0x70, 0xe0, 0x54, 0x41, 0xab, 0x04, 0xca, 0x15, 0xbd, 0x0e, 0xfb,
+		0x9b, 0xf4, 0xe7, 0xcc, 0x07, 0x57, 0x62, 0xa9, 0x27, 0x89, 0xe5, 0x8f, 0x4a, 0x74, 0x6d, 0x24,
+		0x1e, 0x0b, 0xd7, 0x28, 0x73, 0xeb, 0xbc, 0x61, 0xde, 0xfe, 0xd1, 0x79, 0xc4, 0xf7, 0x48, 0x4b,
+		0xcf, 0x8e, 0xe0, 0x6d, 0x30, 0x13, 0x26, 0xe5, 0x9b, 0xd7, 0xc1, 0xbf, 0x96, 0x60, 0x8a, 0xa8,
+		0xd6, 0x54, 0x75, 0x6b, 0x0b, 0x9b, 0xad, 0xf2, 0x88, 0x6f, 0x87, 0x54, 0xe8, 0x76, 0x96, 0x66,
+		0x17, 0x73, 0x87, 0x6f, 0xdd, 0x9c, 0x9e, 0x6e, 0xb3, 0x5d, 0xa6, 0x3b, 0xd9, 0x3d, 0x76, 0xcb,
+		0x5d, 0xec, 0x5e, 0x18, 0xb0, 0xe8, 0xf5, 0x72, 0x9e, 0x96, 0xe4, 0x25, 0xf4, 0x20, 0x24, 0x74,
+		0x7c, 0x8d, 0x9b, 0x10, 0x5b, 0xdb, 0x26, 0x6f, 0xdd, 0x9c, 0x4e, 0xb2, 0x66, 0x9c, 0x2a, 0x59,
+		0x89, 0xeb, 0xf8, 0x9a, 0xd7, 0x56, 0x8e, 0xc1, 0x91, 0xf6, 0x1d, 0x72, 0xa6, 0xe5, 0xaf, 0x4b,
+		0x34, 0x60, 0xbd, 0xec, 0x3d, 0xbe, 0xf9, 0x01, 0xbd, 0xce, 0x16, 0xf3, 0xc4, 0x72, 0x3e, 0x79,
+		0x45, 0x67, 0x4e, 0x7d, 0x2c, 0x01, 0xd1, 0x65, 0xab, 0x42, 0x6e, 0x26, 0x04, 0xf7, 0x7b, 0xa1,
+		0x29, 0xb1, 0xe6, 0x60, 0x3e, 0x7d, 0xaa, 0x7b, 0x5c, 0xc7, 0xf8, 0xae, 0xc0, 0x88, 0x3f, 0xe8,
+		0x3f, 0xd6, 0x86, 0x89, 0x0f, 0x33, 0xfd, 0x40, 0xb7, 0x98, 0x4e, 0x63, 0x6f, 0x27, 0x6f, 0x13,
+		0xf1, 0x75, 0xff, 0x70, 0x1b, 0x6a, 0x81, 0x94, 0xbe, 0xaf, 0x0b, 0x24, 0x87, 0xfb, 0xf3, 0x30,
+		0x16, 0x8c, 0x08, 0xdb, 0x69, 0x2f, 0x80, 0x9b, 0x3e, 0xd5, 0x3d, 0xae, 0xd3, 0xe4, 0x26, 0x80,
+		0x27, 0x94, 0xb9, 0xa7, 0x0d, 0x07, 0x17, 0x2d, 0x7d, 0xa2, 0x2b, 0x34, 0xa7, 0x8d, 0x9f, 0x93,
+		0x60, 0x7f, 0xf8, 0x02, 0xff, 0x70, 0xbb, 0x31, 0x0f, 0xa3, 0x4a, 0xbf, 0x65, 0x37, 0x54, 0x8e,
+		0x44, 0x3a, 0x8c, 0x06, 0x96, 0xc3, 0x7b, 0xdb, 0xf0, 0xf3, 0xa3, 0xa6, 0x1f, 0xec, 0x1a, 0xd5,
+		0x69, 0xef, 0x5d, 0x12, 0xec, 0x69, 0xed, 0xe8, 0xdb, 0x99, 0x60, 0x4b, 0x8a, 0xf4, 0xd9, 0x5e,
+		0x29, 0x1c, 0x29, 0xfe, 0xae, 0x04, 0x07, 0xda, 0xb9, 0xda, 0x33, 0xed, 0x3a, 0x16, 0x4e, 0x97,
+		0x7e, 0x7c, 0x77, 0x74, 0xde, 0x19, 0xec, 0xf7, 0x82, 0xed, 0x66, 0xb0, 0x0f, 0x33, 0xfd, 0x40,
+		0xb7, 0x98, 0xce, 0x31, 0xde, 0xed, 0x4e, 0xea, 0x7d, 0x25, 0x02, 0xc7, 0xbd, 0xe9, 0xb2, 0xe7,
+		0x1b, 0xd8, 0xdc, 0x71, 0x32, 0x62, 0x75, 0xb5, 0xa2, 0xe9, 0xde, 0xc7, 0x17, 0xf7, 0x7b, 0x57,
+		0x46, 0x8a, 0x2b, 0x44, 0x96, 0x5f, 0x96, 0x60, 0x68, 0x4d, 0xad, 0x60, 0x05, 0x3f, 0xdf, 0xc0,
+		0x96, 0xdd, 0xe2, 0x41, 0x2f, 0xf2, 0xd8, 0xd6, 0xd6, 0x96, 0xf8, 0x70, 0x33, 0xa6, 0xf0, 0x12,
+		0x49, 0x64, 0x56, 0x35, 0xf2, 0x71, 0x69, 0x94, 0x82, 0x59, 0x81, 0xfc, 0x84, 0x14, 0x3d, 0x22,
+		0x63, 0x27, 0x1a, 0xec, 0x5a, 0x27, 0xc9, 0x76, 0x35, 0x74, 0x76, 0xa2, 0x41, 0x32, 0xa3, 0x26,
+		0x26, 0x4f, 0x79, 0xb0, 0xdf, 0x3d, 0x8b, 0x2b, 0xa2, 0x28, 0x3f, 0x01, 0xc3, 0x4c, 0x12, 0x3e,
+		0x42, 0xfb, 0x21, 0x4e, 0x1f, 0xae, 0x70, 0xe5, 0x19, 0x24, 0xe5, 0x4b, 0xec, 0x59, 0x30, 0xc6,
+		0x9f, 0x89, 0xc4, 0x0a, 0xb9, 0x5c, 0xa8, 0x96, 0x8f, 0x75, 0x4e, 0x3a, 0x30, 0x1d, 0x3a, 0x1a,
+		0xfe, 0xfd, 0x7e, 0xd8, 0xc3, 0xb6, 0x16, 0x27, 0xd5, 0xba, 0x76, 0x72, 0xdb, 0xb6, 0xc5, 0x93,
+		0x8d, 0xc0, 0xc0, 0xb3, 0x6a, 0x5d, 0x93, 0x77, 0x20, 0x76, 0xc1, 0xb6, 0xeb, 0xe8, 0x38, 0xf4,
+		0x9b, 0x8d, 0x2a, 0x16, 0xf7, 0x7f, 0x9d, 0x6c, 0x95, 0x5a, 0xd7, 0x66, 0x09, 0x82, 0xd2, 0xa8,
+		0x62, 0x85, 0xa1, 0xa0, 0x3c, 0x4c, 0x6f, 0x35, 0xaa, 0xd5, 0x9d, 0x62, 0x19, 0x97, 0x8c, 0x32,
+		0x89, 0x17, 0xe8, 0xcf, 0xbc, 0x97, 0x8b, 0xf8, 0x7a, 0x5d, 0xd5, 0x9d, 0x94, 0x62, 0x5c, 0x39,
+		0x48, 0xd1, 0xe6, 0x29, 0x96, 0xc2, 0x91, 0xf2, 0x02, 0x47, 0xfe, 0xd3, 0x08, 0xc4, 0x05, 0x6b,
+		0xfa, 0x4e, 0x17, 0xae, 0x62, 0x7a, 0xe3, 0x43, 0xe2, 0xef, 0x74, 0xf1, 0x32, 0x42, 0x10, 0xad,
+		0xf0, 0xc1, 0x4b, 0x5c, 0xe8, 0x53, 0x48, 0x81, 0xc0, 0x9c, 0xd7, 0xd3, 0x08, 0x8c, 0x3c, 0xaa,
+		0x36, 0x09, 0xb1, 0xba, 0x21, 0xae, 0xd9, 0x5d, 0xe8, 0x53, 0x68, 0x09, 0xa5, 0x60, 0x80, 0xb8,
+		0x56, 0x9b, 0x8d, 0x16, 0x81, 0xf3, 0x32, 0xda, 0x4b, 0x6e, 0x95, 0xdb, 0x25, 0xf6, 0x78, 0x09,
+		0xa9, 0x60, 0x45, 0x12, 0x97, 0xb1, 0x07, 0x7b, 0x53, 0x71, 0xfe, 0xb8, 0x8b, 0x47, 0x19, 0xec,
+		0x97, 0x91, 0x88, 0xdc, 0x6b, 0xaa, 0x6d, 0x63, 0x53, 0x27, 0x0c, 0x19, 0x3a, 0xf9, 0xec, 0x75,
+		0xd3, 0x28, 0xb3, 0x9f, 0x5f, 0x4e, 0x28, 0xf4, 0x7f, 0xf2, 0x38, 0x88, 0xc9, 0xed, 0xa1, 0x48,
+		0x2b, 0xe9, 0x37, 0x0a, 0xca, 0xb0, 0x00, 0xe6, 0x08, 0x52, 0x1e, 0x26, 0xd4, 0x72, 0x59, 0x23,
+		0x06, 0x4f, 0x6e, 0x13, 0x6a, 0xd4, 0x01, 0x93, 0xd3, 0x80, 0xf0, 0xb1, 0x40, 0x2e, 0x41, 0x8e,
+		0xe3, 0xe7, 0x12, 0x30, 0x58, 0x67, 0x42, 0xc9, 0xe7, 0x60, 0xbc, 0x49, 0x52, 0x22, 0xdf, 0x15,
+		0x4d, 0x2f, 0x8b, 0x27, 0xe5, 0xc8, 0xff, 0x04, 0x46, 0x7f, 0x49, 0x8d, 0x05, 0x72, 0xf4, 0xff,
+		0xdc, 0x3b, 0xc3, 0x5f, 0xe1, 0x1c, 0xf5, 0xbc, 0xc2, 0xa9, 0xd6, 0xb5, 0x5c, 0x82, 0xf2, 0xe7,
+		0x6f, 0x6f, 0x66, 0x9b, 0xdf, 0xde, 0xac, 0x60, 0x5d, 0xa4, 0xd0, 0x49, 0x95, 0x5a, 0xd7, 0x2c,
+		0x6a, 0x8e, 0xee, 0x4f, 0xbb, 0x59, 0xe7, 0x3c, 0xff, 0xd3, 0xa7, 0x38, 0x63, 0x0b, 0xd9, 0xb5,
+		0x45, 0xc7, 0x8e, 0x3f, 0x17, 0x81, 0x83, 0x1e, 0x3b, 0xf6, 0x20, 0x37, 0x9b, 0x73, 0xba, 0xb5,
+		0xc5, 0x77, 0xf1, 0x04, 0xe7, 0x25, 0x88, 0x11, 0x7c, 0x34, 0xd5, 0xfe, 0x27, 0x2a, 0x53, 0x9f,
+		0xf8, 0xd2, 0x3f, 0x96, 0x67, 0xa4, 0xd0, 0x51, 0xa1, 0x4c, 0x72, 0x3f, 0xdd, 0xbd, 0xfe, 0x92,
+		0xee, 0x4f, 0xc6, 0x59, 0xb7, 0x4f, 0x8d, 0x41, 0x1d, 0xbe, 0x91, 0x05, 0x39, 0xe4, 0xf0, 0x81,
+		0x39, 0xd3, 0xf6, 0xa7, 0x28, 0x3d, 0x78, 0xea, 0xb0, 0x97, 0xd6, 0xda, 0x8d, 0x60, 0x97, 0x07,
+		0x23, 0xd7, 0x61, 0xef, 0x93, 0xa4, 0x6d, 0xf7, 0xca, 0xa3, 0x70, 0xf9, 0x7b, 0x9d, 0xaf, 0xd9,
+		0x24, 0xbe, 0x1d, 0x11, 0x5f, 0xaa, 0x81, 0x2b, 0x1f, 0xdf, 0xc7, 0x1d, 0x99, 0x0d, 0x5d, 0x4a,
+		0x66, 0x3d, 0xcb, 0x88, 0xe2, 0xa1, 0x94, 0x7f, 0x45, 0x82, 0x7d, 0x4d, 0x4d, 0x73, 0x1f, 0xbf,
+		0xd0, 0xe2, 0x51, 0xb8, 0x5d, 0x9d, 0x29, 0x2f, 0xb4, 0x10, 0xf6, 0x68, 0x47, 0x61, 0x99, 0x14,
+		0x3e, 0x69, 0x1f, 0x87, 0x3d, 0x7e, 0x61, 0x85, 0x9a, 0xee, 0x81, 0x51, 0xff, 0xbe, 0x85, 0xab,
+		0x6b, 0xc4, 0xb7, 0x73, 0x91, 0x8b, 0x41, 0x3d, 0x3b, 0x7d, 0xcd, 0x7b, 0x2f, 0x8d, 0xb0, 0x3d,
+		0x6b, 0xd7, 0x5d, 0x75, 0x29, 0xe5, 0xf7, 0x4a, 0x30, 0xe3, 0x6f, 0xc1, 0x73, 0x0c, 0xdd, 0x9b,
+		0xb0, 0xb7, 0x6d, 0x88, 0xbf, 0x21, 0xc1, 0x5d, 0x6d, 0x64, 0xe2, 0x0a, 0x78, 0x01, 0x26, 0x3d,
+		0xf7, 0xc4, 0x84, 0x0b, 0x17, 0xc3, 0x7e, 0xbc, 0xf3, 0x05, 0x00, 0x27, 0x9e, 0x3a, 0x40, 0x94,
+		0xf2, 0xf1, 0xaf, 0x4c, 0x4f, 0x34, 0xd7, 0x59, 0xca, 0x44, 0xf3, 0xdd, 0xae, 0xdb, 0x68, 0x1f,
+		0x1f, 0x94, 0xe0, 0x5e, 0x7f, 0x57, 0x5b, 0xc4, 0xfc, 0xdf, 0xaf, 0x71, 0xf8, 0x13, 0x09, 0x8e,
+		0x77, 0x23, 0x9c, 0xb3, 0x0f, 0x9b, 0x70, 0xef, 0x38, 0x04, 0xc7, 0xa3, 0xa7, 0x9b, 0x13, 0xfc,
+		0xcd, 0x1c, 0x87, 0xdb, 0x1d, 0x50, 0x7c, 0x9d, 0x4f, 0x2c, 0xef, 0x90, 0x3b, 0x4a, 0xf6, 0x27,
+		0x27, 0x84, 0x92, 0x7d, 0xe9, 0x89, 0x16, 0x63, 0x11, 0x69, 0x31, 0x16, 0x9e, 0x73, 0xa4, 0xab,
+		0xb0, 0xaf, 0xa9, 0x45, 0xae, 0xb9, 0xb7, 0xc1, 0x44, 0x0b, 0x53, 0xe6, 0xb3, 0xba, 0x07, 0x4b,
+		0x56, 0x50, 0xb3, 0xb1, 0xca, 0x3b, 0x30, 0x4d, 0xdb, 0x6d, 0xb9, 0x99, 0xbc, 0xb3, 0x5d, 0xae,
+		0xc1, 0x4c, 0x78, 0xd3, 0xbc, 0xef, 0x8b, 0x30, 0xc0, 0xc6, 0x99, 0x77, 0x77, 0x17, 0x86, 0xc2,
+		0x19, 0xc8, 0xbf, 0x28, 0x7c, 0xd9, 0xbc, 0x10, 0xbb, 0xf5, 0x1c, 0xea, 0xa6, 0xaf, 0xb7, 0x69,
+		0x0e, 0x79, 0x94, 0xf1, 0x65, 0xe1, 0xd5, 0x5a, 0x4b, 0xc7, 0xd5, 0x51, 0xba, 0x6d, 0x5e, 0x8d,
+		0xe9, 0xe6, 0xce, 0xba, 0xaf, 0x8f, 0x0a, 0xf7, 0xe5, 0xf4, 0xa9, 0x83, 0xfb, 0xfa, 0xfe, 0xa8,
+		0xde, 0x71, 0x64, 0x1d, 0xc4, 0xfc, 0x61, 0x74, 0x64, 0xdf, 0x96, 0x60, 0x3f, 0xed, 0x9b, 0xf7,
+		0x0a, 0x58, 0xaf, 0x2a, 0xbf, 0x1f, 0x10, 0x39, 0x38, 0x6d, 0x39, 0xbb, 0x93, 0x96, 0x59, 0xba,
+		0xec, 0x5b, 0x5f, 0xee, 0x07, 0x54, 0xb6, 0xec, 0x20, 0x36, 0x7b, 0x25, 0x22, 0x59, 0xb6, 0x6c,
+		0x3f, 0xb6, 0x7f, 0x38, 0x63, 0xb7, 0x61, 0x38, 0x5f, 0x97, 0x20, 0xdd, 0xaa, 0xcb, 0x7c, 0xf8,
+		0x34, 0xd8, 0xeb, 0xbb, 0x42, 0x1e, 0x1c, 0xc1, 0xfb, 0xbb, 0xb9, 0x44, 0x17, 0x98, 0x46, 0x7b,
+		0x4c, 0x7c, 0xa7, 0xe3, 0x80, 0x69, 0xbf, 0x85, 0x36, 0x47, 0xd6, 0xdf, 0xb7, 0xe9, 0xf3, 0xa9,
+		0x26, 0xbf, 0xfa, 0x43, 0x11, 0x7b, 0x5f, 0x87, 0xa9, 0x10, 0xa9, 0xef, 0xf4, 0xba, 0xb7, 0x1d,
+		0x3a, 0x98, 0xb7, 0x3b, 0x7c, 0x7f, 0x98, 0xcf, 0x04, 0xff, 0x0b, 0x44, 0x9e, 0xbd, 0x58, 0xab,
+		0x27, 0x13, 0xe5, 0x67, 0xe0, 0x40, 0x4b, 0x2a, 0x2e, 0x5b, 0x06, 0x62, 0xe4, 0x53, 0xb2, 0x94,
+		0xe4, 0xb7, 0x9d, 0xa0, 0x58, 0x01, 0x6a, 0x4a, 0x23, 0x23, 0x48, 0x52, 0xd6, 0xe4, 0x8b, 0x02,
+		0x2e, 0x86, 0x7c, 0x09, 0xc6, 0x3d, 0x30, 0xde, 0xc8, 0x19, 0x92, 0x20, 0x32, 0xaa, 0xce, 0x8b,
+		0xd2, 0x61, 0xf7, 0xa6, 0x0d, 0xa3, 0xca, 0xbb, 0x4d, 0xf1, 0xe5, 0x49, 0x40, 0x8c, 0x19, 0xbd,
+		0x42, 0x2d, 0x9a, 0x28, 0xc0, 0x84, 0x0f, 0xca, 0x1b, 0x79, 0x53, 0xd7, 0xb3, 0xe5, 0xd3, 0x70,
+		0x98, 0x32, 0x6d, 0x75, 0xc9, 0x75, 0x67, 0xb1, 0x2c, 0xb4, 0x1c, 0xf8, 0x4c, 0x40, 0x7e, 0x1e,
+		0xee, 0x6e, 0x4f, 0xe6, 0x46, 0x3e, 0xec, 0x74, 0xaf, 0x53, 0xe4, 0xd3, 0x8a, 0x11, 0x97, 0x94,
+		0x31, 0x90, 0x1f, 0x87, 0x23, 0xe1, 0x4d, 0xd2, 0x8f, 0xfa, 0x84, 0xb0, 0x2d, 0x7f, 0x05, 0x43,
+		0xb6, 0xe1, 0x68, 0x47, 0xfa, 0xdb, 0x2f, 0xf5, 0x63, 0x70, 0x4f, 0x58, 0xab, 0x16, 0x39, 0x90,
+		0x2c, 0x7b, 0x84, 0x66, 0xc7, 0x98, 0x92, 0xe7, 0xc3, 0x0b, 0xb9, 0x01, 0x47, 0x3a, 0x91, 0x73,
+		0x99, 0x2f, 0x91, 0x2c, 0x31, 0x85, 0x77, 0x5a, 0xc4, 0xc3, 0x85, 0x16, 0x1c, 0xe4, 0x69, 0x38,
+		0xc4, 0x9b, 0x0d, 0x5c, 0xe1, 0x15, 0xb6, 0xb8, 0x0d, 0x53, 0x61, 0x08, 0x5c, 0x1e, 0xf7, 0xd5,
+		0x1e, 0xe9, 0xcd, 0xbc, 0xda, 0x73, 0xea, 0xf3, 0x07, 0xa1, 0x9f, 0x36, 0x85, 0x3e, 0x20, 0x01,
+		0x78, 0x3e, 0x3f, 0x9d, 0x0d, 0xeb, 0x5f, 0xeb, 0xa4, 0x4d, 0xfa, 0x64, 0xd7, 0xf8, 0x7c, 0x53,
+		0x71, 0xfc, 0x9d, 0xff, 0xe6, 0xeb, 0xef, 0x8b, 0xdc, 0x8d, 0xe4, 0x93, 0x21, 0xe9, 0x22, 0x8f,
+		0x43, 0xff, 0x98, 0xef, 0x19, 0xfc, 0x13, 0xdd, 0x35, 0x25, 0x24, 0x9b, 0xed, 0x16, 0x9d, 0x0b,
+		0x76, 0x8e, 0x0a, 0x76, 0x1a, 0x3d, 0xd4, 0x59, 0xb0, 0x93, 0xef, 0xf0, 0x7b, 0xf5, 0x17, 0xd1,
+		0xbf, 0x95, 0x60, 0xb2, 0x55, 0xce, 0x01, 0x9d, 0xed, 0x4e, 0x8a, 0xe6, 0x98, 0x37, 0xfd, 0xe8,
+		0x2e, 0x28, 0x79, 0x57, 0x16, 0x68, 0x57, 0xb2, 0xe8, 0x89, 0x5d, 0x74, 0xe5, 0xa4, 0xf7, 0xea,
+		0xff, 0x5f, 0x4a, 0x70, 0xa8, 0xed, 0x16, 0x1e, 0x65, 0xbb, 0x93, 0xb2, 0x4d, 0x70, 0x9f, 0xce,
+		0xbd, 0x19, 0x16, 0xbc, 0xc7, 0x4f, 0xd2, 0x1e, 0x5f, 0x42, 0x8b, 0xbb, 0xe9, 0x71, 0xcb, 0xef,
+		0x2b, 0xd0, 0xe7, 0x25, 0xdf, 0x43, 0x28, 0xed, 0xcd, 0xa9, 0x69, 0x67, 0x9c, 0x3e, 0xd9, 0x35,
+		0x3e, 0xef, 0xc2, 0xd3, 0xb4, 0x0b, 0x0a, 0x5a, 0x7b, 0x93, 0x83, 0x76, 0xf2, 0x1d, 0xfe, 0xc8,
+		0xe4, 0x45, 0xf4, 0xbf, 0xa4, 0xd6, 0xaf, 0x92, 0x3c, 0xd2, 0x56, 0xc4, 0xf0, 0x5d, 0x7f, 0xfa,
+		0x6c, 0xef, 0x84, 0xbc, 0x93, 0x35, 0xda, 0xc9, 0x0a, 0xc2, 0xb7, 0xbb, 0x93, 0x2d, 0x07, 0x11,
+		0x7d, 0x51, 0x82, 0xc9, 0x56, 0x9b, 0xe6, 0x0e, 0xd3, 0xb2, 0x4d, 0x16, 0xa0, 0xc3, 0xb4, 0x6c,
+		0xb7, 0x43, 0x97, 0xdf, 0x42, 0x3b, 0x7f, 0x06, 0x3d, 0x1c, 0xd6, 0xf9, 0xb6, 0xa3, 0x48, 0xe6,
+		0x62, 0xdb, 0x5d, 0x68, 0x87, 0xb9, 0xd8, 0xcd, 0x46, 0xbb, 0xc3, 0x5c, 0xec, 0x6a, 0x13, 0xdc,
+		0x79, 0x2e, 0x3a, 0x3d, 0xeb, 0x72, 0x18, 0x2d, 0xf4, 0x39, 0x09, 0x46, 0x7c, 0x5b, 0x36, 0xf4,
+		0x60, 0x5b, 0x41, 0x5b, 0xed, 0x68, 0xd3, 0xa7, 0x7a, 0x21, 0xe1, 0x7d, 0x59, 0xa4, 0x7d, 0x99,
+		0x43, 0xd9, 0xdd, 0xf4, 0xc5, 0xff, 0x19, 0xd5, 0xeb, 0x12, 0x4c, 0xb4, 0xd8, 0x06, 0x75, 0x98,
+		0x85, 0xe1, 0xbb, 0xba, 0xf4, 0xd9, 0xde, 0x09, 0x79, 0xaf, 0xce, 0xd3, 0x5e, 0xbd, 0x15, 0x3d,
+		0xbe, 0x9b, 0x5e, 0x79, 0xd6, 0xe7, 0x9b, 0xee, 0x67, 0xe3, 0x9e, 0x76, 0xd0, 0x99, 0x1e, 0x05,
+		0x13, 0x1d, 0x7a, 0xa4, 0x67, 0x3a, 0xde, 0x9f, 0xa7, 0x68, 0x7f, 0x9e, 0x44, 0xab, 0x6f, 0xae,
+		0x3f, 0xcd, 0xcb, 0xfa, 0x27, 0x9b, 0x9f, 0x68, 0x6d, 0x6f, 0x45, 0x2d, 0x77, 0x53, 0xe9, 0x87,
+		0x7a, 0xa2, 0xe1, 0x9d, 0x3a, 0x4b, 0x3b, 0x75, 0x0a, 0x3d, 0x10, 0xd6, 0x29, 0xcf, 0x4b, 0x1e,
+		0x9a, 0xbe, 0x65, 0x9c, 0x7c, 0x07, 0xdb, 0xa3, 0xbd, 0x88, 0x7e, 0x42, 0x7c, 0x97, 0x7d, 0xac,
+		0x6d, 0xbb, 0x9e, 0x8d, 0x56, 0xfa, 0xde, 0x2e, 0x30, 0xb9, 0x5c, 0x77, 0x53, 0xb9, 0xa6, 0xd0,
+		0xc1, 0x30, 0xb9, 0xc8, 0x66, 0x0b, 0xbd, 0x5b, 0x72, 0x1e, 0x5e, 0x39, 0xde, 0x9e, 0xb7, 0x77,
+		0x37, 0x96, 0xbe, 0xaf, 0x2b, 0x5c, 0x2e, 0xc9, 0x11, 0x2a, 0xc9, 0x0c, 0x9a, 0x0a, 0x95, 0x84,
+		0x09, 0xf0, 0x47, 0x12, 0xec, 0x0b, 0xd9, 0x52, 0xa1, 0x73, 0x6d, 0x1b, 0x6c, 0xbf, 0x7f, 0x4b,
+		0xbf, 0x65, 0x77, 0xc4, 0x5c, 0xfc, 0xb7, 0x52, 0xf1, 0x33, 0xe8, 0x6c, 0x98, 0xf8, 0xad, 0x2f,
+		0x74, 0x6e, 0xee, 0x14, 0xb5, 0xf2, 0xc9, 0x77, 0x68, 0xe5, 0x17, 0xd1, 0x7f, 0x90, 0x20, 0x1d,
+		0xbe, 0xf1, 0x42, 0x8f, 0xf7, 0x2e, 0x9e, 0x77, 0xc7, 0x97, 0x7e, 0x62, 0xd7, 0xf4, 0xdd, 0xfa,
+		0x99, 0xd0, 0x1e, 0xd2, 0xcd, 0x25, 0x99, 0xab, 0xba, 0x51, 0x7b, 0x11, 0x7d, 0x45, 0x82, 0xfd,
+		0xa1, 0x7b, 0x35, 0xf4, 0x58, 0xaf, 0x62, 0xfa, 0xb6, 0x88, 0xe9, 0xc7, 0x77, 0x4b, 0xce, 0x3b,
+		0x39, 0x47, 0x3b, 0xf9, 0x18, 0x3a, 0xd7, 0x5b, 0x27, 0xc9, 0x4e, 0xb4, 0x7c, 0xf2, 0x1d, 0xe4,
+		0x8f, 0xf9, 0x22, 0xfa, 0x8c, 0x04, 0xe3, 0x4d, 0xbb, 0x3e, 0x74, 0xba, 0x83, 0x68, 0xad, 0xb7,
+		0x91, 0xe9, 0x33, 0xbd, 0x92, 0xf1, 0x9e, 0x3c, 0x44, 0x7b, 0x72, 0x02, 0xdd, 0x17, 0xde, 0x93,
+		0xa6, 0x0f, 0x53, 0x6f, 0xfb, 0x95, 0xb2, 0x3f, 0x18, 0x08, 0xbd, 0xe4, 0xa0, 0x36, 0xec, 0xed,
+		0x17, 0x76, 0xf7, 0x3e, 0xf8, 0xae, 0x3f, 0xf7, 0x94, 0xff, 0x65, 0x14, 0x10, 0xd5, 0x53, 0xb6,
+		0x61, 0x6f, 0x1b, 0xa6, 0xf6, 0x02, 0x8b, 0x2c, 0x31, 0x90, 0xb7, 0x97, 0xbc, 0x6f, 0x5d, 0xb4,
+		0xbd, 0xf9, 0x7d, 0xdf, 0xc7, 0xbf, 0x32, 0x7d, 0xb4, 0x8b, 0x3d, 0x3a, 0x41, 0x56, 0x12, 0x35,
+		0xf5, 0x3a, 0x7f, 0xe8, 0xe2, 0x32, 0x80, 0x5a, 0xad, 0x1a, 0xd7, 0x8a, 0x55, 0x92, 0x56, 0x63,
+		0x29, 0xcd, 0x50, 0xab, 0x68, 0x16, 0x73, 0xd6, 0xf3, 0x8b, 0x4a, 0x7d, 0x4a, 0x82, 0xb2, 0x5a,
+		0xd2, 0x2c, 0xf2, 0xc5, 0x40, 0xa2, 0x8c, 0xf5, 0x1d, 0xc6, 0x36, 0xfa, 0xe6, 0xd8, 0xc6, 0x09,
+		0x27, 0xca, 0xf5, 0x69, 0x40, 0xaa, 0x17, 0x8f, 0xfe, 0x0a, 0x2e, 0x7f, 0x9b, 0x38, 0x74, 0x85,
+		0xf1, 0x71, 0xa6, 0xbf, 0xb5, 0x31, 0xae, 0x06, 0x41, 0xe9, 0x23, 0xbe, 0x24, 0x85, 0xef, 0xab,
+		0xe6, 0xa8, 0xe7, 0xab, 0xe6, 0xcc, 0xf8, 0x1f, 0x7e, 0xea, 0xc4, 0x88, 0x8f, 0x63, 0x6e, 0xd8,
+		0x9b, 0x5e, 0x3e, 0xfe, 0xaa, 0x04, 0xe3, 0x4d, 0x2d, 0x22, 0x19, 0xa6, 0xb2, 0x1b, 0xeb, 0x17,
+		0x56, 0x95, 0xc5, 0x67, 0xb3, 0xeb, 0x8b, 0xab, 0x2b, 0xe2, 0xf1, 0x7e, 0xcf, 0xdb, 0x18, 0x68,
+		0x1a, 0x0e, 0xb4, 0xc0, 0x99, 0xcf, 0x2f, 0xe5, 0x17, 0xb2, 0xe4, 0xcd, 0x7e, 0x74, 0x17, 0x1c,
+		0x6a, 0xc9, 0xc4, 0x41, 0x89, 0x84, 0xa0, 0x28, 0x79, 0x07, 0x25, 0x7a, 0xbb, 0xe7, 0xd3, 0xff,
+		0x1b, 0x00, 0xf2, 0xaf, 0x0a, 0xae, 0x69, 0xb5, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2120,6 +2312,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MaxVotingPowerEnforcementThreshold.Equal(that1.MaxVotingPowerEnforcementThreshold) {
 		return false
 	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2176,6 +2377,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2395,6 +2629,26 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.ValidatorBondShares.Size()
+		i -= size
+		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	{
 		size := m.MinSelfDelegation.Size()
 		i -= size
@@ -2702,6 +2956,16 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatorBond {
+		i--
+		if m.ValidatorBond {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.Shares.Size()
 		i -= size
@@ -2970,6 +3234,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MaxVotingPowerEnforcementThreshold.Size()
 		i -= size
@@ -3209,6 +3503,55 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3329,6 +3672,10 @@ func (m *Validator) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.MinSelfDelegation.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3431,6 +3778,9 @@ func (m *Delegation) Size() (n int) {
 	}
 	l = m.Shares.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.ValidatorBond {
+		n += 2
+	}
 	return n
 }
 
@@ -3547,6 +3897,12 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.MaxVotingPowerEnforcementThreshold.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3606,6 +3962,30 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4581,6 +4961,74 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5239,6 +5687,26 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBond", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorBond = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6181,6 +6649,108 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6670,6 +7240,171 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
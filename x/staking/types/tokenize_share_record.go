@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the name of the module accounts holding the tokenized
+// delegations
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new tokenize share record of the tokenized delegation to a validator
+//
+//nolint:interfacer
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, validator sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator.String(),
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the tokenized delegation
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
+
+// Validate performs a stateless validation of the record
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid tokenize share record %d owner: %w", r.Id, err)
+	}
+	if r.ModuleAccount != fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, r.Id) {
+		return fmt.Errorf("invalid tokenize share record %d module account: %s", r.Id, r.ModuleAccount)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return fmt.Errorf("invalid tokenize share record %d validator: %w", r.Id, err)
+	}
+	return nil
+}
@@ -46,17 +46,19 @@ func NewValidator(operator sdk.ValAddress, pubKey cryptotypes.PubKey, descriptio
 	}
 
 	return Validator{
-		OperatorAddress:   operator.String(),
-		ConsensusPubkey:   pkAny,
-		Jailed:            false,
-		Status:            Unbonded,
-		Tokens:            sdk.ZeroInt(),
-		DelegatorShares:   sdk.ZeroDec(),
-		Description:       description,
-		UnbondingHeight:   int64(0),
-		UnbondingTime:     time.Unix(0, 0).UTC(),
-		Commission:        NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
-		MinSelfDelegation: sdk.OneInt(),
+		OperatorAddress:     operator.String(),
+		ConsensusPubkey:     pkAny,
+		Jailed:              false,
+		Status:              Unbonded,
+		Tokens:              sdk.ZeroInt(),
+		DelegatorShares:     sdk.ZeroDec(),
+		Description:         description,
+		UnbondingHeight:     int64(0),
+		UnbondingTime:       time.Unix(0, 0).UTC(),
+		Commission:          NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation:   sdk.OneInt(),
+		ValidatorBondShares: sdk.ZeroDec(),
+		LiquidShares:        sdk.ZeroDec(),
 	}, nil
 }
 